@@ -0,0 +1,180 @@
+package tcpguarder
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Allowlist 基于前缀树的IP白名单, 支持 IPv4 和 IPv6, 并发安全
+type Allowlist struct {
+	mu sync.RWMutex
+	v4 *trieNode
+	v6 *trieNode
+	n  int
+}
+
+type trieNode struct {
+	child [2]*trieNode
+	leaf  bool //该前缀在白名单中
+}
+
+func NewAllowlist() *Allowlist {
+	return &Allowlist{v4: &trieNode{}, v6: &trieNode{}}
+}
+
+func (a *Allowlist) root(ip net.IP) (*trieNode, net.IP) {
+	if v4 := ip.To4(); v4 != nil {
+		return a.v4, v4
+	}
+	return a.v6, ip.To16()
+}
+
+// prefix 返回 ipnet 所在的树, 地址和前缀长度,
+// IPv4 映射的 IPv6 前缀(如 ::ffff:10.0.0.0/104)按 IPv4 前缀处理
+func (a *Allowlist) prefix(ipnet *net.IPNet) (*trieNode, net.IP, int) {
+	ones, bits := ipnet.Mask.Size()
+	node, ip := a.root(ipnet.IP)
+	if len(ip) == net.IPv4len && bits == 8*net.IPv6len {
+		ones -= 96
+	}
+	if ones < 0 || ones > len(ip)*8 {
+		return nil, nil, 0
+	}
+	return node, ip, ones
+}
+
+func bit(ip net.IP, i int) int {
+	return int(ip[i/8]>>(7-uint(i%8))) & 1
+}
+
+// Add 添加网段, 无效的网段忽略
+func (a *Allowlist) Add(ipnet *net.IPNet) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	node, ip, ones := a.prefix(ipnet)
+	if node == nil {
+		return
+	}
+	for i := 0; i < ones; i++ {
+		b := bit(ip, i)
+		if node.child[b] == nil {
+			node.child[b] = &trieNode{}
+		}
+		node = node.child[b]
+	}
+	if !node.leaf {
+		node.leaf = true
+		a.n++
+	}
+}
+
+// Remove 删除与 ipnet 完全相同的条目, 不影响包含它的网段
+func (a *Allowlist) Remove(ipnet *net.IPNet) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	node, ip, ones := a.prefix(ipnet)
+	for i := 0; i < ones && node != nil; i++ {
+		node = node.child[bit(ip, i)]
+	}
+	if node == nil || !node.leaf {
+		return false
+	}
+	node.leaf = false
+	a.n--
+	return true
+}
+
+// AddIP 添加单个IP
+func (a *Allowlist) AddIP(ip net.IP) {
+	if v4 := ip.To4(); v4 != nil {
+		a.Add(&net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)})
+		return
+	}
+	a.Add(&net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)})
+}
+
+func (a *Allowlist) Contains(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	node, ip := a.root(ip)
+	for i := 0; node != nil; i++ {
+		if node.leaf {
+			return true
+		}
+		if i == len(ip)*8 {
+			break
+		}
+		node = node.child[bit(ip, i)]
+	}
+	return false
+}
+
+func (a *Allowlist) ContainsString(ip string) bool {
+	return a.Contains(net.ParseIP(ip))
+}
+
+// Len 白名单条目数
+func (a *Allowlist) Len() int {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.n
+}
+
+// Replace 用 b 的内容替换 a, 用于热加载
+func (a *Allowlist) Replace(b *Allowlist) {
+	b.mu.RLock()
+	v4, v6, n := b.v4, b.v6, b.n
+	b.mu.RUnlock()
+	a.mu.Lock()
+	a.v4, a.v6, a.n = v4, v6, n
+	a.mu.Unlock()
+}
+
+// ParseAllowlist 每行一个或多个 IP/CIDR, # 之后为注释,
+// 无效的条目跳过并在 error 中列出, 此时返回的白名单仍然可用
+func ParseAllowlist(r io.Reader) (*Allowlist, error) {
+	a := NewAllowlist()
+	scanner := bufio.NewScanner(r)
+	n := 0
+	var bad []string
+	for scanner.Scan() {
+		n++
+		line := scanner.Text()
+		if i := strings.Index(line, "#"); i >= 0 {
+			line = line[:i]
+		}
+		for _, v := range strings.Fields(line) {
+			ipnet := ParseIPNet(v)
+			if ipnet == nil {
+				bad = append(bad, fmt.Sprintf("line %v: bad ip %q", n, v))
+				continue
+			}
+			a.Add(ipnet)
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if len(bad) > 0 {
+		return a, errors.New(strings.Join(bad, "; "))
+	}
+	return a, nil
+}
+
+func LoadAllowlist(file string) (*Allowlist, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseAllowlist(f)
+}
@@ -0,0 +1,113 @@
+package tcpguarder
+
+import (
+	"net"
+	"strings"
+	"testing"
+)
+
+func TestAllowlistContains(t *testing.T) {
+	a, err := ParseAllowlist(strings.NewReader(`
+10.0.0.0/8 192.168.1.1 # comment
+2001:db8::/32
+::ffff:172.16.0.0/108
+::1
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.Len() != 5 {
+		t.Errorf("Len = %v, want 5", a.Len())
+	}
+	tests := []struct {
+		ip   string
+		want bool
+	}{
+		{"10.1.2.3", true},
+		{"::ffff:10.1.2.3", true},
+		{"11.0.0.1", false},
+		{"192.168.1.1", true},
+		{"192.168.1.2", false},
+		{"172.16.5.1", true},
+		{"::ffff:172.16.5.1", true},
+		{"172.31.0.1", true},
+		{"172.32.0.1", false},
+		{"2001:db8:1::1", true},
+		{"2001:db9::1", false},
+		{"::1", true},
+		{"::2", false},
+		{"bad", false},
+	}
+	for _, tt := range tests {
+		if got := a.ContainsString(tt.ip); got != tt.want {
+			t.Errorf("Contains(%v) = %v, want %v", tt.ip, got, tt.want)
+		}
+	}
+}
+
+func TestAllowlistMapped(t *testing.T) {
+	a := NewAllowlist()
+	for _, s := range []string{"::ffff:10.0.0.0/104", "::ffff:0:0/96", "::ffff:192.0.2.1/128"} {
+		_, ipnet, err := net.ParseCIDR(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		a.Add(ipnet)
+		if !a.Contains(ipnet.IP) {
+			t.Errorf("%v: network address not contained", s)
+		}
+		if !a.Remove(ipnet) {
+			t.Errorf("Remove(%v) = false", s)
+		}
+	}
+	if a.Len() != 0 {
+		t.Errorf("Len = %v after remove, want 0", a.Len())
+	}
+	_, all, _ := net.ParseCIDR("::ffff:0:0/96")
+	a.Add(all)
+	if !a.ContainsString("198.51.100.1") || a.ContainsString("2001:db8::1") {
+		t.Error("::ffff:0:0/96 should contain all of IPv4 and no IPv6")
+	}
+	//不属于 IPv4 映射范围的 IPv6 前缀不能误入 IPv4 树
+	_, v6, _ := net.ParseCIDR("::/80")
+	a.Add(v6)
+	if !a.ContainsString("::1") {
+		t.Error("::/80 not added")
+	}
+}
+
+func TestAllowlistRemove(t *testing.T) {
+	a := NewAllowlist()
+	wide := ParseIPNet("10.0.0.0/8")
+	narrow := ParseIPNet("10.1.0.0/16")
+	v6 := ParseIPNet("2001:db8::/32")
+	a.Add(wide)
+	a.Add(narrow)
+	a.Add(v6)
+	if a.Remove(ParseIPNet("10.2.0.0/16")) {
+		t.Error("Remove of a prefix never added = true")
+	}
+	if !a.Remove(narrow) || !a.ContainsString("10.1.0.1") {
+		t.Error("removing 10.1.0.0/16 must keep 10.0.0.0/8")
+	}
+	if a.Remove(narrow) {
+		t.Error("second Remove = true")
+	}
+	if !a.Remove(wide) || a.ContainsString("10.1.0.1") {
+		t.Error("10.0.0.0/8 not removed")
+	}
+	if !a.Remove(v6) || a.ContainsString("2001:db8::1") || a.Len() != 0 {
+		t.Error("2001:db8::/32 not removed")
+	}
+}
+
+func TestParseAllowlistError(t *testing.T) {
+	a, err := ParseAllowlist(strings.NewReader("10.0.0.1\n10.0.0.0/33 192.168.0.0/16\nbad\n"))
+	if err == nil || err.Error() != `line 2: bad ip "10.0.0.0/33"; line 3: bad ip "bad"` {
+		t.Errorf("err = %v", err)
+	}
+	//无效的条目跳过, 其余的保留
+	if a == nil || a.Len() != 2 || !a.ContainsString("10.0.0.1") || !a.ContainsString("192.168.1.1") {
+		t.Errorf("bad entries must not drop the rest: %v", a)
+	}
+}
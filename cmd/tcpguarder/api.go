@@ -0,0 +1,307 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+)
+
+// controlState run 的运行状态, 由控制接口查询和修改
+type controlState struct {
+	mu      sync.Mutex
+	paused  bool
+	updated time.Time
+	stats   []tcpguarder.ConnStat
+	filter  tcpguarder.Filter
+	rules   tcpguarder.RuleSet
+	ipset   string
+	timeout int
+	sets    []string
+	white   map[string]*net.IPNet //接口添加的白名单, 重新加载白名单文件时保留
+}
+
+var control = &controlState{white: make(map[string]*net.IPNet)}
+
+// controlSocket run 监听的控制接口
+var controlSocket string
+
+// update 保存最近一次检测的连接和参数
+func (s *controlState) update(g *guard, stats []tcpguarder.ConnStat) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.updated = time.Now()
+	s.stats = stats
+	s.filter = g.filter
+	s.rules = g.rules
+	s.ipset = g.ipset
+	s.timeout = g.timeout
+	s.sets = ipsetNames(g.c)
+}
+
+func (s *controlState) isPaused() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.paused
+}
+
+func (s *controlState) setPaused(paused bool) {
+	s.mu.Lock()
+	s.paused = paused
+	s.mu.Unlock()
+}
+
+// whiteNets 接口添加的白名单
+func (s *controlState) whiteNets() []*net.IPNet {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	nets := make([]*net.IPNet, 0, len(s.white))
+	for _, v := range s.white {
+		nets = append(nets, v)
+	}
+	return nets
+}
+
+// apiRequest 控制接口的请求
+type apiRequest struct {
+	IP      string `json:"ip"`
+	Set     string `json:"set,omitempty"`
+	Timeout int    `json:"timeout,omitempty"`
+}
+
+type apiStatus struct {
+	Paused      bool      `json:"paused"`
+	Updated     time.Time `json:"updated"`
+	Connections int       `json:"connections"`
+	Bans        int       `json:"bans"`
+	Sets        []string  `json:"sets"`
+	Whitelist   int       `json:"whitelist"`
+}
+
+type topItem struct {
+	IP  string `json:"ip"`
+	N   int    `json:"n"`
+	Geo string `json:"geo,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, code int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, code int, err error) {
+	writeJSON(w, code, map[string]string{"error": err.Error()})
+}
+
+// apiHandler 检查请求方法, POST 时解析 apiRequest
+func apiHandler(method string, fn func(w http.ResponseWriter, r *http.Request, req apiRequest)) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("%v only", method))
+			return
+		}
+		var req apiRequest
+		if method == http.MethodPost {
+			if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+				writeError(w, http.StatusBadRequest, err)
+				return
+			}
+		}
+		fn(w, r, req)
+	}
+}
+
+func apiStatusHandler(w http.ResponseWriter, r *http.Request, req apiRequest) {
+	control.mu.Lock()
+	status := apiStatus{
+		Paused:      control.paused,
+		Updated:     control.updated,
+		Connections: len(control.stats),
+		Sets:        control.sets,
+	}
+	control.mu.Unlock()
+	status.Bans = len(bans.list())
+	status.Whitelist = whitelist.Len()
+	writeJSON(w, http.StatusOK, status)
+}
+
+// apiTopHandler ?n=10 条数, ?ab=1 按异常分数
+func apiTopHandler(w http.ResponseWriter, r *http.Request, req apiRequest) {
+	n, err := strconv.Atoi(r.URL.Query().Get("n"))
+	if err != nil || n <= 0 {
+		n = 10
+	}
+	control.mu.Lock()
+	stats, filter, rules := control.stats, control.filter, control.rules
+	control.mu.Unlock()
+	var ss []tcpguarder.CountItem
+	if ab, _ := strconv.ParseBool(r.URL.Query().Get("ab")); ab {
+		ss = rules.TopFilter(stats, filter)
+	} else {
+		ss = tcpguarder.TopFilter(stats, filter)
+	}
+	if len(ss) > n {
+		ss = ss[:n]
+	}
+	items := make([]topItem, 0, len(ss))
+	for _, v := range ss {
+		items = append(items, topItem{IP: v.Key, N: v.N, Geo: geoinfo(v.Key)})
+	}
+	writeJSON(w, http.StatusOK, items)
+}
+
+func apiBansHandler(w http.ResponseWriter, r *http.Request, req apiRequest) {
+	writeJSON(w, http.StatusOK, bans.list())
+}
+
+func apiBanHandler(w http.ResponseWriter, r *http.Request, req apiRequest) {
+	if ip := net.ParseIP(req.IP); ip == nil || ip.To4() == nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("bad ip %q, only ipv4 can be banned", req.IP))
+		return
+	}
+	if whitelist.ContainsString(req.IP) {
+		writeError(w, http.StatusConflict, fmt.Errorf("%v is in white list", req.IP))
+		return
+	}
+	control.mu.Lock()
+	if req.Set == "" {
+		req.Set = control.ipset
+	}
+	if req.Timeout == 0 {
+		req.Timeout = control.timeout
+	}
+	sets := control.sets
+	control.mu.Unlock()
+	//只能加入 run 使用的 ipset, 名字会拼进 ipset 命令行
+	if !hasString(sets, req.Set) {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("unknown ipset %q, want one of %v", req.Set, sets))
+		return
+	}
+	if req.Timeout < 0 || req.Timeout > 2147483 {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("timeout must be 0-2147483"))
+		return
+	}
+	b := banRequest{IP: req.IP, Set: req.Set, Timeout: req.Timeout, Exist: true, Rule: "manual", Text: []interface{}{"manual"}}
+	if err := ipsetAdd(req.Set, req.IP, req.Timeout, true); err != nil {
+		events.emit(b.event(EventBanFailed, err.Error()), "block failed", req.IP, "manual", err)
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	banned(b)
+	writeJSON(w, http.StatusOK, req)
+}
+
+// apiUnbanHandler 从封禁表记录的 ipset 和 run 使用的所有 ipset 中删除
+func apiUnbanHandler(w http.ResponseWriter, r *http.Request, req apiRequest) {
+	if ip := net.ParseIP(req.IP); ip == nil || ip.To4() == nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("bad ip %q, only ipv4 can be banned", req.IP))
+		return
+	}
+	control.mu.Lock()
+	sets := append([]string(nil), control.sets...)
+	control.mu.Unlock()
+	for _, v := range bans.remove(req.IP) {
+		if !hasString(sets, v.Set) {
+			sets = append(sets, v.Set)
+		}
+	}
+	var removed []string
+	for _, set := range sets {
+		if tcpguarder.NewCmd(fmt.Sprintf("ipset del %v %v", set, req.IP)).Run() == nil {
+			removed = append(removed, set)
+		}
+	}
+	if len(removed) == 0 {
+		writeError(w, http.StatusNotFound, fmt.Errorf("%v not in %v", req.IP, sets))
+		return
+	}
+	for _, set := range removed {
+		events.emit(Event{Event: EventUnban, IP: req.IP, Rule: "manual", Backend: "ipset:" + set, Reason: "api"},
+			"unblock", req.IP, set, "api")
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"ip": req.IP, "sets": removed})
+}
+
+func apiWhiteAddHandler(w http.ResponseWriter, r *http.Request, req apiRequest) {
+	ipnet := tcpguarder.ParseIPNet(req.IP)
+	if ipnet == nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("bad ip %q", req.IP))
+		return
+	}
+	control.mu.Lock()
+	control.white[ipnet.String()] = ipnet
+	control.mu.Unlock()
+	whitelist.Add(ipnet)
+	log.Println("white ip add", ipnet)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"ip": ipnet.String(), "whitelist": whitelist.Len()})
+}
+
+// apiWhiteRemoveHandler 只删除完全相同的条目, 白名单文件中的条目在重新加载文件后恢复
+func apiWhiteRemoveHandler(w http.ResponseWriter, r *http.Request, req apiRequest) {
+	ipnet := tcpguarder.ParseIPNet(req.IP)
+	if ipnet == nil {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("bad ip %q", req.IP))
+		return
+	}
+	control.mu.Lock()
+	delete(control.white, ipnet.String())
+	control.mu.Unlock()
+	if !whitelist.Remove(ipnet) {
+		writeError(w, http.StatusNotFound, fmt.Errorf("%v not in white list", ipnet))
+		return
+	}
+	log.Println("white ip remove", ipnet)
+	writeJSON(w, http.StatusOK, map[string]interface{}{"ip": ipnet.String(), "whitelist": whitelist.Len()})
+}
+
+func apiPauseHandler(paused bool) func(w http.ResponseWriter, r *http.Request, req apiRequest) {
+	return func(w http.ResponseWriter, r *http.Request, req apiRequest) {
+		control.setPaused(paused)
+		log.Println("paused:", paused)
+		writeJSON(w, http.StatusOK, map[string]bool{"paused": paused})
+	}
+}
+
+// serveControl 在 unix socket 上提供控制接口, 只有 root 可以访问
+func serveControl(socket string) error {
+	if fi, err := os.Stat(socket); err == nil && fi.Mode()&os.ModeSocket == 0 {
+		return fmt.Errorf("%v: exists and is not a socket", socket)
+	}
+	//pid 文件保证只有一个实例, 残留的 socket 可以删除
+	os.Remove(socket)
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		return err
+	}
+	if err := os.Chmod(socket, 0600); err != nil {
+		l.Close()
+		return err
+	}
+	controlSocket = socket
+	mux := http.NewServeMux()
+	mux.Handle("/status", apiHandler(http.MethodGet, apiStatusHandler))
+	mux.Handle("/top", apiHandler(http.MethodGet, apiTopHandler))
+	mux.Handle("/bans", apiHandler(http.MethodGet, apiBansHandler))
+	mux.Handle("/ban", apiHandler(http.MethodPost, apiBanHandler))
+	mux.Handle("/unban", apiHandler(http.MethodPost, apiUnbanHandler))
+	mux.Handle("/whitelist/add", apiHandler(http.MethodPost, apiWhiteAddHandler))
+	mux.Handle("/whitelist/remove", apiHandler(http.MethodPost, apiWhiteRemoveHandler))
+	mux.Handle("/pause", apiHandler(http.MethodPost, apiPauseHandler(true)))
+	mux.Handle("/resume", apiHandler(http.MethodPost, apiPauseHandler(false)))
+	fmt.Println("control api on", socket)
+	go func() {
+		if err := http.Serve(l, mux); err != nil && !errors.Is(err, net.ErrClosed) {
+			log.Println("control api:", err)
+		}
+	}()
+	return nil
+}
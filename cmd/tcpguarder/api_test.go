@@ -0,0 +1,34 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAPIBanRejects(t *testing.T) {
+	control.mu.Lock()
+	control.ipset, control.timeout, control.sets = "blackhold", 600, []string{"blackhold", "sshban"}
+	control.mu.Unlock()
+	tests := []struct {
+		req apiRequest
+		err string
+	}{
+		{apiRequest{IP: "bad"}, `bad ip "bad"`},
+		{apiRequest{IP: "2001:db8::1"}, `bad ip "2001:db8::1"`},
+		{apiRequest{IP: "203.0.113.9", Set: "blackhold -exist; x"}, `unknown ipset "blackhold -exist; x"`},
+		{apiRequest{IP: "203.0.113.9", Set: "other"}, `unknown ipset "other"`},
+		{apiRequest{IP: "203.0.113.9", Set: "sshban", Timeout: -1}, "timeout must be 0-2147483"},
+	}
+	for _, tt := range tests {
+		w := httptest.NewRecorder()
+		apiBanHandler(w, httptest.NewRequest(http.MethodPost, "/ban", nil), tt.req)
+		var resp struct{ Error string }
+		json.Unmarshal(w.Body.Bytes(), &resp)
+		if w.Code != http.StatusBadRequest || !strings.HasPrefix(resp.Error, tt.err) {
+			t.Errorf("%+v: %v %v, want 400 %v", tt.req, w.Code, resp.Error, tt.err)
+		}
+	}
+}
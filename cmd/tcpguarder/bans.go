@@ -0,0 +1,107 @@
+package main
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Ban run 封禁的IP, ipset 的超时由内核处理, 这里只用于查询
+type Ban struct {
+	IP      string     `json:"ip"`
+	Set     string     `json:"set"`
+	Rule    string     `json:"rule"`
+	Time    time.Time  `json:"time"`
+	Expires *time.Time `json:"expires"`          //nil 为不过期
+	Origin  string     `json:"origin,omitempty"` //其他主机同步来的封禁
+}
+
+func (b Ban) expired(now time.Time) bool {
+	return b.Expires != nil && now.After(*b.Expires)
+}
+
+type banTable struct {
+	mu   sync.Mutex
+	bans map[[2]string]Ban //set, ip
+}
+
+var bans = &banTable{bans: make(map[[2]string]Ban)}
+
+func (t *banTable) add(b Ban) {
+	t.mu.Lock()
+	t.bans[[2]string{b.Set, b.IP}] = b
+	t.mu.Unlock()
+}
+
+// refresh 已封禁的IP重新设置了超时, 更新过期时间, 封禁时间不变
+func (t *banTable) refresh(r banRequest) {
+	now := time.Now()
+	b := Ban{IP: r.IP, Set: r.Set, Rule: r.Rule, Time: now, Origin: r.Origin}
+	if r.Timeout > 0 {
+		expires := now.Add(time.Duration(r.Timeout) * time.Second)
+		b.Expires = &expires
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if old, ok := t.bans[[2]string{b.Set, b.IP}]; ok {
+		b.Time, b.Rule, b.Origin = old.Time, old.Rule, old.Origin
+	}
+	t.bans[[2]string{b.Set, b.IP}] = b
+}
+
+// remove 删除 ip 在所有 ipset 中的记录
+func (t *banTable) remove(ip string) []Ban {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var removed []Ban
+	for k, v := range t.bans {
+		if v.IP == ip {
+			removed = append(removed, v)
+			delete(t.bans, k)
+		}
+	}
+	return removed
+}
+
+// expire 删除并返回已过期的封禁
+func (t *banTable) expire(now time.Time) []Ban {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var expired []Ban
+	for k, v := range t.bans {
+		if v.expired(now) {
+			expired = append(expired, v)
+			delete(t.bans, k)
+		}
+	}
+	return expired
+}
+
+// list 未过期的封禁, 按时间排序
+func (t *banTable) list() []Ban {
+	now := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	list := make([]Ban, 0, len(t.bans))
+	for _, v := range t.bans {
+		if !v.expired(now) {
+			list = append(list, v)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].Time.Before(list[j].Time)
+	})
+	return list
+}
+
+// banned 封禁成功后记录事件, 指标和封禁表, timeout 为 0 时不过期
+func banned(r banRequest) {
+	events.emit(r.event(EventBan, ""), blocktext(r.IP, r.Text...)...)
+	runMetrics.ban(r.Rule)
+	b := Ban{IP: r.IP, Set: r.Set, Rule: r.Rule, Time: time.Now(), Origin: r.Origin}
+	if r.Timeout > 0 {
+		expires := b.Time.Add(time.Duration(r.Timeout) * time.Second)
+		b.Expires = &expires
+	}
+	bans.add(b)
+}
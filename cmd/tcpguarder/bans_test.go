@@ -0,0 +1,86 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// fakeIPSet 在 PATH 前面放一个 ipset 脚本, 记录参数, 已加入的IP再次 add 时报 already added
+func fakeIPSet(t *testing.T) (logfile string, cleanup func()) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	logfile = filepath.Join(dir, "ipset.log")
+	script := `#!/bin/sh
+echo "$*" >> ` + logfile + `
+if [ "$1" = add ]; then
+	if [ -e "` + dir + `/$3" ]; then
+		echo "ipset v7.1: Element cannot be added to the set: it's already added" >&2
+		exit 1
+	fi
+	touch "` + dir + `/$3"
+fi
+exit 0
+`
+	if err := ioutil.WriteFile(filepath.Join(dir, "ipset"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+path)
+	return logfile, func() {
+		os.Setenv("PATH", path)
+		os.RemoveAll(dir)
+	}
+}
+
+func TestBanRefresh(t *testing.T) {
+	logfile, cleanup := fakeIPSet(t)
+	defer cleanup()
+	count := func() uint64 {
+		runMetrics.Lock()
+		defer runMetrics.Unlock()
+		return runMetrics.bans["trap-test"]
+	}
+	before := count()
+	r := banRequest{IP: "203.0.113.77", Set: "blackhold", Timeout: 60, Exist: true, Rule: "trap-test", Origin: "test"}
+	for i := 0; i < 3; i++ {
+		if !ban(r) {
+			t.Fatalf("ban %v = false", i)
+		}
+	}
+	//只有第一次加入记录封禁, 之后只刷新超时
+	if n := count() - before; n != 1 {
+		t.Errorf("ban events = %v, want 1", n)
+	}
+	b, err := ioutil.ReadFile(logfile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := strings.Repeat("add blackhold 203.0.113.77 timeout 60\n-exist add blackhold 203.0.113.77 timeout 60\n", 2)
+	if got := string(b); got != "add blackhold 203.0.113.77 timeout 60\n"+want {
+		t.Errorf("ipset calls:\n%v", got)
+	}
+	//不刷新的封禁再次加入时视为已封禁
+	r.Exist = false
+	if ban(r) || count()-before != 1 {
+		t.Error("ban without Exist of a banned ip want false and no event")
+	}
+	bans.remove(r.IP)
+}
+
+func TestBanIPv6(t *testing.T) {
+	logfile, cleanup := fakeIPSet(t)
+	defer cleanup()
+	//-source /proc/net/tcp6 读到的 IPv6 地址不能进 inet 类型的 ipset
+	r := banRequest{IP: "2001:db8::1", Set: "blackhold", Timeout: 60, Rule: "v6-test", Origin: "test"}
+	if ban(r) {
+		t.Error("ban of ipv6 = true")
+	}
+	if b, _ := ioutil.ReadFile(logfile); len(b) > 0 {
+		t.Errorf("ipset called for ipv6:\n%s", b)
+	}
+}
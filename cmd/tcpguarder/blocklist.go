@@ -0,0 +1,102 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder/cmd/tcpguarder/iplib"
+	"github.com/urfave/cli/v2"
+)
+
+func BlocklistAction(c *cli.Context) error {
+	files := c.Args().Slice()
+	if len(files) == 0 {
+		return errors.New("usage: blocklist [-name NAME] [-every DURATION] FILE...")
+	}
+	name := c.String("name")
+	//IPv6 写入 name6, 先检查长度, 避免只替换了 IPv4
+	if err := checkRestoreName(name + "6"); err != nil {
+		return err
+	}
+	fmt.Println("please confirm the following iptable is in effect")
+	fmt.Printf("iptables -I INPUT -m set --match-set %v src -j DROP\n", name)
+	fmt.Printf("ip6tables -I INPUT -m set --match-set %v6 src -j DROP\n", name)
+	if err := syncBlocklist(name, files); err != nil {
+		return err
+	}
+	every := c.Duration("every")
+	if every <= 0 {
+		return nil
+	}
+	fmt.Printf("refresh every %v\n", every)
+	tk := time.NewTicker(every)
+	defer tk.Stop()
+	refreshBlocklist(name, files, tk.C)
+	return nil
+}
+
+// refreshBlocklist 每次 tick 重新同步, 失败时保留 ipset 中原来的内容
+func refreshBlocklist(name string, files []string, tick <-chan time.Time) {
+	for range tick {
+		if err := syncBlocklist(name, files); err != nil {
+			log.Println(err)
+		}
+	}
+}
+
+// blocklistTimeout 下载 http(s) 列表的超时
+const blocklistTimeout = 30 * time.Second
+
+// openBlocklist http:// 或 https:// 开头时下载, 否则打开本地文件
+func openBlocklist(file string) (io.ReadCloser, error) {
+	if !strings.HasPrefix(file, "http://") && !strings.HasPrefix(file, "https://") {
+		return os.Open(file)
+	}
+	client := &http.Client{Timeout: blocklistTimeout}
+	resp, err := client.Get(file)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%v: %v", file, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// syncBlocklist 合并去重所有文件后原子替换 ipset name(IPv4) 和 name6(IPv6)
+func syncBlocklist(name string, files []string) error {
+	var nets []*net.IPNet
+	for _, file := range files {
+		f, err := openBlocklist(file)
+		if err != nil {
+			return err
+		}
+		list, errs, err := iplib.ParseCIDRList(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%v: %v", file, err)
+		}
+		for _, e := range errs {
+			log.Printf("%v: %v\n", file, e)
+		}
+		log.Printf("%v: %v entries\n", file, len(list))
+		nets = append(nets, list...)
+	}
+	v4, v6 := iplib.SplitFamily(iplib.Aggregate(nets))
+	if err := restoreipset(name, "inet", netsToStrings(v4)); err != nil {
+		return err
+	}
+	if err := restoreipset(name+"6", "inet6", netsToStrings(v6)); err != nil {
+		return err
+	}
+	log.Printf("ipset %v: %v cidr, %v6: %v cidr\n", name, len(v4), name, len(v6))
+	return nil
+}
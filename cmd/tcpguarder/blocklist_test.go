@@ -0,0 +1,89 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBlocklistFeed(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	out := filepath.Join(dir, "restore")
+	script := "#!/bin/sh\ncat >> " + out + "\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "ipset"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	var mu sync.Mutex
+	feed := `; Spamhaus DROP List
+192.0.2.0/24 ; SBL1
+198.51.100.0/25 ; SBL2
+198.51.100.128/25 ; SBL3
+2001:db8::/32 ; SBL4
+`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/drop.txt" {
+			http.NotFound(w, r)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		w.Write([]byte(feed))
+	}))
+	defer ts.Close()
+	local := filepath.Join(dir, "firehol.netset")
+	if err := ioutil.WriteFile(local, []byte("# firehol\n203.0.113.7\n192.0.2.0/25\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	files := []string{ts.URL + "/drop.txt", local}
+
+	if err := syncBlocklist("drop", files); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadFile(out)
+	for _, v := range []string{
+		"add drop-tmp 192.0.2.0/24 -exist\nadd drop-tmp 198.51.100.0/24 -exist\nadd drop-tmp 203.0.113.7/32 -exist\nswap drop-tmp drop\n",
+		"create drop6 hash:net family inet6 ",
+		"add drop6-tmp 2001:db8::/32 -exist\nswap drop6-tmp drop6\n",
+	} {
+		if !strings.Contains(string(b), v) {
+			t.Errorf("ipset restore input missing %q:\n%s", v, b)
+		}
+	}
+
+	//-every 时重新下载, 使用新的内容
+	os.Remove(out)
+	mu.Lock()
+	feed = "192.0.2.0/24\n"
+	mu.Unlock()
+	tick := make(chan time.Time, 1)
+	tick <- time.Now()
+	close(tick)
+	refreshBlocklist("drop", files, tick)
+	b, _ = ioutil.ReadFile(out)
+	if !strings.Contains(string(b), "add drop-tmp 192.0.2.0/24 -exist\nadd drop-tmp 203.0.113.7/32 -exist\nswap") ||
+		strings.Contains(string(b), "198.51.100.0") || strings.Contains(string(b), "add drop6-tmp") {
+		t.Errorf("refresh ipset restore input:\n%s", b)
+	}
+
+	//下载失败时不替换 ipset
+	os.Remove(out)
+	if err := syncBlocklist("drop", []string{ts.URL + "/nosuch.txt"}); err == nil || !strings.Contains(err.Error(), "404") {
+		t.Errorf("syncBlocklist 404 = %v", err)
+	}
+	if _, err := os.Stat(out); err == nil {
+		t.Error("ipset restored after a failed download")
+	}
+}
@@ -0,0 +1,64 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+
+	"github.com/lixiangzhong/tcpguarder/cmd/tcpguarder/iplib"
+	"github.com/urfave/cli/v2"
+)
+
+func CIDRCheckAction(c *cli.Context) error {
+	if c.NArg() == 0 {
+		return errors.New("usage: cidr [-out FILE] FILE...")
+	}
+	bad := 0
+	var nets []*net.IPNet
+	for _, file := range c.Args().Slice() {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		list, errs, err := iplib.ParseCIDRList(f)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%v: %v", file, err)
+		}
+		for _, e := range errs {
+			fmt.Printf("%v: %v\n", file, e)
+		}
+		bad += len(errs)
+		minimised := iplib.Aggregate(list)
+		fmt.Printf("%v: cidr: %v minimised: %v\n", file, len(list), len(minimised))
+		nets = append(nets, list...)
+	}
+	all := netsToStrings(iplib.Aggregate(nets))
+	if c.NArg() > 1 {
+		fmt.Println("total minimised:", len(all))
+	}
+	if out := c.String("out"); out != "" {
+		f, err := os.Create(out)
+		if err != nil {
+			return err
+		}
+		w := bufio.NewWriter(f)
+		for _, v := range all {
+			fmt.Fprintln(w, v)
+		}
+		if err := w.Flush(); err != nil {
+			f.Close()
+			return err
+		}
+		if err := f.Close(); err != nil {
+			return err
+		}
+		fmt.Println("write minimised list:", out)
+	}
+	if bad > 0 {
+		return fmt.Errorf("%v bad cidr", bad)
+	}
+	return nil
+}
@@ -0,0 +1,540 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
+)
+
+// Config 配置文件, 命令行参数优先于配置文件
+//
+//	sources: [/proc/net/tcp, /proc/net/tcp6]
+//	ports: [80, 443]
+//	filter: exclude time-wait
+//	every: 3s
+//	kill: 200
+//	kill_score: 50
+//	kill_country: {CN: 500, "*": 50}
+//	port_groups: {web: [80, 443, 8080]}
+//	policies:
+//	  - {name: ssh, ports: [22], kill: 10, ipset: sshban, timeout: 3600}
+//	  - {name: web, ports: [web], kill: 300}
+//	rules:
+//	  - {name: closing, weight: 1, expr: state CLOSING or state FIN_WAIT1}
+//	scan: {ports: 20, window: 1m}
+//	trap: {ports: [23, 3389], timeout: 86400}
+//	whitelist:
+//	  file: whiteip.txt
+//	  auto: true
+//	  ssh_ports: [22]
+//	  crawlers: [googlebot.com, search.msn.com]
+//	ipset: {name: blackhold, timeout: 600}
+//	geoip: {country: GeoLite2-Country.mmdb, asn: GeoLite2-ASN.mmdb}
+//	daemon: {pid_file: /var/run/tcpguarder.pid, cleanup: true, socket: /var/run/tcpguarder.sock}
+//	metrics: {listen: ":9107", top: 10}
+//	log: {format: json, output: journald}
+//	hooks:
+//	  - url: https://hooks.example.com/tcpguarder
+//	    events: [ban, unban]
+//	    body: '{"text": {{printf "%s %s %s" .Event .IP .Rule | json}}}'
+//	    headers: {Authorization: Bearer xxx}
+//	    timeout: 5s
+//	    retries: 3
+//	  - {exec: /usr/local/bin/cdn-block, events: [ban]}
+//	mail:
+//	  smtp: smtp.example.com:587
+//	  user: tcpguarder
+//	  password: xxx
+//	  from: tcpguarder@example.com
+//	  to: [ops@example.com]
+//	  every: 1h
+//	  burst: 100
+//	  min_interval: 10m
+//	peers:
+//	  name: web1
+//	  listen: ":9108"
+//	  secret: xxxxxxxxxxxxxxxx
+//	  urls: [http://10.0.0.2:9108, http://10.0.0.3:9108]
+type Config struct {
+	Sources     []string         `yaml:"sources"`
+	Ports       []int            `yaml:"ports"`
+	Filter      string           `yaml:"filter"`
+	Every       time.Duration    `yaml:"every"`
+	Top         int              `yaml:"top"`
+	Kill        int              `yaml:"kill"`
+	KillScore   int              `yaml:"kill_score"`
+	KillCountry map[string]int   `yaml:"kill_country"`
+	PortGroups  map[string][]int `yaml:"port_groups"`
+	Policies    []PolicyConfig   `yaml:"policies"`
+	RulesFile   string           `yaml:"rules_file"`
+	Rules       []RuleConfig     `yaml:"rules"`
+	Scan        struct {
+		Ports  int           `yaml:"ports"`
+		Window time.Duration `yaml:"window"`
+	} `yaml:"scan"`
+	Trap struct {
+		Ports   []int `yaml:"ports"`
+		Timeout int   `yaml:"timeout"`
+	} `yaml:"trap"`
+	Whitelist struct {
+		File       string        `yaml:"file"`
+		Auto       *bool         `yaml:"auto"`
+		SSHPorts   []int         `yaml:"ssh_ports"`
+		Crawlers   []string      `yaml:"crawlers"`
+		CrawlerDNS string        `yaml:"crawler_dns"`
+		CrawlerTTL time.Duration `yaml:"crawler_ttl"`
+	} `yaml:"whitelist"`
+	IPSet struct {
+		Name    string `yaml:"name"`
+		Timeout int    `yaml:"timeout"`
+	} `yaml:"ipset"`
+	GeoIP struct {
+		Country string `yaml:"country"`
+		ASN     string `yaml:"asn"`
+	} `yaml:"geoip"`
+	Daemon struct {
+		PidFile string `yaml:"pid_file"`
+		Cleanup bool   `yaml:"cleanup"`
+		Socket  string `yaml:"socket"`
+	} `yaml:"daemon"`
+	Metrics struct {
+		Listen string `yaml:"listen"`
+		Top    int    `yaml:"top"`
+	} `yaml:"metrics"`
+	Log struct {
+		Format string `yaml:"format"`
+		Output string `yaml:"output"`
+	} `yaml:"log"`
+	Hooks []HookConfig `yaml:"hooks"`
+	Mail  struct {
+		SMTP        string        `yaml:"smtp"`
+		User        string        `yaml:"user"`
+		Password    string        `yaml:"password"`
+		From        string        `yaml:"from"`
+		To          []string      `yaml:"to"`
+		Every       time.Duration `yaml:"every"`
+		Burst       int           `yaml:"burst"`
+		MinInterval time.Duration `yaml:"min_interval"`
+	} `yaml:"mail"`
+	Peers struct {
+		Name   string   `yaml:"name"`
+		Listen string   `yaml:"listen"`
+		Secret string   `yaml:"secret"`
+		URLs   []string `yaml:"urls"`
+	} `yaml:"peers"`
+}
+
+type RuleConfig struct {
+	Name   string `yaml:"name"`
+	Weight int    `yaml:"weight"`
+	Expr   string `yaml:"expr"`
+}
+
+// config 当前加载的配置文件, 未指定 -config 时为空配置
+var config = &Config{}
+
+func LoadConfig(file string) (*Config, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	var cfg Config
+	if err := yaml.UnmarshalStrict(b, &cfg); err != nil {
+		return nil, fmt.Errorf("%v: %v", file, err)
+	}
+	return &cfg, nil
+}
+
+// flagValues 配置项对应的命令行参数
+func (cfg *Config) flagValues() map[string][]string {
+	m := make(map[string][]string)
+	str := func(name, v string) {
+		if v != "" {
+			m[name] = []string{v}
+		}
+	}
+	num := func(name string, v int) {
+		if v != 0 {
+			m[name] = []string{strconv.Itoa(v)}
+		}
+	}
+	nums := func(name string, v []int) {
+		for _, n := range v {
+			m[name] = append(m[name], strconv.Itoa(n))
+		}
+	}
+	dur := func(name string, v time.Duration) {
+		if v != 0 {
+			m[name] = []string{v.String()}
+		}
+	}
+	m["source"] = cfg.Sources
+	nums("port", cfg.Ports)
+	str("filter", cfg.Filter)
+	dur("duration", cfg.Every)
+	num("top", cfg.Top)
+	num("kill", cfg.Kill)
+	num("kill-score", cfg.KillScore)
+	for cc, n := range cfg.KillCountry {
+		m["kill-country"] = append(m["kill-country"], cc+"="+strconv.Itoa(n))
+	}
+	str("rules", cfg.RulesFile)
+	num("scan", cfg.Scan.Ports)
+	dur("scan-window", cfg.Scan.Window)
+	nums("trap", cfg.Trap.Ports)
+	num("trap-timeout", cfg.Trap.Timeout)
+	str("white", cfg.Whitelist.File)
+	if cfg.Whitelist.Auto != nil {
+		m["auto-white"] = []string{strconv.FormatBool(*cfg.Whitelist.Auto)}
+	}
+	nums("ssh-port", cfg.Whitelist.SSHPorts)
+	m["crawler"] = cfg.Whitelist.Crawlers
+	str("crawler-dns", cfg.Whitelist.CrawlerDNS)
+	dur("crawler-ttl", cfg.Whitelist.CrawlerTTL)
+	str("ipset", cfg.IPSet.Name)
+	num("timeout", cfg.IPSet.Timeout)
+	str("geoip-country", cfg.GeoIP.Country)
+	str("geoip-asn", cfg.GeoIP.ASN)
+	str("pid-file", cfg.Daemon.PidFile)
+	if cfg.Daemon.Cleanup {
+		m["cleanup"] = []string{"true"}
+	}
+	str("socket", cfg.Daemon.Socket)
+	str("metrics", cfg.Metrics.Listen)
+	num("metrics-top", cfg.Metrics.Top)
+	str("log-format", cfg.Log.Format)
+	str("log-output", cfg.Log.Output)
+	str("mail-smtp", cfg.Mail.SMTP)
+	str("mail-user", cfg.Mail.User)
+	str("mail-password", cfg.Mail.Password)
+	str("mail-from", cfg.Mail.From)
+	m["mail-to"] = cfg.Mail.To
+	dur("mail-every", cfg.Mail.Every)
+	num("mail-burst", cfg.Mail.Burst)
+	dur("mail-min-interval", cfg.Mail.MinInterval)
+	str("peer-name", cfg.Peers.Name)
+	str("peer-listen", cfg.Peers.Listen)
+	str("peer-secret", cfg.Peers.Secret)
+	m["peer"] = cfg.Peers.URLs
+	return m
+}
+
+var (
+	cmdlineFlags = make(map[string]bool)     //命令行指定的参数, 重新加载配置时不覆盖
+	flagDefaults = make(map[string][]string) //参数默认值, 配置文件删除某项时恢复
+)
+
+// contextFlags 当前命令的参数, 顶层时为 app 的参数
+func contextFlags(c *cli.Context) []cli.Flag {
+	if c.Command != nil && c.Command.Name != "" {
+		return c.Command.Flags
+	}
+	return c.App.Flags
+}
+
+// applyConfig 加载 -config 并填充命令行未指定的参数
+func applyConfig(c *cli.Context) error {
+	defer func() {
+		tcpguarder.Sources = c.StringSlice("source")
+	}()
+	for _, f := range contextFlags(c) {
+		name := f.Names()[0]
+		cmdlineFlags[name] = c.IsSet(name)
+		//列表参数的值在 app 和命令之间共享, 只记录第一次的值
+		if _, ok := flagDefaults[name]; !ok {
+			flagDefaults[name] = flagStrings(c, f)
+		}
+	}
+	file := c.String("config")
+	if file == "" {
+		return nil
+	}
+	cfg, err := LoadConfig(file)
+	if err != nil {
+		return err
+	}
+	config = cfg
+	values := cfg.flagValues()
+	for _, f := range contextFlags(c) {
+		name := f.Names()[0]
+		if c.IsSet(name) {
+			continue
+		}
+		//配置文件中未设置的列表为空, 不能清空默认值
+		if len(values[name]) == 0 {
+			continue
+		}
+		if err := setFlag(c, f, values[name]); err != nil {
+			return fmt.Errorf("%v: %v: %v", file, name, err)
+		}
+	}
+	return nil
+}
+
+// reloadConfig 重新读取 -config, 命令行指定的参数保持不变, 配置文件中删除的项恢复默认值
+func reloadConfig(c *cli.Context) error {
+	file := c.String("config")
+	if file == "" {
+		return nil
+	}
+	cfg, err := LoadConfig(file)
+	if err != nil {
+		return err
+	}
+	if errs := cfg.Check(); len(errs) > 0 {
+		return fmt.Errorf("%v: %v", file, errs[0])
+	}
+	values := cfg.flagValues()
+	for _, f := range contextFlags(c) {
+		name := f.Names()[0]
+		if cmdlineFlags[name] {
+			continue
+		}
+		v, ok := values[name]
+		if !ok || len(v) == 0 {
+			v = flagDefaults[name]
+		}
+		if err := setFlag(c, f, v); err != nil {
+			return fmt.Errorf("%v: %v: %v", file, name, err)
+		}
+	}
+	config = cfg
+	tcpguarder.Sources = c.StringSlice("source")
+	return nil
+}
+
+// saveFlags 保存可被配置文件改变的参数, 用于 restoreFlags
+func saveFlags(c *cli.Context) map[string][]string {
+	m := make(map[string][]string)
+	for _, f := range contextFlags(c) {
+		if name := f.Names()[0]; !cmdlineFlags[name] {
+			m[name] = flagStrings(c, f)
+		}
+	}
+	return m
+}
+
+// restoreFlags 恢复 saveFlags 保存的参数
+func restoreFlags(c *cli.Context, saved map[string][]string) {
+	for _, f := range contextFlags(c) {
+		if v, ok := saved[f.Names()[0]]; ok {
+			if err := setFlag(c, f, v); err != nil {
+				log.Println("restore flag:", err)
+			}
+		}
+	}
+}
+
+// flagStrings 参数当前值, 可用于 setFlag
+func flagStrings(c *cli.Context, f cli.Flag) []string {
+	name := f.Names()[0]
+	switch f.(type) {
+	case *cli.IntSliceFlag:
+		var ss []string
+		for _, v := range c.IntSlice(name) {
+			ss = append(ss, strconv.Itoa(v))
+		}
+		return ss
+	case *cli.StringSliceFlag:
+		return c.StringSlice(name)
+	case *cli.BoolFlag:
+		return []string{strconv.FormatBool(c.Bool(name))}
+	case *cli.IntFlag:
+		return []string{strconv.Itoa(c.Int(name))}
+	case *cli.DurationFlag:
+		return []string{c.Duration(name).String()}
+	}
+	return []string{c.String(name)}
+}
+
+// setFlag 覆盖参数的值, 列表参数先清空
+func setFlag(c *cli.Context, f cli.Flag, values []string) error {
+	name := f.Names()[0]
+	switch f := f.(type) {
+	case *cli.IntSliceFlag:
+		if f.Value != nil {
+			*f.Value = cli.IntSlice{}
+		}
+	case *cli.StringSliceFlag:
+		if f.Value != nil {
+			*f.Value = cli.StringSlice{}
+		}
+	}
+	for _, v := range values {
+		if err := c.Set(name, v); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Check 检查配置是否有效
+func (cfg *Config) Check() []error {
+	var errs []error
+	add := func(format string, a ...interface{}) {
+		errs = append(errs, fmt.Errorf(format, a...))
+	}
+	//不存在的 source 读取时跳过, 全部不存在才是错误
+	var missing error
+	for _, v := range cfg.Sources {
+		if _, err := os.Stat(v); err == nil {
+			missing = nil
+			break
+		} else if missing == nil {
+			missing = err
+		}
+	}
+	if missing != nil {
+		add("sources: %v", missing)
+	}
+	ports := func(name string, ports []int) {
+		for _, p := range ports {
+			if p <= 0 || p > 65535 {
+				add("%v: bad port %v", name, p)
+			}
+		}
+	}
+	ports("ports", cfg.Ports)
+	ports("trap.ports", cfg.Trap.Ports)
+	ports("whitelist.ssh_ports", cfg.Whitelist.SSHPorts)
+	if cfg.Filter != "" {
+		if _, err := tcpguarder.ParseFilter(cfg.Filter); err != nil {
+			add("filter: %v", err)
+		}
+	}
+	if cfg.Every < 0 {
+		add("every: must be positive")
+	}
+	if cfg.Kill < 0 || cfg.KillScore < 0 || cfg.Scan.Ports < 0 || cfg.Metrics.Top < 0 {
+		add("kill, kill_score, scan.ports, metrics.top: must not be negative")
+	}
+	for cc, n := range cfg.KillCountry {
+		if n <= 0 {
+			add("kill_country: %v: must be positive", cc)
+		}
+	}
+	for name, group := range cfg.PortGroups {
+		ports("port_groups."+name, group)
+	}
+	names := make(map[string]bool)
+	for _, pc := range cfg.Policies {
+		if names[pc.Name] {
+			add("policies: duplicate name %v", pc.Name)
+		}
+		names[pc.Name] = true
+		if _, err := newPolicy(pc, cfg.PortGroups, cfg.IPSet.Name, cfg.IPSet.Timeout); err != nil {
+			add("policies: %v", err)
+		}
+	}
+	if len(cfg.KillCountry) > 0 && cfg.GeoIP.Country == "" {
+		add("kill_country: needs geoip.country")
+	}
+	if cfg.RulesFile != "" {
+		if _, err := tcpguarder.LoadRules(cfg.RulesFile); err != nil {
+			add("rules_file: %v", err)
+		}
+	}
+	if _, err := cfg.RuleSet(); err != nil {
+		add("rules: %v", err)
+	}
+	for name, timeout := range map[string]int{"ipset.timeout": cfg.IPSet.Timeout, "trap.timeout": cfg.Trap.Timeout} {
+		//ipset 最大超时
+		if timeout < 0 || timeout > 2147483 {
+			add("%v: must be 0-2147483", name)
+		}
+	}
+	switch cfg.Log.Format {
+	case "", "text", "json", "logfmt":
+	default:
+		add("log.format: unknown %q, want text, json or logfmt", cfg.Log.Format)
+	}
+	switch cfg.Log.Output {
+	case "", "stderr", "syslog", "journald":
+	default:
+		add("log.output: unknown %q, want stderr, syslog or journald", cfg.Log.Output)
+	}
+	if cfg.Mail.SMTP != "" {
+		if _, _, err := net.SplitHostPort(cfg.Mail.SMTP); err != nil {
+			add("mail.smtp: %v", err)
+		}
+		if len(cfg.Mail.To) == 0 {
+			add("mail.to: needed by mail.smtp")
+		}
+	}
+	if cfg.Mail.Every < 0 || cfg.Mail.Burst < 0 || cfg.Mail.MinInterval < 0 {
+		add("mail.every, mail.burst, mail.min_interval: must not be negative")
+	}
+	if (cfg.Peers.Listen != "" || len(cfg.Peers.URLs) > 0) && len(cfg.Peers.Secret) < 16 {
+		add("peers.secret: need at least 16 characters")
+	}
+	for i, hc := range cfg.Hooks {
+		if _, err := newHook(hc); err != nil {
+			add("hooks[%v]: %v", i, err)
+		}
+	}
+	if len(cfg.IPSet.Name) > 31 {
+		add("ipset.name: longer than 31")
+	}
+	if cfg.Whitelist.File != "" {
+		if _, err := tcpguarder.LoadAllowlist(cfg.Whitelist.File); err != nil {
+			add("whitelist.file: %v", err)
+		}
+	}
+	for name, file := range map[string]string{"geoip.country": cfg.GeoIP.Country, "geoip.asn": cfg.GeoIP.ASN} {
+		if file == "" {
+			continue
+		}
+		if _, err := tcpguarder.OpenMMDB(file); err != nil {
+			add("%v: %v", name, err)
+		}
+	}
+	return errs
+}
+
+// RuleSet 配置文件中的 rules, 未配置时返回 nil
+func (cfg *Config) RuleSet() (tcpguarder.RuleSet, error) {
+	var rules tcpguarder.RuleSet
+	for _, v := range cfg.Rules {
+		rule, err := tcpguarder.NewRule(v.Name, v.Weight, v.Expr)
+		if err != nil {
+			return nil, err
+		}
+		rules = append(rules, rule)
+	}
+	return rules, nil
+}
+
+func ConfigCheckAction(c *cli.Context) error {
+	file := c.String("config")
+	if file == "" {
+		file = c.Args().First()
+	}
+	if file == "" {
+		return errors.New("usage: config check -config FILE")
+	}
+	cfg, err := LoadConfig(file)
+	if err != nil {
+		return err
+	}
+	errs := cfg.Check()
+	if len(errs) == 0 {
+		fmt.Println(file, "ok")
+		return nil
+	}
+	var ss []string
+	for _, e := range errs {
+		ss = append(ss, e.Error())
+	}
+	fmt.Println(strings.Join(ss, "\n"))
+	return fmt.Errorf("%v: %v errors", file, len(errs))
+}
@@ -0,0 +1,178 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v2"
+)
+
+func TestConfigCheck(t *testing.T) {
+	tests := []struct {
+		yaml string
+		err  string //为空时应没有错误
+	}{
+		{"ports: [80, 443]\nkill: 100\nfilter: exclude time-wait", ""},
+		{"sources: [/nonexistent/tcp]", "sources: stat /nonexistent/tcp"},
+		{"sources: [/nonexistent/tcp6, /proc/net/tcp]", ""},
+		{"ports: [0]", "ports: bad port 0"},
+		{"trap: {ports: [65536]}", "trap.ports: bad port 65536"},
+		{"filter: cwnd ==", "filter: "},
+		{"every: -1s", "every: must be positive"},
+		{"kill: -1", "kill, kill_score, scan.ports, metrics.top: must not be negative"},
+		{"kill_country: {CN: 0}\ngeoip: {country: x}", "kill_country: CN: must be positive"},
+		{"kill_country: {CN: 10}", "kill_country: needs geoip.country"},
+		{"policies: [{name: a, ports: [22], kill: 1}, {name: a, ports: [22], kill: 1}]", "policies: duplicate name a"},
+		{"rules: [{name: x, weight: 1, expr: 'state bogus'}]", "rules: "},
+		{"ipset: {timeout: 2147484}", "ipset.timeout: must be 0-2147483"},
+		{"ipset: {name: aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa}", "ipset.name: longer than 31"},
+		{"log: {format: xml}", `log.format: unknown "xml"`},
+		{"log: {output: file}", `log.output: unknown "file"`},
+		{"mail: {smtp: smtp.example.com}", "mail.smtp: "},
+		{"mail: {smtp: 'smtp.example.com:25'}", "mail.to: needed by mail.smtp"},
+		{"mail: {burst: -1}", "mail.every, mail.burst, mail.min_interval: must not be negative"},
+		{"peers: {listen: ':9108', secret: short}", "peers.secret: need at least 16 characters"},
+		{"hooks: [{events: [ban]}]", "hooks[0]: "},
+		{"geoip: {asn: /nonexistent.mmdb}", "geoip.asn: "},
+	}
+	for _, tt := range tests {
+		var cfg Config
+		if err := yaml.UnmarshalStrict([]byte(tt.yaml), &cfg); err != nil {
+			t.Fatalf("%q: %v", tt.yaml, err)
+		}
+		errs := cfg.Check()
+		if tt.err == "" {
+			if len(errs) > 0 {
+				t.Errorf("%q: Check = %v, want no error", tt.yaml, errs)
+			}
+			continue
+		}
+		found := false
+		for _, err := range errs {
+			found = found || strings.HasPrefix(err.Error(), tt.err)
+		}
+		if !found {
+			t.Errorf("%q: Check = %v, want %v", tt.yaml, errs, tt.err)
+		}
+	}
+}
+
+// runConfigApp 用独立的参数运行 f, 列表参数的值不与 flags.go 中的共享
+func runConfigApp(t *testing.T, args []string, f func(c *cli.Context) error) {
+	cmdlineFlags = make(map[string]bool)
+	flagDefaults = make(map[string][]string)
+	config = &Config{}
+	defer func(s []string) { tcpguarder.Sources = s }(tcpguarder.Sources)
+	app := cli.NewApp()
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{Name: "config"},
+		&cli.IntFlag{Name: "kill"},
+		&cli.IntFlag{Name: "timeout", Value: 600},
+		&cli.StringFlag{Name: "ipset", Value: "blackhold"},
+		&cli.IntSliceFlag{Name: "port"},
+		&cli.IntSliceFlag{Name: "ssh-port", Value: cli.NewIntSlice(22)},
+		&cli.StringSliceFlag{Name: "source", Value: cli.NewStringSlice("/proc/net/tcp")},
+		&cli.BoolFlag{Name: "auto-white", Value: true},
+		&cli.StringFlag{Name: "log-format", Value: "text"},
+		&cli.StringFlag{Name: "log-output", Value: "stderr"},
+	}
+	app.Action = f
+	if err := app.Run(append([]string{"tcpguarder"}, args...)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeConfig(t *testing.T, file, text string) {
+	if err := ioutil.WriteFile(file, []byte(text), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestApplyConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.yaml")
+	writeConfig(t, file, `
+kill: 100
+ports: [80, 443]
+ipset: {name: fromconfig, timeout: 60}
+whitelist: {auto: false}
+`)
+	runConfigApp(t, []string{"-config", file, "-kill", "5", "-port", "22"}, func(c *cli.Context) error {
+		if err := applyConfig(c); err != nil {
+			t.Fatal(err)
+		}
+		//命令行优先, 其次配置文件, 配置文件未设置的项保持默认值
+		if c.Int("kill") != 5 || !reflect.DeepEqual(c.IntSlice("port"), []int{22}) {
+			t.Errorf("command line not kept: kill %v port %v", c.Int("kill"), c.IntSlice("port"))
+		}
+		if c.String("ipset") != "fromconfig" || c.Int("timeout") != 60 || c.Bool("auto-white") {
+			t.Errorf("config not applied: ipset %v timeout %v auto-white %v", c.String("ipset"), c.Int("timeout"), c.Bool("auto-white"))
+		}
+		if !reflect.DeepEqual(c.IntSlice("ssh-port"), []int{22}) || !reflect.DeepEqual(tcpguarder.Sources, []string{"/proc/net/tcp"}) {
+			t.Errorf("defaults not kept: ssh-port %v sources %v", c.IntSlice("ssh-port"), tcpguarder.Sources)
+		}
+
+		//删除的项恢复默认值, 新增的项生效, 命令行参数仍然优先
+		writeConfig(t, file, "kill: 200\nipset: {timeout: 30}\nwhitelist: {ssh_ports: [2222]}\nsources: ["+file+"]\n")
+		if err := reloadConfig(c); err != nil {
+			t.Fatal(err)
+		}
+		if c.Int("kill") != 5 || !reflect.DeepEqual(c.IntSlice("port"), []int{22}) {
+			t.Errorf("reload: command line not kept: kill %v port %v", c.Int("kill"), c.IntSlice("port"))
+		}
+		if c.String("ipset") != "blackhold" || !c.Bool("auto-white") {
+			t.Errorf("reload: defaults not restored: ipset %v auto-white %v", c.String("ipset"), c.Bool("auto-white"))
+		}
+		if c.Int("timeout") != 30 || !reflect.DeepEqual(c.IntSlice("ssh-port"), []int{2222}) ||
+			!reflect.DeepEqual(tcpguarder.Sources, []string{file}) {
+			t.Errorf("reload: config not applied: timeout %v ssh-port %v sources %v", c.Int("timeout"), c.IntSlice("ssh-port"), tcpguarder.Sources)
+		}
+
+		//无效的配置不生效
+		writeConfig(t, file, "kill: -1\n")
+		if err := reloadConfig(c); err == nil {
+			t.Error("reload of invalid config want error")
+		}
+		if c.Int("timeout") != 30 || config.IPSet.Timeout != 30 {
+			t.Errorf("invalid config applied: timeout %v", c.Int("timeout"))
+		}
+		return nil
+	})
+}
+
+func TestReloadGuardRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "config.yaml")
+	writeConfig(t, file, "kill: 100\nports: [80]\n")
+	runConfigApp(t, []string{"-config", file}, func(c *cli.Context) error {
+		if err := applyConfig(c); err != nil {
+			t.Fatal(err)
+		}
+		//配置检查通过, 但没有任何封禁条件, 生成新状态时失败
+		writeConfig(t, file, "ipset: {name: other}\nports: [443]\nsources: ["+file+"]\n")
+		if _, err := reloadGuard(c, nil); err == nil || !strings.HasPrefix(err.Error(), "nothing to do") {
+			t.Fatalf("reloadGuard = %v, want nothing to do", err)
+		}
+		if c.Int("kill") != 100 || c.String("ipset") != "blackhold" || !reflect.DeepEqual(c.IntSlice("port"), []int{80}) {
+			t.Errorf("flags not restored: kill %v ipset %v port %v", c.Int("kill"), c.String("ipset"), c.IntSlice("port"))
+		}
+		if config.Kill != 100 || !reflect.DeepEqual(tcpguarder.Sources, []string{"/proc/net/tcp"}) {
+			t.Errorf("config not restored: kill %v sources %v", config.Kill, tcpguarder.Sources)
+		}
+		return nil
+	})
+}
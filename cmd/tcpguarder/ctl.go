@@ -0,0 +1,148 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// ctlCall 请求 run 的控制接口, in 不为 nil 时 POST
+func ctlCall(c *cli.Context, path string, in, out interface{}) error {
+	socket := c.String("socket")
+	client := &http.Client{
+		Timeout: 10 * time.Second,
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", socket)
+			},
+		},
+	}
+	var resp *http.Response
+	var err error
+	if in != nil {
+		b, _ := json.Marshal(in)
+		resp, err = client.Post("http://tcpguarder"+path, "application/json", bytes.NewReader(b))
+	} else {
+		resp, err = client.Get("http://tcpguarder" + path)
+	}
+	if err != nil {
+		return fmt.Errorf("is tcpguarder run listening on %v? %v", socket, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		var e struct {
+			Error string `json:"error"`
+		}
+		json.NewDecoder(resp.Body).Decode(&e)
+		return errors.New(e.Error)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// ctlPrint 请求并输出 json 结果
+func ctlPrint(c *cli.Context, path string, in interface{}) error {
+	var out interface{}
+	if err := ctlCall(c, path, in, &out); err != nil {
+		return err
+	}
+	b, _ := json.MarshalIndent(out, "", "  ")
+	fmt.Println(string(b))
+	return nil
+}
+
+func ctlArgIP(c *cli.Context) (apiRequest, error) {
+	req := apiRequest{IP: c.Args().First()}
+	if req.IP == "" {
+		return req, fmt.Errorf("usage: %v IP", c.Command.Name)
+	}
+	return req, nil
+}
+
+func CtlStatusAction(c *cli.Context) error {
+	return ctlPrint(c, "/status", nil)
+}
+
+func CtlTopAction(c *cli.Context) error {
+	q := url.Values{}
+	q.Set("n", fmt.Sprint(c.Int("top")))
+	q.Set("ab", fmt.Sprint(c.Bool("ab")))
+	var items []topItem
+	if err := ctlCall(c, "/top?"+q.Encode(), nil, &items); err != nil {
+		return err
+	}
+	for _, v := range items {
+		if v.Geo != "" {
+			fmt.Printf("%v\t%v\t%v\n", v.IP, v.N, v.Geo)
+			continue
+		}
+		fmt.Printf("%v\t%v\n", v.IP, v.N)
+	}
+	return nil
+}
+
+func CtlBansAction(c *cli.Context) error {
+	if err := checkOutput(c.String("output")); err != nil {
+		return err
+	}
+	var list []Ban
+	if err := ctlCall(c, "/bans", nil, &list); err != nil {
+		return err
+	}
+	return writeBans(os.Stdout, c.String("output"), list)
+}
+
+func CtlBanAction(c *cli.Context) error {
+	req, err := ctlArgIP(c)
+	if err != nil {
+		return err
+	}
+	if c.IsSet("ipset") {
+		req.Set = c.String("ipset")
+	}
+	if c.IsSet("timeout") {
+		req.Timeout = c.Int("timeout")
+	}
+	return ctlPrint(c, "/ban", req)
+}
+
+func CtlUnbanAction(c *cli.Context) error {
+	req, err := ctlArgIP(c)
+	if err != nil {
+		return err
+	}
+	return ctlPrint(c, "/unban", req)
+}
+
+func CtlWhiteAddAction(c *cli.Context) error {
+	req, err := ctlArgIP(c)
+	if err != nil {
+		return err
+	}
+	return ctlPrint(c, "/whitelist/add", req)
+}
+
+func CtlWhiteRemoveAction(c *cli.Context) error {
+	req, err := ctlArgIP(c)
+	if err != nil {
+		return err
+	}
+	return ctlPrint(c, "/whitelist/remove", req)
+}
+
+func CtlPauseAction(c *cli.Context) error {
+	return ctlPrint(c, "/pause", apiRequest{})
+}
+
+func CtlResumeAction(c *cli.Context) error {
+	return ctlPrint(c, "/resume", apiRequest{})
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/urfave/cli/v2"
+)
+
+// pidFile run 持有锁的 pid 文件
+var pidFile *os.File
+
+// lockPidFile 锁定并写入 pid 文件, 防止多个实例同时操作 ipset
+func lockPidFile(file string) (*os.File, error) {
+	f, err := os.OpenFile(file, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		b, _ := ioutil.ReadAll(f)
+		f.Close()
+		return nil, fmt.Errorf("%v: another instance is running, pid %v", file, strings.TrimSpace(string(b)))
+	}
+	if err := f.Truncate(0); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if _, err := f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return f, nil
+}
+
+func unlockPidFile(f *os.File) {
+	if f == nil {
+		return
+	}
+	os.Remove(f.Name())
+	f.Close()
+}
+
+// sdNotify 发送 systemd 通知, 不是由 systemd 启动(没有 NOTIFY_SOCKET)时忽略
+func sdNotify(state string) {
+	addr := os.Getenv("NOTIFY_SOCKET")
+	if addr == "" {
+		return
+	}
+	//抽象命名空间
+	if addr[0] == '@' {
+		addr = "\x00" + addr[1:]
+	}
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: addr, Net: "unixgram"})
+	if err != nil {
+		log.Println("sd_notify:", err)
+		return
+	}
+	defer conn.Close()
+	if _, err := conn.Write([]byte(state)); err != nil {
+		log.Println("sd_notify:", err)
+	}
+}
+
+// sdWatchdog systemd WatchdogSec, 未启用时返回 0
+func sdWatchdog() time.Duration {
+	usec, err := strconv.ParseInt(os.Getenv("WATCHDOG_USEC"), 10, 64)
+	if err != nil || usec <= 0 {
+		return 0
+	}
+	if pid := os.Getenv("WATCHDOG_PID"); pid != "" && pid != strconv.Itoa(os.Getpid()) {
+		return 0
+	}
+	return time.Duration(usec) * time.Microsecond
+}
+
+// reloadGuard 重新加载配置文件, 新状态全部生成成功后才替换,
+// 任何一步失败时恢复参数, 配置和 Sources, 继续使用旧的配置
+func reloadGuard(c *cli.Context, old *guard) (g *guard, err error) {
+	flags := saveFlags(c)
+	cfg, sources := config, tcpguarder.Sources
+	defer func() {
+		if err != nil {
+			restoreFlags(c, flags)
+			config, tcpguarder.Sources = cfg, sources
+		}
+	}()
+	if err = reloadConfig(c); err != nil {
+		return nil, err
+	}
+	s, err := prepareKill(c)
+	if err != nil {
+		return nil, err
+	}
+	if g, err = newGuard(c, old, s.policies); err != nil {
+		s.log.close()
+		return nil, err
+	}
+	s.apply(c)
+	return g, nil
+}
+
+// ipsetNames run 使用的 ipset
+func ipsetNames(c *cli.Context) []string {
+	names := []string{c.String("ipset")}
+	for _, p := range policies {
+		if !hasString(names, p.IPSet) {
+			names = append(names, p.IPSet)
+		}
+	}
+	return names
+}
+
+func hasString(ss []string, s string) bool {
+	for _, v := range ss {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// cleanupIPSets 清空并删除 ipset, 仍被 iptables 引用的 ipset 只清空
+func cleanupIPSets(c *cli.Context) {
+	for _, name := range ipsetNames(c) {
+		if err := tcpguarder.NewCmd(fmt.Sprintf("ipset flush %v", name)).Run(); err != nil {
+			log.Println("ipset flush", name, err)
+			continue
+		}
+		if err := tcpguarder.NewCmd(fmt.Sprintf("ipset destroy %v", name)).Run(); err != nil {
+			log.Println("ipset", name, "flushed, still referenced by iptables")
+			continue
+		}
+		log.Println("ipset", name, "destroyed")
+	}
+}
@@ -0,0 +1,358 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"log/syslog"
+	"net"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+)
+
+// 事件类型
+const (
+	EventBan       = "ban"
+	EventSkip      = "skip" //在白名单中
+	EventBanFailed = "ban_failed"
+	EventUnban     = "unban"
+	EventThreshold = "threshold" //超过阈值, 在白名单检查和封禁之前
+)
+
+// Event run 的一次决策
+type Event struct {
+	Time     time.Time `json:"time"`
+	Event    string    `json:"event"`
+	IP       string    `json:"ip"`
+	Rule     string    `json:"rule,omitempty"`
+	Count    int       `json:"count,omitempty"` //连接数, 端口数或异常分数, 取决于 rule
+	Ports    []int     `json:"ports,omitempty"`
+	Duration int       `json:"duration,omitempty"` //封禁秒数
+	Backend  string    `json:"backend,omitempty"`
+	Reason   string    `json:"reason,omitempty"`
+	Country  string    `json:"country,omitempty"`
+	ASN      uint64    `json:"asn,omitempty"`
+	Origin   string    `json:"origin,omitempty"` //其他主机同步来的封禁, 为来源主机名
+}
+
+// logfmt key=value 格式, 值含空格等时加引号
+func (e Event) logfmt() string {
+	var b strings.Builder
+	kv := func(k, v string) {
+		if v == "" {
+			return
+		}
+		if b.Len() > 0 {
+			b.WriteByte(' ')
+		}
+		if strings.ContainsAny(v, " =\"\\\n\t") {
+			v = strconv.Quote(v)
+		}
+		b.WriteString(k + "=" + v)
+	}
+	num := func(k string, v int) {
+		if v != 0 {
+			kv(k, strconv.Itoa(v))
+		}
+	}
+	kv("time", e.Time.Format(time.RFC3339Nano))
+	kv("event", e.Event)
+	kv("ip", e.IP)
+	kv("rule", e.Rule)
+	num("count", e.Count)
+	kv("ports", jointostring(e.Ports, ","))
+	num("duration", e.Duration)
+	kv("backend", e.Backend)
+	kv("reason", e.Reason)
+	kv("country", e.Country)
+	if e.ASN != 0 {
+		kv("asn", strconv.FormatUint(e.ASN, 10))
+	}
+	kv("origin", e.Origin)
+	return b.String()
+}
+
+// fields journald 字段, 值中不能有换行
+func (e Event) fields() []string {
+	ss := []string{"TCPGUARDER_EVENT=" + e.Event, "TCPGUARDER_IP=" + e.IP}
+	add := func(k, v string) {
+		if v != "" && v != "0" {
+			ss = append(ss, k+"="+strings.ReplaceAll(v, "\n", " "))
+		}
+	}
+	add("TCPGUARDER_RULE", e.Rule)
+	add("TCPGUARDER_COUNT", strconv.Itoa(e.Count))
+	add("TCPGUARDER_PORTS", jointostring(e.Ports, ","))
+	add("TCPGUARDER_DURATION", strconv.Itoa(e.Duration))
+	add("TCPGUARDER_BACKEND", e.Backend)
+	add("TCPGUARDER_REASON", e.Reason)
+	add("TCPGUARDER_COUNTRY", e.Country)
+	add("TCPGUARDER_ASN", strconv.FormatUint(e.ASN, 10))
+	add("TCPGUARDER_ORIGIN", e.Origin)
+	return ss
+}
+
+// journalSocket journald 接收日志的 socket
+var journalSocket = "/run/systemd/journal/socket"
+
+// priority syslog 和 journald 的级别, 封禁失败为 err, 封禁为 warning, 其余为 info
+func (e Event) priority() syslog.Priority {
+	switch e.Event {
+	case EventBanFailed:
+		return syslog.LOG_ERR
+	case EventBan:
+		return syslog.LOG_WARNING
+	}
+	return syslog.LOG_INFO
+}
+
+// eventLog 事件日志, 格式 text/json/logfmt, 输出到 stderr/syslog/journald
+type eventLog struct {
+	mu      sync.Mutex
+	format  string
+	output  string
+	syslog  *syslog.Writer
+	journal net.Conn
+	recent  map[string]time.Time
+}
+
+var events = &eventLog{format: "text", output: "stderr", recent: make(map[string]time.Time)}
+
+// skipInterval 同一IP和规则的 skip, threshold, ban_failed 事件最多每隔多久记录一次, 避免每次检测都输出
+const skipInterval = 10 * time.Minute
+
+// openEventLog 按格式和输出打开新的事件日志, 由 replace 换入 events
+func openEventLog(format, output string) (*eventLog, error) {
+	switch format {
+	case "text", "json", "logfmt":
+	default:
+		return nil, fmt.Errorf("log-format: unknown %q, want text, json or logfmt", format)
+	}
+	l := &eventLog{format: format, output: output}
+	var err error
+	switch output {
+	case "stderr":
+	case "syslog":
+		if l.syslog, err = syslog.New(syslog.LOG_INFO|syslog.LOG_DAEMON, "tcpguarder"); err != nil {
+			return nil, fmt.Errorf("log-output: %v", err)
+		}
+	case "journald":
+		if l.journal, err = net.Dial("unixgram", journalSocket); err != nil {
+			return nil, fmt.Errorf("log-output: %v", err)
+		}
+	default:
+		return nil, fmt.Errorf("log-output: unknown %q, want stderr, syslog or journald", output)
+	}
+	return l, nil
+}
+
+// replace 换成 n 的格式和输出, 关闭原来的输出, 保留限流记录
+func (l *eventLog) replace(n *eventLog) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.close()
+	l.format, l.output, l.syslog, l.journal = n.format, n.output, n.syslog, n.journal
+}
+
+func (l *eventLog) close() {
+	if l.syslog != nil {
+		l.syslog.Close()
+		l.syslog = nil
+	}
+	if l.journal != nil {
+		l.journal.Close()
+		l.journal = nil
+	}
+}
+
+// emit 记录事件并触发 hook, text 为 text 格式的内容, 为空时 text 格式不记录
+func (l *eventLog) emit(e Event, text ...interface{}) {
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	if geo := tcpguarder.CurrentGeo(); geo != nil && e.Country == "" {
+		info := geo.Lookup(net.ParseIP(e.IP))
+		e.Country, e.ASN = info.Country, info.ASN
+	}
+	hooks.fire(e)
+	digest.add(e)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.format == "text" && len(text) == 0 {
+		return
+	}
+	var line string
+	switch l.format {
+	case "json":
+		b, _ := json.Marshal(e)
+		line = string(b)
+	case "logfmt":
+		line = e.logfmt()
+	default:
+		line = strings.TrimSuffix(fmt.Sprintln(text...), "\n")
+	}
+	switch {
+	case l.syslog != nil:
+		switch e.priority() {
+		case syslog.LOG_ERR:
+			l.syslog.Err(line)
+		case syslog.LOG_WARNING:
+			l.syslog.Warning(line)
+		default:
+			l.syslog.Info(line)
+		}
+	case l.journal != nil:
+		l.writeJournal(line, e)
+	case l.format == "text":
+		log.Println(line)
+	default:
+		fmt.Fprintln(os.Stderr, line)
+	}
+}
+
+// writeJournal journald 原生协议
+// https://systemd.io/JOURNAL_NATIVE_PROTOCOL/
+func (l *eventLog) writeJournal(line string, e Event) {
+	fields := append([]string{
+		"MESSAGE=" + strings.ReplaceAll(line, "\n", " "),
+		"PRIORITY=" + strconv.Itoa(int(e.priority())),
+		"SYSLOG_IDENTIFIER=tcpguarder",
+	}, e.fields()...)
+	msg := strings.Join(fields, "\n") + "\n"
+	if _, err := io.WriteString(l.journal, msg); err == nil {
+		return
+	}
+	//journald 重启后旧连接失效, 重新连接后再写一次
+	conn, err := net.Dial("unixgram", journalSocket)
+	if err != nil {
+		log.Println("journald:", err)
+		return
+	}
+	l.journal.Close()
+	l.journal = conn
+	if _, err := io.WriteString(l.journal, msg); err != nil {
+		log.Println("journald:", err)
+	}
+}
+
+// limit 同一事件, IP和规则 skipInterval 内只返回一次 true
+func (l *eventLog) limit(name string, r banRequest) bool {
+	key := name + "/" + r.IP + "/" + r.Rule
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if last, ok := l.recent[key]; ok && now.Sub(last) < skipInterval {
+		return false
+	}
+	for k, v := range l.recent {
+		if now.Sub(v) >= skipInterval {
+			delete(l.recent, k)
+		}
+	}
+	l.recent[key] = now
+	return true
+}
+
+// skip 记录白名单跳过的封禁, 同一IP和规则限频
+func (l *eventLog) skip(r banRequest, reason string) {
+	if l.limit(EventSkip, r) {
+		l.emit(r.event(EventSkip, reason), "skip", r.IP, r.Rule, reason)
+	}
+}
+
+// threshold 记录超过阈值, 同一IP和规则限频, text 格式不记录
+func (l *eventLog) threshold(r banRequest) {
+	if l.limit(EventThreshold, r) {
+		l.emit(r.event(EventThreshold, ""))
+	}
+}
+
+// banRequest 一次封禁决策
+type banRequest struct {
+	IP      string
+	Set     string
+	Timeout int  //0 为不过期
+	Exist   bool //已在 ipset 中时只刷新超时, 不重复记录; 否则视为已封禁
+	Rule    string
+	Count   int
+	Ports   []int
+	Origin  string
+	Text    []interface{} //text 格式日志中 block ip 之后的内容
+}
+
+func (r banRequest) event(name, reason string) Event {
+	return Event{
+		Event:    name,
+		IP:       r.IP,
+		Rule:     r.Rule,
+		Count:    r.Count,
+		Ports:    r.Ports,
+		Duration: r.Timeout,
+		Backend:  "ipset:" + r.Set,
+		Reason:   reason,
+		Origin:   r.Origin,
+	}
+}
+
+var errAlreadyBanned = errors.New("already in ipset")
+
+func ipsetAdd(set, ip string, timeout int, exist bool) error {
+	cmd := "ipset add"
+	if exist {
+		cmd = "ipset -exist add"
+	}
+	cmd = fmt.Sprintf("%v %v %v timeout %v", cmd, set, ip, timeout)
+	out, err := tcpguarder.NewCmd(cmd).CombinedOutput()
+	if err == nil {
+		return nil
+	}
+	if strings.Contains(string(out), "already added") {
+		return errAlreadyBanned
+	}
+	if msg := strings.TrimSpace(string(out)); msg != "" {
+		return fmt.Errorf("%v: %v", cmd, msg)
+	}
+	return fmt.Errorf("%v: %v", cmd, err)
+}
+
+// ban 检查白名单后加入 ipset 并记录, 返回是否成功
+func ban(r banRequest) bool {
+	if r.Origin == "" {
+		events.threshold(r)
+	}
+	if reason := whiteReason(r.IP); reason != "" {
+		if reason != crawlerPending {
+			events.skip(r, reason)
+		}
+		return false
+	}
+	//ipset 是 inet 类型, -source /proc/net/tcp6 读到的 IPv6 连接只统计不封禁
+	if ip := net.ParseIP(r.IP); ip == nil || ip.To4() == nil {
+		events.skip(r, "not ipv4")
+		return false
+	}
+	err := ipsetAdd(r.Set, r.IP, r.Timeout, false)
+	if err == errAlreadyBanned && r.Exist {
+		//只有第一次加入时记录 ban 事件, 之后每次检测只刷新超时
+		if err = ipsetAdd(r.Set, r.IP, r.Timeout, true); err == nil {
+			bans.refresh(r)
+			return true
+		}
+	}
+	if err != nil {
+		//每次检测都会重试, 同一IP和规则限频, 避免刷屏和灌满 hook 队列
+		if err != errAlreadyBanned && events.limit(EventBanFailed, r) {
+			events.emit(r.event(EventBanFailed, err.Error()), "block failed", r.IP, r.Rule, err)
+		}
+		return false
+	}
+	banned(r)
+	return true
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"io/ioutil"
+	"log/syslog"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEventPriority(t *testing.T) {
+	tests := []struct {
+		event string
+		want  syslog.Priority
+	}{
+		{EventBanFailed, syslog.LOG_ERR},
+		{EventBan, syslog.LOG_WARNING},
+		{EventUnban, syslog.LOG_INFO},
+		{EventSkip, syslog.LOG_INFO},
+		{EventThreshold, syslog.LOG_INFO},
+	}
+	for _, tt := range tests {
+		if got := (Event{Event: tt.event}).priority(); got != tt.want {
+			t.Errorf("%v: priority %v, want %v", tt.event, got, tt.want)
+		}
+	}
+}
+
+func listenJournal(t *testing.T, file string) *net.UnixConn {
+	os.Remove(file)
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: file, Net: "unixgram"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
+
+func readJournal(t *testing.T, conn *net.UnixConn) string {
+	b := make([]byte, 4096)
+	conn.SetReadDeadline(time.Now().Add(time.Second))
+	n, err := conn.Read(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(b[:n])
+}
+
+func TestEventLogJournalRedial(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(s string) { journalSocket = s }(journalSocket)
+	journalSocket = filepath.Join(dir, "journal.sock")
+	conn := listenJournal(t, journalSocket)
+	l, err := openEventLog("logfmt", "journald")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.close()
+	l.emit(Event{Event: EventBanFailed, IP: "203.0.113.9", Rule: "scan"})
+	if msg := readJournal(t, conn); !strings.Contains(msg, "PRIORITY=3\n") || !strings.Contains(msg, "TCPGUARDER_IP=203.0.113.9\n") {
+		t.Errorf("journal message %q", msg)
+	}
+	//journald 重启
+	conn.Close()
+	conn = listenJournal(t, journalSocket)
+	defer conn.Close()
+	l.emit(Event{Event: EventBan, IP: "203.0.113.10", Rule: "scan"})
+	if msg := readJournal(t, conn); !strings.Contains(msg, "PRIORITY=4\n") || !strings.Contains(msg, "TCPGUARDER_IP=203.0.113.10\n") {
+		t.Errorf("journal message after restart %q", msg)
+	}
+}
+
+func TestBanFailedLimit(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	script := "#!/bin/sh\necho \"ipset v7.1: The set with the given name does not exist\" >&2\nexit 1\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "ipset"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	defer func(s string) { journalSocket = s }(journalSocket)
+	journalSocket = filepath.Join(dir, "journal.sock")
+	conn := listenJournal(t, journalSocket)
+	defer conn.Close()
+	l, err := openEventLog("logfmt", "journald")
+	if err != nil {
+		t.Fatal(err)
+	}
+	events.replace(l)
+	defer events.replace(&eventLog{format: "text", output: "stderr"})
+
+	//ipset 不存在时每次检测都失败, 只记录第一次
+	r := banRequest{IP: "203.0.113.21", Set: "missing", Timeout: 60, Rule: "failed-test", Origin: "test"}
+	for i := 0; i < 3; i++ {
+		if ban(r) {
+			t.Fatal("ban = true with failing ipset")
+		}
+	}
+	if msg := readJournal(t, conn); !strings.Contains(msg, "TCPGUARDER_EVENT=ban_failed\n") {
+		t.Errorf("journal message %q", msg)
+	}
+	b := make([]byte, 4096)
+	conn.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	if n, err := conn.Read(b); err == nil {
+		t.Errorf("ban_failed not rate limited: %q", b[:n])
+	}
+	//其他IP不受影响
+	r.IP = "203.0.113.22"
+	ban(r)
+	if msg := readJournal(t, conn); !strings.Contains(msg, "TCPGUARDER_IP=203.0.113.22\n") {
+		t.Errorf("journal message %q", msg)
+	}
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/urfave/cli/v2"
+)
+
+// cwndBuckets tcpguarder_cwnd 直方图的上界
+var cwndBuckets = []int{1, 2, 4, 10, 20, 50, 100, 200}
+
+// portStat 按本地端口汇总的连接指标
+type portStat struct {
+	TxQueue    int64
+	RxQueue    int64
+	Retransmit int64
+	Cwnd       []int //每个 cwndBuckets 上界的连接数, 不累计
+	CwndSum    int64
+	Conns      int
+}
+
+// exporter 只采集连接指标, 不封禁
+type exporter struct {
+	sync.Mutex
+	top       int
+	conns     map[connKey]int
+	ports     map[string]*portStat
+	remotes   []tcpguarder.CountItem
+	remoteIPs int
+	scrapes   uint64
+	errors    uint64
+	seconds   float64
+}
+
+func (e *exporter) collect(filter tcpguarder.Filter) {
+	start := time.Now()
+	all, err := tcpguarder.ConnStats()
+	d := time.Since(start)
+	e.Lock()
+	defer e.Unlock()
+	e.scrapes++
+	e.seconds += d.Seconds()
+	if err != nil {
+		log.Println(err)
+		e.errors++
+		return
+	}
+	//监听端口从全部连接中取, 过滤后的连接可能不含 LISTEN
+	port := localPorts(all)
+	stats := tcpguarder.FilterStats(all, filter)
+	e.conns = countConns(stats, port)
+	e.ports = make(map[string]*portStat)
+	for _, v := range stats {
+		if v.Stat == tcpguarder.LISTEN {
+			continue
+		}
+		p := e.ports[port(v)]
+		if p == nil {
+			p = &portStat{Cwnd: make([]int, len(cwndBuckets)+1)}
+			e.ports[port(v)] = p
+		}
+		p.Conns++
+		p.TxQueue += v.TxQueue
+		p.RxQueue += v.RxQueue
+		p.Retransmit += v.RTOTimeouts
+		p.CwndSum += int64(v.CongestionWindow)
+		i := sort.SearchInts(cwndBuckets, v.CongestionWindow)
+		p.Cwnd[i]++
+	}
+	remotes := tcpguarder.TopFilter(stats, nil)
+	e.remoteIPs = len(remotes)
+	if len(remotes) > e.top {
+		remotes = remotes[:e.top]
+	}
+	e.remotes = remotes
+}
+
+func (e *exporter) write(w io.Writer) {
+	e.Lock()
+	defer e.Unlock()
+	p := promWriter{w}
+	conns := make([]connKey, 0, len(e.conns))
+	for k := range e.conns {
+		conns = append(conns, k)
+	}
+	sort.Slice(conns, func(i, j int) bool {
+		if conns[i].State != conns[j].State {
+			return conns[i].State < conns[j].State
+		}
+		return conns[i].Port < conns[j].Port
+	})
+	p.header("tcpguarder_connections", "gauge", "TCP connections by state and local listening port.")
+	for _, k := range conns {
+		p.sample("tcpguarder_connections", float64(e.conns[k]), "state", string(k.State), "port", k.Port)
+	}
+	ports := make([]string, 0, len(e.ports))
+	for k := range e.ports {
+		ports = append(ports, k)
+	}
+	sort.Strings(ports)
+	p.header("tcpguarder_tx_queue_bytes", "gauge", "Sum of send queues by local listening port.")
+	for _, k := range ports {
+		p.sample("tcpguarder_tx_queue_bytes", float64(e.ports[k].TxQueue), "port", k)
+	}
+	p.header("tcpguarder_rx_queue_bytes", "gauge", "Sum of receive queues by local listening port.")
+	for _, k := range ports {
+		p.sample("tcpguarder_rx_queue_bytes", float64(e.ports[k].RxQueue), "port", k)
+	}
+	p.header("tcpguarder_retransmits", "gauge", "Sum of unrecovered RTO timeouts of current connections by local listening port.")
+	for _, k := range ports {
+		p.sample("tcpguarder_retransmits", float64(e.ports[k].Retransmit), "port", k)
+	}
+	p.header("tcpguarder_cwnd", "histogram", "Congestion window of current connections by local listening port.")
+	for _, k := range ports {
+		ps := e.ports[k]
+		n := 0
+		for i, le := range cwndBuckets {
+			n += ps.Cwnd[i]
+			p.sample("tcpguarder_cwnd_bucket", float64(n), "port", k, "le", strconv.Itoa(le))
+		}
+		p.sample("tcpguarder_cwnd_bucket", float64(ps.Conns), "port", k, "le", "+Inf")
+		p.sample("tcpguarder_cwnd_sum", float64(ps.CwndSum), "port", k)
+		p.sample("tcpguarder_cwnd_count", float64(ps.Conns), "port", k)
+	}
+	p.header("tcpguarder_remote_ips", "gauge", "Distinct remote IPs.")
+	p.sample("tcpguarder_remote_ips", float64(e.remoteIPs))
+	p.header("tcpguarder_remote_connections", "gauge", "TCP connections of the top remote IPs.")
+	for _, v := range e.remotes {
+		p.sample("tcpguarder_remote_connections", float64(v.N), "ip", v.Key)
+	}
+	p.header("tcpguarder_scrape_duration_seconds", "summary", "Time spent reading connections.")
+	p.sample("tcpguarder_scrape_duration_seconds_sum", e.seconds)
+	p.sample("tcpguarder_scrape_duration_seconds_count", float64(e.scrapes))
+	p.header("tcpguarder_scrape_errors_total", "counter", "Scrapes that failed to read connections.")
+	p.sample("tcpguarder_scrape_errors_total", float64(e.errors))
+	p.header("tcpguarder_parse_errors_total", "counter", "Connection lines that could not be parsed.")
+	p.sample("tcpguarder_parse_errors_total", float64(tcpguarder.ParseErrors()))
+}
+
+func ExporterAction(c *cli.Context) error {
+	filter, err := connFilter(c)
+	if err != nil {
+		return err
+	}
+	addr := c.String("metrics")
+	if addr == "" {
+		addr = ":9107"
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	e := &exporter{top: c.Int("metrics-top")}
+	duration := c.Duration("duration")
+	fmt.Printf("collect every %v, top %v remote ips\n", duration, e.top)
+	e.collect(filter)
+	go func() {
+		tk := time.NewTicker(duration)
+		defer tk.Stop()
+		for range tk.C {
+			e.collect(filter)
+		}
+	}()
+	return serveMetrics(l, e)
+}
@@ -0,0 +1,132 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/lixiangzhong/tcpguarder"
+)
+
+func TestPromWriter(t *testing.T) {
+	var b bytes.Buffer
+	p := promWriter{&b}
+	p.header("x_total", "counter", "Help.")
+	p.sample("x_total", 1.5, "a", `q"uote`, "b", `back\slash`, "c", "new\nline")
+	p.sample("x_total", 2)
+	want := `# HELP x_total Help.
+# TYPE x_total counter
+x_total{a="q\"uote",b="back\\slash",c="new\nline"} 1.5
+x_total 2
+`
+	if b.String() != want {
+		t.Errorf("promWriter:\n%v\nwant:\n%v", b.String(), want)
+	}
+}
+
+func TestExporterWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	//监听 80; 203.0.113.1 连入 80 的 cwnd 为 1, 3, 200, 201; 主动连接 198.51.100.7 的 cwnd 为 0
+	row := func(sl int, local, remote, st, txrx, retrans string, cwnd int) string {
+		return fmt.Sprintf("%4d: %v %v %v %v 00:00000000 %v     0        0 %v 1 0000000000000000 20 4 30 %v -1\n",
+			sl, local, remote, st, txrx, retrans, 100+sl, cwnd)
+	}
+	lines := "  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode\n" +
+		row(0, "00000000:0050", "00000000:0000", "0A", "00000000:00000000", "00000000", 10) +
+		row(1, "0100000A:0050", "017100CB:C350", "01", "00000010:00000020", "00000002", 1) +
+		row(2, "0100000A:0050", "017100CB:C351", "01", "00000001:00000000", "00000000", 3) +
+		row(3, "0100000A:0050", "017100CB:C352", "01", "00000000:00000000", "00000001", 200) +
+		row(4, "0100000A:0050", "017100CB:C353", "06", "00000000:00000000", "00000000", 201) +
+		row(5, "0100000A:9C40", "076433C6:01BB", "01", "00000000:00000000", "00000000", 0)
+	source := filepath.Join(dir, "tcp")
+	if err := ioutil.WriteFile(source, []byte(lines), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer func(s []string) { tcpguarder.Sources = s }(tcpguarder.Sources)
+	tcpguarder.Sources = []string{source}
+
+	e := &exporter{top: 1}
+	e.collect(nil)
+	e.seconds = 0.25 //采集耗时不固定
+	var b bytes.Buffer
+	e.write(&b)
+	want := `# HELP tcpguarder_connections TCP connections by state and local listening port.
+# TYPE tcpguarder_connections gauge
+tcpguarder_connections{state="ESTABLISHED",port="80"} 3
+tcpguarder_connections{state="ESTABLISHED",port="other"} 1
+tcpguarder_connections{state="LISTEN",port="80"} 1
+tcpguarder_connections{state="TIME_WAIT",port="80"} 1
+# HELP tcpguarder_tx_queue_bytes Sum of send queues by local listening port.
+# TYPE tcpguarder_tx_queue_bytes gauge
+tcpguarder_tx_queue_bytes{port="80"} 17
+tcpguarder_tx_queue_bytes{port="other"} 0
+# HELP tcpguarder_rx_queue_bytes Sum of receive queues by local listening port.
+# TYPE tcpguarder_rx_queue_bytes gauge
+tcpguarder_rx_queue_bytes{port="80"} 32
+tcpguarder_rx_queue_bytes{port="other"} 0
+# HELP tcpguarder_retransmits Sum of unrecovered RTO timeouts of current connections by local listening port.
+# TYPE tcpguarder_retransmits gauge
+tcpguarder_retransmits{port="80"} 3
+tcpguarder_retransmits{port="other"} 0
+# HELP tcpguarder_cwnd Congestion window of current connections by local listening port.
+# TYPE tcpguarder_cwnd histogram
+tcpguarder_cwnd_bucket{port="80",le="1"} 1
+tcpguarder_cwnd_bucket{port="80",le="2"} 1
+tcpguarder_cwnd_bucket{port="80",le="4"} 2
+tcpguarder_cwnd_bucket{port="80",le="10"} 2
+tcpguarder_cwnd_bucket{port="80",le="20"} 2
+tcpguarder_cwnd_bucket{port="80",le="50"} 2
+tcpguarder_cwnd_bucket{port="80",le="100"} 2
+tcpguarder_cwnd_bucket{port="80",le="200"} 3
+tcpguarder_cwnd_bucket{port="80",le="+Inf"} 4
+tcpguarder_cwnd_sum{port="80"} 405
+tcpguarder_cwnd_count{port="80"} 4
+tcpguarder_cwnd_bucket{port="other",le="1"} 1
+tcpguarder_cwnd_bucket{port="other",le="2"} 1
+tcpguarder_cwnd_bucket{port="other",le="4"} 1
+tcpguarder_cwnd_bucket{port="other",le="10"} 1
+tcpguarder_cwnd_bucket{port="other",le="20"} 1
+tcpguarder_cwnd_bucket{port="other",le="50"} 1
+tcpguarder_cwnd_bucket{port="other",le="100"} 1
+tcpguarder_cwnd_bucket{port="other",le="200"} 1
+tcpguarder_cwnd_bucket{port="other",le="+Inf"} 1
+tcpguarder_cwnd_sum{port="other"} 0
+tcpguarder_cwnd_count{port="other"} 1
+# HELP tcpguarder_remote_ips Distinct remote IPs.
+# TYPE tcpguarder_remote_ips gauge
+tcpguarder_remote_ips 2
+# HELP tcpguarder_remote_connections TCP connections of the top remote IPs.
+# TYPE tcpguarder_remote_connections gauge
+tcpguarder_remote_connections{ip="203.0.113.1"} 4
+# HELP tcpguarder_scrape_duration_seconds Time spent reading connections.
+# TYPE tcpguarder_scrape_duration_seconds summary
+tcpguarder_scrape_duration_seconds_sum 0.25
+tcpguarder_scrape_duration_seconds_count 1
+# HELP tcpguarder_scrape_errors_total Scrapes that failed to read connections.
+# TYPE tcpguarder_scrape_errors_total counter
+tcpguarder_scrape_errors_total 0
+# HELP tcpguarder_parse_errors_total Connection lines that could not be parsed.
+# TYPE tcpguarder_parse_errors_total counter
+tcpguarder_parse_errors_total ` + fmt.Sprint(tcpguarder.ParseErrors()) + `
+`
+	if got := b.String(); got != want {
+		t.Errorf("exporter:\n%v\nwant:\n%v", got, want)
+	}
+	//读取失败时只计数, 保留上次的连接指标
+	tcpguarder.Sources = []string{filepath.Join(dir, "nosuch")}
+	e.collect(nil)
+	b.Reset()
+	e.write(&b)
+	if !strings.Contains(b.String(), "tcpguarder_scrape_errors_total 1\n") ||
+		!strings.Contains(b.String(), `tcpguarder_cwnd_count{port="80"} 4`) {
+		t.Errorf("after a failed scrape:\n%v", b.String())
+	}
+}
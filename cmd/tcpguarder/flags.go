@@ -18,10 +18,9 @@ var (
 		Usage:   "local ports, default all ports,example: -port 80 -port 443",
 	}
 	FlagKill = cli.IntFlag{
-		Name:     "kill",
-		Aliases:  []string{"k"},
-		Required: true,
-		Usage:    "block ip if connection/ip gt `n`",
+		Name:    "kill",
+		Aliases: []string{"k"},
+		Usage:   "block ip if connection/ip gt `n`, 0 disable",
 	}
 	FlagWhiteIPFile = cli.StringFlag{
 		Name:    "white",
@@ -51,4 +50,219 @@ var (
 		Aliases: []string{"ab"},
 		Usage:   "show abnormal top",
 	}
+	FlagScan = cli.IntFlag{
+		Name:  "scan",
+		Usage: "block ip if distinct local ports/ip in scan window gte `n`, 0 disable",
+	}
+	FlagScanWindow = cli.DurationFlag{
+		Name:  "scan-window",
+		Usage: "port scan detect sliding window",
+		Value: time.Minute,
+	}
+	FlagTrapPort = cli.IntSliceFlag{
+		Name:  "trap",
+		Usage: "trap ports, block any ip connect to them,example: -trap 23 -trap 3389",
+	}
+	FlagTrapTimeout = cli.IntFlag{
+		Name:  "trap-timeout",
+		Usage: "ipset timeout second for ip caught by trap ports",
+		Value: 86400,
+	}
+	FlagRules = cli.StringFlag{
+		Name:  "rules",
+		Usage: "load abnormal rules from `FILE`, one rule per line: name weight expression",
+	}
+	FlagKillScore = cli.IntFlag{
+		Name:  "kill-score",
+		Usage: "block ip if abnormal score/ip gte `n`, 0 disable",
+	}
+	FlagFilter = cli.StringFlag{
+		Name:    "filter",
+		Aliases: []string{"f"},
+		Usage:   "count only connections match ss style `EXPR`,example: -filter 'state established and sport = :443'",
+	}
+	FlagGroupBy = cli.StringFlag{
+		Name:  "group-by",
+		Usage: "group top list by `DIMS`: remote, remote/N, remote6/N, lip, lport, state, uid, process,example: -group-by remote/24,lport",
+	}
+	FlagAutoWhite = cli.BoolFlag{
+		Name:  "auto-white",
+		Usage: "auto add default gateways, nameservers and established ssh clients to white ip",
+		Value: true,
+	}
+	FlagSSHPort = cli.IntSliceFlag{
+		Name:  "ssh-port",
+		Usage: "ssh ports for -auto-white",
+		Value: cli.NewIntSlice(22),
+	}
+	FlagCrawler = cli.StringSliceFlag{
+		Name:  "crawler",
+		Usage: "do not block verified crawlers whose PTR is under `DOMAIN`,example: -crawler googlebot.com -crawler search.msn.com",
+	}
+	FlagCrawlerDNS = cli.StringFlag{
+		Name:  "crawler-dns",
+		Usage: "DNS server `host:port` for crawler verify, default system resolver",
+	}
+	FlagCrawlerTTL = cli.DurationFlag{
+		Name:  "crawler-ttl",
+		Usage: "cache crawler verify result for `duration`",
+		Value: time.Hour,
+	}
+	FlagRIRFile = cli.StringSliceFlag{
+		Name:     "file",
+		Usage:    "RIR delegated-stats `FILE`,example: -file delegated-apnic-latest -file delegated-ripencc-latest",
+		Required: true,
+	}
+	FlagCountry = cli.StringSliceFlag{
+		Name:     "cc",
+		Usage:    "country `code`,example: -cc CN -cc HK",
+		Required: true,
+	}
+	FlagGeoSetName = cli.StringFlag{
+		Name:  "name",
+		Usage: "ipset `name`, ipv6 set is name6, default lower country codes",
+	}
+	FlagGeoIPCountry = cli.StringFlag{
+		Name:  "geoip-country",
+		Usage: "country mmdb `FILE`, example GeoLite2-Country.mmdb",
+	}
+	FlagGeoIPASN = cli.StringFlag{
+		Name:  "geoip-asn",
+		Usage: "asn mmdb `FILE`, example GeoLite2-ASN.mmdb",
+	}
+	FlagKillCountry = cli.StringSliceFlag{
+		Name:  "kill-country",
+		Usage: "block ip if connection/ip gt `CC=n` for country CC, * for unknown and others,example: -kill-country CN=500 -kill-country '*=50'",
+	}
+	FlagPolicy = cli.StringSliceFlag{
+		Name:  "policy",
+		Usage: "per port threshold `NAME:PORTS:KILL[:IPSET[:TIMEOUT]]`, PORTS is comma separated ports or port groups from config, example: -policy ssh:22:10:sshban:3600 -policy web:80,443:300",
+	}
+	FlagPidFile = cli.StringFlag{
+		Name:  "pid-file",
+		Usage: "lock `FILE` to prevent concurrent instances, empty disable",
+		Value: "/var/run/tcpguarder.pid",
+	}
+	FlagCleanup = cli.BoolFlag{
+		Name:  "cleanup",
+		Usage: "flush and destroy ipsets on SIGTERM/SIGINT",
+	}
+	FlagMetrics = cli.StringFlag{
+		Name:  "metrics",
+		Usage: "serve prometheus metrics on `ADDR`/metrics, example: -metrics :9107",
+	}
+	FlagMetricsTop = cli.IntFlag{
+		Name:  "metrics-top",
+		Usage: "export connections of top `n` remote ips",
+		Value: 10,
+	}
+	FlagSocket = cli.StringFlag{
+		Name:  "socket",
+		Usage: "control api unix socket `FILE`, empty disable",
+		Value: "/var/run/tcpguarder.sock",
+	}
+	FlagOutput = cli.StringFlag{
+		Name:  "output",
+		Usage: "output `FORMAT`: table, json or csv",
+		Value: "table",
+	}
+	FlagLogFormat = cli.StringFlag{
+		Name:  "log-format",
+		Usage: "ban event log `FORMAT`: text, json or logfmt",
+		Value: "text",
+	}
+	FlagLogOutput = cli.StringFlag{
+		Name:  "log-output",
+		Usage: "ban event log to `OUTPUT`: stderr, syslog or journald",
+		Value: "stderr",
+	}
+	FlagWebhook = cli.StringSliceFlag{
+		Name:  "webhook",
+		Usage: "post ban, unban and threshold events as json to `URL`",
+	}
+	FlagHookExec = cli.StringSliceFlag{
+		Name:  "hook-exec",
+		Usage: "run `CMD` on ban, unban and threshold events, event in TCPGUARDER_* env and json on stdin",
+	}
+	FlagMailSMTP = cli.StringFlag{
+		Name:  "mail-smtp",
+		Usage: "send ban digests through smtp server `HOST:PORT`, STARTTLS when supported, empty disable",
+	}
+	FlagMailUser = cli.StringFlag{
+		Name:  "mail-user",
+		Usage: "smtp auth `USER`",
+	}
+	FlagMailPassword = cli.StringFlag{
+		Name:    "mail-password",
+		Usage:   "smtp auth `PASSWORD`",
+		EnvVars: []string{"TCPGUARDER_MAIL_PASSWORD"},
+	}
+	FlagMailFrom = cli.StringFlag{
+		Name:  "mail-from",
+		Usage: "digest sender `ADDR`, default tcpguarder@hostname",
+	}
+	FlagMailTo = cli.StringSliceFlag{
+		Name:  "mail-to",
+		Usage: "digest recipient `ADDR`",
+	}
+	FlagMailEvery = cli.DurationFlag{
+		Name:  "mail-every",
+		Usage: "send a digest every `duration` when there are bans, 0 as soon as -mail-min-interval allows",
+		Value: time.Hour,
+	}
+	FlagMailBurst = cli.IntFlag{
+		Name:  "mail-burst",
+		Usage: "send the digest early after `n` bans, 0 disable",
+	}
+	FlagMailMinInterval = cli.DurationFlag{
+		Name:  "mail-min-interval",
+		Usage: "at most one mail per `duration`",
+		Value: 10 * time.Minute,
+	}
+	FlagPeer = cli.StringSliceFlag{
+		Name:  "peer",
+		Usage: "share bans with the tcpguarder run -peer-listen at `URL`, example: -peer http://10.0.0.2:9108",
+	}
+	FlagPeerListen = cli.StringFlag{
+		Name:  "peer-listen",
+		Usage: "accept bans from peers on `ADDR`, example: :9108",
+	}
+	FlagPeerSecret = cli.StringFlag{
+		Name:    "peer-secret",
+		Usage:   "shared hmac `SECRET` of all peers, at least 16 characters",
+		EnvVars: []string{"TCPGUARDER_PEER_SECRET"},
+	}
+	FlagPeerName = cli.StringFlag{
+		Name:  "peer-name",
+		Usage: "`NAME` of this host in shared bans, default hostname",
+	}
+	FlagCIDROut = cli.StringFlag{
+		Name:    "out",
+		Aliases: []string{"o"},
+		Usage:   "write minimised list to `FILE`",
+	}
+	FlagIPLib = cli.StringFlag{
+		Name:  "iplib",
+		Usage: "load cidr list from `PATH` instead of the embedded one, a file, or a directory containing china-cidr.txt/not-china-cidr.txt",
+	}
+	FlagBlocklistName = cli.StringFlag{
+		Name:  "name",
+		Usage: "ipset `name`, ipv6 set is name6",
+		Value: "blocklist",
+	}
+	FlagBlocklistEvery = cli.DurationFlag{
+		Name:    "every",
+		Aliases: []string{"d"},
+		Usage:   "reload files and sync ipset every `duration`, 0 sync once and exit",
+	}
+	FlagConfig = cli.StringFlag{
+		Name:    "config",
+		Aliases: []string{"c"},
+		Usage:   "load settings from yaml `FILE`, command line flags override it",
+	}
+	FlagSource = cli.StringSliceFlag{
+		Name:  "source",
+		Usage: "read connections from `FILE`,example: -source /proc/net/tcp -source /proc/net/tcp6",
+		Value: cli.NewStringSlice("/proc/net/tcp"),
+	}
 )
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/urfave/cli/v2"
+)
+
+func loadGeoIP(c *cli.Context) error {
+	geo, err := openGeoIP(c)
+	if err != nil {
+		return err
+	}
+	tcpguarder.SetGeo(geo)
+	return nil
+}
+
+// openGeoIP 打开 -geoip-country 和 -geoip-asn, 都未指定时返回 nil
+func openGeoIP(c *cli.Context) (*tcpguarder.GeoIP, error) {
+	var geo tcpguarder.GeoIP
+	if file := c.String("geoip-country"); file != "" {
+		db, err := tcpguarder.OpenMMDB(file)
+		if err != nil {
+			return nil, fmt.Errorf("geoip-country: %v", err)
+		}
+		geo.Country = db
+	}
+	if file := c.String("geoip-asn"); file != "" {
+		db, err := tcpguarder.OpenMMDB(file)
+		if err != nil {
+			return nil, fmt.Errorf("geoip-asn: %v", err)
+		}
+		geo.ASN = db
+	}
+	if geo.Country == nil && geo.ASN == nil {
+		return nil, nil
+	}
+	return &geo, nil
+}
+
+// geoinfo 未加载 mmdb 时返回空
+func geoinfo(ip string) string {
+	geo := tcpguarder.CurrentGeo()
+	if geo == nil {
+		return ""
+	}
+	return geo.Lookup(net.ParseIP(ip)).String()
+}
+
+// blocktext text 格式的封禁日志
+func blocktext(ip string, args ...interface{}) []interface{} {
+	args = append([]interface{}{"block", ip}, args...)
+	if geo := geoinfo(ip); geo != "" {
+		args = append(args, geo)
+	}
+	return args
+}
+
+// countryKill 按国家的封禁阈值, * 为其余国家
+type countryKill map[string]int
+
+// parseCountryKill geo 为将要使用的 GeoIP, 需要国家库
+func parseCountryKill(ss []string, geo *tcpguarder.GeoIP) (countryKill, error) {
+	m := make(countryKill)
+	for _, v := range ss {
+		kv := strings.SplitN(v, "=", 2)
+		if len(kv) != 2 {
+			return nil, fmt.Errorf("kill-country: bad %q, want CC=n", v)
+		}
+		n, err := strconv.Atoi(kv[1])
+		if err != nil {
+			return nil, fmt.Errorf("kill-country: bad %q, want CC=n", v)
+		}
+		m[strings.ToUpper(kv[0])] = n
+	}
+	if len(m) > 0 && (geo == nil || geo.Country == nil) {
+		return nil, fmt.Errorf("kill-country needs -geoip-country")
+	}
+	return m, nil
+}
+
+// limit 返回 ip 的封禁阈值
+func (m countryKill) limit(ip string, kill int) int {
+	if len(m) == 0 {
+		return kill
+	}
+	if n, ok := m[tcpguarder.CurrentGeo().Lookup(net.ParseIP(ip)).Country]; ok {
+		return n
+	}
+	if n, ok := m["*"]; ok {
+		return n
+	}
+	return kill
+}
+
+// min 最小阈值, 用于提前结束遍历
+func (m countryKill) min(kill int) int {
+	for _, n := range m {
+		if n < kill {
+			kill = n
+		}
+	}
+	return kill
+}
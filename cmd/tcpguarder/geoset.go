@@ -0,0 +1,63 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/lixiangzhong/tcpguarder/cmd/tcpguarder/iplib"
+	"github.com/urfave/cli/v2"
+)
+
+func GeoSetAction(c *cli.Context) error {
+	ccs := c.StringSlice("cc")
+	name := c.String("name")
+	if name == "" {
+		name = strings.ToLower(strings.Join(ccs, ""))
+	}
+	if err := checkRestoreName(name + "6"); err != nil {
+		return err
+	}
+	var v4, v6 []*net.IPNet
+	for _, file := range c.StringSlice("file") {
+		f, err := os.Open(file)
+		if err != nil {
+			return err
+		}
+		a, b, err := tcpguarder.ParseDelegated(f, ccs)
+		f.Close()
+		if err != nil {
+			return fmt.Errorf("%v: %v", file, err)
+		}
+		v4 = append(v4, a...)
+		v6 = append(v6, b...)
+	}
+	v4, v6 = iplib.Aggregate(v4), iplib.Aggregate(v6)
+	fmt.Println("please confirm the following iptable is in effect")
+	if len(v4) > 0 {
+		if err := restoreipset(name, "inet", netsToStrings(v4)); err != nil {
+			return err
+		}
+		fmt.Printf("ipset %v: %v ipv4 cidr\n", name, len(v4))
+		fmt.Printf("iptables -I INPUT -p tcp -m set --match-set %v src -j DROP\n", name)
+	}
+	if len(v6) > 0 {
+		name6 := name + "6"
+		if err := restoreipset(name6, "inet6", netsToStrings(v6)); err != nil {
+			return err
+		}
+		fmt.Printf("ipset %v: %v ipv6 cidr\n", name6, len(v6))
+		fmt.Printf("ip6tables -I INPUT -p tcp -m set --match-set %v src -j DROP\n", name6)
+	}
+	return nil
+}
+
+func netsToStrings(nets []*net.IPNet) []string {
+	s := make([]string, 0, len(nets))
+	for _, v := range nets {
+		s = append(s, v.String())
+	}
+	return s
+}
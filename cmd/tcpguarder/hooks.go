@@ -0,0 +1,305 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// hookEvents 未指定 events 时触发 hook 的事件
+var hookEvents = []string{EventBan, EventUnban, EventThreshold}
+
+// hookQueue 每个 hook 排队的事件数, 满了丢弃
+const hookQueue = 256
+
+// Hook 事件发生时 POST 到 URL 或执行命令
+type Hook struct {
+	URL     string
+	Exec    string
+	Events  []string
+	Headers map[string]string
+	Timeout time.Duration
+	Retries int //失败后重试次数, 间隔 1s, 2s, 4s ...
+	body    *template.Template
+	queue   chan Event
+	peer    *peerSettings //不为 nil 时为同步封禁的其他主机
+}
+
+func (h *Hook) String() string {
+	name := h.URL
+	if h.peer != nil {
+		name = "peer " + h.URL
+	}
+	if h.Exec != "" {
+		name = "exec " + h.Exec
+	}
+	return fmt.Sprintf("%v on %v, timeout %v retries %v", name, strings.Join(h.Events, ","), h.Timeout, h.Retries)
+}
+
+type HookConfig struct {
+	URL     string            `yaml:"url"`
+	Exec    string            `yaml:"exec"`
+	Events  []string          `yaml:"events"`
+	Body    string            `yaml:"body"` //text/template, 数据为 Event, 为空时为 Event 的 json
+	Headers map[string]string `yaml:"headers"`
+	Timeout time.Duration     `yaml:"timeout"`
+	Retries *int              `yaml:"retries"`
+}
+
+var hookFuncs = template.FuncMap{
+	"json": func(v interface{}) (string, error) {
+		b, err := json.Marshal(v)
+		return string(b), err
+	},
+}
+
+func newHook(hc HookConfig) (*Hook, error) {
+	h := &Hook{
+		URL:     hc.URL,
+		Exec:    hc.Exec,
+		Events:  hc.Events,
+		Headers: hc.Headers,
+		Timeout: hc.Timeout,
+		Retries: 2,
+	}
+	if (h.URL == "") == (h.Exec == "") {
+		return nil, errors.New("hook: want one of url or exec")
+	}
+	if h.Exec != "" && len(strings.Fields(h.Exec)) == 0 {
+		return nil, errors.New("hook: blank exec")
+	}
+	if h.URL != "" {
+		u, err := url.Parse(h.URL)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, fmt.Errorf("hook: bad url %q", h.URL)
+		}
+	}
+	if h.Exec != "" && hc.Body != "" {
+		return nil, fmt.Errorf("hook %v: body only for url", h.Exec)
+	}
+	if len(h.Events) == 0 {
+		h.Events = hookEvents
+	}
+	for _, v := range h.Events {
+		switch v {
+		case EventBan, EventUnban, EventThreshold, EventSkip, EventBanFailed:
+		default:
+			return nil, fmt.Errorf("hook: unknown event %q", v)
+		}
+	}
+	if h.Timeout <= 0 {
+		h.Timeout = 5 * time.Second
+	}
+	if hc.Retries != nil {
+		if *hc.Retries < 0 {
+			return nil, errors.New("hook: retries must not be negative")
+		}
+		h.Retries = *hc.Retries
+	}
+	if hc.Body != "" {
+		t, err := template.New("body").Funcs(hookFuncs).Option("missingkey=error").Parse(hc.Body)
+		if err != nil {
+			return nil, fmt.Errorf("hook body: %v", err)
+		}
+		h.body = t
+	}
+	return h, nil
+}
+
+// loadHooks -webhook, -hook-exec 优先于配置文件中的 hooks
+func loadHooks(c *cli.Context) ([]*Hook, error) {
+	hcs := config.Hooks
+	if c.IsSet("webhook") || c.IsSet("hook-exec") {
+		hcs = nil
+		for _, v := range c.StringSlice("webhook") {
+			hcs = append(hcs, HookConfig{URL: v})
+		}
+		for _, v := range c.StringSlice("hook-exec") {
+			hcs = append(hcs, HookConfig{Exec: v})
+		}
+	}
+	var list []*Hook
+	for _, hc := range hcs {
+		h, err := newHook(hc)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, h)
+	}
+	return list, nil
+}
+
+// match 其他主机同步来的封禁不再发给其他主机
+func (h *Hook) match(e Event) bool {
+	if h.peer != nil && e.Origin != "" {
+		return false
+	}
+	return hasString(h.Events, e.Event)
+}
+
+func (h *Hook) run(wg *sync.WaitGroup) {
+	defer wg.Done()
+	for e := range h.queue {
+		h.send(e)
+	}
+}
+
+// noRetry 重试也不会成功的错误, 如 4xx
+type noRetry struct{ error }
+
+func (h *Hook) send(e Event) {
+	var err error
+	for i := 0; i <= h.Retries; i++ {
+		if i > 0 {
+			time.Sleep(time.Second << uint(i-1))
+		}
+		if h.Exec != "" {
+			err = h.exec(e)
+		} else {
+			err = h.post(e)
+		}
+		if _, ok := err.(noRetry); err == nil || ok {
+			break
+		}
+	}
+	if err != nil {
+		log.Println("hook", h, e.Event, e.IP, err)
+	}
+}
+
+func (h *Hook) post(e Event) error {
+	var body []byte
+	if h.peer != nil {
+		e.Origin = h.peer.name
+		body, _ = json.Marshal(e)
+	} else if h.body != nil {
+		var buf bytes.Buffer
+		if err := h.body.Execute(&buf, e); err != nil {
+			return noRetry{err}
+		}
+		body = buf.Bytes()
+	} else {
+		body, _ = json.Marshal(e)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), h.Timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, h.URL, bytes.NewReader(body))
+	if err != nil {
+		return noRetry{err}
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "tcpguarder")
+	for k, v := range h.Headers {
+		req.Header.Set(k, v)
+	}
+	if h.peer != nil {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		req.Header.Set(peerTimestampHeader, ts)
+		req.Header.Set(peerSignatureHeader, peerSign(h.peer.secret, ts, body))
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+	switch {
+	case resp.StatusCode < 300:
+		return nil
+	case resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests:
+		return noRetry{fmt.Errorf("%v %s", resp.Status, bytes.TrimSpace(msg))}
+	}
+	return fmt.Errorf("%v %s", resp.Status, bytes.TrimSpace(msg))
+}
+
+// exec 事件在 TCPGUARDER_* 环境变量中, stdin 为事件的 json
+func (h *Hook) exec(e Event) error {
+	ss := strings.Fields(h.Exec)
+	ctx, cancel := context.WithTimeout(context.Background(), h.Timeout)
+	defer cancel()
+	cmd := exec.CommandContext(ctx, ss[0], ss[1:]...)
+	cmd.Env = append(os.Environ(), "TCPGUARDER_TIME="+e.Time.Format(time.RFC3339))
+	cmd.Env = append(cmd.Env, e.fields()...)
+	b, _ := json.Marshal(e)
+	cmd.Stdin = bytes.NewReader(append(b, '\n'))
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		if msg := strings.TrimSpace(string(out)); msg != "" {
+			return fmt.Errorf("%v: %v", err, msg)
+		}
+		return err
+	}
+	return nil
+}
+
+// hookSet 当前生效的 hook, 重新加载时替换
+type hookSet struct {
+	mu   sync.Mutex
+	list []*Hook
+	wg   sync.WaitGroup
+}
+
+var hooks = &hookSet{}
+
+// replace 启动新的 hook, 旧的 hook 处理完队列中的事件后退出
+func (s *hookSet) replace(list []*Hook) {
+	s.mu.Lock()
+	old := s.list
+	s.list = list
+	for _, h := range list {
+		h.queue = make(chan Event, hookQueue)
+		s.wg.Add(1)
+		go h.run(&s.wg)
+	}
+	s.mu.Unlock()
+	for _, h := range old {
+		close(h.queue)
+	}
+}
+
+// fire 不阻塞, 队列满时丢弃
+func (s *hookSet) fire(e Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, h := range s.list {
+		if !h.match(e) {
+			continue
+		}
+		select {
+		case h.queue <- e:
+		default:
+			log.Println("hook", h, "queue full, drop", e.Event, e.IP)
+		}
+	}
+}
+
+// stop 停止所有 hook, 最多等待 d 让队列中的事件发送完
+func (s *hookSet) stop(d time.Duration) {
+	s.replace(nil)
+	done := make(chan struct{})
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(d):
+		log.Println("hook: timeout, pending events dropped")
+	}
+}
@@ -0,0 +1,156 @@
+package main
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestNewHookError(t *testing.T) {
+	neg := -1
+	tests := []struct {
+		hc  HookConfig
+		err string
+	}{
+		{HookConfig{}, "hook: want one of url or exec"},
+		{HookConfig{URL: "http://a", Exec: "/bin/true"}, "hook: want one of url or exec"},
+		{HookConfig{Exec: "   "}, "hook: blank exec"},
+		{HookConfig{Exec: "\t\n"}, "hook: blank exec"},
+		{HookConfig{URL: "ftp://a"}, `hook: bad url "ftp://a"`},
+		{HookConfig{Exec: "/bin/true", Body: "x"}, "hook /bin/true: body only for url"},
+		{HookConfig{URL: "http://a", Events: []string{"nosuch"}}, `hook: unknown event "nosuch"`},
+		{HookConfig{URL: "http://a", Retries: &neg}, "hook: retries must not be negative"},
+	}
+	for _, tt := range tests {
+		if _, err := newHook(tt.hc); err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+			t.Errorf("newHook(%+v) = %v, want %v", tt.hc, err, tt.err)
+		}
+	}
+	h, err := newHook(HookConfig{Exec: "/bin/true --flag"})
+	if err != nil || h.Timeout <= 0 || h.Retries != 2 || len(h.Events) == 0 {
+		t.Errorf("newHook defaults = %+v, %v", h, err)
+	}
+}
+
+func TestHookPost(t *testing.T) {
+	var got struct {
+		body, ctype, auth string
+	}
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := ioutil.ReadAll(r.Body)
+		got.body, got.ctype, got.auth = string(b), r.Header.Get("Content-Type"), r.Header.Get("Authorization")
+	}))
+	defer ts.Close()
+	h, err := newHook(HookConfig{
+		URL:     ts.URL,
+		Body:    `{"text": "{{.Event}} {{.IP}} {{json .Rule}}"}`,
+		Headers: map[string]string{"Authorization": "Bearer x"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := h.post(Event{Event: EventBan, IP: "203.0.113.5", Rule: `a"b`}); err != nil {
+		t.Fatal(err)
+	}
+	if got.body != `{"text": "ban 203.0.113.5 "a\"b""}` || got.ctype != "application/json" || got.auth != "Bearer x" {
+		t.Errorf("request = %+v", got)
+	}
+	//没有 body 模板时为事件的 json
+	h, _ = newHook(HookConfig{URL: ts.URL})
+	if err := h.post(Event{Event: EventUnban, IP: "203.0.113.6"}); err != nil {
+		t.Fatal(err)
+	}
+	var e Event
+	if err := json.Unmarshal([]byte(got.body), &e); err != nil || e.Event != EventUnban || e.IP != "203.0.113.6" {
+		t.Errorf("json body %q: %v", got.body, err)
+	}
+	//模板执行失败不重试
+	h, _ = newHook(HookConfig{URL: ts.URL, Body: "{{.Nosuch}}"})
+	if _, ok := h.post(Event{Event: EventBan}).(noRetry); !ok {
+		t.Error("template error should not be retried")
+	}
+}
+
+func TestHookRetry(t *testing.T) {
+	tests := []struct {
+		status int
+		want   int32 //请求次数
+	}{
+		{http.StatusOK, 1},
+		{http.StatusBadRequest, 1},
+		{http.StatusNotFound, 1},
+		{http.StatusTooManyRequests, 2},
+		{http.StatusBadGateway, 2},
+	}
+	for _, tt := range tests {
+		var n int32
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&n, 1)
+			w.WriteHeader(tt.status)
+		}))
+		one := 1
+		h, err := newHook(HookConfig{URL: ts.URL, Retries: &one})
+		if err != nil {
+			t.Fatal(err)
+		}
+		h.send(Event{Event: EventBan, IP: "203.0.113.7"})
+		ts.Close()
+		if got := atomic.LoadInt32(&n); got != tt.want {
+			t.Errorf("status %v: %v requests, want %v", tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestHookExec(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	script := filepath.Join(dir, "hook.sh")
+	out := filepath.Join(dir, "out")
+	if err := ioutil.WriteFile(script, []byte("env | grep ^TCPGUARDER_ | sort > \"$1\"\ncat >> \"$1\"\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	h, err := newHook(HookConfig{Exec: "/bin/sh " + script + " " + out})
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := Event{
+		Time:  time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		Event: EventBan,
+		IP:    "203.0.113.8",
+		Rule:  "scan",
+		Count: 12,
+	}
+	if err := h.exec(e); err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadFile(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(b)), "\n")
+	env := strings.Join(lines[:len(lines)-1], "\n")
+	for _, v := range []string{"TCPGUARDER_EVENT=ban", "TCPGUARDER_IP=203.0.113.8", "TCPGUARDER_RULE=scan", "TCPGUARDER_COUNT=12", "TCPGUARDER_TIME=2020-01-02T03:04:05Z"} {
+		if !strings.Contains(env, v+"\n") && !strings.HasSuffix(env, v) {
+			t.Errorf("env missing %v:\n%v", v, env)
+		}
+	}
+	var stdin Event
+	if err := json.Unmarshal([]byte(lines[len(lines)-1]), &stdin); err != nil || stdin.IP != e.IP || stdin.Count != 12 {
+		t.Errorf("stdin %q: %v", lines[len(lines)-1], err)
+	}
+	//失败时带上命令的输出
+	h, _ = newHook(HookConfig{Exec: "/bin/sh " + filepath.Join(dir, "nosuch.sh")})
+	if err := h.exec(e); err == nil || !strings.Contains(err.Error(), "nosuch.sh") {
+		t.Errorf("exec error = %v", err)
+	}
+}
@@ -1,256 +0,0 @@
-// Code generated by go-bindata. (@generated) DO NOT EDIT.
-// sources:
-// china-cidr.txt
-// not-china-cidr.txt
-package iplib
-
-import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-	"io"
-	"io/ioutil"
-	"os"
-	"path/filepath"
-	"strings"
-	"time"
-)
-
-func bindataRead(data []byte, name string) ([]byte, error) {
-	gz, err := gzip.NewReader(bytes.NewBuffer(data))
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-
-	var buf bytes.Buffer
-	_, err = io.Copy(&buf, gz)
-	clErr := gz.Close()
-
-	if err != nil {
-		return nil, fmt.Errorf("Read %q: %v", name, err)
-	}
-	if clErr != nil {
-		return nil, err
-	}
-
-	return buf.Bytes(), nil
-}
-
-type asset struct {
-	bytes []byte
-	info  os.FileInfo
-}
-
-type bindataFileInfo struct {
-	name    string
-	size    int64
-	mode    os.FileMode
-	modTime time.Time
-}
-
-func (fi bindataFileInfo) Name() string {
-	return fi.name
-}
-func (fi bindataFileInfo) Size() int64 {
-	return fi.size
-}
-func (fi bindataFileInfo) Mode() os.FileMode {
-	return fi.mode
-}
-func (fi bindataFileInfo) ModTime() time.Time {
-	return fi.modTime
-}
-func (fi bindataFileInfo) IsDir() bool {
-	return false
-}
-func (fi bindataFileInfo) Sys() interface{} {
-	return nil
-}
-
-var _chinaCidrTxt = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x5d\x59\x96\x6d\x2b\x08\xfb\xaf\xc1\xec\xab\xd8\xcf\x7f\x62\x6f\x89\x5d\x92\xf3\x3e\x6a\xd5\x09\xdb\x5e\x44\x54\xc4\xf8\x85\xcf\xbe\xf0\xcf\xd2\xdf\xfc\x19\xe7\xcf\xec\x3f\xfb\xfc\x19\xfd\x67\x9a\x21\xe2\xf8\x8b\xf3\xfb\x09\x11\x89\x1c\xeb\xa4\x07\xff\x7d\x63\xc6\x2f\xcf\x9f\xe6\x3f\x6f\x2e\xb6\x7e\x66\xff\x19\x1e\xb5\xce\xc0\xb1\xfb\xef\x97\xb4\x41\xd2\xf6\x92\xb6\xaf\xbe\x98\xe5\xa4\x97\x3c\xbd\x58\xff\x66\xce\x2f\xbd\x0c\xe9\x65\x48\x2f\xbf\xf4\xf2\x2b\x6a\x7e\x45\xcd\xaf\x41\xfa\x17\xc3\x2d\x6c\xff\x46\x3d\xc1\xfb\xce\x68\xf8\xef\x70\xf2\xec\x5f\xcc\xfd\x85\x8f\x39\xbf\x08\xd1\xfa\x29\x42\xff\x62\xb4\x07\xcc\x32\x7c\x19\xf6\x12\x8e\x35\x00\x28\x90\x7d\x2c\xf9\xe5\x63\xf9\x56\x69\x82\xd7\xfe\xbb\x43\xd3\xfa\x1d\x6e\x17\x05\x68\xa7\x48\x5d\x1d\xb0\x53\xc3\xb7\x8a\xb9\xd3\x8a\xb7\x5d\xe2\xea\xc3\xe8\xbf\x6f\xd5\xbc\x73\xf3\xfa\xe2\x0d\x59\xa0\x67\xfa\x8b\x31\xeb\x71\xc2\x54\xa0\xf7\x55\xc4\xe8\xd9\xc5\x1d\xa8\x38\x18\x2f\xa5\x78\x33\x8c\x6d\x43\xa8\x4c\xec\x58\x9b\x19\xf4\x55\x27\x76\xe0\xd2\xd8\x81\x4f\x63\x7f\xdd\xef\x91\x6e\x45\x7b\x86\x62\x9c\x02\xfa\x97\x61\xaf\x16\xb1\x43\x35\x2c\x64\x04\xf6\x12\xb0\x98\xbe\x18\xca\x97\xeb\xbf\x64\x7f\xbb\x1a\xf3\x63\xf8\xeb\x9f\x85\xf1\xe5\xcd\x22\x1b\xed\x0e\x5b\x28\xed\xde\xef\x9f\xc5\xdb\x80\x13\xdc\xbe\x98\x20\x9c\xdc\x56\xa4\xd7\x50\xb6\x2a\x96\xc2\xe7\x99\x03\xee\x8b\x30\x8b\xd6\xbc\xc2\xfd\x5f\x0a\x7f\xd1\x2b\xb1\x9b\x6b\xfe\xbe\xac\x90\x8f\x40\xd8\xbf\x2d\x03\x88\xa1\x3f\x54\x6f\x7f\xe6\x2f\x06\x3b\x7c\xf2\x70\x87\x74\x4e\x3d\xf6\xd7\xc7\x47\x13\xbd\x31\x9e\xbd\xcc\xa7\x49\x27\xca\x88\x72\xc6\x54\x86\x7d\x15\xb2\x18\xf6\xb5\x0a\xb0\x42\xcc\xdb\x6b\x1b\xf5\x93\x8e\x05\xcf\x63\x0d\x45\x0b\x0b\x0f\xc0\xe9\xb3\x54\x27\x0f\xc6\x61\xff\xac\x21\xc1\xfa\x3f\xab\x40\x08\xff\xac\xfc\x59\xf3\xd6\xdc\xac\xb8\x50\x0d\x88\xfa\x6e\x26\x47\x16\x30\xa4\x17\x73\x7f\x7b\xed\x6b\xed\x48\xa1\x34\x7f\xa7\xfc\x99\x8f\x82\x15\x2b\x07\xfc\x56\x0f\xbb\x58\xfb\x4a\xb8\x4c\xb6\xd0\xe5\x97\x09\xf3\xd7\x5e\x22\x25\x7f\xb1\x30\x5c\x92\xaa\x4f\x38\x8e\x14\x8b\xe3\xc0\xb0\x79\x79\xc2\x41\x09\x43\x77\x3a\xaa\xe7\x6b\x3f\x98\x6a\x39\x79\xdf\x6e\xd2\xd1\xb9\x1a\x3e\x1f\xbe\xe9\x07\xf5\x70\x33\x8e\xce\xe6\xaf\xd4\x71\x72\x39\xe6\x15\xb7\x88\x5a\xe5\x8a\xf5\xcb\x90\xb2\x45\x6c\x80\x09\xa3\x05\xc0\xfd\x30\xcc\x44\x29\x7e\x66\xe1\x45\x3e\x8c\xb8\xea\x58\x0c\xba\x20\x3e\x61\x68\x6d\xb5\xdb\x45\x57\xac\xac\x3c\xfa\x1d\x06\xab\xdf\x56\xfa\xa9\xee\x21\x18\xeb\xa2\xdf\xd6\x75\x74\xc4\xa1\x83\xd3\x74\x2b\xdc\x16\x86\xa9\x7e\x37\xd3\x54\xbf\xd4\xbe\x70\x43\x1d\x06\xf1\xa4\x93\x41\xb0\xc7\x6f\x2b\x4e\xda\xb3\xf1\x42\x47\x76\x6d\xb4\x1b\x6a\x87\x1c\x4b\xa0\xce\x7c\x6f\xab\x4d\x10\xa0\x7a\x25\x7e\x56\xec\x26\x39\x61\xee\xb7\xc2\x0e\x5f\xa2\x13\x9e\x69\x73\xc1\x3b\x71\x6e\x78\xba\x79\xc1\x70\xdb\xe7\xcd\x3e\xb3\x4a\xaf\xb9\x53\x45\x79\x9c\xea\x77\xc4\x83\x7f\xb2\xd7\x67\x13\x95\x0c\x8d\x64\xa5\xac\xf9\x6a\x17\xd5\xb1\x75\xc6\x35\x33\x6e\x12\xbe\x6d\xf1\x9a\xa6\xda\x60\x9f\x4d\x11\x13\xf3\x94\xcc\x69\x9c\x9e\xcf\xeb\xf7\xeb\xed\x81\xbd\x3d\xb0\xb7\x07\xf6\xf6\x78\x9a\x9d\xff\x3e\x45\x19\x4f\x0f\x9c\xd9\x1d\x99\x57\x17\x7a\x1c\x32\x6e\x5b\x78\xc0\x9a\x6f\xc3\x8c\x3d\x1e\xc3\x4b\xe5\xb5\xa1\xf7\xee\xae\xe8\x46\xb7\x83\x17\x3a\xfd\xbb\x51\x5b\x95\xdc\x21\xcb\x45\xed\xce\xa1\x13\xcc\xc1\x56\xe7\xb0\xfe\x67\x7d\x13\xa6\x78\x35\xcb\x53\xfa\x6e\x42\x99\xaa\xe0\xc6\xd1\xca\x6b\x33\xc7\xf5\xb6\xda\x84\xa3\xbb\x76\xb4\x33\x9b\xd0\xf2\x2d\xa5\x7f\xad\xab\xf1\xf2\x2c\xd6\x64\xbc\x57\xb4\x85\x4f\xc1\xed\x8d\x51\xff\x7d\xe4\x94\x83\x9b\x8a\xbd\xee\xf0\xdf\xa7\xd3\xb2\x7d\x4b\x39\xda\x51\xe2\x97\x5f\xb0\xad\x79\xcf\x86\xb4\x33\xfa\xc7\x4e\xf8\xd6\xcd\xde\xb0\xce\x76\x39\xdd\x41\xbe\x13\x44\xb6\xaf\xda\x9b\xbf\x36\x5c\x69\x8c\x0d\xc3\x4d\x70\x7e\xeb\xf9\x16\xa3\xc2\xac\x3d\xe1\x95\xe4\xd9\xae\xfe\x56\x1c\xa4\x0f\xc2\xf5\xb4\xa7\xbf\xb0\xd1\x1e\x1f\x69\x43\xd7\xac\x57\xee\x33\xe2\xab\xf6\x0c\x9a\xf2\x6d\xde\x8e\xb5\xe8\x58\xd9\x19\xf0\x4c\x48\x1b\xd6\x80\x89\x6e\x65\xfc\x16\x20\x53\xe9\xe2\x11\x51\xd9\x66\x61\xc6\xeb\xab\x51\xaf\xae\x3f\x51\x83\x0a\x8f\x4a\x6d\x3f\xea\xd3\xeb\x37\x3c\xda\xd0\x84\xe3\x7c\x3d\x70\xd4\x17\x76\x50\x26\xe3\x4c\x69\x3b\xde\x99\x76\x66\xd7\x87\x00\xb5\x8e\x96\x80\x15\xa2\x19\x94\xce\x63\xdd\xfe\x98\x21\xb1\xb0\x13\xdf\x41\xb0\xe0\x91\xe0\x07\x42\xa6\x96\xae\xfe\x99\xed\x8d\xf1\x85\x0e\x9f\x8d\x83\xe6\x28\x9f\xc3\x31\x05\xa4\x6c\xf5\x08\x29\x4b\x3f\x02\x8a\x2b\x48\x07\xe7\xd7\x78\x0b\x43\x81\x4a\x7d\xf2\x7f\xe3\x3b\x01\x1c\x7c\x66\x80\x8d\xb1\xb6\xa5\x52\x6d\x4b\xfd\x24\x71\xac\x6d\x69\x5f\x27\x24\xe5\x38\x4b\xb1\x8b\xd3\x65\xa5\xdb\x1a\x39\xb8\xb4\x02\x4a\xb2\x7f\xb6\xca\xda\xdb\xe9\x61\xbb\xf8\x28\x2d\x1b\x03\xab\x4c\x08\xbc\x32\xe1\x63\x96\xd8\x2b\x72\xc0\x5b\xbd\x4c\xd4\x2a\x0c\x1e\x1f\x1d\xd0\x87\x1d\x43\x96\xc6\xbc\x52\x1a\xb7\x5e\x23\x99\x55\xda\x9d\x6a\x0e\x84\x16\xe9\x92\xd6\x5b\x37\xcd\x69\x2e\x44\x90\x35\x76\xf4\x01\x2f\xfd\x55\x8c\x6c\x85\xec\x84\x70\xec\xc3\x22\xcb\x53\x69\x5f\x01\xde\x5a\xf8\x70\xd6\x44\x4f\x9e\xf8\x9c\x73\x5b\xde\x32\xb6\x9e\xe5\x4c\xa9\x72\x1e\x28\x88\x66\x2a\x58\xc7\x89\xa1\xbd\x26\x84\xf6\xf2\xd0\xaf\xbd\x26\x7c\xf2\xce\xa3\xde\xc6\x5b\x45\x3f\x33\xdb\xc5\xa1\x6f\x66\x3a\x78\x0d\xb5\xe4\x4d\x76\x65\xcc\x82\x77\x0a\x5a\x30\x33\xbc\xb3\xcd\x86\x1c\xb7\x31\xac\x9d\xe0\x15\xef\x3b\xdf\x20\x58\xb2\x8a\x55\xbe\x37\xce\x7b\xcf\x32\xaf\xe0\x91\xbf\xdf\x75\xd0\xc5\x81\x71\xc6\xf4\xcb\x47\xc9\x97\xaf\xd7\xdd\xe9\x1b\x1e\x0e\x5d\xf0\x8d\xbf\x8d\x3b\x63\x0b\x9d\x71\xc6\xcc\x0b\xc8\xa2\x8d\xef\x64\xbf\x31\xb5\x6c\x95\xfc\x2a\x8c\xf7\x85\xef\x78\x5f\xf0\x8e\xf7\x05\x2f\x33\xed\xc8\x35\x50\xe4\x37\x97\x27\xd7\x4d\xb0\xe8\x4f\x78\xad\xb0\xbb\x22\x37\x27\x4b\x95\x42\x53\xce\x8d\x73\x6e\x4b\x49\x84\xd0\xdc\xe7\x4b\x51\x7b\xb9\xb5\xd3\xe7\x0f\x57\xcc\xbd\xbd\x15\xe6\xc1\x43\xf2\xeb\x5c\x97\xbd\xca\xdd\x78\x6f\x04\x9d\xe8\x77\x5f\xee\x04\xce\x9d\xbe\x12\x83\x74\xe1\xdf\x7e\x34\xfa\xfc\x70\x07\x96\xe9\xa7\x6e\x2f\xbc\x94\x25\x16\xc1\xb9\x33\xa6\xba\x9c\xdd\x9e\x07\xf9\x2b\x0d\xd4\x71\x77\x22\x37\xe4\x76\x1e\xbb\xdd\x00\x37\xe4\xa7\xf1\xc5\xda\xe9\x3b\xb3\xeb\x78\xbb\x07\x13\xa7\xf0\x21\xb7\xa6\xad\xb0\x3e\xd8\x18\xa2\x98\x48\xe1\xc3\x61\x9d\xc2\x5b\xbb\x9f\xa4\x0d\x38\xcc\x31\xe6\x15\x49\x9c\x25\x50\x82\x17\xc4\x71\x92\xe2\x5d\xfa\x9f\xb8\xc8\x6e\x13\x57\xf9\x9e\x33\x63\x6c\xc6\xc4\x7d\x90\x06\xe7\x3d\xae\xce\x33\xd1\x5d\x39\xe5\xb5\xd7\x73\x66\x8a\x15\x92\x46\xbb\x2f\xec\x2a\x63\x6c\xc1\xfc\x96\x10\x0b\x15\x28\x54\x0e\x77\x4b\x62\x43\x6c\xed\xcc\xad\x3d\x57\x2f\xc8\xa4\x19\xb6\x9d\x2e\x96\xf0\x38\xe0\x72\x00\x1d\x7a\x63\xe4\xc3\x1c\x3f\xe4\x9b\xbc\xb7\x05\xc3\x09\x4c\xb2\x25\x47\x6a\xce\xcc\x9d\x95\x23\x0d\xc7\x99\x32\x76\x7c\x8e\x3c\x3d\x64\xe9\x5b\x2f\x49\x27\x4c\xd3\x41\x8e\x2c\xa1\x27\x3e\xfb\x53\x37\x3c\x96\xee\x1d\x40\x6c\x88\x89\xdb\x27\x61\x6b\x26\x48\x1d\x66\x5f\x81\xa9\x66\xc2\xf4\xc4\xca\x5a\x25\x60\x5c\x6a\x14\xe3\xce\x36\x96\xf4\x13\x23\x03\x67\xe3\x69\xeb\x28\x5b\x18\xbe\x73\xc1\x2d\x70\xc9\x49\x0e\x4c\x8c\x32\x2c\xa7\x0f\xf9\x36\x27\x6a\x94\xf4\x65\xfe\xd6\x39\xa6\x09\x4e\x9c\xd4\xae\xc9\x4d\x8c\x19\x2d\x49\xcd\x12\x4b\xfe\x23\xee\x11\xe3\x4c\x94\x2b\x37\x33\x4f\xa8\x47\xe9\xeb\x07\x71\x5a\x34\x7f\x1e\xe5\xf1\x21\xf9\xc8\x5f\x2b\x7f\x25\x16\x6f\x6f\x91\xb9\xb3\xe9\x0c\x39\x29\x1e\x0f\x0d\x96\x96\x1b\x17\xf9\x2e\x25\xa3\x29\x60\x6b\xaf\x2f\x33\x92\xc2\x13\xd3\xe8\x68\xac\x6c\xcd\xd4\xa8\x3d\x3b\x4d\x10\x13\x76\x82\x99\x21\xf7\x35\x9c\x8c\x6d\x7c\x37\x03\x16\xa4\x21\xc1\xd3\x76\xee\xac\x99\xe5\x73\x7e\xf6\x82\x6b\xbd\x99\x71\xba\x74\x76\x17\xc6\x19\x1f\x23\x92\xe4\x79\x08\x1b\x0f\x56\x02\xf2\x9d\x68\x01\x63\x3b\x0e\x19\xc0\x77\xe2\xbe\xe1\x49\x1d\x2b\x01\x4b\x53\x02\x49\xdf\x12\x68\xaa\x2c\x3c\x4b\x97\x40\x7d\x52\x02\x31\xdf\x8c\x8b\x0a\xe8\xc4\x81\x83\x47\x89\x4e\x7d\x58\x02\x6b\xf7\x8e\x25\x7c\xe3\x8a\x58\xe0\xf0\x26\x75\x31\xa9\x8c\x36\x04\x75\x53\x89\x1f\x23\x8a\x1c\x3f\x8a\x1b\xb9\x91\x4b\x64\x96\xf0\x7d\x66\x4c\x8d\xa5\x7d\x31\x52\xc5\x8a\x9d\x89\x65\xe3\x8c\xd2\x62\xef\x12\x9f\x5a\xf1\x62\xac\x64\x2e\x17\xaf\xcd\x0a\x2f\xc6\x4a\xa6\x49\xa6\xf0\xda\x6c\xe6\xda\x29\xa3\xce\x81\x49\x4f\x2f\xb2\x56\x2b\x59\x7a\x3f\x4b\x6f\x67\x66\xd3\x89\x51\x51\x9a\xb8\x72\xf6\xdc\xc2\x19\x76\x10\x0f\xe6\xfc\x2d\x28\xe6\xfc\x69\x32\x9f\xd8\xb8\x3c\xa4\x34\x96\x42\x7d\x50\x7e\xfa\x00\xcb\x5a\x68\x4e\x2b\x85\x3b\xbb\xbc\x5d\xcb\x9d\x70\xa6\xaf\x83\xe3\xd2\xf2\x07\x37\xf9\x0f\x96\xe8\xdc\x4c\x85\x97\xb8\x8e\x03\xe3\x24\xe1\x2d\xf3\xf7\x9b\x7f\x99\xcb\x89\x97\x9c\xc3\x27\x19\xd7\xd7\x2b\xf2\xcb\x57\xcb\xd3\x77\xca\x3a\x35\xbb\x59\x6d\x7c\xe7\xf3\x85\x5f\x0f\x6d\x7c\xc7\xef\xc6\x77\x05\xb1\xb1\x61\xf8\xc4\xd9\xa5\x37\x60\x17\xbc\xdd\xbd\x60\xe1\xaf\x8d\xe1\x13\x45\x27\x69\xc9\xaa\x67\xc2\x6f\x6e\x3b\x38\x50\x7c\xa3\xdc\xf3\xc7\x65\x31\x2a\x4c\xfe\x12\x43\xf9\x7a\x19\x64\xc1\x37\x5d\x2c\xfc\xf8\x7e\x7f\x97\xf0\xdc\x6a\x79\x2d\x34\x5c\x97\x3c\xf8\x9c\x3c\x6c\x4c\xd9\x97\x2f\x67\x82\x91\xb2\xc3\x5d\x8f\x8d\x13\x47\x8f\x1a\xbf\x54\xc6\x95\xbf\x9b\xa4\x6f\x91\xd3\x7b\x3a\xf6\xc1\x18\xbf\xbe\xa1\xb8\xa0\xf1\x57\xe2\xa8\xca\x2d\x0d\x3b\xf6\x3b\x29\xea\xc4\x0a\xea\xd1\x4e\x9b\xf8\xf7\xe9\x78\x0b\x51\xbb\x80\x1a\xb7\x60\xa4\x82\x35\x69\xf5\xc1\xfc\x39\xde\xa6\xc4\x86\x81\xbe\x3e\xd1\xbc\xb0\x61\x1f\x58\xe0\x3e\x9b\x38\x0b\xc6\x3e\x98\xb8\x87\xb5\xf7\xd9\x0e\x85\xf8\xd9\x22\x35\xdc\x3d\xe2\xbe\x10\x4b\x6b\x91\x18\x78\x42\x0e\xfc\x04\xdc\xc1\xf8\xdd\x68\x1c\x9b\x51\x0f\x9e\xa3\x8a\x71\xe2\xbe\xd5\xe2\xfa\xfa\xe6\xa4\x85\x47\xa5\xcf\x35\x43\x6c\xa3\x56\x35\x83\xcd\xc3\x8d\x41\x14\x7a\xe2\x20\x0b\x1d\x57\x2c\x4c\xe2\x92\xa6\x0f\x87\xa1\x9d\x83\xad\xfb\xf5\xce\x11\x85\x8f\x51\x36\xcc\x14\x97\xc6\x9c\x25\x16\x09\xf7\x8c\xe6\x25\xfe\xb6\xc4\x36\xce\x5c\x16\x92\x6e\x13\xb7\xca\xe1\xab\xa4\x57\x39\xbc\x05\xc1\x38\x66\x8d\xa4\x9f\x25\x9a\x34\xa2\x6f\xc2\x52\x60\x6a\xb6\xcc\xcd\x96\xb9\x25\x32\x8d\x2a\xdc\x4e\x5e\x90\x38\x2b\xcb\x30\xc8\x3c\x06\x69\x37\xf9\x60\xae\x06\x8d\x77\xcb\x2c\x8a\x7c\x43\x97\x60\x66\x88\x72\x6f\x42\x23\x88\xa2\xc7\xca\x5b\xaf\x2c\xd8\x38\x2e\xf7\x77\x7d\x47\xbc\x0b\x32\x7b\x54\x19\x05\x78\xa2\xb8\xb0\x51\xf8\x77\x92\xb0\x50\xa4\x1e\x68\x3c\x55\xfa\x7e\xeb\x2b\x8c\x2f\xb5\x18\x05\x86\xfc\x15\xc6\x23\x2c\xe2\xe2\xc2\xe3\x0b\x80\x3a\xf1\x0d\xac\xda\xc2\xc3\x19\x12\x1f\xc8\x48\x6b\xd9\x04\xa1\x07\x6a\x0b\x0e\x05\xe3\x60\x87\x45\xd5\x8b\x8e\x35\xb9\xcb\xa8\x07\x8d\x20\xc8\xb0\xb7\xaa\x8a\x07\x1a\x27\x35\x38\x29\x60\x85\x09\x9b\x64\x54\x38\x27\xec\x1d\x58\x73\xdd\xe8\xef\x70\x79\xe3\x20\xe1\x61\x68\x3b\x1e\x92\x7e\x97\xf0\xd8\xc1\x25\x7e\x8c\x3a\x21\x50\xef\x26\xa4\x92\x47\x1c\x01\x1e\xb5\x33\xcc\x9c\x94\x05\xfa\x6c\x26\xdf\xef\x1e\xe4\xc9\x3a\x30\xce\x19\x9a\x21\xd2\x8c\xe8\x18\x94\xa4\x85\x9f\x92\x44\xcb\xb9\x05\x13\x36\x2a\x4e\x4a\x7e\x42\x4d\x5d\x86\x56\x13\xe5\x9c\x60\x53\xf8\xbb\x75\xb1\x20\x35\x84\x71\x43\x18\x4d\xf5\xb0\x92\xbc\xc1\xb9\x21\x68\xda\x99\xb0\x62\x33\xa4\xbb\x87\xb5\x10\xca\x11\xc7\xd1\x28\x32\x8e\xde\x09\x8d\x42\x5b\xe6\xd8\x6f\xbd\xb5\x53\x93\x92\x71\x17\x25\xe9\xa2\x04\xeb\xc5\x83\x39\x3f\x37\xe9\xbc\x5d\x96\x99\xdb\xf2\x87\x6d\x9e\x79\xc8\x65\x1e\x9f\x59\x1a\x31\x4b\x51\x33\x58\x05\x94\xb7\xac\x7e\x30\x77\xce\x4b\x53\x4f\x95\x31\x31\x53\x91\x66\x2f\xd2\xec\x85\x9b\xbd\xa0\x12\x73\x16\xaa\xa7\x28\xe5\x27\xed\x77\xe6\x58\x70\xf5\x77\x13\xe3\x9a\x16\xe9\x94\x22\x9d\x52\xa0\x53\xea\x57\xa7\xee\x36\xbe\xd8\xc2\xbf\xe4\x84\xb5\x15\xb6\x3b\xa5\x81\x2d\xdc\x04\xd7\x28\x70\xed\x8f\xdd\xdf\x25\x6e\x3d\x2c\x6d\x74\xa7\x17\x47\x4f\x7e\x6d\x08\x61\x31\xc9\x67\x5f\x76\x76\xdc\xee\xa7\x6b\xed\x99\xd6\xb7\x7e\x0c\xe3\x36\xca\x37\xbf\x89\xec\xa6\x1f\xf3\xb3\x85\x19\xcf\x1c\xd3\xe3\x5d\xcb\x82\x85\x28\x3f\x4b\x14\x32\x3d\xc3\x9c\x85\xae\xc9\x51\x79\xe6\x4f\x79\x82\x91\xd7\x2c\x38\xf5\xf0\x09\xd7\x91\x70\x5c\x27\xf5\x9b\xb0\xfa\xe4\x9f\x79\x6c\xb0\x6a\x76\x2e\x36\x44\x09\xce\x2b\x1c\xbb\x79\xdf\x8e\x7b\x71\xab\x07\xdf\xe5\xbd\xe5\xbf\xe2\x1d\x76\x18\xaf\xf4\x6b\xa2\x5f\x26\x78\xa6\xe4\x13\xe0\x97\x74\x2d\x23\x27\x08\xf0\xa5\x96\xb7\xbc\x9f\xb0\xe2\xb7\x73\x69\xc1\xd2\x81\x47\x44\x9e\xaf\xbb\x8f\x0e\xdc\xc7\x21\x1b\xf6\x40\xb0\x0d\x84\x57\x8b\xd8\xf0\x36\xc9\x86\xe1\x96\xa9\x5f\x13\xb6\x09\xae\x91\xda\x04\xcf\x34\xa3\xf4\x67\x4d\xe5\x85\x47\x3b\xe5\xd2\xdf\x1d\x04\xcf\xff\x1a\x3d\xed\xa6\xca\x90\x68\x2c\xd4\xa6\x03\x9b\xce\x8f\x6b\xdd\xc2\x66\x0e\x2d\x6f\xff\x6b\x79\x53\xc6\xb7\xcf\x36\x62\xf0\x91\xf7\x08\xa3\x3a\xa7\x5c\xbc\xac\x19\x1f\x0e\xff\xac\x1e\x78\x26\xa9\x0d\xcf\x1c\xb5\xe1\x6d\x7f\x87\xa7\x59\xc6\x97\xaf\xb1\x58\x19\xdf\x1b\x1f\x65\xdc\xae\x4f\x58\xc0\x28\x05\x8c\xb1\x0a\xc1\x34\xc4\x5c\x86\x0e\x22\x64\xae\x54\xcc\x1a\xa5\xba\x9d\x14\x10\x8a\x49\x1a\xb9\x4b\x1a\xad\x73\x1a\xa6\x4d\xe9\x86\x67\x94\x46\x97\x34\xdc\x82\x86\xd2\x48\x26\x21\x2c\x73\x1a\xdb\x08\x07\x08\x59\xd3\x98\x95\xa3\x34\x12\x95\x34\x7e\x54\x0a\xbf\x73\x42\x39\x94\xcc\xc1\xb9\xc1\xa7\x48\x60\x3c\xcb\x4c\xdf\xa9\xc8\xf1\x4b\x12\x3f\x4b\x71\x72\xe0\xf0\x45\xd2\x6b\x82\xab\xa4\xdf\xa5\x3e\x3d\x33\x1e\x92\xbf\xf0\xbc\x5f\x1e\x11\x82\x56\xd9\x4d\xa2\x89\xa0\x8d\x30\x99\x8e\x72\x8d\x49\xa3\x68\xbd\x5c\x90\x0e\x22\x04\x4d\x43\x0b\x56\x7f\x08\xd2\x78\xb1\x54\x49\x43\x9b\xcf\xe5\x35\x46\xb1\x28\x51\x9c\xc7\x28\xd1\x61\xc8\x74\x53\x77\x96\x72\x98\x49\xa3\x9b\xf6\xba\xb0\x76\x5c\x7c\xfa\x42\x4c\x05\x19\x73\x9d\x1a\x32\x66\x6a\x7b\x52\x83\xf8\x12\x9e\xf9\xc2\xbe\x96\x39\x7d\x1e\xb0\xf6\x75\x13\x5c\x19\x0f\x29\xdf\x90\xf4\x62\x30\x25\x48\x0a\x22\xbc\x4c\xf9\xc8\x2f\x43\x09\xc1\x34\x51\x93\x6a\x0a\x53\xd8\x17\xb3\x46\xc9\x5a\x8e\xf2\x43\xa0\xce\xf0\x05\x86\x10\x9a\x16\x5d\x9b\x33\x6a\xfb\x09\x0f\x5c\xcb\x5b\x8c\xd2\x25\x04\xb7\x79\xfa\x32\x43\x29\xd4\x36\x67\x05\x42\x22\x11\x9b\xbe\xce\xf1\xa3\x24\x1f\xa9\xda\x49\x44\x55\x12\xd1\x93\x3e\x16\xfa\x49\x44\x55\x72\xd1\x86\xdf\x4b\xf9\x97\x0c\x71\xe5\xf0\x55\xf2\x6b\x92\x5f\x93\xf2\xf6\x20\xb8\x4b\x75\x33\xe7\x1f\x83\x34\xa0\xcb\x3a\x0e\xa1\x4d\x14\x35\x8a\x69\x08\x93\x5a\xc7\x24\xc5\x36\x93\x72\x8a\x48\x49\x2a\x52\x92\x8a\x94\xb4\x24\x59\x43\xc2\xd6\x33\x21\x5b\x6a\xcd\xfc\x49\xb1\xb6\xbd\xea\xfb\x1e\x25\x3c\xf7\x7e\xf6\xd6\x47\xcc\xbd\x93\x5d\x00\x61\x7a\x2c\xe2\xf3\x67\xd4\x5b\x79\xf6\x16\xc5\x67\x81\x93\xd7\xdd\x11\x0c\x30\x2a\xd6\x30\x7f\x43\x0a\xc4\x12\xc8\x77\x1b\x85\xc0\x7a\x4b\x3e\x5b\xf0\x48\xc8\x9c\x66\xec\x9a\xe8\xd0\x44\xb5\x18\xb1\x4b\xc3\x88\xaa\x93\x55\x2b\xc9\xc7\x36\xf8\x10\xe6\x92\x13\x63\x14\xd2\x23\x7b\xd6\x91\x9d\x75\x64\xe7\x35\xe5\x60\x8a\x32\x49\xfb\xa2\x97\x43\x70\xf3\x16\xef\x1f\xc4\x8d\xb2\x28\x5f\xd5\x1c\xb2\x54\xc2\x5b\xb7\x13\x81\xba\xbc\x6a\x17\x57\x29\x43\x15\x7d\xba\x72\x3b\x94\x35\x2c\x88\xc0\xc3\xa2\xa8\x66\x5a\x75\x82\xa8\x5a\xec\xea\xfd\x07\x2d\x55\x55\x05\xa8\xca\x47\xf5\x7f\x72\x29\x81\xd3\x98\x5d\xce\xb9\xf0\x88\xae\x2a\xed\xdb\xc7\x42\xc1\x77\xc7\x30\xbc\x8c\xdf\xf6\xb1\xc6\xd0\x64\xfc\xb5\x8f\x35\xdf\xa6\xc3\xa3\x69\xb5\x9a\x0e\x8f\xe6\xdd\x51\x31\xc9\x2e\x11\x78\xb4\x34\x6d\xcb\xb6\x38\x77\x20\xc1\xb2\xa4\xc1\xd3\x60\xd3\x86\xe9\x3c\xcd\xf9\xc1\x1e\x7f\x97\xe0\xa2\x7c\x76\xe5\xba\x2e\x5c\xd7\x85\xeb\xba\x48\xb6\xee\x92\x12\xc3\xf3\x9c\xd0\x97\x14\x41\x82\xf4\x7e\xd7\xde\xef\xe7\x22\x04\x44\xe1\x79\xa5\xab\xbe\xe0\x17\xcc\xb9\x1a\xc6\x13\x8d\x7b\x1c\x50\x42\xd0\x28\xc4\x64\x83\xdb\x6e\x7c\x99\x21\x67\x30\x44\x21\x18\xb2\x76\x99\xeb\x55\xc6\x3c\x85\x0c\x99\x42\x86\xf0\xd3\xf8\xb2\xe4\xdf\x25\x3d\x91\xac\x43\x17\x91\x43\xdb\x75\x78\x5f\x37\x4c\xb2\x73\x84\x2e\x59\xca\xa2\x73\x7c\x16\xb5\x11\x82\x34\x9a\x45\x69\x06\x51\xe6\x87\xae\x53\xc7\x52\x09\x4e\x88\x75\xe0\x7e\x53\xb8\xbb\x9b\x1b\x9e\x0d\xc5\x1d\xfb\xec\x27\x1e\x78\xb6\x75\xa0\x93\x60\x58\xcf\xb4\x3b\xa6\x9d\xb1\x8f\x27\x44\x66\x9e\x58\x4b\x86\x62\x65\x62\x54\x02\x3d\x39\xf9\x8e\x2c\x37\x31\x72\x9c\xc7\xaf\x9a\xbf\x16\x08\xa7\x91\x49\x40\x29\xe4\x01\x50\x00\x38\xa1\x6a\x99\xa3\x64\x42\x0a\x96\x47\xc1\x01\xe9\x84\x56\x85\x50\x35\x4a\xad\xd2\x52\x26\x2d\x4b\x7b\x0b\x4e\xc0\x69\xda\x72\xf9\xa4\x6d\x68\x91\x36\xbf\x73\xb9\x0b\xe9\x64\x8e\x89\x4f\x0a\x0d\x40\xc7\x12\x9e\x3b\xaf\x48\xe7\x54\x5a\x01\xb8\xc9\x2d\xb6\xc1\x9e\xd1\x0b\x10\xb8\x2b\x0a\x69\xf0\x8e\x25\xff\x12\x38\x7d\x1c\x07\x8e\xa9\xc1\xde\x56\xec\x25\x8c\xca\x11\x50\x54\x4f\x9c\x89\x57\xea\x4f\x05\x99\xfb\x2a\x6b\x41\x8b\x20\x45\xa4\x35\xa7\x13\x2c\x2b\xc1\x84\x50\x35\x44\xd5\x7a\xa1\xb6\xee\x84\x24\x15\x8b\x49\x0b\x96\xb4\x60\x55\x0b\xd6\xaa\x12\x34\x8d\xae\x69\xf4\x9f\x0e\x90\xa2\x5b\x90\xa2\x5b\x90\x6c\x69\x39\xe3\x84\x28\x8d\x6c\xda\xc8\xa6\x4d\x28\x43\xa7\xf2\x5c\xb6\x08\x55\x59\x47\xd3\xc8\x5a\xd2\x4c\x89\xb6\x2f\x1a\x63\x1a\x0c\xed\x13\x56\x31\x66\xde\xa9\xbb\x30\x4e\xb0\x7c\x75\x5c\xf9\x7b\x0e\x9c\x5d\x96\xfc\xb2\xa4\xd7\x25\x7c\x25\x1e\x69\x32\xb8\xda\xd7\x33\x87\x1f\x5a\x3d\xc9\x6f\x54\x4e\x2f\x9a\x04\x20\xfd\x7c\x99\xb3\x4b\x95\x62\x94\x3c\x9d\xe7\x28\x44\x0d\x92\x4b\x96\x6a\x08\xab\x37\x5e\x52\x6d\x3b\x77\x69\xf9\xd0\x39\x8d\xc9\x95\x94\x86\xa5\x9f\xce\x0a\x42\x48\xc4\x0d\xfd\x93\xd6\x96\xee\x9e\x6a\x24\xe4\xd0\x3f\xe6\xf2\xfe\x55\xc1\x3c\x8f\xf5\x2f\x4b\xfc\x44\x13\x4c\x17\xd1\xd7\xa5\xf7\xbb\xf4\x7e\x97\xde\xed\xd2\xbb\x5d\x24\x63\xe7\xad\x0b\x27\xb0\x70\xef\x2a\xc8\xba\xca\x9c\xce\x9b\x67\xeb\x52\x80\x94\x22\x96\x1f\x02\x8d\x8a\xbe\x64\x1f\x85\xa8\x9a\x6d\xd5\x5c\x7a\x96\x72\x34\xad\xdc\x90\xba\x90\x26\xb8\x6e\x10\x68\x08\xad\x9c\x69\x0f\x08\x97\x75\x5e\x96\xef\x6b\x07\x92\x28\x73\x59\x57\x99\x33\x44\x68\x8c\x8f\x53\x58\x2a\x32\x7e\x67\x0d\x61\x7c\x2c\xf5\x87\x4c\x70\x83\x56\xe5\x13\xd7\xce\xe9\xb1\x92\xc2\xfa\xae\x63\x29\x9f\xcc\xb8\x83\xf7\xc4\x9c\xc0\x43\x7e\xe8\x90\x1f\xbc\x6e\xdc\x17\x32\x38\x57\x11\x2c\x83\x17\x8a\xdb\xfc\x47\x08\x43\x43\x0c\x6d\xea\xf0\x43\x90\xba\xc8\xbc\x33\x78\x0d\xb5\x08\x1a\xc2\xa4\x41\x2d\x69\x88\xf4\xd3\xe5\x1a\x82\x06\x49\x09\xb8\x82\x75\x03\x24\x28\x83\xdf\xba\xe0\xcf\x49\x62\x13\x67\xbb\xed\x13\x87\xd7\xdc\x68\xde\x99\xdf\x25\x3e\x0d\x50\x37\x80\x12\x6c\x1c\xbf\x49\xf8\x2e\x98\x24\xd1\xfc\x2e\xf5\x63\x9d\x6b\x5d\x63\x11\x02\xa9\xfc\x25\x08\x03\xb9\x15\x96\x14\x92\x8e\x76\xb6\xdd\x96\x12\xa4\x9c\x74\xd6\xb3\x4d\xb1\xa4\x1c\xa4\x1e\x2d\x63\x2c\x09\x81\xbb\x96\xfb\xca\x8c\x10\x4c\x09\xc4\xfb\x25\xc8\x62\xa4\x04\xe1\xdb\x12\x64\xdd\xe0\x89\x4a\x8b\x99\x49\x7b\x58\x36\x25\x68\x08\x6e\x8f\x28\xf3\x41\xd9\xc7\x76\x01\x09\x91\xca\x11\x59\xa5\x28\x91\xd5\xf1\x12\x71\xbf\x6e\x65\xf9\x93\x43\x83\xd9\x62\x39\x84\x82\x15\xc6\x32\x60\x1b\x00\xa9\xda\xc6\x1a\xdd\xc4\x38\xf9\xb8\x25\x18\x96\xcf\x68\x27\x67\x19\x60\x61\xfc\xc4\xc9\x27\xcd\xfc\xa7\x70\xb8\x99\xe0\xa5\x0b\x9c\x1d\x09\xe7\x62\x2c\x9c\x67\xf6\x54\xdc\x24\xc5\x4d\x52\xdc\xf2\x71\x07\x15\xda\xb2\xdf\xae\x98\x5e\xf4\x63\x59\x02\x95\xa5\xe2\x27\x29\x7e\x92\xe2\x27\x29\x7e\x92\xe2\x97\x8f\x3b\x4f\x56\x97\xa5\xb0\xca\x53\x64\x75\x58\x64\x75\xe8\x76\x58\x9d\x13\x64\x95\xa5\x14\x15\x1d\x45\x18\xb0\x48\x15\x8a\x54\xa1\xfc\x54\xa1\x6a\x06\x2c\x3c\x0b\x1f\x3b\x6f\x9f\x61\x9c\xc5\x93\x4d\xfd\xda\xf7\x4d\x80\x86\x1b\x1d\x0d\x37\x6a\x46\xcb\x92\x8e\xe0\xda\x69\x41\xf2\xb8\xc7\xbd\x2d\xc6\x98\x2b\xde\x21\x76\xe7\x7e\xef\xe9\xb9\xf2\xda\xf0\x58\xab\x1d\x78\x8c\x71\x16\x84\xad\xa5\xce\x5b\x4b\xe8\xa9\x69\xc3\xcc\x81\x8f\x9d\xda\x81\x76\x33\x8a\xcf\x74\xcb\x51\x83\x86\x89\xcf\xeb\x5b\x59\x26\x5f\xc7\x8a\x78\xc3\xf4\xac\x66\x1c\x96\x5b\xbb\x09\x6b\xa6\xc0\xd7\x90\xcf\xf1\xbd\xec\xbc\x11\x16\xa9\xbc\x0b\x4a\x1b\x9e\x2b\x15\x1b\x0e\xfe\x1a\xa9\x1c\x60\xf0\x7f\xb0\x86\x6f\x58\x0d\x30\xf0\xbf\x18\xcb\x56\xb0\x45\x9a\x61\x6b\x8d\xdb\x3e\xcb\x15\xd0\xb6\x58\x1a\xcf\x7e\x29\xcc\x2f\x2f\x89\x1a\xc0\xdf\x57\x0d\xcf\x85\x50\x74\x34\xae\x0d\x54\x0d\xe8\xae\xa7\xee\xdb\x9e\xcb\xa2\xa8\x86\x67\x54\xb7\x42\x26\xcc\x60\xef\x05\xdd\x72\x1d\x07\x3d\xfb\x6b\x31\x42\x89\xc3\x42\x51\xdd\xcf\xef\x6e\x98\x05\x76\xab\x2c\xb0\x59\x77\x81\xed\xed\xd5\xc1\x6d\xdd\xfa\xfc\xef\x2e\x14\xf3\xbb\xe4\x76\xa0\xdd\x34\x63\x7e\x2c\x5f\xe3\x77\x6c\xc5\x9a\x83\xf1\xf8\x7f\xc1\xcb\xff\x1b\x1e\x9b\xd4\xea\x4e\x73\x05\x62\xdc\xe7\x68\x68\x7d\xbd\x26\x69\xb3\xb8\xed\x99\x23\xd6\x88\x8e\xf8\xea\x76\x3d\xeb\xdb\x7a\xee\xed\xb5\x46\x74\x53\x51\x23\x1a\x3a\xd6\xf8\x4c\xd4\xf2\x5f\xb5\xa9\x97\xde\x76\xcd\x5f\x9f\xd2\x79\xb7\x58\x99\xdc\x51\xc3\x59\x56\xd5\xfe\xb5\x6b\x21\x59\xc7\xf2\xf7\x71\x8b\x34\xb6\x3f\x8f\x0e\x38\xcb\x77\xa8\x7c\x8b\x6b\xa8\x03\x8a\x2f\x74\x5b\xde\x3a\x8e\x29\x5f\xdb\x66\xde\x1b\xd9\x67\xf1\xb3\xf9\x97\x5c\xee\x03\x61\xa9\xb4\xcd\xdc\xf2\x70\x77\x59\x7a\x78\x0b\xb1\x8e\x9e\x89\x3b\xf8\x38\x2b\x7f\xbd\xed\x2b\xaa\xbb\x11\xc6\x39\x25\x67\xd8\x37\xcc\x38\xd0\xe2\x72\x77\xb5\xf2\x98\x20\x22\xb0\x77\x6b\x65\xc2\xfc\x5c\xd0\x1c\x48\x5f\x8f\x49\xe7\x42\xd7\x1f\xb5\xa3\xdd\x10\x27\x5e\x5b\x5b\xf1\x0f\xfa\x54\xf4\xe0\xd2\x06\x1e\x5e\xda\x02\x60\xe3\xe8\x71\xad\x45\x91\x20\x19\xec\xb9\xec\x11\x96\x46\xf0\xf0\x9a\x4e\x1f\x5e\xd3\xe9\xc3\x6b\x3a\x05\x5c\x25\xc7\xaa\x45\x58\x6a\x3a\x10\xd6\xe4\x05\x84\xb5\xd6\x47\x82\xd4\x6b\x9f\xc0\x00\x21\x48\x31\xf6\x6e\x3e\x84\xd0\xa6\xd8\x27\x61\x40\x58\xe7\x90\x98\x0b\x95\xe3\x1e\xc0\x3d\xc2\x3e\xa9\x04\xc2\x3a\x6c\x7a\x04\x77\x67\x06\x49\x16\xaa\xc9\xd8\x7b\x3c\x0f\x07\x8e\xbe\x4f\x6e\x80\x90\xa8\xfd\xc7\xd7\x5d\x81\x04\x4c\xed\x7f\x56\xfb\x0f\x73\xff\x8e\xa3\x12\x6e\x82\x05\x2a\x81\x05\x2e\xa0\xad\xa5\x9e\x01\xe6\x66\xb4\x20\xcd\x68\x41\x1a\xc9\x82\x34\x92\x05\x2e\x93\x05\x2e\xf3\xc4\xd8\xec\x13\x63\xef\x7b\x19\x86\x14\x6a\x2d\x6c\x90\x80\xed\x6e\x91\x86\xab\xed\x59\xe6\xc0\x4c\x63\xfb\x7a\xe6\x0e\xf1\x7b\x8e\xd2\x43\xfc\x9e\x57\xea\xe0\xae\x0b\x8e\x26\xb2\x21\x0c\xed\x27\xb3\x5d\x96\x3c\x77\xdb\x21\x82\x9f\x5d\x47\x77\xfe\xf5\x78\xf4\x2d\x35\xcc\x3d\x55\x2e\xda\x76\x84\x7b\x44\x4b\xaa\x77\x5a\xf5\x82\xbf\x27\x03\x16\xba\x3e\xea\x43\xfc\xde\x64\xed\x28\xbd\x4b\x7c\x8e\x33\x7d\x85\xdb\xec\x13\xb7\x8e\x92\xb1\x51\x9b\x34\xaa\x76\xcd\x58\xb5\x06\xfe\xdf\x83\x5f\x4c\xea\x2f\x99\x7d\x8b\x61\x67\x01\xee\xe6\xd7\xb7\x08\x02\x75\x48\x33\x1c\x77\x9d\x3b\xdd\x93\x31\xe2\x5d\xbb\xfc\x08\xd7\xc9\xff\x0d\x01\x35\x5c\x7f\xc0\x22\x13\x70\x9a\x76\x02\x64\x20\x04\x8e\x21\x99\x98\x66\x92\x89\x45\x62\xa0\x69\x23\xde\x97\x00\xc6\xc5\xf7\x7a\xd6\x25\x44\xe8\xd3\x68\x15\xbb\x2d\xbe\xcb\x04\x0b\x06\xca\xce\xa8\x17\x27\xc4\xce\x99\x18\xdb\x7c\x62\xe0\x26\xf7\xba\xde\x61\xd0\x38\xe1\x5c\x6f\xbd\x84\xa3\xa2\x5d\xc2\xb1\x77\x3f\x04\x98\x7b\xe3\xd3\x3c\x17\x0c\x5c\xfa\x41\x75\x4b\x85\x21\x28\x2b\x8f\x80\xdd\xe1\x05\x1e\xd0\x61\xe0\x8d\x7e\xc2\xc1\x6d\x37\x78\xf8\x8e\xfe\x94\x9f\x4b\x18\x19\x92\x73\x42\x85\x0c\x47\x7f\x77\xee\x17\xe1\xba\x25\xbb\xdf\x0d\xfa\xd7\x02\x57\x18\x2f\x3c\x1c\xc2\x7d\x4c\x61\xe3\x73\x23\x6a\xc5\x4f\xcf\x85\xcd\x25\x60\x83\x3b\xa1\x55\x89\x82\x6a\x8d\x31\x4b\x1a\x77\x89\x19\x0d\xe7\xa9\x21\x56\xe0\xe7\x89\x71\x1c\x4e\xdc\x81\x01\x26\x6e\xc0\xae\xc6\x62\xd0\x58\x0e\xda\x73\x86\xef\xb0\xb0\x3a\xc6\x12\xea\xae\x3d\xca\x0d\x4c\x6d\xb7\x36\xa9\x5e\xdb\xb9\xe3\x19\xe0\xf6\x77\x8b\x32\x5c\x7c\xb9\x7d\xdd\x34\xdc\xd7\xe0\x66\x69\xd2\x17\x9f\xe4\x4a\xf8\xca\xc2\x42\x01\x51\xa7\x90\xd7\xff\xc5\x84\x06\x2f\x32\x2c\xf8\x24\xb4\xc3\x9a\x11\xde\xab\xb3\x07\x86\x07\x13\x3c\x61\xe2\xf0\x75\xc4\x44\xbd\x63\xd0\x0e\xc9\xc2\x9a\x76\xc3\x0c\x45\xca\x1f\x96\x28\x73\xf1\x49\x02\xa4\xaf\xdc\x45\xfb\x42\xe5\x8a\x4f\x47\xf4\xed\xde\xae\xdd\x10\x93\x05\xd7\x2b\x0b\x1a\x06\xae\xc0\x42\x13\x51\x0b\xc2\x15\xed\x05\x4d\x21\x04\x6e\xef\x72\xea\x82\x77\x05\x79\x20\x64\xdb\xb1\xc3\xfb\x07\x2d\xdf\xef\x06\xc3\x42\x85\x50\xa5\x68\x54\xd8\xce\xdd\xdd\xb9\x43\x3b\x97\x7d\x60\xfe\x83\x18\x6e\x70\xb2\x83\x1b\x37\xc2\x92\x66\xc5\x04\x56\x88\xef\x7e\xf5\x86\xd8\xa1\x3e\xbd\x44\x60\xfa\xc0\x2d\x16\x23\xc7\x16\xee\x8e\x11\xde\x10\x71\x51\x44\x2d\xe5\x7e\x6c\x2a\x63\xca\x1d\xee\xe0\x3a\x7e\x8e\x68\x2e\xcc\x8c\xb3\xe0\x51\x09\x1b\x7d\xcf\x5c\xd7\x2c\xa5\xc9\x6f\x2f\xeb\xe0\xc2\xdf\x8d\xda\xa2\x90\x44\x28\x5f\x26\x44\xed\xf4\x5c\x13\x6d\x08\xd3\x84\x3b\xdf\xa1\xfe\xab\x2c\x41\xea\xbd\x69\xbc\x21\x75\x7e\xac\xd2\x2a\xed\xa3\x52\xb6\xaf\xf2\xd7\xfb\x3a\xc0\xc1\xd4\x08\xed\xb9\x0e\xdb\xf8\xfa\x02\x38\x18\x47\x54\xec\x3c\x74\x63\x67\x99\x15\xfb\xbb\x4f\xee\x78\x70\x97\xbe\x57\x75\x36\x6c\xfc\xf5\x4d\x36\x0e\x89\x3d\x2c\x50\x5a\x16\xbe\x94\x1f\xeb\xda\xf1\x0d\x90\x0e\x24\x39\x1c\x70\xf9\xbe\xd3\x92\xef\xd8\x0e\x06\xaf\x23\x38\x8e\x6f\x0b\xf2\x60\x6c\x76\x74\xc6\xb2\x31\x31\x8f\xa1\x6e\xe9\xf0\xae\x23\x1c\x81\x1a\xb9\x70\x80\x86\x30\x43\xd5\x7d\x61\xe4\x18\x33\xee\x24\x33\x16\xa8\x96\x90\x73\x2d\x91\xa4\x36\x52\x29\x16\x6e\xfc\x9d\x13\xcf\x24\x9a\x2c\xa3\x82\xba\xb0\x04\xa7\x41\x8b\xbe\x42\x0e\xee\xfc\xfd\x27\x3b\xaa\x4b\x21\x76\xb7\x72\x3d\x42\x6c\x68\x99\x21\x07\xa6\x3e\x2d\xc4\x6e\x56\x78\x62\xb4\x22\x93\x75\x79\x6e\x6d\x1c\x57\x14\xbb\xcb\x4b\xf4\xd8\x3b\x0b\x07\xaf\x53\xb3\x87\xf7\x22\x7c\xc1\x0a\xdc\x5a\xbf\x5a\x80\x97\xdf\xf3\x45\x05\x09\x71\x6f\x7e\x5c\xc2\xd9\x1e\x79\x84\xbd\x4d\xe0\x84\xc6\xa5\x8d\x52\x7a\x66\xf8\x2a\xb5\x95\xa9\xf4\xf9\xaa\xde\xb8\xa1\xfc\x73\x5f\x27\x04\x8d\xbf\x52\xd2\x8d\xa4\xa3\x07\xc6\x94\x69\x22\x7e\x97\xca\x37\x62\x6e\xea\xc2\x3d\x9d\xc5\x97\x75\x9e\xe3\x6d\x90\x9c\xb6\xc1\xd2\xf0\xee\x05\x1f\x55\x29\x10\xff\xa4\x40\xfc\x93\x02\xd5\x23\x05\xe6\x1f\x8f\x0c\x12\xc9\x9d\x2b\xcb\x77\xcc\x3c\x45\xe4\xa7\xf4\x5e\x1f\xd9\xb0\xd9\x63\x90\x14\x89\x75\x53\xfc\x2a\xc7\xe5\x92\x44\xee\xeb\x24\xd3\x78\x8a\x3c\x3f\xa5\x28\x25\xe3\x71\x93\xca\x75\xda\xb1\x61\x00\x45\x14\x94\xf6\xf4\xe1\xe2\xc2\x43\x46\xec\x9d\x54\x58\x03\x4a\xf5\xa3\x06\xaa\xa4\x6e\xde\xad\x98\x0b\x0b\x28\x73\xa9\xd2\x54\x92\x2a\xf7\x4d\xe5\x8e\x76\xcc\xc1\x0d\x27\xa6\x04\xcf\x5b\x9c\xf0\xf7\x61\xc0\x8d\x2b\xd6\xba\x11\x5f\x25\x1e\x0e\x89\x87\x43\x7a\x7e\x6a\x37\x2c\x1c\xb7\x70\xdc\xc6\x90\x9b\xb0\xf1\xd4\x90\x1a\x4b\xf3\xd4\xde\x71\xde\xc1\xa9\xf2\xf7\x26\x25\xd7\xfc\x70\xaa\x49\xe0\x9b\xd6\x71\xff\x38\x77\x12\xd6\x89\x35\xe7\xd4\x69\xf6\x4f\x9d\x24\x54\xea\xbc\x6a\xe9\xdc\x83\xfd\x1b\x9c\x14\x89\xb3\xd4\xa5\x43\x3b\xcf\xcf\xa9\xb3\x2e\x91\x3a\xee\x77\x6d\x2c\xe1\x71\x75\x93\x06\x33\xdf\x20\x95\x2d\x0d\xe9\x95\x21\xbd\x32\x84\x5d\x44\xb3\x49\x43\x4a\x33\xa4\x95\x07\xaf\x1c\x72\xa0\x11\x98\xe1\xd5\xc8\x0d\x21\xf1\x1c\xa8\xac\x39\xb0\x06\x97\x03\x8b\xc8\x1c\xb8\x25\x72\x38\x65\x4d\xef\x3b\xa6\x47\x62\x0b\xdd\x8e\xdf\xd8\x55\x52\x83\x99\x2e\x47\xe2\xf6\xbc\xd4\xaa\x7d\x34\x8f\x94\x73\x9e\x71\x29\xe1\xcc\x8d\x27\xc4\xb2\x40\x21\x4a\x57\x4a\x35\xa2\x60\xb5\x26\x8e\x54\xb0\x98\xc3\x39\xa3\x47\x4a\xab\x52\x92\x7d\x7f\x80\x28\x15\xf3\xf9\xa9\x21\x37\x1f\x0d\xd8\x1c\x99\x35\x9e\xeb\xf4\xf7\x9d\xba\xc7\x70\xb6\xf4\x37\x49\x08\x52\x5a\x46\xb3\x46\x36\x1a\x7f\x59\x56\x63\x79\xa9\x38\xb7\x81\x12\xf3\x78\x4e\xd7\xed\xd4\xf9\x6c\xfc\x99\x66\xe6\x7b\xa1\xe5\x85\x37\x5c\xd6\xe4\xc4\x0b\x07\xc7\x81\x31\x0e\xe8\x9c\xbf\xd2\xa1\x74\xcf\x81\xdd\x86\xb0\x8f\xba\x30\xca\xdc\x9c\xa5\x57\x74\xdf\x23\x3f\xaf\x65\x07\xb7\x4a\x98\x4b\x5b\xbe\xb0\xef\x3c\x5d\x78\x75\xbd\x9c\x65\x4c\x64\x19\x13\x05\x36\xaa\x1c\xd2\x68\x2e\x1f\xe8\x01\x99\x96\x9d\xb9\x7c\xc8\xc2\xe5\x43\x06\x2e\x5f\x40\x15\xd2\xf1\x39\x91\xd9\xf0\x1c\x02\x6e\xd8\xea\xd1\x26\xdf\xb1\xf7\xcd\x88\xe6\x3f\x3c\x25\xdf\x18\x77\xa3\x72\x81\x5d\x41\x87\xcd\xa8\x0e\xb4\xb6\xca\xa4\x48\x67\x99\xaa\x73\x65\x9d\x2c\x57\xd8\xe6\x73\x48\x8d\x57\xb9\xf1\x2a\x8b\xc2\x8a\x1b\xd8\x3b\x74\xe0\xe0\xb8\x55\x96\xeb\x4f\x49\x69\x87\x21\x93\x12\x9c\x1b\x69\x8b\x99\x17\xe6\xb9\x91\x36\x03\xbe\xeb\x37\xec\x1c\x78\x70\xe0\x28\x49\xd3\xec\x97\x65\x56\xcf\xb2\xb5\x95\x79\xcf\x2a\xf3\x5c\x9a\xbb\x0c\x86\x2e\x22\xaa\xb3\x22\x9b\x3b\x2b\x4b\xb9\xb3\xb2\x94\x3b\xef\xb3\x65\xde\x03\x70\xf3\x5d\x82\xc4\x1c\x83\x6b\x2e\x5b\x5f\x79\xf0\xf2\x32\x0f\xee\x51\x37\xa2\x7d\x9f\x4b\x20\xed\xa9\x04\x12\x80\x25\xc0\xee\xfa\x82\x99\x02\x0f\x8e\x4b\x8b\x74\x74\xd2\x7e\x70\xe2\x92\x90\x46\x82\x4e\xd7\xcf\x77\x14\x88\x25\xb0\x88\x59\x66\xac\x1c\x3f\x77\xc1\x81\xe2\x53\x2f\x14\x99\xc7\x8b\x11\x3b\x16\xfb\xda\xbd\xc7\x06\x84\x73\xca\x7b\x08\xf7\x94\xf7\x12\xce\x29\xef\x21\xdc\x89\xb8\x9c\xf7\x32\xd2\x81\xb8\x8f\x55\x78\xb6\x99\x10\xf4\x80\x9d\xfb\x39\xce\x75\x02\x4d\x37\xc7\xb9\xdf\x4b\x8d\xa6\x4d\xf4\x15\x78\xb1\x31\xee\x9c\x1c\xed\x1d\x82\x99\xd8\x86\xd4\xf7\xb2\xf3\x58\x64\x2f\xbe\xc8\xe4\x77\x5d\xf2\x01\xee\x8c\x69\xd7\xac\x24\x5e\xb6\x96\xc4\x33\x44\x49\xd2\xf7\xf9\x63\x24\x1f\xb9\x69\x32\x0b\x83\x22\x3b\x2c\x45\xf6\x39\x4b\x21\x51\x84\x5e\xf5\x0e\xa6\xad\xfd\x22\x2d\x5d\x78\x62\x77\x9c\x19\x9b\x7c\x97\xec\xb9\xee\x95\xc4\x45\xa9\xa4\xd2\x14\x5e\x05\x96\x4a\xc2\xa4\x54\x5e\x05\x94\x2a\x65\xaf\xc2\x15\x55\xc6\x28\xaf\xe3\x0a\xaf\xd4\x0a\xaf\xd4\x4a\x63\x06\x97\x9d\xd6\xd2\x84\xe3\x3a\xa9\x6a\x65\x70\xec\xfb\xd6\x45\xbe\x18\xf7\x1f\x8b\xc8\xc1\x32\x84\x03\xc7\x7a\x9b\x12\xe3\x23\x93\xd4\x40\xcd\x58\x59\xf4\xd5\xc0\x07\x53\x55\x74\xfe\x2a\x5a\x7d\x3d\xfb\x0f\xf9\x40\x1a\xbb\x55\x36\x4b\xab\xec\x47\xd4\xc8\xd3\x6e\x95\xcd\xd4\x1a\x99\xc5\x2a\x6b\xb9\xd5\x68\x81\x53\x59\xee\x54\x23\x96\xb8\x6f\xad\x3e\x8c\x9a\x4b\x35\x5e\x1d\x55\xe3\x95\x5b\x95\x13\xbc\x6a\xac\x28\x55\xe3\x95\x5b\x35\xe6\xfe\x9a\xb8\xac\x89\xcb\x9a\xc0\xda\xc4\x21\xea\x36\x55\xa4\x50\x4d\xd2\xac\x89\xb9\xbb\x8a\x0a\x5e\x13\xcf\x95\x35\xb1\xf6\x53\x45\x25\xaf\x89\x95\xe0\x9a\x3f\x2e\x2b\x69\xb5\x35\x93\x5e\x56\x33\x97\x3d\xd3\xc6\x6c\x15\x7d\xdc\x31\x56\xa5\x50\x56\x74\xf2\xbf\x30\x75\x4a\x16\xfe\xe1\xd3\x98\x09\x8d\x20\x6e\xc5\xd7\x42\x4a\x40\x2d\x3c\x6c\x6b\x11\x7e\x29\xac\x06\xd4\xc2\x7b\xf5\xb5\xf0\xd2\xbf\x16\x69\xe4\xc2\x1b\x32\x95\xe5\x59\xad\x34\x4b\x57\x3d\xfd\x14\x01\x55\x1b\x4e\x04\xb5\x51\xab\x55\xe9\x20\xf0\x3f\x7d\x30\x4e\x62\xb5\x71\x07\x36\x6e\x97\x46\x7b\x37\xf5\xee\x13\xe5\x87\x0b\x63\x1a\x47\xa2\x91\xd6\xce\xe3\xa0\x4b\xab\x75\x16\x6f\x95\xf7\x6d\xeb\x38\xa9\x2d\x55\xa3\x05\x6c\x86\x26\x1b\xb1\x4d\xa4\x55\xa3\x1d\x8b\x16\x78\xcf\xaa\x45\x52\x19\x5b\xa4\x73\xa4\xc6\xa7\xfd\x2d\xd2\xf8\x6d\x91\x8b\xdd\x22\x2f\x43\x9b\x6c\xb4\xb6\xc8\x73\x42\x93\xc5\x7f\x33\xda\x04\x6e\x46\xdb\x3e\xcd\x68\xad\x33\x21\xc7\xa5\x19\xa3\x89\xe0\x73\x9c\x39\x7c\x81\x93\xc2\x89\xf3\x78\x3d\xda\x44\xa3\x6a\xc6\x3a\x4d\x4b\xb8\x0d\xdc\x0c\x2d\x5f\xfc\x2b\x75\x49\x62\xa5\x62\x62\xec\xe0\x26\x0a\x56\x4b\xbc\x3e\x6f\x72\x48\xd5\x44\x74\x35\x51\xa8\x1a\xd8\x1a\x5f\x2c\xe1\x51\x1e\x35\x56\xa9\x5a\xe6\x86\x67\x61\xd6\x32\xe9\x20\x8d\xb7\x22\x5a\x96\x76\x16\x61\x35\xb1\xe4\xc5\x45\xcf\xc2\x13\x85\x4c\x3d\x26\x6c\xd0\x4b\x85\xcf\x39\x9b\x88\xa7\x26\xda\x5c\x2b\xc2\xb1\x85\x05\x73\x13\x6d\xaf\xf1\x36\x7b\xab\xa4\x36\x4f\x18\xe8\xeb\xe0\xc0\x34\x57\xb6\x2a\xf6\x18\x8d\xb6\xff\x1b\x6b\x5b\x8d\xb5\xad\xd6\x78\x20\x36\x5e\x6d\xb5\x26\xad\xda\x78\x35\xd4\x3a\xed\xe7\xb6\xce\x5d\xd8\xa5\xcf\xba\xb0\x5b\x97\xb1\xd0\x65\x1c\x77\xb1\x43\x19\xcc\x30\x7c\x0a\xde\x06\xad\x59\x1b\x9f\x82\xb7\xc1\x45\x1b\x2c\x71\xe4\x04\xab\x0d\x5e\x59\xb4\xc1\x73\x78\x1b\xbc\x9f\xef\x25\x03\x3d\xb1\xb3\x22\xd8\x79\xfd\xdc\x03\x6b\x14\x3d\xb0\x46\xd1\x65\x6f\xb9\xcb\x9a\xb7\x07\xe6\xe5\x1e\x71\xff\xa4\x47\x1a\x74\x13\xf2\x57\x6c\xe2\x1e\x99\x91\xbb\xec\xab\xba\x4f\x50\x8e\xce\x45\x11\x51\xdb\x8d\x58\x77\xc2\x4e\x90\xea\x2d\x8b\xd3\x2e\x5a\xa0\x7b\x64\xc4\xc2\x26\xae\x4a\x62\x13\xa2\xc4\x59\x27\x6e\xf3\xc4\x23\x1a\xaf\x60\x1d\x8c\xf2\xa6\xe7\x8f\x11\x45\x66\xc9\xd5\x33\xad\xad\x3a\x4b\xbd\xce\xa6\x61\x3d\xd3\x90\xe9\x99\x8b\xc9\xf6\x5f\xbd\xd0\xcc\xd5\x0b\x37\x40\xe1\xe6\x14\x9d\xab\x8b\x10\xea\x85\x87\x5f\x2f\xef\xd6\xd5\xc5\x18\x9e\x75\xaa\xce\xc7\x8a\x9d\x85\x94\x7b\x8e\x83\x79\xae\x93\x12\xd5\x2b\xcf\x63\xbd\xb2\x7e\xef\x18\xb3\xe6\x3d\xc0\xde\xb8\x24\xbc\xad\xd7\x45\x68\x2d\x3f\x6b\x9c\x18\xb1\x55\xff\x50\x5e\xf6\x8e\x3b\xc3\x9d\x0e\xca\x7b\x27\xbb\x88\xce\xe2\x6e\xc2\x40\x90\xba\xb2\xd3\x56\x4b\xbf\xdb\x83\xe9\x95\x82\x13\x63\x3b\xba\xc1\xcd\x3d\x84\x7f\x07\x98\x40\xaf\xcf\x59\x82\x37\x49\x8d\x58\x76\xf0\x4e\x74\x1f\xef\x5d\x8d\x89\x47\xa0\xb1\x35\x78\x13\x6f\x04\x56\x82\x86\x6c\xea\x8d\xc0\xd3\xe5\x08\xac\x97\x8e\xc0\xd3\xe1\x08\xac\x97\x4c\xdc\x40\x9c\x8e\xc0\x9c\x3b\x44\x40\x8e\xc0\xe2\x78\x44\x52\xff\x47\xa4\x99\x61\x44\xb1\x03\x94\xb3\xa5\x21\xeb\xe6\x21\x32\x6f\x44\x9e\x1c\x86\x9c\xf3\x0f\x23\xcd\x65\x18\xad\x46\x87\xd1\x82\x60\xf0\xaa\x7b\x88\xa5\xeb\x10\xf5\x72\x18\xf3\xfa\x90\x85\xf4\x90\x85\xf7\x30\xde\x0e\x9d\x18\xc7\xfc\x10\xdb\xd9\x91\x70\xd8\x0e\xe3\xb5\xcf\x10\x81\x3c\x12\xf1\xe8\x60\x83\xd9\x21\x0b\xe9\x21\x22\x76\x88\x76\x39\x58\xc8\x0e\x96\xaa\x83\x95\xc7\x91\x39\x6b\x16\xa3\x23\x73\xab\x8a\x75\xd5\xc8\xbc\x73\x32\x71\x87\x65\xd8\xc8\xd2\xe3\xc5\xcd\x4a\xee\xe7\xc2\x83\xa3\xcc\xd5\xcf\x1d\xd8\x83\x35\xcb\x21\x52\x78\x14\xde\xe0\x1e\xa2\x1a\x0e\x39\x33\x1a\x85\xd5\xbb\x51\xa4\x4f\x64\xa3\x0f\xef\x8a\xa4\x75\x01\x24\x33\x96\xd0\x64\x8d\x35\xaa\xf0\x76\x65\xc5\x67\x88\xc5\xc6\x90\xa3\x9e\xc1\xa7\x35\xa3\xdd\xa7\x34\x36\xa4\xc5\xd4\x10\xf5\x72\xc8\x72\x77\x88\xd9\xc4\xe8\x34\xb3\x0e\x36\x84\x18\x9d\xe6\xce\xd1\x79\x18\xf2\x52\x79\xc8\x52\x79\x5c\x5d\x35\x3f\x0c\xe7\xb6\x43\x4c\xa6\x46\x97\x6e\xec\x22\xc2\x44\xbc\x8f\x2e\xcc\x2f\xba\xee\x10\xcb\x8a\x21\x96\x15\x43\x4e\x83\x26\x2e\x81\xca\xbb\x97\x62\xe9\xe1\x20\xe1\x31\xfd\xc1\x8d\x25\xa6\x17\x63\xb0\x5e\x38\x86\x8c\xa0\x21\xcd\x31\xa4\x39\x86\xc8\x29\x31\xb6\x1e\x43\xac\xb6\xe1\xb5\xfe\x18\xd2\x7d\xd1\x62\x7f\x9e\x90\x8c\x8c\x43\x60\x73\xdd\xc0\x36\x65\x13\x93\x11\x72\x08\x6c\xcf\x1b\xd8\x9a\x63\xe2\x18\x25\x02\x5b\x49\x87\x20\x76\xcc\x21\xa8\x89\x78\xfc\x04\x52\x7c\x36\x2e\xbb\xd7\x8e\x9e\xcd\x78\xc4\xbb\x76\x87\x10\x35\x44\x95\x10\xb8\x17\xe5\x84\x2e\xb9\xb2\x69\x79\xe0\xc9\x69\xe2\x56\x19\xd3\x04\x31\x09\x6c\x75\x1d\x8c\x4f\xdc\x9c\xd0\xb3\x12\x24\x0a\x9b\x37\x87\x24\xe6\xf4\x62\xb2\xbe\x6c\xc3\x08\x37\xf9\x1e\xb9\xff\x12\x9b\xf6\x4c\x66\xe6\xde\xca\x92\x62\x16\x86\xc8\x7a\x1b\x80\xf6\x4e\xe7\x77\xd3\xef\x5c\x85\x22\x55\x28\xd2\xdf\x45\x39\xac\x68\x5f\x15\x6d\xb6\xa2\x95\x12\x4b\xbf\x18\x2a\x1b\x82\x87\x2a\xb5\xac\x52\x88\x4a\xeb\xb1\x89\x87\x84\x8f\x9a\x01\x69\x7a\x4e\x18\x01\x79\xb0\xca\xf5\x81\x50\x59\x91\x3d\xfe\x2c\xf0\x7b\x91\xef\x49\x30\xd9\xca\x07\x36\xf4\x9b\xb8\x49\x78\xbe\x4e\xe1\x04\x89\xc1\xe6\xf6\xa1\x29\xff\x88\x41\xde\x54\xc2\xb8\x90\x9d\x8f\x03\x9d\xc0\xf5\xbe\xf7\xdc\xd2\x25\x08\xd7\xab\x55\x7f\xe8\x72\xd5\x62\x12\x0a\x0d\x79\x3e\xe5\x9f\x98\xe5\xdc\x10\x39\x36\xbc\x81\xa1\x10\x83\xb7\xe8\x62\x14\x49\x1a\x59\xb5\x5f\x57\x25\x61\x21\xb7\x2e\x53\xc2\xcc\xb2\x08\x4d\x42\x10\xdb\x45\x39\xb4\x5f\x84\x28\x51\x8a\x46\xe9\x52\x4e\xbe\x42\x11\x23\x8f\xd8\x28\xc7\x59\x93\xc0\x8c\x15\x8d\x19\x25\x1a\x69\x66\xfe\x20\x74\x94\x00\x7c\x49\x64\x12\xf8\x22\x4f\x22\xa1\xfe\x73\x97\x26\x8a\xbd\x95\x13\x68\x62\x8b\x99\x53\xc8\x3c\x22\x63\x66\xbd\xc4\x09\x59\x43\x70\xd3\xc9\xc9\x74\xfc\xb9\x72\x13\xc5\x54\x2a\x46\xd6\x60\x27\xe6\x52\x14\x61\x0a\xd9\xef\x5c\xcf\x2a\x4b\x0c\xe1\x33\x36\x9c\xf7\xc7\x8d\x8d\x31\x6d\x99\xae\xe7\x8d\x51\xb2\x44\x39\x56\xf6\x07\x8f\x51\x27\x5a\x04\x2a\x56\x93\x8b\x56\xbc\xbd\xe9\xdf\x95\x20\x29\xcc\x51\x8c\x8c\xda\x85\x87\x3a\x5f\xae\x89\xbc\xe5\xe9\x58\xc2\x73\x86\x9d\x97\xb6\xee\x64\x20\x16\x21\x70\xff\x0e\x99\x17\xe2\x90\x29\x79\x12\x48\x31\x30\x5e\xbe\x4f\xbc\x3a\x23\x3f\x2c\xdf\xa9\x94\xc6\x36\xf9\xf4\x62\xf3\x25\x68\x08\x96\x6d\x16\xa5\x08\x62\xa1\xe9\x8f\x25\xa3\x06\xbb\x08\x14\xc5\x58\xd8\xd9\x5c\xcc\xa2\x24\x32\x59\xed\xfa\x1b\xc9\x5c\x4e\x1e\xaf\x72\x69\xc7\x5f\x41\x46\x89\x60\x72\x80\x12\xf5\x1a\xcf\x7a\x6b\x58\xa2\xf0\x24\x2d\x37\x7b\xfc\xc9\xe0\xce\xb8\xca\x77\xe3\x3c\x75\x3c\xcb\xfd\x1b\x7c\xd9\xf7\x60\x16\xb7\x26\x3b\x79\x4e\xe0\xee\x92\x55\x63\xe4\x6b\x38\xfe\x46\x2f\x31\x54\x95\x2c\x75\xf4\x9a\xde\xa7\xb3\xf1\x15\x9c\x0d\x27\x46\xbe\x37\xd9\xb1\x8a\x49\x27\x8d\x24\x97\xc9\xa2\x5e\xfd\x88\x49\xae\x10\x27\xde\xe8\x71\x57\x3b\xc4\x51\x7a\x65\xc3\x53\x20\x91\x92\xe4\xc6\xd9\x2c\x52\x17\x8c\xdb\x39\x8e\xe5\x3b\x8b\xca\x24\x27\x88\x4e\x08\x59\x08\x34\xfc\x92\xea\xcb\x49\xaf\x74\x26\xd6\x6e\x53\x92\xee\x48\x49\xef\x84\x66\xbd\xe5\x59\xa4\x5e\x45\xaf\x89\xaa\x88\x4f\x45\x46\x70\x92\xab\x5d\x4e\x68\x4a\xe8\x9a\x28\x2d\x1e\x92\x32\x62\x52\xce\x4a\x55\xab\xd2\xc8\x4c\x66\x62\xb4\x61\x8e\xef\x72\x06\x10\xf0\x0c\xd6\x09\x5c\xf9\xa6\xa5\xe8\x68\x12\x1c\xfd\x5e\x44\x66\xc2\xee\x21\x20\xd0\x0d\xd1\x24\x0b\xf2\xe8\xb7\x13\x18\x4b\x31\x87\x66\x72\x37\x71\x21\x89\x20\x21\xe8\x04\x7f\x59\xfd\x33\xce\x95\x31\xee\x55\xad\xf0\x3f\x04\x49\x61\xf7\xd8\x23\x18\xe9\x83\x39\x7e\x31\x30\x2e\xfa\xfd\x27\x42\xaf\x4c\xb0\x4c\x79\xac\x0b\x4f\x00\x39\x01\x93\x1c\xec\xfa\x42\x3b\x78\x54\xc6\xbc\xb4\xce\xa6\x45\xba\xfb\xa8\x48\xc8\x42\xe8\x41\x09\x1a\x65\xa0\x92\x3b\x8b\x6d\x5a\x0f\x72\x8e\x90\xb7\x5b\x32\x0e\x41\x05\x93\x95\x72\x4e\xb4\x15\x39\x71\xcb\x12\xde\x94\xc0\xf7\xb3\xe7\x94\x26\x21\xa4\x43\xcf\xda\xf9\xe1\x5a\x19\x13\x5b\x4e\x2c\xe1\x99\xb3\xfd\x9e\x00\xe5\xc0\xd7\x4f\x27\x4e\xf2\xbd\x06\xc6\x9c\xe3\x11\x5e\x80\x2b\x63\xd2\x0b\x26\x96\xf0\x43\xf2\xe3\x65\x93\x5b\xe6\x4b\x8e\x2c\xa3\x73\xfd\x28\x42\x95\x12\xca\x6d\xf4\x61\x68\xd7\x31\x21\x49\xce\xc1\x26\x2a\x13\xf3\xd2\x04\x1e\xbf\x3d\xb8\x4b\x04\x12\xb4\x33\x81\xac\x04\xb4\x05\x89\xba\xf5\xef\x04\x2d\x33\x6b\x26\x43\xe7\xa5\x21\x57\xa4\xe3\x48\x72\x33\x5d\x2c\x54\x9c\x90\x95\x50\x95\x30\x24\x4d\x29\x57\x62\x1b\x6d\x27\xe0\xe6\xe8\x22\x50\x1a\x99\x87\xd1\xc8\x67\x24\xee\xfe\x1a\x45\x1b\x5c\xf6\xe5\x9d\xc0\x05\xe5\x9b\x94\x13\xf3\x35\x7c\xd9\x5c\x19\x55\xba\xac\x8a\x32\x32\x09\xc4\xc6\x4e\xc0\xb5\x81\x6f\x88\x47\x25\x24\x26\xb0\x8e\x34\xda\x71\x79\x9a\x80\x40\x51\x3a\x6b\x51\xa3\x4b\xbd\xf8\x98\x73\x62\xae\xa7\x98\x79\x38\x81\xb9\x5b\x36\xbf\xc5\xe3\x4f\x42\x9f\x40\x10\x82\x39\xa9\x6b\xa7\x77\xed\xf4\xa1\x0c\x3d\x34\xd1\xa1\x8d\x33\xe4\x5a\x7c\xe0\x85\x93\x05\xbe\xea\x30\x31\x31\xa7\x13\xf0\x40\xc4\xde\xc6\x71\x78\x04\xdc\x1a\xb7\x10\xe9\xfc\xd9\xc2\x36\xa4\x7b\xe1\xe9\xac\x7c\x11\x02\xe6\xc9\x3b\x21\x16\x22\x19\x89\x4e\xcc\x4e\x19\xc4\xc8\xc3\x82\xba\x61\x08\x91\xa4\x8b\x85\x48\x37\x8f\x6c\x3b\x2f\x7b\x09\xb0\x09\x9d\xdf\x6b\xe1\x02\x90\xa5\xe9\xaa\xb2\x86\x28\x54\x02\x91\x57\x4e\x40\x79\x65\xd7\xaf\x58\xbf\xb8\x54\x49\x61\x48\xa1\x24\x53\xfb\xcd\x94\xef\xb5\x07\x75\x01\x11\xd2\xc7\xbe\x33\xd2\x27\x29\xd2\x61\x9c\x13\xe8\x2a\x7c\x48\xea\x21\x83\x3d\x0e\x59\x10\x81\xb8\x9c\x53\x75\x25\x64\x21\x0c\x29\x15\x0d\x27\x27\xc4\xaa\x04\x8a\x22\xfe\x27\x02\x9f\x98\x4e\x1c\x35\x42\x2c\x4a\xc8\x34\x36\xb2\x32\xba\xac\x39\x3c\x53\xf2\x33\x11\xe8\x1e\x9e\xc3\xc0\x9f\x39\xcb\x22\x7e\xa0\x42\x61\xfb\x7e\xd3\x5d\x76\x27\x24\x25\x54\xe3\x28\xd2\x78\xb2\x46\x71\x42\x97\x6c\xa5\x97\xd9\x89\x45\x28\x7c\x34\x34\xbf\x33\x73\x57\x71\x92\x11\xe4\x42\xba\xbd\x0d\xe9\xf8\x42\x30\x7b\x37\x3e\x76\xf1\x28\x3c\xcc\x9b\x7a\x7e\x69\xca\xde\x9d\x1d\x39\x4c\x6c\x8c\x35\x7c\xd6\xf8\x4c\x18\xca\x36\xea\x1b\x22\x0c\x6d\xee\xc1\x46\xa7\x4e\xa0\x52\xb1\x67\x26\x8b\xbc\x39\x65\x51\x45\xb0\x6e\x2e\x4f\x82\x45\xd0\xf7\x16\x01\xb6\xb0\x2d\xb2\x95\xdc\xc4\x4b\x41\x7c\xdf\x71\x45\x31\x31\xfb\x92\x89\x51\xf3\x9c\x22\xaf\x70\x12\x3c\xae\xa3\xdc\x41\x76\x02\x7b\xcb\x31\x9a\x19\x22\xef\x87\x39\xce\x8c\xbb\x60\x29\xa4\x49\x77\x45\x75\x9d\x16\xe5\xb4\xcf\x43\x70\x6b\xab\x57\x9c\x98\xa4\x3b\x12\xfb\xe8\x89\x89\x0c\x5e\x1c\xe3\x20\x8f\x49\xbb\x2f\xc9\x0c\x1a\x93\x08\x96\x28\x6b\x9a\x49\x90\x42\x65\x69\xaa\x2c\x4d\x23\x7b\x6c\x86\x9e\xaf\x17\x41\x5c\xe2\xc4\xc2\x43\x21\x16\x69\xfc\x82\x5e\x2d\x0f\x41\x62\xf0\x34\x1c\x55\x58\xf9\xae\x3a\x85\xa8\x3c\xcf\x46\xbe\x97\x3b\x31\xca\x88\x58\x49\x9c\x46\x56\x50\x4d\x7d\x60\x39\x81\x06\x67\x94\x9b\x5d\xa6\x5b\xee\x3e\x6a\x60\x12\x8e\x72\x3b\xc2\x62\x53\x2e\x57\xb9\x15\x55\x6e\x45\xd9\x8d\x99\x43\x95\x14\xa4\xd8\xb9\x96\x5d\x18\xac\xb3\xfa\x33\x31\x75\x44\xff\xd0\x32\xd1\x31\x37\xbb\x1c\xd4\xd9\x73\xcf\x75\xca\x3c\x84\x19\xd8\x12\xd9\xe2\x90\x96\x1e\x64\xfb\x67\x71\x90\xea\xef\x58\xc2\x8b\xdf\xab\x21\x1e\x79\x8c\x0d\x18\x26\xa6\x51\x25\x3b\xf6\x8e\x8d\xb1\x38\xc3\x12\x1b\x3c\x27\x64\x0d\x61\x92\x07\xdd\xc3\x70\x42\x94\x5c\x59\x7c\x98\x5c\xcf\x35\x53\x61\x6d\x91\x9b\xd6\x22\x69\x58\x26\x9e\x30\xe6\x77\xee\x1b\x8b\x7c\x89\x7e\x12\x48\xc3\xb2\xc8\x8d\x6f\xc6\x93\x9e\xb1\x94\x35\xe3\xbe\x35\x71\xe8\xe0\x04\x0d\x41\xc6\x94\x4e\xe0\x22\x89\xee\x6a\x26\xbd\x67\x2c\x13\xcd\x68\x23\xdb\x31\xac\xe1\x1c\xc3\x12\xce\xde\xb9\xc7\x49\x20\x89\x86\x6f\x89\x35\x7c\x13\x29\x6d\x7c\x49\xce\x13\x90\xf0\x64\x08\xb0\x08\xc4\x91\x72\x2f\xce\x4c\x2e\xc6\x39\x81\x04\xbb\xa9\xee\x6b\x64\x75\xed\xdf\x69\x1d\x61\x49\x5d\xb6\x65\x69\xb8\x4c\x6b\xd5\x89\xa5\xf3\xb2\x76\x5e\x96\x59\xd8\xf8\xee\xd8\xc4\xb4\xce\xb0\x4a\x1b\xe7\xf6\xe3\x12\xcc\xc4\x2b\x92\xfd\x38\x05\xb3\x2a\x12\xcf\xc4\x88\xc2\x8c\xfd\x1a\x4c\xcc\x11\x1a\x5d\xc7\x9c\x58\x7c\xdb\x89\xaf\x03\x27\xa4\xaa\x04\x13\x82\x16\xc2\x42\x45\x36\x6b\xbc\xff\xec\x84\x28\x69\x88\x0f\x3d\xd1\x28\x8d\xcf\x43\xfd\x3b\x17\xab\x8b\x47\x35\x93\x5b\x23\x66\x5d\x14\xc6\x1f\x77\x65\xd6\xb5\xd7\xbb\x16\x8b\xed\x2a\xec\xc7\x85\x99\x1e\x4f\x39\xa1\x99\x24\x41\xd3\x94\x0d\x6d\x8c\xf1\x3f\xb9\x66\xc9\x96\x17\x12\x89\x55\xdd\x14\x58\xe9\x48\x81\xd9\x5d\x7c\xa7\x79\xf8\x20\x01\xe2\x4f\x08\x2a\xa4\xfa\x4f\xf3\xd7\x2d\xb0\x5a\xe2\x24\xcd\xbf\x93\xe6\x93\xc4\xc6\xcc\xfc\x0c\x8d\x42\xd8\x27\x70\x3f\x7d\x70\xbc\xfc\x25\x5d\xfd\xa7\xf4\x29\x14\xcc\x9e\x19\xc5\xab\x8e\xe9\x81\x98\x13\xb8\x9a\xea\x90\x31\xb1\xec\x49\x6c\x7e\x3c\x31\xfb\x7b\x54\x0f\x8d\x49\x6e\xa8\x39\x81\x38\xc6\x1d\x6b\x53\x9a\x85\x45\x8b\x9c\xc9\x39\xc6\x55\xc7\xc4\xb8\xa4\x48\x45\xa6\xec\xfb\xf2\xc8\x91\xb0\x7a\x00\x67\x49\x9c\x06\x4f\x02\x4f\xd9\xe2\xf3\xcd\xee\xfb\x24\xe3\x62\xd4\x00\x53\x91\xed\x2a\x75\x47\x67\x49\x8c\xca\x9c\xc0\x85\x6a\x34\x03\x27\x55\x2a\xaf\xe7\xb7\x78\x31\xe9\x98\x89\xcd\x3c\x26\x66\xdd\x26\x91\x23\x62\xff\x4e\xf3\xca\xf3\xdf\x16\x6e\x04\xee\xed\x4e\xfb\xf3\x76\x5d\xb2\xbd\xf0\x5c\xc2\x2e\xdc\xd3\x3f\x5a\x80\x8a\x5b\xb6\x89\xbb\xa4\xcf\xc2\x39\xa9\xcb\x46\x75\xcd\xe6\x84\xaa\x04\xad\x14\xd9\x76\x2e\x42\xe5\x62\xf1\xa2\x35\x75\x95\x1f\x2a\x6b\xd3\xf8\x24\x53\x71\x9a\x3a\x64\xe8\x0e\xe9\xbb\x21\x9b\x72\x49\x55\xdf\x34\x74\xd8\x0c\x51\x2a\x93\x6e\x21\xbc\x83\x52\x20\x98\x12\xd8\xa1\x2a\x7b\x78\x9e\x38\x09\xa6\xc1\x9c\xd9\x05\xb4\xc7\x37\x25\x68\x0c\x56\x5e\xdc\xcb\x1b\x45\x89\x3c\x59\x66\xbe\xac\xe7\x58\xbe\x73\x97\xe7\x28\x6b\x2b\xf5\x73\x66\xd7\x79\x59\xba\x98\xfc\xb5\x9b\x1e\x8d\x3a\xa1\x4a\x12\xe2\x7b\x56\x6c\x86\x27\x81\x65\x4a\xd6\x8d\xd6\x77\x34\x0a\x04\x62\xe7\xac\x02\x3b\xeb\x1a\x3f\x67\xe9\xb1\x4c\x77\x3a\xd7\x4b\xf8\xc0\xfe\x59\x74\x43\xf1\x7d\xe6\xe9\x69\x82\xbc\x3b\x92\x55\x9b\xcc\xea\x56\x37\x97\x0f\xfd\x85\xac\x27\xde\xf9\x3b\x97\x41\x24\x7e\x2e\xea\xf7\xb7\x28\x5b\xf1\x92\x3f\x57\x59\x93\x65\xd5\x3e\xb3\xae\xe9\x73\xd3\xfe\x6b\xda\x7f\x4d\xfb\x4f\xf5\xbc\xdc\x85\x8d\xe4\x3a\xc5\x7e\x3e\x59\x08\x45\x92\xe0\x9d\x82\x2c\x4e\xb1\xfd\xb9\x60\xcd\x95\x95\xf7\x3c\xb8\x31\x86\xb4\xf6\xd0\xd6\x54\x35\x2f\x0f\xf5\xab\xac\x2b\x71\x7f\xbf\x57\x32\x11\xae\x18\x5a\xee\xa1\x03\xfd\x47\xd8\xe8\xb9\x53\x61\xab\xdc\xf5\xe8\x6b\x16\x82\x29\x81\x58\xa7\xe8\xd1\xd4\x7a\x4a\x55\x08\x9a\x06\xeb\x7e\x45\xee\x34\xdc\xd7\x1f\x6e\x80\xc8\x52\xaf\x88\x84\x2a\x51\x8b\xa9\x22\xaa\x44\x2d\x66\x14\x59\x5d\xd4\xe9\x77\x31\xd6\x82\x8b\xac\x8e\xef\x3b\x87\x0f\x0f\xf9\x2e\x8d\x29\x4e\x18\x3c\x87\xd0\x95\x20\x51\x78\xe8\x4f\x02\xfb\xb9\x66\x95\xb5\xf0\x75\x64\x53\xe7\x54\x4e\xb0\x1f\x82\x46\xe1\x3c\xc9\x61\xd5\x7a\xbf\x8d\x31\x7b\xe2\xce\x7c\x6a\x57\xf4\x4c\xa7\xa8\x17\xf1\x49\x20\xce\x2d\x6c\x18\xec\x2f\x88\x12\x7f\xc8\x6d\x36\x27\x30\x87\xa8\x7e\xa9\x9e\xad\x8c\x5d\x59\x65\x77\xec\xe8\x07\x86\xeb\xd5\xaa\x4b\xb0\x88\x04\xdf\xa9\x5e\xee\x41\xf3\xde\x82\xb9\x4b\xd5\x7a\x9e\xa7\x3b\xe0\x6d\x65\x4c\x74\x2b\x30\xc1\x2d\xeb\x04\xd7\x82\xc3\xbf\xd0\xa7\xb7\x7f\x53\x3f\xb0\x4e\xaa\x1f\x59\x0e\x2d\x68\x2f\xa2\xa1\x86\xee\xf0\xe9\xe7\x13\x72\xb2\x6f\x0b\xc6\x83\x56\x0c\xd9\x31\x95\x8c\x39\xa4\x57\x55\x43\xc5\x76\xa5\x11\x5e\x92\x09\x26\x9b\x89\xde\xf4\x38\x11\x66\x9e\x28\x73\x78\x7d\xa6\x7e\xf0\xee\x4d\xfd\x12\xbe\x1e\x53\xe9\x35\x95\x4a\xaf\xa9\xd4\x2f\xba\x47\x98\x98\x76\xb7\x5e\x82\xed\x65\xe0\x21\x6c\x47\x78\x0e\x6b\xa6\xf8\xe1\xd5\x6c\x47\xce\x51\x52\xcb\xdb\x35\xed\x23\x6c\x4f\x7c\x97\xb0\x5e\x87\x05\xc2\xf1\x4d\xbb\x08\xd0\x98\x13\xe6\xd7\xb3\x11\x0f\xc1\x1d\xbe\x33\x72\x87\x58\xf7\x18\xb9\x65\xa2\x61\xb3\xe1\x23\x67\xf5\xba\xf7\x5e\x28\xc3\x5a\xc5\x51\x7f\x79\x66\x5c\x76\x38\xc4\x6e\xca\xfd\x2b\xed\x15\x3f\x77\xb0\x93\x5c\x81\xb1\x44\x67\x0a\xdf\x0d\x3a\x21\xd4\xfd\x3a\x90\xbc\xf0\x2d\x02\x1d\x22\xb7\xe4\xc1\xe5\x18\xc8\xac\x79\x20\xb7\xce\x99\xef\x35\xe9\x8c\xf8\x32\x29\xe1\x39\x3d\xaf\xcf\x47\xe5\x29\xef\xc0\x4b\xe6\x0b\x87\x4c\xf9\x80\x0a\xb2\x6a\xd0\x5f\x56\xe7\xe9\xcb\x8d\xa8\x3b\x1a\xf9\x61\x75\x1c\x5e\xc6\x2d\x63\xb1\x5a\x59\x16\x1b\xa7\xd0\x8d\xd6\xbf\xfb\x3b\x0c\xf1\x46\x8e\xfb\x2a\xbd\xd7\x56\x57\x8d\x00\xbe\x97\x80\x7d\xf8\x1a\xbc\xbc\x35\xbe\xb6\x6f\x14\xe6\xbf\x75\xad\xe7\x95\x2b\x06\x78\x6f\x6f\xbf\x55\xb3\x93\x89\xfe\xbe\xc7\xdd\x35\x8a\xe1\xcb\x69\xf3\x71\xba\xf0\x08\xac\x05\xef\xa8\x5b\xf0\x8c\xba\x89\x0c\xb3\xcc\x01\xf3\xcc\x28\x0b\x16\xbe\x03\x62\xc1\x3b\x5c\x62\x58\xae\x85\x21\xd7\x0c\x5b\xc3\x31\xdc\x77\xff\x56\x46\xf0\x66\xd5\x44\x91\xea\x9d\xe9\xdb\xeb\xe5\x18\xee\x13\x7b\xeb\x5b\x3b\x2e\x10\xf3\x81\x57\x3b\x5a\x10\x8a\xd7\x0a\xe6\xe1\x2e\x77\x28\xe6\xe5\xfe\xfd\x15\x5a\xac\xa1\x02\xbe\x71\xe1\x8c\xde\x0c\x39\x71\xbb\xcc\xb6\xbf\x5e\x09\x1e\xd7\xb5\x8c\xd7\x93\x3d\x60\xf5\x06\x78\x57\x99\x30\x51\xb1\xce\x33\x6d\xab\x54\x83\x9a\x09\x1e\x82\x5d\xd0\x30\x17\xdf\x13\x80\xaf\x2d\x3d\xa9\xb3\x71\x78\xf9\xc4\x66\x5c\xe4\xf9\xfd\x0a\x8b\x8d\xdf\xf9\x48\x0c\xcf\x18\x32\xee\xef\x4f\x1a\xc6\x6d\x5c\xfc\x0a\x33\x35\x20\xac\x16\x3c\xf8\x1b\xe3\x79\x87\x36\xae\x92\xdd\x87\xd1\xcc\xbf\xd5\xf7\x50\xdd\x44\x78\x10\x19\xdd\x1f\xd9\x2b\xe5\x7b\x8e\x31\x39\xea\x05\xa3\x8e\x88\x15\x88\xcb\x2f\xc2\x11\x71\x53\xd2\x47\x2a\x53\x04\x61\xef\x10\x5f\xac\x72\xc2\x80\xe7\xe6\x36\xe1\xca\xe2\x08\xef\x11\xd6\x0d\x03\xa5\x57\x03\x16\xf5\xbe\x90\xbc\x13\x37\x2e\x4b\x0b\x94\x54\xab\x14\xb7\x71\x49\x3b\xc5\xbd\xaf\x5f\xef\xc0\xc3\xb0\x75\xfd\x1d\xe2\x63\x23\xe1\xd8\x30\x27\x38\x97\x6b\x9b\x00\xa6\xcc\x1b\x43\x1b\x1a\x5c\xd5\xd9\xf0\xad\x02\x0e\xe1\xde\x0f\xbb\x21\x06\x16\x20\x63\x71\x2d\x81\x37\xed\x8d\xdf\xfd\x85\x43\x28\x58\xa2\x77\x7f\x21\x00\xc1\x34\xc4\x2d\x84\x5d\xe9\x93\xff\x76\xa7\x1f\x66\xb4\xef\x8e\x82\xbe\x20\x74\xa9\xdd\xc1\xb9\x52\x81\x39\xc1\x6d\x36\x70\xe6\xf3\x4b\x8a\xd0\x2d\xc6\xec\x65\x5f\x8c\x11\x53\xbe\x8f\x43\x6e\x88\xa3\xc9\x1e\x67\x1d\x68\x94\x72\x6a\x6f\x9b\x33\x1a\xbd\xed\x18\x13\xa8\x81\x31\x7d\x01\x2b\x70\x9b\x3e\xfa\xb7\x48\xe3\x65\x3d\x23\x7d\x8a\x94\x3e\xac\x4d\x42\xc5\x28\xae\x07\x9f\xee\x71\xb0\xbf\x44\x46\x5f\xcf\x31\xfa\x4a\x36\x45\x4c\x36\x77\x0c\x9a\x29\x62\x41\x45\x78\xe1\xb7\xe4\x88\xee\xd7\xf5\xca\x60\xf7\x29\x0b\xe9\x16\x2a\x6e\xc9\x84\x70\x5b\x31\xa6\xd7\xda\xeb\xf3\xe1\x8e\xb0\x90\x51\xbd\x53\x80\x23\xda\x4d\xb8\x47\xb4\xc7\xe4\xe5\x34\x71\x7a\xfd\xba\x6a\x14\x07\x15\xc4\x0d\x81\xef\x2b\xab\x97\x70\x39\xf5\x10\xa0\xac\xd7\x68\xb3\x6e\x18\x30\x41\x7c\xdf\x32\x2e\xa3\x2c\x0a\x8c\xca\xe4\x26\xbc\xc1\xf6\x4c\x97\x9c\xf9\xd5\x14\xe9\x10\xee\x4e\x65\x5c\x26\x3c\xd0\x05\x16\xa9\x7a\xc6\x9d\x8b\x0f\x60\xc6\x44\x6a\xd2\xb9\x80\x8b\x2c\x05\x7d\xe2\x5e\x63\x80\x37\x17\xbe\xba\xdf\xc2\xb7\x1c\x8e\xec\x5e\x65\x38\xf8\x0a\x84\x85\xef\x51\x45\xcc\xf4\xa2\x50\xcc\xc4\x2d\xf9\xbe\xc5\x5e\x17\x82\x06\x5e\x3b\x93\x0f\x35\x9c\x0f\x32\xbd\x7f\x1b\x33\x09\x8f\x4c\xc2\x23\xfb\xbd\x69\x28\xcf\x7b\x1a\x77\xa7\x14\x9f\x79\xc5\x86\x30\x30\xd6\xfe\x40\x7f\xd5\x9b\x30\x33\x7c\x2c\xba\xf0\x9b\x43\x17\x4e\xc6\xd8\xa0\x39\x96\xc8\x09\x42\x78\x93\xc2\x26\x0c\x49\xe2\xa7\x84\xa0\x2a\x6e\xc2\xdd\x83\x70\x02\xda\x2a\x6c\x02\xf4\x69\x24\x8b\xb3\x43\xe0\x24\xd1\x30\x61\x13\x9e\xf5\xf9\x21\x5c\x35\xff\x12\x82\x94\x62\x50\xd5\xb2\x34\x5e\x96\xc6\x7b\x0f\xde\xc7\xfd\xdc\x39\x16\x9a\x98\x87\xa6\x84\xbc\x6e\x10\xe3\x57\x7c\x99\xfb\x12\xa8\x74\x03\x95\xb6\xcc\xd3\x46\xa6\x57\x83\x1d\xd2\x18\x8a\x99\xd9\x7c\xe2\x1e\x18\xd3\xa0\xba\x6f\x06\xef\xf8\xcf\x5b\xcd\x8a\x40\xf3\x47\x7e\x8a\xc7\x6c\xb9\xf2\xa1\x50\x2d\x34\x25\x94\x37\xe0\xc2\x82\x86\x1f\x33\xdc\xb2\x89\xe5\xce\x10\x1b\x51\x3a\x75\x50\x3a\x1d\xfa\xc2\x11\x30\xa5\x1f\xc2\x42\xe3\x15\x1e\x9f\xe5\x3a\xaf\x39\xd0\x04\xe6\xb7\x5a\x8e\xe5\x29\xdf\xe9\xef\x5e\xab\x87\xd0\x15\x8e\x5f\xe3\xf6\xd8\x07\xa1\x3b\xa7\xdd\xdf\x49\x6a\x2c\x2c\x18\x0b\xeb\xdd\x65\xf9\xf1\x38\xed\x8a\xfb\x62\x0e\xde\xb8\xbd\xfb\x3f\xd9\x41\x46\x60\x98\x02\x4c\xc7\x73\x0d\x44\x08\x54\xfc\xbb\xd0\xf7\x52\x56\x9a\x60\x69\x65\x3e\x51\x87\x0d\xb3\x85\x61\xd8\x55\x12\x9b\xfe\x16\xe0\x1b\x61\x0e\x5f\xd3\xd5\xbb\x1c\xe8\x07\xbd\x53\xe9\xe8\x7e\x51\x40\x8a\x2c\xdc\x00\x1f\x3d\xba\xad\xaf\x03\x6b\xd7\x2a\x96\xa2\x17\xb8\xd8\xbe\xf1\xbd\x09\x7a\x70\x80\xd6\x2d\x28\x5b\x2a\x70\xd7\xb8\xf8\x0d\xa6\x7a\xd7\x26\xed\xe5\x66\xaf\x1e\x7d\xa0\x2c\xab\xdf\x08\xb0\xee\xae\xcb\x55\x1e\x74\xed\x28\xd4\x4d\x91\xfa\x82\x34\xcd\xfa\xf8\x67\x07\x3e\xe3\x32\xec\xaf\xb8\xfe\xac\xfb\x66\xd7\xcb\xd9\x31\xb6\xa8\x5f\x6f\xb2\xd7\x2e\x11\x5c\x97\x6d\x18\x30\x74\xa6\xa2\x8d\xeb\xc3\xfb\xc5\x7e\xa7\xc2\x37\x79\x68\xb7\x7b\x61\x0b\x30\x17\xb8\x4a\x81\xd1\x6b\xe5\xcd\xb3\x4b\x88\xa7\x53\x1d\x42\x7a\x7d\xef\x5a\x57\xa5\x6a\x34\xb8\x47\xea\x04\x5a\x0c\xd6\xf7\xfa\x79\xdb\xb0\x63\xa3\x5f\xbd\xac\x5d\x08\x0a\x49\xf5\xbb\x07\x14\x9c\xc6\xae\x6d\x5f\x3c\xe7\xeb\x9d\x5c\x77\x85\x2c\xd2\x26\x4e\x05\x6b\xee\xbe\x31\x1c\xaa\x6e\x8c\x6d\x68\x91\x86\x82\x45\x2e\x0d\xcf\x4b\xbc\xc1\x15\x2b\xbd\x73\x1e\xeb\xdb\xe1\xda\x89\x65\x92\x36\xfe\x52\xb9\x1b\x4c\xfb\x63\x27\x87\x52\xbf\xbd\x05\xbc\x09\xdd\xdf\xa4\xc2\x20\xdd\x1d\x2e\xac\x45\xc4\xa3\x58\x97\x58\x66\x55\x29\x49\x53\xb6\xf4\x13\x26\x87\x1f\x4a\xfe\xa1\x70\x5e\xfd\xcb\x9d\x8a\xd3\xbf\x1c\x84\x90\x34\x4a\xcd\x54\xa7\xfe\xd5\x20\x84\xa2\x51\xfa\x0f\x41\x73\x69\xa6\x84\x20\x51\x86\x09\xc1\x5f\x53\xa5\x38\x63\xbd\xec\x06\x41\xec\x27\x92\x75\xdf\x84\x47\x4a\xd5\x30\x35\x2b\xa5\xfd\x84\x69\x3f\x61\xba\x96\x38\xfe\x16\xb9\x77\x69\x2a\x0b\x9a\x8e\x05\x6d\xce\x38\xb4\xf9\x2c\x6a\xca\x16\x7e\x52\x36\xed\x27\x4b\x5a\x42\x4b\x3f\xb9\xe7\x9f\x30\x3f\x3c\xe3\x7c\x05\x29\x8f\xef\x27\xce\x7e\x07\xe8\x85\x90\x02\x8f\xaf\x4b\x00\x4d\x32\x56\x21\x98\xa6\x20\x25\x1d\x5f\xd2\x28\xa5\x6a\x14\xe6\xf6\xf1\xfd\xd4\xa4\x6a\xa2\xc2\x0b\x63\x72\xaa\x84\xd0\xaa\x34\xcd\xb6\x6b\xa2\xca\x4f\x63\x1d\x0e\x75\x6e\x31\xe6\xd4\x71\x1e\x7f\x83\x74\xb5\x3a\xfd\xa7\x15\x87\x49\x90\xd8\xf3\x0f\x25\xfc\x34\xb5\x56\x7a\xb2\x17\x17\xcf\x19\x97\x63\x05\x6d\x19\x15\x6d\xe3\x47\x6c\x8d\x25\xda\x38\x9d\x9f\x8e\x55\x41\x36\x94\x29\xa7\xaa\x81\xa9\x8c\xc0\xfc\x34\x71\x10\x42\xa2\x96\x71\x47\xc0\x58\xc5\x49\xc8\x92\x07\xb3\xcf\x08\x22\xf9\x26\xa1\x4a\x14\xe6\x16\x57\x89\x84\x10\xb3\x16\xf5\xbc\x95\xf9\x28\xdc\xf3\x33\x15\x2d\x49\xd7\x44\xce\x43\x60\x90\xec\x7e\x09\x0c\x28\xed\xa7\xca\x95\x13\x8e\xde\x4c\x1d\x09\xd2\x4c\xf1\x3e\xf6\x09\x14\xa9\x40\xf4\xa7\x15\x2b\x12\x4a\xd5\x38\x3c\x58\x3d\xa3\x1f\x0a\x4f\x0a\x93\xd2\xed\x87\xf2\x13\x8b\xc5\xa7\x3b\x51\xd6\x58\x16\x34\x96\x73\x58\x60\x4a\x90\x32\x6b\x1b\x47\x9d\xde\x9d\x62\x9a\x4e\xe4\x5a\xa4\xef\x87\x90\x7e\x08\x59\x09\x5d\x08\x8d\xb3\x49\x1f\x4f\x35\x23\xad\x03\x70\xa6\x18\xf7\x76\xba\x2f\x94\x63\xd9\xb2\x86\xa9\x3f\x29\xd7\xa0\x61\x8a\x29\xa5\x55\xa1\x88\x4c\x71\x0a\xa7\x93\x7f\x62\x65\x15\x94\x23\xab\xa0\x1c\x59\xb9\x2d\x3b\x13\x37\x4e\x45\x12\xe9\x92\x0d\x0f\xa7\xfc\xd3\xd5\xf9\x67\x38\x65\x9d\xdd\x47\xf9\x58\x6d\x1b\x6e\xdf\x4a\x51\x64\xa2\x9c\x51\xaa\x44\x61\x69\x38\xca\x14\x06\x4c\x88\x41\x83\xf8\xbb\xf2\x4c\xd9\xaf\xfe\x21\x85\x1b\xdb\xaf\x04\x4a\x61\xe2\xf8\xa5\x64\x49\x59\x5b\xa6\x68\xc7\xae\xdd\x61\x88\x14\x43\x66\x71\xed\x96\x35\x4a\xe0\xf9\xde\x83\xfc\x44\x62\xe1\xe4\xb1\x7e\xc2\xf0\xc0\x58\xee\x57\xb1\xe2\x4e\x09\x1a\x26\xc6\x9f\x68\x31\xfc\xa4\xed\x35\xe5\xa4\x9c\x2d\xb8\x4c\xd2\xf6\x8b\x14\x38\x62\xf9\xcd\xb1\x88\x3e\x3b\x29\x3d\x28\x85\x15\x67\x77\xfe\xda\x35\x9d\x98\xa4\x2d\x17\x3f\x68\x28\xcb\xbf\xa4\xdf\x88\xcc\xa0\x4e\xca\xe1\x97\xd4\x7f\x49\xbf\xc9\x97\xdf\xe4\x5b\xfd\x09\x55\xfb\x4f\x5b\x55\x49\xcb\x9d\x83\xff\x90\x58\x30\x6c\x3f\x3b\x85\x52\x72\xee\x6d\x4c\x52\xf6\x2d\xaa\xec\xac\xfc\xa4\x1d\xaa\x4e\x4f\xcb\x1b\x2c\xc7\x73\x23\x99\xce\xb1\xb6\x15\x10\x25\x54\x7f\x42\xf1\x7a\xc3\xdd\xc6\xda\x0f\xa5\x6b\x6e\x56\x35\x4c\x0f\x1a\xa6\x66\xce\xac\xb9\x9e\x23\x14\x61\xd7\x26\xca\xb6\x53\x7e\x4a\x18\xb5\xd9\x9a\xb3\x34\x27\x34\xec\xa7\xd0\x3a\xd0\x9b\xce\xbd\xab\xfa\x3f\xe5\x16\x91\xec\x24\x1d\x7b\xed\x77\xec\x35\x95\x7c\x31\x74\x51\x0f\xf7\xed\xe5\x46\x04\x6d\xb8\xfe\xd3\x70\xbe\x24\x95\x84\x7f\xc6\x79\xd7\x39\xd6\x49\xf9\x97\x54\x83\xa6\xee\x4a\x93\xa4\xa5\x1d\xfc\xb3\xea\xdc\xfe\x82\xb9\xb3\xba\x4e\xbe\x2b\x94\x8c\xe2\xe1\x5b\x1c\xc6\x94\xa0\x61\x94\x35\x86\xab\xc5\x1c\xab\x66\x8d\xa5\x2d\x35\x7e\x05\xae\xbb\x86\xff\x21\xc9\xc0\x8f\xf6\x71\xa9\xa3\xc9\xe4\xf2\x0c\x38\x91\xf2\x13\x46\xf8\x79\x26\xf3\x93\x53\xfd\x49\xa7\xfc\x64\x5e\x7f\xd2\x11\x19\xe7\xde\x81\xa9\xed\xdd\x9f\x82\x86\x89\x41\xb2\x4f\xca\x81\xd1\xf7\xff\x2a\xc7\xda\x2f\x88\x13\x89\x7b\x3a\x26\x1d\x84\x31\xfd\x8c\x5d\xdf\x9f\xfb\x29\x80\x8e\xcb\x75\x5a\xa5\x85\xd2\x71\x19\xd3\x35\x86\x44\x52\xfe\x8d\x98\xaa\x26\x1f\x93\x14\x55\xb5\x3b\x3f\xe6\x61\xf9\x19\xb3\xaa\xba\xeb\x48\x4c\xa3\x69\x2f\x64\xdd\xe3\xdb\x27\x46\x4a\xfa\xa9\x5f\xfe\x11\xe1\x31\xff\xcc\x63\x4e\xfa\x49\x4b\x56\xbf\x4e\x92\x69\x32\xe6\x1f\x11\x19\xb3\xee\xca\x2c\xd7\xbe\x42\xe8\x4a\xe0\xe9\x21\x96\x9f\x81\x51\x3e\xeb\x3f\x94\x9f\x8c\x44\xbf\x88\xaa\xb5\x7a\x98\xaa\xb1\x72\xd6\x30\xe5\xa7\x3c\xc5\x34\x56\xf9\x49\x59\xf4\xb9\x49\xf9\x29\x4f\xfb\xc9\xab\xff\xb4\x4e\xfb\xa9\x69\xff\x29\x8f\x0e\x94\xad\xc4\x08\x29\xff\x94\x48\x96\xc4\x4e\xe2\xb5\xd6\x3e\xce\xfb\x89\xa8\xa3\xbe\xfc\x76\x7e\xf9\x1d\x87\x3f\x7a\xf9\x3e\x86\x63\x3e\x2d\xbf\x7c\xea\x76\x47\x9c\x7c\xfd\x11\x7c\x55\xa7\xc7\xf9\xbf\xff\xc4\x92\x2a\x57\x9d\xfd\x63\x55\x85\x36\x56\x55\x68\xfd\x40\x4c\x53\xd6\x99\xc1\x49\xd2\x57\x55\xf7\x44\x63\xac\x3f\xda\x79\xac\x3f\x1a\x82\x93\x82\x96\x41\x35\x04\x27\x09\x4f\x75\x59\x73\x3b\x45\x52\xfa\xd1\x1a\xd6\xe5\x4c\xcc\xbf\xbd\x43\x9c\x5b\x93\xdc\x35\x99\x1f\x1e\xeb\xbf\x3c\xd6\x7f\x6b\xd2\xf5\x2c\xc1\x49\xf6\x93\x96\x6c\x64\x2c\x92\x69\xed\x4c\x79\x7f\xfc\x08\x87\xf1\x65\xe9\x98\x21\xbb\x64\xcb\x11\xf9\x4f\x42\xb2\xf8\x89\xe3\x87\x57\xc6\xcf\x44\x3a\x7e\x59\x7c\xfc\x74\xb0\xe9\x62\xd3\xf2\x27\xd9\xbb\x27\x6d\xa5\x88\x00\xb3\xdf\x49\xc5\x7e\x26\x15\xcb\x5a\x6a\x73\x9b\x06\xaa\xbf\xe5\x9f\xc5\x91\x93\xec\x27\x94\xc8\x3e\x27\xe5\x5f\xd2\x6f\xf2\xf5\x37\x2d\x19\x55\x4e\xaa\x3f\xa1\xda\x6f\xf2\xed\x37\xf9\xfe\x9b\x7c\xff\x4d\x8b\xf7\xa2\x17\xe9\xa7\x42\x3a\x68\x97\x93\x72\x26\xfd\x4c\x41\xb6\xa6\x20\xa6\xfc\xb4\xe9\xaf\x14\xb6\xf2\xd3\x61\xe5\x47\xa9\x76\x92\x34\x43\xf9\xad\x73\xf9\xd1\xb3\xdd\xf7\x79\xd7\x50\xba\x96\x9c\x24\xfb\x49\x4b\xb6\xce\xb7\xdb\x74\x8e\x58\x3f\x2d\x56\x95\x63\x33\xa7\x74\xa5\x68\xd7\x54\xdd\x7c\x5d\x4e\xd8\x7f\xd2\xfe\xa9\xdf\xcf\x1a\xd4\x7e\xd6\xa0\xd6\x7e\xd9\xaa\xad\x7d\x25\x25\xd9\x4f\xe2\xe1\x37\xf5\xf8\x13\x51\xf7\x60\x96\xef\x90\x9f\x50\xa2\xbb\x4e\x92\x28\xf8\x4e\xea\x3f\x24\x1d\x86\xed\x33\x1c\x86\x72\x32\x9c\xe1\x6a\xc8\x21\x3c\x83\x9d\x4d\x78\x26\x60\x9b\x80\x66\x13\x4e\x40\x7b\x90\x45\xc0\x93\x76\x27\xc0\x49\xbe\x13\xae\xa7\x29\x27\x14\x3c\xee\x6e\x1f\x98\xee\xb4\x6b\x7f\x54\x16\x02\x43\x96\x76\xcd\x98\x56\x48\xb4\x6b\x6e\x1f\x9a\xec\xce\xae\x78\x07\xec\x0d\x6f\xd0\xc4\xf6\xe1\x69\x7a\xfb\x4a\xe4\xc2\x14\xf0\xcb\xb6\x20\xd8\x3e\xb7\x6b\x04\xdb\x17\x6a\x98\x70\xa3\x22\x54\x2a\x5e\xa5\x8a\x94\x41\xf1\xd0\xe2\xad\x91\xe5\x62\x23\x23\x9a\x46\x17\x30\xda\x7a\xfd\x19\xca\x1e\xd1\x58\xb5\xc1\x7b\x52\xe1\xe2\x67\x61\xd3\xe0\xfd\xa8\x87\xc1\xac\x68\x19\xc6\xbe\x5b\xbe\x4e\xa8\x94\x41\xa4\x0e\x89\x16\xb7\x5b\xf5\xfc\xf0\xb9\x29\x76\xf0\xb5\x2d\x5a\x38\x61\xfe\xe0\xe4\x2a\x2e\x47\x3d\x5c\x3d\x43\xab\xcd\x15\x9e\xcb\x6b\xf1\x27\x40\x96\xef\x60\x0c\xd3\xe0\xf9\x8d\x9b\x27\x7a\x60\x3d\x84\x7b\xa1\xd5\x09\xa6\xa5\x02\x7b\x9f\xc6\xa6\xe4\xfd\x3b\xd7\x0e\xc6\x42\xc0\xdc\xfe\xed\xf5\xbb\xc3\x57\xd8\x05\x6f\x67\x39\xbc\xb7\x7b\x17\x84\x52\x2c\x7c\xaf\x81\x6d\xfc\xc6\xba\xbb\x7e\x7b\x16\xd9\x0e\xaf\x37\x9b\xed\x18\x0e\xe2\x76\x2c\x25\x9a\x93\xaf\x88\x98\x6f\xc2\x2e\x71\xf8\xec\x5e\x3a\x5d\x35\xe9\x74\x27\xa9\xfb\xc5\x13\x2c\x71\x0f\xf8\x15\xef\x9d\x74\xb7\x56\xb9\x6b\xa2\x0d\x8f\xaa\xba\xe1\xd6\x53\xfb\x7d\xa2\x38\x1f\x74\x6d\xa9\x76\xc8\xbb\xe9\xb0\xf1\x99\x64\x4f\x36\x5b\x62\x1f\xb8\xa5\x75\x3f\x0f\x09\xc7\xbd\xc9\x74\x09\xfd\x16\xeb\xde\xfa\x1d\x17\x3f\x83\xdb\x4e\x16\x6a\x2b\x75\x6c\xc9\xf3\x02\x72\xbc\x95\xc2\x96\xec\x68\x1d\xe9\x10\x13\xce\xd7\xe9\xfa\x42\x05\x1a\x00\x2f\x1a\xef\x42\xbd\x21\xb0\x70\x05\x16\x7d\xcf\x23\xbf\x4a\x65\x89\x7f\x9d\xd2\x1f\xdc\x28\xbf\xfd\x58\xc5\x6d\xe4\x45\x39\xe7\x5e\x97\x70\x76\x46\x5e\x9c\x7a\x7b\x74\x13\x32\xb4\xfd\x7b\xd1\xe1\xd4\xaa\x53\xa9\x2c\x54\xfe\x6e\x99\x6a\x65\xc8\xf0\x8e\xb1\x95\x06\x32\x6a\xef\xd2\x35\x9d\xbb\xa6\x53\xd7\xc4\xeb\xf6\x39\x5e\x8c\x7d\xe5\x62\xb5\xc1\xd8\x8d\xcf\x97\x71\x04\x42\xe6\x10\x96\xa1\x3e\xc7\xe9\xc5\x2d\x70\x64\x51\x42\xc6\xb4\x9d\x05\x73\xdf\xfa\x11\x46\x86\x77\x6c\x36\xc6\xe2\xd8\x73\x98\xb9\xa1\x71\xe0\x28\x69\x61\x63\x45\xab\xd4\x5a\x13\x53\x73\x59\x43\xcb\xbe\xce\x57\x38\xb6\x3f\x1f\x80\xad\x63\xd7\x90\x65\x6f\x77\xa3\x4d\x6c\x95\x39\x4c\x02\xe3\x75\x45\x71\x20\x3e\x0b\xaa\x4b\x38\x4a\xf5\x25\xe4\x2e\x51\xce\x61\xde\x25\x58\x97\x28\x67\xa3\xe7\x12\xba\x66\x3b\x34\xdb\xbb\x53\xf0\x52\x3d\x5b\x5c\x8f\x12\xb5\x6c\x57\xa7\x7c\xe9\x0c\x2d\xdd\x5d\x56\x02\x45\x8b\x63\x96\x8e\x24\xbb\x94\xfc\x43\x69\x3f\x94\x9f\x32\x9f\xed\x96\x47\xc9\x01\xcb\x13\xdf\x2d\xd1\x43\x08\xc0\x4f\x1d\x1d\xcc\x5c\x82\xf6\xea\x40\x16\x7a\xb2\x13\x6a\x9d\x61\x0a\x8b\x5d\xb8\xa6\xd2\x68\x19\x11\xe7\xbb\x6b\xf1\x5d\xef\x57\x62\xe0\x11\x99\x81\xdd\xdc\x02\x86\xcb\xc0\xf7\x46\x0f\x61\x18\x66\x10\x59\xc8\xc6\x81\x7e\x91\x2f\x21\x43\xab\x38\x21\x41\x23\x2c\x73\x0d\x8e\x62\x31\x70\x14\xbf\x90\xf4\xa2\xec\x67\xab\x4f\x04\xd3\x6a\xc2\x6d\x83\xfe\x2c\x95\x6f\xa9\xd3\xf3\xc3\xb6\x71\x33\x4e\xbe\x76\x4c\x3f\xf9\xad\x5d\xfc\xfe\x2e\x3f\xad\x67\x20\xb9\x1b\x87\x76\xe3\xc8\x38\x43\xde\xeb\x11\x27\xbd\xc2\xe5\xd7\x3e\x1f\xe8\x47\x3d\x76\xbe\xac\xd5\xdf\xcd\xae\xad\x7d\x45\x81\x74\x7b\x61\x79\x46\x8c\xc5\xde\xfe\xd3\x23\xdd\x7d\x1c\x20\xbd\x01\x77\x48\xe1\x4c\x80\x4e\x40\x06\x31\x23\xfb\xfd\xfe\x2e\xf7\x9e\x44\xb9\xe0\x89\xf8\xd3\x6c\x30\xec\xff\x53\x70\xe4\xb6\x55\x20\x54\x1e\x9d\x00\xdc\xb6\x08\xc0\x6d\xb7\xf2\x6f\x24\x5f\x52\xba\x02\xe9\x91\xee\x44\x7d\x1c\x9f\x9d\xc2\xe1\xd5\xf8\xf1\xbd\xf7\x10\xd6\x35\x1e\x68\xfe\xdc\x71\xc6\x22\x1b\xe7\xf1\x11\x48\x9c\x22\x4c\x3e\xe3\x8b\x70\x1d\x61\x7c\x11\xdd\xb0\xc4\x71\xaf\x5b\xac\xa8\xe4\xdf\x78\x61\x18\xb5\xcb\x13\xe6\xcb\x35\x0e\x8d\xfb\xf4\x8b\xf1\xbd\x39\xad\x1d\xfc\x44\xc8\xf8\x6c\xc0\x9d\xe0\x05\xef\x04\xeb\x30\x42\x29\x77\xf7\x9e\x52\x0c\x49\x79\x68\xca\x4f\x36\x2d\x78\x45\x82\xff\x7e\x4b\x8a\x31\x55\x79\x18\x80\xe3\x4b\x7d\xdd\x28\x5c\xcc\xbc\xb1\x41\x74\x74\x29\xba\x30\xb5\x50\xa2\x4b\x82\x8e\xa1\xf5\xf1\x46\xfa\xa0\x2b\x48\x3b\xa7\x72\xe7\xdd\x4b\x38\xf3\xee\x25\x9c\x79\xf7\x12\xb8\xb0\xf9\x29\x9a\x97\x72\x14\xcd\x4b\x38\xe6\x7c\x9b\x00\x4d\xbd\xd3\xd4\x34\xde\xc6\xdb\x25\x9c\x8d\xd2\x4b\x68\xb7\xe8\x39\x80\xed\xff\xb8\x5b\x16\x3b\x34\xb1\x4d\xa6\x01\x90\x23\x7d\x0b\xdc\xd1\xd9\x9e\xd7\xae\x05\x41\x64\x38\x26\x0e\xc4\x7d\x93\xf1\xe1\x68\x1a\x74\x73\x77\xd0\xa6\xc5\xb8\xd7\x6f\x37\x0a\x98\x4a\x11\xde\xab\x89\x98\x8d\x7c\x7a\xf8\x16\x38\x26\xd5\x3a\x26\xd5\x4d\xc2\xe2\x33\x6f\x1b\x1b\x0c\xc6\x3e\x90\x95\x3a\x55\xa0\x93\x38\xe8\x03\x6f\x8e\x8c\x6d\xc1\xfc\x1a\x6e\xd9\x2f\x73\xce\x39\x0b\x0e\x1c\x3e\x8d\x37\x58\xdd\x50\x39\x0b\x36\xce\xef\x5d\x1d\xda\x38\x51\x78\x12\x33\x7d\xe0\x8d\xc8\x9d\x1e\x94\x77\x50\x17\xf4\xc1\xdd\xdc\xf1\x85\xf8\x8d\xaf\x0b\xcd\x83\xdb\xcb\x1d\x2f\xc5\x8e\xe7\x48\x65\x25\x1e\x59\xca\xde\x9d\x8c\x95\x34\x3a\x61\x89\xcb\xef\x2f\x4c\x2b\x53\xba\xe2\x7b\x36\x71\x35\x33\x55\x2d\xd6\xc8\xbd\xba\x08\x14\x82\x4b\x80\x97\xc1\x77\x70\xce\x21\x4a\x19\xd0\x49\xc6\x7e\x2c\xff\xc5\x07\xdf\x06\xe1\x11\xde\xda\x63\x88\x27\xd8\x38\xf8\x4a\xf5\x00\xa7\x69\xf1\x62\xb8\xa5\x76\x08\xc0\x2e\x4e\x28\x87\x3f\xd6\x1c\x02\x05\x2c\x34\x4c\xfd\xc5\xa9\xcd\xd8\x16\xf0\x0e\xa7\x05\xdc\xa7\xb4\x80\xde\x90\x26\x0a\xf8\x0d\x2e\x07\x5a\xc0\x8d\xc7\xf5\xa0\xed\x1d\xb3\x0b\xbe\x21\x6d\xe4\x3a\xc5\x02\x6e\x36\xae\xb7\x6f\x4f\xdf\x2d\xbf\xf9\xcf\x43\xdd\xc6\x57\xf8\x6f\xbf\xfa\x2f\xdd\x41\x05\x1c\x28\xac\x36\xbe\xd2\xe0\xe0\x20\x79\xf5\xd7\x86\x70\xc9\xed\x6c\x0a\xbe\x42\xaf\xad\x83\x17\xf9\xbd\x95\xb9\x4b\x16\x53\xc3\xa2\xc5\xe5\xa5\xf6\xe8\x75\xee\xe0\x3f\x01\x5b\x18\xb8\x62\x99\xc5\x8b\xe8\x29\xcd\xd1\x6d\x95\xf8\x41\xc1\x22\xee\x56\xef\xad\xcd\x53\xca\x88\x57\x3d\x2d\xc2\x96\xf7\xde\x33\x3d\xa5\x75\x74\xc5\xd7\xde\xbf\x84\x1c\x9e\xec\x5e\x41\xa1\x33\xe3\x57\xa8\x68\xe0\x10\x68\xbd\x3a\xfc\x46\xce\xc2\xd0\x25\x0b\xbf\x2e\x89\xdf\x73\x82\xb7\x53\x2b\x58\xc8\xf2\x66\xfa\x89\x2a\x96\xb1\xe0\x9b\xf0\x1b\x5f\x81\xbb\x71\xc2\x94\x9f\xb7\xab\x85\xc2\x9d\x90\x0f\xde\x53\xfa\x81\xfb\xec\xfe\xc2\xb5\xb2\x3d\x70\xeb\xa7\x0b\xf6\x6d\x67\xb2\xd0\x99\x70\x16\x2a\x92\x4f\x3f\x26\x00\x07\xd6\x65\x7e\x79\xe0\xde\xb0\x3a\x70\x6f\x57\x1d\xd8\x29\x6a\xdc\x77\x8a\x2e\xde\x4b\xfd\x8b\x4d\xc2\x5b\x90\xf8\x5c\x8b\x73\xf1\xea\x7e\xdf\xe7\x95\xaf\x30\x81\x0b\xb7\xed\x95\x1e\x96\xf8\xfb\x30\xe9\xa6\xbf\x37\x1e\x2e\xfe\xc9\x1f\xcb\x3b\x8e\xe1\xf2\x81\x7b\xf3\xe3\xc0\x00\xdd\xd5\xaf\x19\xeb\xee\xeb\xf0\x61\x56\x43\x3a\xe1\xde\x24\x3a\x70\x6b\x5e\x3b\x72\x25\x4e\xb9\xeb\xe1\x0d\x99\x8f\xa2\x32\x52\x14\x4e\x8a\x81\xfb\x34\x06\xee\xd4\x18\xa8\x57\xe3\xb9\xb8\x63\x11\xcf\x73\x4e\x51\x70\x20\x82\x92\xbe\xe0\x93\xc1\x0e\xef\xfe\xc1\x86\x81\xeb\xd1\x1f\x9f\xd6\x8e\x19\x35\x1a\x69\x6d\xa0\xa0\x8b\xb8\x8b\x39\x25\x4e\x88\x38\x64\x63\x08\x5c\xc8\xf9\x9d\x86\x7c\xec\x73\x71\x15\xc3\xe5\xdc\x2b\x06\x57\x51\xe0\x99\xbf\xb8\x09\x24\x62\x70\xbd\x6d\x91\x6e\xb1\x5a\xa4\x99\xd5\xdf\x34\x7f\xab\x79\x3f\xbb\x83\xb2\x19\x3a\x96\x34\x83\xe5\x9f\x6f\x9a\x81\xeb\xd4\x8d\x9f\x78\x59\xf8\x89\x97\x8d\x8d\xc3\xdf\x47\xab\x16\x7e\xfe\x60\x37\xbe\x07\x3e\x07\x07\x4a\xef\xed\x32\x6c\x6c\x18\x3e\x3e\xd1\x68\x5f\xcc\x84\x9e\x7a\xb2\xbe\x3d\xed\x66\x56\x73\xbc\xfe\x32\xf4\xf5\x66\x46\xc2\xfc\xf9\x49\x5a\xe9\xd4\xe7\x25\x62\x7e\x23\xf1\xbb\x30\xb6\xcf\xe8\xd8\x89\x0b\xdf\x13\xce\x83\xa1\xbd\x06\xfa\x72\xd9\x98\xeb\xff\x1e\x8a\xd9\xf0\x9e\x6a\x99\x81\x73\x14\xbb\x58\xbf\x43\xad\x97\x2d\xe9\x51\x60\x2f\x61\x08\xe1\xee\x66\x1f\xc2\xdd\x62\xbc\x84\x82\x84\xc2\x79\x64\x6c\xcc\x08\x3e\x19\x6e\x6c\xe0\xc5\x6d\x21\xc7\x84\xbb\xb1\xb5\x71\xc7\x26\x80\xbd\x82\x7d\x5a\xf8\xbc\xfc\x1c\x82\x21\x4b\x81\x4f\x23\x5b\x7e\x5c\x38\xc3\x62\xcc\x29\xd7\x51\xdd\xae\x1f\x38\xa4\x32\x7b\x9b\x9f\xe7\x2b\x71\xd2\x1c\xc5\xc8\xcf\xf7\x31\xcf\x9d\xf6\x1c\xd4\x77\xd3\xc0\x8c\x47\xb5\x91\x93\x21\x33\x1e\xe4\xcb\xff\xdb\x93\x6d\x0b\x53\x62\xa5\x3c\x17\x29\x36\xdb\xfd\xad\x02\x17\xbc\xcb\x85\x05\x9f\xca\xe2\xf0\x31\xf2\x82\xb7\x55\x57\x52\x97\x4d\x12\x6a\x58\x09\x05\x48\xfa\xc8\xdb\xf5\xc1\x26\x38\xbc\x42\x65\xf4\x09\xb3\x31\x16\x2b\xa3\xab\x7e\x4b\x53\x0b\xa3\xef\x05\xde\x76\xd9\x30\x40\xe8\xfe\x76\x0f\x2c\xa1\x7d\x81\x91\x93\x2d\x4b\xa4\xe6\x27\xb4\x21\x58\xf1\x80\x65\x1c\x3f\x16\x4d\xb8\xca\x9f\x28\x22\x1a\xa0\xcb\x25\x12\x2d\x89\x44\x4b\x7a\xf6\x05\x2b\xd1\x31\x38\xcf\xf9\x79\x8b\x86\xbc\x09\x94\x51\x0c\xe9\xbb\x4e\xf5\x37\xbe\x3e\xf5\x0f\xce\xaf\xa5\x9e\xfd\x42\xbc\x38\xbc\xa6\x9a\xf0\x31\xee\x4e\xde\x5e\xf1\xfc\x92\x40\xca\x98\xdf\x7d\x1c\x02\x08\x89\x4a\xd0\xd7\x2b\xeb\x18\x02\xe6\xc8\xc4\x6b\x8f\x44\x6b\x64\x4b\xe4\x10\xc8\xd2\x5b\x78\xec\xfa\xd7\x40\x81\x73\x26\xd8\xea\x73\x8d\xbd\x71\xc7\xea\xf6\x4c\x39\xc3\x41\x98\x87\xe6\x9c\x1b\xe7\xcc\xbd\x1a\xb5\xe7\x2c\xd0\x77\x4b\xc4\x69\x77\x13\xb7\x5d\x08\x33\x48\xa2\xcd\xda\xe3\x7d\xf0\xe5\x3d\xd7\xb8\x31\x42\xab\x3b\x21\x40\xab\xbb\x47\x6f\x1c\xca\x73\x95\x7c\x57\xdd\x96\x3f\x38\x50\xdc\x5e\x82\x4e\x59\xf3\x07\x1e\xd1\x2c\xe3\x9e\xed\x44\x6f\x13\xc9\xd0\xc5\xd9\x4a\x07\x0c\x1b\xd6\x7b\x59\x6f\x6f\x74\xe3\xa7\x5b\x2d\x7c\xcd\x15\x16\x86\x32\x67\xf4\x01\x3b\xd1\x7b\x0f\xd0\xf2\x35\x83\x58\xe5\xa8\x54\xe2\xda\xcf\x23\xff\xf9\xe1\x2b\x7d\x16\xbe\x6e\x70\x16\xa6\x72\xd6\xce\xe5\xac\x9d\xf2\x6a\x58\xaa\xda\x8f\xc3\xfd\x95\x76\x7b\xcf\x2a\xcd\xaf\x43\xc3\x16\x0c\x0b\xaa\x60\xbe\x1b\x72\x0b\xcd\xde\xec\xaf\xbb\xfc\xb2\x51\x40\x3c\xa8\x3b\xd7\x3e\x4b\xbd\xa6\xe2\x44\xda\xcb\x20\x20\x6d\x53\x71\x20\x9d\xf3\x17\x24\xed\xf3\x17\x20\xad\xf3\x97\x4b\x80\xba\x2c\x02\x54\xdd\x09\xd8\xca\xf1\x39\x02\x7b\xa5\xa1\x76\x8e\x91\x98\x2b\x82\x23\x32\x63\x47\x64\x96\xc9\x63\xd0\xcd\xbf\x6b\x8b\xb4\xc7\x05\xb7\xf2\xdb\x88\xd2\x32\x4b\x9e\x4c\x0e\x4d\x7d\x18\xe0\x09\xc4\x32\xc7\x05\xc3\x0c\xcb\xcf\xa1\xd6\x2e\x61\x21\xf6\x47\xef\xa9\x96\xc9\x03\xaa\xe5\xa7\x60\xec\xcc\x46\xc5\xb8\xd7\x47\xe2\x8a\x6b\x3a\x74\xae\xca\xb0\xc7\x21\xaa\x0c\x99\xfc\x12\x7a\x60\x2a\xa6\x3f\x78\x82\xa3\xf6\x2e\x23\x76\x62\xfe\x36\x83\x3b\xf9\x30\x20\xf4\x57\xef\x45\x00\x76\x84\x07\x1f\xc2\x25\x50\x67\xaf\x67\x25\xb0\x06\x85\x6b\x90\x69\xa8\x9c\x32\x9c\xcb\x5e\x48\xda\xb7\x3c\x81\x74\x56\xa0\x8f\x92\x4c\xc3\x9c\x55\xe8\xa3\xf4\x33\x2c\x0e\x21\x6b\x56\x76\x18\xe5\x26\xd2\xfe\x87\x54\xb8\x9d\xc6\xbd\xd3\x8a\x29\x85\xfe\x53\x8f\x6d\x61\x80\xa4\xed\xab\x03\x23\xee\xc7\x09\x90\x64\xe1\x87\x14\xbb\x96\xeb\x5c\x5b\x22\xd2\x4f\xe9\xcf\xed\x23\x24\x6d\xdb\x03\x0a\x95\x7e\x72\xcc\xf9\x37\xad\xf6\x1b\x8a\x1b\xb9\x80\x14\x5e\x04\x7b\x12\xc3\x78\xf8\xdc\x7d\xdd\xd9\x46\x85\x36\x6b\x0b\x1c\xbb\xad\xaf\xac\xab\x95\xab\x9c\xad\xaf\x35\xf2\x57\xd4\xde\x0b\xa9\x67\x85\xd4\xb3\xc2\xca\x08\xb8\xdf\x4b\x0b\xa2\x7c\x2f\x5f\x6c\x11\xe1\xf5\x88\x35\x2e\x7c\xc2\x63\xe1\xa7\x39\x15\x78\xf2\x3a\x3c\x7c\x15\xf9\x8d\x0b\x84\x8f\x01\xcb\x8d\x6e\x4d\x1d\x66\xd0\x56\xcb\xf3\x8f\xd5\x37\xac\x58\x91\x65\x50\x7c\x5b\xa8\xbf\x53\x8d\x59\x95\x71\xcd\x17\x37\x0a\x99\x20\xb8\x05\xf6\x87\x08\xd3\x99\xe6\xf2\x26\xb4\xa9\xd7\x3e\xec\x7b\xaa\x67\xb3\x6c\xe1\x5a\x8f\x53\x8c\x47\x38\x72\xfa\x12\xf6\xa5\x86\x47\xd8\xb7\x09\xfc\xa5\xc1\x0c\x87\x36\x29\xfa\xfe\xc1\x7d\x56\x60\xe2\x92\xf6\xd6\xd6\x40\xbc\x77\xc2\x1e\xe1\xcc\x96\xc9\x9e\xca\x56\x37\x6c\xa0\xfb\x1e\xc2\x75\xbf\xe7\x6f\xf0\xbd\xfd\x17\xf7\xf8\x3c\x40\xd1\x4d\x05\xde\x8d\x9a\xd8\x4d\x96\xcb\x69\x97\x05\xef\xa2\x66\xef\xa7\xdf\x87\x41\xd2\xf8\xe0\x05\x3f\x47\xf7\x81\x0d\x47\xf7\x18\x21\xed\x4d\x58\x82\x95\xc2\x46\x0e\xfc\xfc\xca\x2d\x88\x99\x3e\xfe\x4e\x63\x99\x9c\x03\x84\xed\x14\x7f\x5b\xad\x30\x1c\xcf\x32\x79\xe3\xbb\xc7\xb1\xf1\x19\x1d\x27\x78\xff\xb6\x1c\x03\xc2\x96\xb8\x9b\x52\x8e\xe3\x9f\x87\xad\x6b\x80\xdd\x83\x97\xb0\xcd\x5a\x1f\x61\x6f\x76\x03\x41\xa3\x24\x4d\x34\x6e\xbf\x5d\x8f\xb2\x37\xb6\x31\x88\x16\x2d\xee\x1d\x60\xa0\xec\x3d\x60\xa0\xe4\x9f\xac\xf6\x3d\x16\xa0\xa4\x9f\xbc\xf6\xf5\x17\xa0\x94\xf5\x40\x09\x52\xb4\xe9\x7e\x1b\xf3\xdc\x0a\x42\x8a\xb6\xc5\xb9\x1f\x83\xe9\x54\x4d\x67\x3b\x35\x00\xca\x9e\xcb\x90\x12\x30\x9d\x78\xcd\x25\x2f\xc1\x98\x5d\xae\x87\xcd\x8d\xef\x1e\xd7\x49\xb3\x32\x3b\x15\x89\xff\x24\xda\x25\x04\x88\x01\xfe\xea\x13\x1c\x7b\x6e\xd8\x13\x43\xf9\xca\x23\x61\x6c\x65\x3d\x5d\x88\x19\xc1\x04\xb3\xbf\xde\x35\xf5\xc6\x11\x6b\x3a\xe0\x21\xdb\x83\xcf\x2e\xfc\xc6\x43\xc2\x3f\xa9\xb2\x70\xc3\x81\x36\xfa\xb3\x29\x3b\xb8\x72\x69\x9f\xb1\xe0\x25\x64\x0d\x91\x5e\x11\xd0\x5d\x7f\x1a\xa4\xac\x7a\xf0\x9f\xc6\xe1\x9e\x18\xf8\x62\xdb\x25\xd8\xcb\xf0\xbe\xfd\xbe\x52\x58\x6f\x7b\x43\x91\xe1\xb1\x6f\x7b\x84\xab\x5d\x6e\x42\x78\x59\x5a\x1c\x9a\xa0\x85\xce\x09\xbe\xd3\x52\x27\xc4\x9f\x08\x06\xec\xe7\x84\x58\x31\x02\x3e\x77\x7b\x1e\x81\xc4\x14\x1e\x13\x64\x7a\xf5\x63\xaa\xf6\xef\xd8\x20\x2f\x42\x4e\xf4\xbd\x0d\x84\xc7\xf3\xe6\x0e\x6c\xa1\x80\xf9\xe7\x21\x5c\x87\xef\x9b\x70\x59\x66\xe3\x3b\x98\x36\xbe\x2c\x79\x12\x90\xf0\x9d\x93\x3b\xdd\xb7\xa1\x61\xee\x54\xd3\x55\xba\xd3\xfd\x97\x20\xf1\xe3\x7d\xcb\x70\x12\x62\xa0\xea\x42\x4b\x4e\x08\x3b\x40\x19\x5e\xe2\x38\xf0\xbe\x99\x9e\x81\x80\xa9\x73\xdb\x5a\xae\x04\x8b\x24\xc7\x2d\x0f\x73\xdc\xda\xa1\x79\xa0\x21\x88\x86\xc8\xed\xee\x0f\xff\xe6\x7c\x1f\x44\xf2\x06\x70\x78\x27\xfe\x05\x9f\x69\xe1\xc6\x3d\x0b\x0e\x84\x9f\x35\xcc\xc1\x10\xbe\x18\x97\x2c\x21\xb4\xfb\xb8\x54\xbe\x78\xef\xdf\x6d\x02\x56\xd9\x5d\x28\x3f\xc5\x3b\x2f\x3f\xb4\xaf\xb0\xdb\xf5\x6d\x67\x7c\x47\xea\xc6\xc0\x9b\xc7\x79\x6e\x7e\x21\xe6\xe0\x7f\xab\x6c\xdf\xad\xb0\xaf\x0d\xc6\x3d\x32\xbe\xd6\x44\xb9\xed\x07\x3d\xcf\x49\xf3\x23\xec\x35\xe1\x21\x5c\x3f\x12\xb9\x7f\x30\x15\x94\xf0\x11\x40\x87\xe7\x3e\xa5\x1b\xc3\xc8\x10\x37\xc2\x0f\xe1\xd6\x7f\x13\xde\xaa\xa7\x04\xda\xdf\xf4\xc9\xb6\x63\x82\x5e\xd7\x23\xa8\x1c\x27\xfa\x9c\x9f\x13\xfd\x0d\x8f\x10\xdb\xd0\xe4\x73\xa9\x8c\xef\x96\xc9\xc1\x99\xbf\x5f\x46\xdb\xb8\x49\xfc\xa1\xd8\x18\x3f\xcf\xd7\x87\x70\x3b\xff\x10\x8a\x46\x29\x87\xfd\x0e\xa1\x4a\x25\xde\x61\xdc\x21\xfc\x64\x7b\xcd\xc7\x0a\xc9\x84\x17\xe2\x1d\x48\x5e\x82\x36\x5d\xc2\x92\xc2\x30\x28\x6e\xff\xe3\xdb\xc3\xab\x5f\x6c\x29\xeb\x87\x47\x37\x7e\xf5\xda\x38\xbe\xef\xcb\x73\x98\x4b\xa5\x0e\x04\x93\x10\x77\x4a\xdb\x84\x37\x07\x1d\xc2\x5d\x5f\x5c\xc2\xad\xc5\x49\xb4\xbe\x62\x2c\x42\x7e\xe5\x3e\xe5\x38\x87\xfa\x40\xda\x9b\x2a\x40\xd9\xe3\x05\x28\xdb\xde\x07\x29\x4b\xc7\x2b\x70\x02\x37\x13\x49\x38\xa8\x12\xd8\x1b\x4f\xf0\xe6\x8b\x89\x32\x22\x50\x25\x0a\x1f\x7e\xf8\x16\x38\x0c\x9d\xfc\xa1\x9a\xe1\xf7\x61\xeb\x39\x85\x9d\x75\xad\xdb\x3f\xd7\x91\x75\x87\xd0\x94\x70\x0d\x1f\x6f\x88\x0e\x84\x92\x7d\x6f\x61\xe1\x86\xb5\x6a\xf8\xf8\x4c\x69\x78\x22\x34\xbf\xc5\xf1\xde\x24\xda\xf8\x0e\xbd\x05\xdf\x50\x3d\xc1\x03\x07\x78\x47\x75\x87\x70\x0f\xe5\x2f\xc1\x24\x8d\xa6\x84\x7b\xca\x70\x72\xbd\x8b\xb4\x43\x28\x18\x05\xc5\xdd\x94\xcb\x69\xa9\xc2\xa7\xfe\x4e\xa0\x24\x53\x3a\x7a\x50\x7e\x84\x54\x81\x90\xa9\x99\xee\xb9\xeb\x82\x34\xce\x06\xea\x49\x65\x69\x65\xcf\x07\x79\x59\x5a\x55\xbf\x57\xc9\x1f\x61\xef\x5c\x5f\xc2\xda\xb7\x2e\x4f\x0d\xf3\xd2\x2e\x08\x5b\xe4\x40\xd9\x3b\xe4\x8f\x72\x36\xc8\x81\xc2\xb9\xdc\xed\xf1\x83\xef\x30\xdb\x38\x70\xb9\x9f\x74\x59\xf8\x2a\x5c\x07\x4b\x3d\xef\xbd\x94\x8d\xaf\xb4\x39\x95\x3c\x36\x41\x8f\xb0\x1d\xdb\x01\x81\xea\x69\xc5\x3d\x63\x44\x22\x04\x6c\xca\x67\x75\x79\x08\x4f\x6a\x1e\x82\x34\xe7\xdb\x06\x3e\xe5\xbe\xdb\xc0\xb7\x62\x57\xaa\x2d\xc2\x3d\x89\x3a\x2d\xd5\x39\x85\xbb\x8b\x0f\xc5\x3c\x1b\xaa\x40\x3a\x1b\xaa\x48\xe2\x2e\x5a\xef\xd6\x2a\x25\x51\xc7\xae\x0b\xfb\x9a\x50\xd7\x76\x3b\x0e\x89\x89\xf4\xd3\xba\xc2\x46\x4e\xda\x8e\x56\x88\xf4\xd3\x0b\xc7\x05\xd4\x25\x9d\x8d\xbf\x3a\x57\x3e\x60\x5d\xb3\xf1\xd5\xff\xdc\x5e\xf8\xbb\xbd\x54\xc3\x14\x57\xf7\xa8\xb0\x86\xa9\x39\xf9\xc1\xc6\x76\xd1\x36\x29\xf7\x30\x31\x5d\x7c\xad\x43\x3c\x3d\xdb\x32\x0f\x30\x84\x9f\xf8\x4d\xe2\x87\x80\x45\x98\x84\x37\x75\x1d\xc2\x5d\x3e\x5d\x02\xa6\x91\x40\x47\x76\x13\xe5\x74\x07\xee\x86\xf7\x9c\x7b\xe3\x2d\xec\xf3\x23\x5c\xe5\xe5\x86\xc0\x28\xb0\x86\xaf\x91\x1e\x2c\xa8\xcb\x90\xe8\xce\x26\x0b\x5f\x36\x5d\xf0\x2e\x98\x16\xbc\x26\x1a\xde\xde\x4f\x6c\x55\x9c\xfa\x6a\x9a\xcc\x7c\xab\x95\xbe\xf2\x8e\xdf\x17\xaa\xfc\xf1\x0e\x85\x0d\x6f\x13\x38\xbc\x9a\xe6\x82\x51\xf1\x35\xfc\x58\xf8\xee\x07\x2f\xd8\xf9\xeb\xb3\xea\xde\xf8\xae\x49\x16\x7e\x36\xf2\x1b\x67\xc1\x98\xfb\x72\xc7\x74\x84\xc7\x24\x18\x35\x49\x84\x23\xa9\xca\x67\xeb\x0e\x07\x42\xdc\x6f\xae\xfb\x2d\xfc\xeb\x14\xa1\xfa\x02\xe7\x2e\x61\xea\x7a\x69\xef\xda\x44\xd4\x4a\xcf\xd6\xd5\xb6\x4c\x5a\x00\x76\x82\xbe\x8e\xbf\x4a\x70\xed\x6b\xdf\xe8\x6a\xa4\x97\x70\xe6\xc0\x4b\x30\x89\x72\x2f\xa7\xd5\xfe\x4e\x02\xab\xc3\x73\xf9\x6c\x26\xd8\x78\x75\xdf\xa6\x8a\x7f\x5f\x69\x49\x8b\x50\x0c\xac\x61\x1a\x5a\x3a\xb7\xe8\xf7\x50\xaf\x80\x6c\xcf\x60\x7a\x7d\x4d\xcf\xa8\x69\xa2\xa7\x5b\xb5\x88\x4e\x31\x5a\xa4\x93\x8e\x16\xe9\xa4\xa3\x45\x7a\x27\xa9\xf9\xf2\xeb\xad\x02\x5b\xfa\xd2\x80\x27\x52\x1a\xbd\x9c\xd4\xca\x07\x67\x0d\x6b\x3e\x3f\x09\x15\x34\xba\x6f\xef\xa1\xa4\xba\x90\xe1\x37\x38\x97\x69\xf4\xc0\xd2\x44\x15\x43\x46\x78\x6e\xce\x21\x9a\x74\x4f\xc2\x7d\x8a\x6c\x32\xfb\x21\x5c\xdd\xea\x10\xee\x92\xbb\x15\x7a\xb6\xb4\xf1\xcb\x49\x0e\x39\xff\x63\x28\x74\x22\x57\xaa\x72\xa4\x67\x5a\x0f\xe1\x0e\x36\x27\x74\xaa\x00\x5d\xcd\x75\x02\x67\xdf\x39\x7b\x78\x51\xb4\xfb\xfd\x83\xab\x03\x2e\x78\x8d\xc5\x3a\x3f\x30\xdb\xe1\xa5\xda\x70\xa0\xdd\x23\xaa\x8d\xef\xf4\xde\xf1\x09\xd9\x17\xfd\x16\x74\xe1\x2b\x73\x16\xbc\x1b\x95\xbb\x64\x47\x1e\x6f\xd8\x38\xaf\xab\x7d\x9c\xb2\x74\xaa\xc8\x1b\xa7\x07\x63\xf8\xb7\x36\xe8\xef\xe9\xdb\xfa\xd2\x2e\x2f\x2f\xd8\xdc\x9d\x28\x63\x4c\x68\xeb\xee\xb7\x26\xee\xae\xf3\x82\x03\xda\x6b\xe0\xdb\x67\x9d\x1e\xc6\x9d\x08\x37\xc2\x7a\x58\x16\x71\x2f\xdb\x48\xd7\x12\x7b\x20\x13\xc6\x1e\xf6\x65\xa1\x97\xb8\xe3\x2b\x16\x36\xe1\xcd\xb2\x87\xc0\x51\xf0\x05\xa0\x49\xb8\x86\x75\x27\x44\x09\x60\xba\xda\x03\x31\x6f\x0f\xf4\x84\xd8\x84\xad\xc3\x82\xfe\x10\xae\x64\x98\x84\xfe\x6c\x0b\x7a\x78\x16\x57\x79\xc3\x01\x9a\x42\x0f\x74\xa1\xd8\x61\xc4\x26\x9a\x32\xe6\x2d\x4f\x7a\x78\x62\x69\x07\x8f\xb8\x09\x7b\x08\x29\xbd\x9e\xb6\xf3\xae\xef\x0b\xc1\x19\x5a\x7a\x5b\x22\x1b\xdf\x0b\xdc\x1d\xf6\x5f\xc6\x86\xc6\x4d\x3e\xbf\xdf\xc3\xb6\x8d\x0b\x30\xb9\x63\xe0\x72\xb3\xc4\x9d\x6e\x96\x68\xcc\xf8\xdb\xb5\x30\x68\x66\x6d\x2a\x66\x98\xde\xb3\xd9\x1b\x46\x1c\x16\x96\x8a\x84\x2f\x70\xd0\xd2\xdd\xad\xc6\x9b\xab\x36\x7e\x63\xda\xee\xa2\xb6\x39\x3a\x46\x73\x27\xb4\xe1\x5b\x84\xdd\xf0\x94\xbe\xfb\xe6\xd2\xab\x8b\xdd\xc7\xd8\xf6\xc7\x37\xeb\xad\x6f\xfd\x25\x53\xd0\x32\xac\x93\xa9\xf6\x44\x19\x8b\x54\x9f\x49\xfe\x42\xc0\xe1\x0b\x3f\x7e\xb4\x0f\x2e\xec\x77\xa3\xc1\x6d\x78\x71\xab\xdb\xb3\x25\xdc\x10\x6e\xf2\x75\xa3\xa1\x3d\xa7\xe7\x86\xc9\xba\xda\x9d\xa1\x0d\x1b\x88\x14\xa3\xf3\x12\x6f\x43\x9c\xa0\xfa\x3a\x58\x36\x6a\xe4\x77\x54\xea\xb0\x63\x66\x68\x3d\x3c\x61\xc9\xdc\xc1\xcf\xf2\x2f\x5c\x8c\x85\x43\x3b\x8b\x1b\xff\xb2\xe8\x26\xf8\xfa\xd6\x17\x0c\x48\x59\xcb\x16\xa4\xac\xd5\x23\x50\xb6\x31\x09\x50\xd6\xa5\x9a\x4b\x38\x8a\xce\x25\x04\x2e\xba\x1b\x85\x69\x59\x4a\xf8\x29\x4b\xd7\xb2\x94\xa4\x61\xca\x4f\x79\xf7\x35\x66\xa0\xec\xe5\x21\x50\xce\xd6\xd8\x25\x79\xdb\x54\x22\xec\xbd\x66\xa0\x6c\x6f\x96\x40\x39\xd7\x97\x88\x94\xb9\x8c\x93\xd4\x7e\x49\xfd\x27\xe2\xbe\xda\x44\xa4\xfe\x93\xe3\x3e\x48\x26\xd2\x6f\x5a\xda\x98\x6d\x9d\x1c\x2b\xe9\xb7\x5c\xe5\x37\xad\xfa\x5b\xae\xda\x7f\x22\xb6\xfa\x13\x6a\x5f\x7e\x22\x52\xf8\x49\x7e\x3b\x24\x44\xd2\x18\x9a\xbc\x85\x9f\x88\xee\x59\x50\x43\xfd\xb4\xbd\xfd\x76\x87\xfd\x76\xda\xd9\x79\x20\x52\xfd\x09\xf5\xdb\x8f\xf6\xdb\x8f\xc7\x94\x6b\x93\xde\xe3\x8d\x97\x00\xb2\x75\x6f\x28\xf0\x48\x26\x59\x0d\x66\x3c\x5d\xf2\x21\x06\x85\x39\xf4\x10\xce\xde\x74\x4f\x78\xd9\xb5\xdf\xc5\x6b\x70\xd0\xf0\x22\xcc\xc6\x77\x91\xdd\x93\xaf\x0b\x08\x83\xdc\x4b\xfb\xa5\xa0\xf7\x75\xa0\xda\xbd\xf1\xdd\xd1\x9c\xf8\x29\xdd\x3d\xd1\x79\x7d\x4f\xef\x79\xd2\xb2\x61\xc0\x52\xe3\x53\xf5\x1e\x97\x93\x82\xbb\xa8\x9d\xcd\xc0\x7b\xf1\xcd\x8f\xb7\x63\xe9\x6e\xb0\x60\xa9\xbd\xdd\x5e\xbd\xeb\x32\xee\x17\x2b\x1d\xd3\xa0\xb4\x09\xe8\x80\xa7\xf7\x2f\xfb\x53\xe5\x79\x99\x0a\x6e\x5f\x58\xb1\xdc\x9d\x9f\x49\x49\x68\xa4\xd6\x97\xa9\x70\x99\x49\x2d\x06\x1f\x7e\x0d\xea\x1e\x64\x6e\x58\x09\xde\xcb\x1a\x27\x30\x7c\x76\x1b\xf5\xf8\x70\x29\x6f\x47\x71\xc1\xab\xe9\x6f\x58\x09\xde\x33\x98\xb1\x2e\x24\xbd\x23\xc6\x61\xc7\x33\x2d\xe4\x9e\x9a\x1b\x83\x3f\x7c\x77\xab\x37\x21\x07\xd8\xaf\x38\x84\xbb\x61\xb1\x09\x6f\x5f\xeb\x84\xb8\xbe\x32\x0e\xe1\x5e\x0b\x39\x84\x42\x89\xe2\x36\xce\x24\xf8\xde\x04\xb6\x8a\x7d\xe3\x4c\x76\xfe\x6a\x11\xcc\x40\x7e\x9c\xf1\x76\x64\x06\xdc\x4d\xa8\xfb\xeb\x35\x4c\xc8\x97\x30\x75\xc2\xf3\x74\xc3\x58\x0f\x1a\x43\xcd\x17\xe1\x6d\x8d\x1c\xc2\xb5\xec\xf3\xbb\x62\x05\x6e\x37\x8d\x75\xbc\x7b\xa7\xe4\x83\x6f\x5f\x8f\xaf\x3d\x27\x42\x63\xf8\x53\x95\x77\x4b\x7e\x2c\x3b\xed\x3d\xce\xf2\xdf\x52\xb0\xef\x7b\xed\x1b\x1e\xe3\x0b\xdf\xb9\x3b\x0f\xa7\xe4\x0b\xcb\x83\x77\x57\x69\x05\xce\x4f\xdb\x5a\xf0\x8a\x96\x09\xcb\xdb\xa8\xdc\xf0\xf4\xa7\xef\x21\x9e\xbb\xc3\x1b\x10\x6a\x86\xa8\x77\x42\xed\x95\xe7\x9c\x2e\x9f\x6f\xf1\xbc\x89\x7a\x60\x27\x18\x03\x05\x8e\x99\xbe\x9e\x85\xcf\x84\xfd\x6c\xd1\x2c\x90\x5f\xbc\xfe\x19\x82\x73\xd8\xb1\x3f\x65\x44\xad\x21\xa2\xa6\x02\xbf\x2f\x13\x1e\xe3\xb8\x7c\x10\x64\x38\xbe\xdc\x11\x95\x8c\x21\x8b\xd1\xb7\x48\xdf\x38\x1e\x94\xe6\xbc\xd6\xbe\xfb\x22\x86\x7b\xb2\x7b\x20\x14\x20\x82\x3a\xee\xd8\x3e\x28\x6c\xb4\x2f\x32\x82\x5c\x27\x6c\xf4\xb5\x71\xd4\x9e\x29\xf0\xa8\xf4\x75\x48\x52\x81\x3f\xc7\x28\x39\x47\xfc\x7e\x1e\x90\x4d\x17\x0e\xfa\xda\x38\x70\xe3\xc0\x2d\xf1\xd7\x4e\x5f\x7b\xa7\xaf\xa3\x11\xdc\x7e\x42\xd3\xc3\x1c\x9b\xcb\x9d\x9f\xb9\xd5\xc5\x12\xde\xe4\xbb\x49\x7e\xc6\x35\xbb\x02\xe7\xe2\xc2\x38\x4b\xf8\x22\xe5\x29\x92\x7f\x91\xfc\xa4\xe9\xee\x31\xe3\xc5\x81\xe3\x4b\xcb\xc7\xce\xf5\x31\x69\x1f\x8b\xdc\xf8\x7b\xef\xf9\xe1\x38\xe4\xbb\x71\x7c\xab\x82\xb9\xfe\x96\xa2\xe0\x4a\xe5\x37\x69\xaf\xbb\xdd\x7c\x30\xb6\x97\x85\x2d\xbb\x2e\x6c\x83\xe0\x08\x04\x51\x98\x4d\xd8\x38\x70\xa6\xaf\x24\xa2\x1c\x73\xf0\x68\x41\x70\xe5\xf8\x58\x73\xf0\xdd\xbb\x61\x44\xa9\xe3\x38\x32\xce\xf2\x3d\xeb\xf7\x2e\xb8\x31\x46\x59\xf5\x1c\x01\xa7\x87\x83\xe0\x4a\xe1\x0d\xe5\xb7\xd9\xbb\xe2\xe3\xb8\x91\xf0\xf5\xe5\x0d\xc3\x04\x8d\xe1\x6e\x76\xe1\x7b\xaa\x87\x4d\x36\xee\x67\x77\x62\x23\xc8\xc9\xbd\x72\xa5\xed\x4a\x0b\x08\xcb\x95\x16\x10\x7c\x71\x7b\xb1\x51\xea\xd7\x46\xef\xe0\xb3\x71\xb6\xf1\x80\xd9\xe7\x9c\x7e\xc5\x9b\x1d\x76\x5c\xea\xcf\x46\xeb\xe2\xca\x89\x9b\x16\x3f\x53\xe5\xf6\xc5\xcb\xb9\xb2\x05\xca\x5a\xfd\x22\xc1\x17\xf4\x87\x90\xb9\x81\xae\x4d\xdb\xc1\x85\x9b\x33\xa2\x2e\xe0\x58\x1a\x00\xa5\x50\xba\x4b\x0b\x48\xaf\x33\x3e\x3a\xe8\xc5\x52\xa7\x26\xe5\x39\x1b\x3f\x07\x77\x98\xf1\x72\xc0\x39\x3d\x87\x0f\x18\xd7\xd5\x48\x86\x28\x50\x1d\xcb\xf7\x1c\x04\x73\xe2\x6e\xd0\x49\xc9\x4b\xf8\x22\xe9\x17\x49\x1f\x9b\xc2\xd5\x5e\xe0\xad\x1c\x3f\xfc\x1a\xef\x5b\xf7\x07\x46\x0a\x1b\xad\x33\x4e\x81\x83\x67\x4e\xfb\x6e\x32\x1f\x3c\xf8\xfb\xf5\x85\x7c\xbf\x57\xc2\xd7\xd5\x81\xe3\x44\x8a\x46\x4e\x6f\x09\xe8\xf8\xf9\x64\xde\x30\x43\x9f\xe7\x75\xfb\xf2\xe6\x7d\xe6\xec\x0b\x07\x7f\xa5\x69\x31\x5f\xaf\xfa\xe9\x62\x64\xe0\x5c\x50\xa9\xc9\xdb\xc4\xf6\x7e\xa3\x62\x15\xe6\x8f\xe7\x0a\xf8\x40\x4c\xb7\x7e\x38\xf0\xaf\x83\xa1\x17\xfa\x2e\xea\x1d\xb7\xaf\x63\x29\x1b\xc9\x05\xbf\x92\x88\xac\xd2\x24\xb5\xc6\xdd\x59\xec\x4b\xd0\x44\xc5\x98\x13\x4b\x62\xce\x75\xcc\xdf\x2d\x40\xab\x94\x26\x1a\x7d\x7b\xfb\xed\x8e\x49\x6b\x2e\x8d\xfb\xbe\xf4\xb7\x33\xe9\x78\x60\x1b\x97\x21\x69\xc1\x2a\xfc\x60\x2c\x6b\x0d\x1f\x4e\x06\x35\xdc\x0b\xb1\x1b\x92\xca\x5a\x03\x33\x5a\x05\x97\x26\x17\x43\xee\x35\x7e\x28\x6d\x6a\x24\xb6\xad\xf1\x5a\xec\x6e\x78\x0d\x98\x2f\xc6\x45\x8a\x71\xc5\xeb\xb5\x0e\xbc\xdf\x0d\xf9\xbc\xda\x3b\x9f\x72\xfc\xce\x00\x36\xbc\xcb\xde\x8d\x39\xfa\xba\xff\xfd\xa2\x17\x94\x0f\xb5\x1c\x43\x0a\x47\x0d\x67\xbd\x3a\x50\x28\xd6\x41\x5c\xdf\xae\xa7\x82\x8d\x06\x7f\xa4\xf5\x4c\x8b\xf7\xdc\xc4\xa1\x5d\xdb\x85\x0d\xb1\x42\xcd\xb8\xb3\x5a\x7a\x1b\x45\x1b\x13\x23\xb5\x8c\x93\x73\xcb\x1f\xf2\x49\x5b\x17\xc7\x01\x16\x86\x9d\x53\xba\x57\xb2\x1d\x83\xff\xc5\x83\x71\x16\xf3\x53\x46\xe8\xd9\x77\x4a\xb9\xbf\xb7\xeb\x89\x62\xc3\x81\x5f\x49\xa9\x68\xf7\x82\xf4\xc3\xd8\x8d\x5d\x9a\xb4\x47\xec\xaa\x1e\x65\xbd\x9a\x49\xea\x1c\x97\xfd\xfb\x63\xe2\x62\x5e\x1f\xde\xf9\x42\x28\x48\x97\x46\xe8\xf5\x19\xb7\x3a\x6e\xd4\x86\xc7\x77\xfb\x49\x7b\x60\x25\xfb\xf8\x70\x3a\xe8\xe3\xac\x85\x1e\x46\x5d\xbe\x8f\x67\x76\x3b\xf1\x08\x58\xe7\x11\x88\x21\x47\xb8\x17\x2a\x37\x3c\xa7\x4d\x27\xf0\x48\xf4\x39\xe2\xea\x72\xec\x8b\x14\x88\x51\x10\x0f\x6a\xee\x11\x89\xdd\x71\x2b\xf3\x60\xec\x8d\xbd\xc1\xf8\xbe\xde\x53\xc2\xfd\x15\x9a\x68\x18\x29\x77\xcf\xc3\xf4\xfe\x48\x3d\x77\xdc\xf6\x8d\x13\x56\xbf\xe2\x8c\x38\xf2\x35\x94\xdf\x10\xb9\x71\x94\xb7\xbf\xec\xb8\x7d\x79\xbf\x07\x78\xe1\xba\xae\x76\x61\x38\xea\xee\x20\xb1\xf1\xee\xae\x9f\x74\xa9\x50\x9e\x6e\xa7\x74\xd7\xe5\xe4\x0b\x9f\x1e\xed\x70\x3d\x45\x74\xe1\x3a\xa5\x79\xd0\x28\x6e\xe5\x94\xd7\x15\xb7\x57\x81\xd0\x05\x73\xce\x83\xd3\xee\x9d\x21\x07\x8e\x99\x0b\xb6\xcf\x5c\x1e\x8e\x5c\x0f\x0b\x1c\x7e\x7b\x9c\x7b\xe1\x97\xc7\x39\x68\xed\xbb\x7e\x58\xb8\xc8\xf7\xe5\xdd\x01\xd2\x93\x56\x8d\x26\xb8\x32\x96\xde\xf5\x3b\xe2\x84\xb5\x97\x24\x7c\xe2\xf2\x18\xb5\x47\xf9\x28\xfb\xfd\xfa\xe4\x45\x41\xa2\x62\x4b\x17\xe6\xbb\xc2\x7c\xb7\x9e\xff\x82\xaf\xcb\x83\xfe\x83\x9d\xbe\x66\x4e\x8a\xca\x7c\x1e\xa1\x7c\x90\xe3\x96\xca\xd0\xa8\x18\x8d\xbf\x36\xfe\xba\x1c\xf6\x3d\x18\xe8\xeb\xe0\xb8\x1d\x87\x52\x11\x36\x2d\xc2\xa6\xf7\x55\xc8\x87\xa5\xa9\xf7\x2b\xdd\xd0\x13\xdc\xbc\xfb\xc0\x10\xe2\x73\x03\x47\x69\xb4\xa8\x3d\x2b\xed\x14\x9b\xe4\x5f\xb9\xb3\xf7\x41\xe0\xc3\x85\x7b\x21\x76\x49\xbf\x55\xf9\xce\xf9\x59\xe2\xb6\xde\x9e\x46\x5f\xf8\x65\x9d\x0d\x0c\x23\x1c\x93\xb9\xfd\xf6\xc3\x5f\x1b\xd7\x2f\x48\x68\x6c\xbd\xfa\x65\x0a\x4b\x4d\x5f\x89\xcb\x2b\xf3\x71\x65\x3e\xae\xcc\xc7\xf5\xa3\x4e\xab\x1f\xd5\xb1\x32\x1f\x57\x66\xdc\xca\x8c\x5b\xf7\xc3\xc4\x17\x16\xce\xb7\x70\xca\x95\x93\xaa\x9c\x54\xe3\xfa\x35\x2e\x64\xe7\x8c\x06\x7f\x1d\x9c\x51\x34\x4e\x9a\x05\x64\x3d\xef\x9f\x02\x0e\x1c\x5f\x6a\xb9\x4f\xb4\x1f\x4e\x95\xe3\x4b\x13\x46\xa9\x69\xac\x92\xbe\xb4\x53\x94\xa6\x88\xd2\x16\xcc\xb6\x55\xd8\xb6\x8a\x80\xaf\x22\xe0\xef\x8b\x7a\x1b\x9f\xf7\x43\x1f\xc4\x41\x73\x5e\x0e\xbd\x30\x9c\xbd\x99\xd1\x70\xb3\x62\x65\xbc\x0e\x78\xa1\x20\x38\x33\xc5\xc4\x7c\xe9\xef\x31\x43\xce\x31\xd1\xcc\x8e\x9b\x6a\x13\xc2\x22\x6d\xb4\x6f\xd0\xc7\x8e\xbb\x48\x8e\x97\xaf\xd1\x07\x33\x67\x4c\x1c\xe1\x2f\x4a\x63\x41\xd6\x83\xbb\x11\x30\x35\xd1\xf6\x1a\x3f\x00\x53\x23\xad\x55\xf9\x6d\x25\xdc\x0e\xdc\xd5\xa6\xc2\x46\xdc\xf1\x5a\x38\xa1\x1c\xf1\x57\x75\x03\x97\xc7\xb8\xe5\xca\x71\xe3\xfa\x08\xfb\x1e\x02\x10\xd6\x2d\x04\xa8\x23\x36\x91\x3f\xd0\x87\x79\xb4\x8f\x84\x95\x3f\xd2\x87\x39\x78\xdf\xbf\xe8\x95\xeb\x58\x84\x17\x62\xfb\x8a\x71\xf2\x55\xb2\x23\xfd\x6a\x7e\xef\xfc\xbd\x51\xf6\xfd\x23\xe9\x18\x3b\x6b\x6f\x6b\x27\x04\xb3\x97\x36\x6f\x3f\xd5\xef\x92\x1e\x4f\x3e\xef\xa1\xdb\x47\xe0\x5e\xeb\x32\x6e\x63\x97\xf9\x27\x76\x99\x80\x3c\x13\x62\xac\x21\x8c\x37\x84\xf1\x86\x30\xde\x10\xc6\x1b\xcc\x78\xfd\x78\x44\x00\xc2\x7a\x79\x19\x09\xc6\x39\xf0\xa4\x17\x97\x3f\x20\x0e\x41\xf2\x66\xdd\x11\xe3\x52\x6c\xef\x48\x50\x2d\x6c\xec\xfd\x68\xd1\xcb\x64\x39\xe7\x84\x34\xdd\x13\x2a\x24\xb9\x5f\x61\xc2\x14\xb0\xa2\xc6\xb2\xe1\x3c\xc8\x5b\x29\xc3\x2c\x19\x92\x08\x70\xfb\x75\x2d\x52\xc2\x31\xe6\x04\x63\x02\x6b\xd6\xcb\xe5\xa6\x44\x19\x52\x2d\x56\xae\x97\x4f\x50\xac\x68\xe6\xa9\xcd\x32\xf7\xf0\x5a\x1a\xbe\x8a\x25\x1a\x76\xfb\xa5\x27\x4a\x9f\x65\xf0\x4c\x1f\xe7\x4a\x2b\xab\x56\x44\x30\xe4\xb9\x75\x72\x53\x20\x01\x1e\x49\xf7\xf5\x61\xc0\xd4\x4c\x79\x3b\xc8\x81\x0c\xba\x12\x5a\xe5\x1c\x99\xa1\xf6\xab\xba\x4c\xa0\x39\x77\x39\x26\x92\x44\x49\x00\x5a\x39\xbe\x40\x20\x4a\x94\x9a\xf3\x4a\xc5\x54\xee\xae\x2b\x11\x4a\x90\x6c\x8d\x9b\xb3\x1d\x37\x25\x40\xe0\xca\x35\x19\x2d\x6b\xcb\xee\xb5\x2f\x4b\x56\x2b\xe7\xe9\x1e\xcc\x92\x9a\xaf\x69\x03\x37\xd1\x18\x8e\x0d\x1b\x11\x86\x16\x7b\x48\xb1\xa5\x81\xdb\xb9\xc7\x07\x84\x28\x69\x48\x7b\xb6\xe3\x0e\x0c\x09\x41\x08\x49\xa3\xf0\xa0\x9b\x04\xa9\x1c\xaf\x0f\x4d\xc5\xae\x75\x6d\xe0\xce\x0d\xdc\x44\xd3\x76\x02\x96\x33\x05\xe9\xf7\x14\x44\xd5\x4f\x41\xa6\xd7\xb5\xfb\xfd\xf2\x60\x75\xc5\x54\x30\x9b\x0a\x66\x53\xc1\xec\x84\xc0\x65\x60\xde\xf3\x87\x40\xb3\x12\x4c\x08\x34\x0b\x27\xfb\x3a\x43\x6c\x38\x3c\x84\x5d\x5f\x49\xe9\x48\xc6\x5a\x94\x5f\x3e\x97\xef\x92\x59\x96\xf0\x34\x65\x27\xdb\x0f\x81\x3e\x4c\xa2\x2f\x19\xab\x08\x13\x1b\x87\xe7\x49\x24\x99\x68\xf6\x4e\x90\x2c\xa2\x96\x31\x5a\x95\x4a\x45\x25\x90\xaa\x95\x4c\x24\x4d\x52\x21\x9f\x54\xc8\x27\x13\xf1\x95\x4c\xf8\x7e\xf9\xfd\xd2\xc6\x94\xba\xf0\xd8\x49\xd7\x6b\xd8\x21\x24\x56\x1e\x26\x96\xef\xdc\xbe\x89\xa6\x96\xe5\x3b\x00\xe3\x67\x9e\x93\x53\xe6\x39\x39\xf1\xd4\x94\x54\xcd\x4d\x89\x27\x8a\x89\x89\x5d\xb3\xcc\xc9\x4e\xa0\x12\x67\xd9\x31\x48\x59\xe4\x56\xd2\xdd\xab\x94\xb5\xed\xb3\xb6\x7d\x96\x79\xdd\x13\x95\x5c\x78\xa2\x4f\xba\x33\x90\x8a\x28\x49\x49\xf7\x12\x92\xce\x69\xa9\x88\x54\x4a\x3c\xcf\xa6\x2c\x53\x79\xca\x32\x95\xcf\x14\x48\x46\xa7\x72\xee\xa0\x1f\x82\x8a\xd3\xa4\xe2\x34\x35\x65\xab\xa6\x92\xad\xa9\x64\xe3\xe9\x29\xf1\xf4\x94\x54\xf1\x4f\xe5\xb7\xd8\x3c\x71\x24\x95\xe0\x6e\x53\xc0\x85\x26\xf9\x9c\x7e\xe4\xf3\x12\xf9\x94\x82\x34\xae\x28\xeb\xa9\xf3\x12\x2b\x9d\x47\x3f\x1e\xa6\x1c\xfb\x47\xf9\x8d\xe3\x88\xed\x11\x68\x07\x37\x0d\x19\x1a\xac\x97\x27\x15\xff\x49\xc5\xff\x32\x33\xe0\x12\xf8\x2e\x02\x95\x81\xfb\x65\xc8\xbe\x86\x97\xb2\x73\x14\x61\x87\xa1\xec\x30\x94\x1d\x86\xf6\xde\xd0\xde\x1b\x32\x5a\xdc\x2c\x80\x71\x90\x04\x48\x7f\x71\xf7\x46\xb8\x29\x1b\x64\x2f\xdd\x3d\xe7\x51\x7a\xb9\x73\x7c\x12\x7b\xf9\xbc\x13\x03\xe5\x09\x81\x13\xd4\x02\x77\x29\x10\x2d\x35\x97\x7f\x68\x4e\x80\xe7\x95\x1c\x79\x66\xcb\x51\x8e\x12\x22\x2d\x46\x73\x10\xc9\x98\x83\x08\xba\x1c\x44\xd0\xe5\xc8\x7b\xc2\x13\x1b\x7f\x1f\x12\x9e\x18\x70\xe2\xc0\xdf\x59\xd6\xba\x7d\x86\x94\xf9\xa7\x92\xfb\x01\x91\x43\x30\xd9\x18\xcb\x26\x3b\x63\xd9\xf4\x6c\xc2\x48\x76\x2c\x2b\x0e\xe0\xe1\x1c\xf5\xf0\x21\x8a\xf8\x76\x43\x91\x2a\xc5\x22\x1e\xce\x3f\x07\x0e\x59\x66\xec\x9c\x65\xc6\xce\x59\xc6\xd2\x3a\xa2\x7f\xe5\xe4\x55\x56\x36\x2d\xb6\xce\xf8\xcb\x9f\x10\x66\x29\x07\x0f\x2a\xdc\x73\x96\xd9\x3c\xcb\x46\x7e\x2e\x2c\xb0\xdc\x14\x04\xab\x50\xf6\xdb\xc7\x0f\x37\x3a\x55\x29\x3a\x0a\x8a\x70\x8c\x1c\x06\xcc\x04\xba\x24\x10\xa5\x4a\xc2\x20\xba\xdf\x9d\x75\xc3\x3b\x97\xe3\x8a\x02\xd2\x18\x5a\x2d\xee\x89\xb2\x56\xee\x2f\x8d\x26\xa7\x0a\x99\xe7\xa3\xcc\xf3\x51\xd6\x6d\xf4\x19\x9f\xcf\x93\xda\x79\x32\xe9\x10\x3a\xef\x5b\x67\xd9\x6a\xca\xb2\xd5\x94\x79\xab\x29\x37\xe5\x8e\xa6\xdc\xd1\x94\xa9\x75\xfb\x32\xcb\xfe\x65\xee\xd2\x39\x5d\x44\x54\xff\xe8\xe4\x63\x3b\x9a\x1e\x80\x3b\x43\xee\x97\xc1\x7b\x7b\x79\x08\xf3\x8d\x8f\x16\xe8\x33\xb9\xcc\x78\x48\x76\xa4\xd9\xf9\x7d\xdf\xc0\x01\xf8\xa8\x28\x0f\xed\x95\x21\x6b\x56\x27\x48\xa1\x79\x1f\x3b\xff\xcc\x44\x3a\x79\x1d\x4f\xd3\x14\x82\x0f\xe6\x02\x9f\xc1\x04\x5a\x1f\x95\x40\x6a\x40\xf1\x47\xa6\xe8\x33\x9f\x7f\x05\x39\xe6\xf3\x99\x8b\xe2\x57\xf9\xde\x25\xbb\x2e\xf9\x0d\xc9\x6f\x48\x7e\x3c\xc6\x9f\xc3\x58\x24\x48\x16\x72\x86\xb7\x67\x1e\x22\x98\x26\x6a\x9a\xa8\x69\xa2\x7c\x10\x16\x64\x04\x6c\xf7\xb2\x70\xf4\x18\x44\xac\x3f\xe7\xb2\x87\x10\xb5\x67\x58\x8d\x28\x41\xb6\x41\x4a\xa4\x03\xb3\x12\x3f\xae\x57\x64\x81\x39\x71\xe0\xef\xa6\x58\xd2\xe3\x4a\x47\x61\x86\x28\xcc\x10\xe5\x44\x58\x74\x86\x89\x33\x97\xa7\x48\x7a\x45\xd2\xab\x92\x5e\x95\xf2\x55\x49\xbf\x49\x7a\x4d\xd2\xeb\x92\x5e\x97\xf4\xba\xa4\x37\x24\xbd\xa1\xed\x1b\x82\x12\xb4\x47\x82\x24\x29\xcc\x18\x95\x19\xa3\x32\xa3\xea\x31\x4e\x90\x76\x8c\xda\x31\x51\x7b\x26\x6a\xd7\xc8\xc1\x73\xd4\x93\xe7\x78\xdc\x1a\x23\x41\x13\xd5\xfe\xe0\x63\x3f\x27\x68\xa2\xda\x45\x51\xfb\x28\x6a\x27\x45\xed\xa5\xa8\xdd\x64\xda\xea\xbc\x49\xed\x6f\xbd\x56\x25\xfc\x44\xd1\x44\xb5\xa3\x4c\x3b\xca\x7e\x86\xcf\xcf\xf8\xd1\x9e\x33\xed\x17\x53\x11\x60\xda\x2f\x72\xa2\x1e\x8f\x47\x2c\x20\x70\x47\x19\x8b\x11\x63\x21\xc1\xfb\x61\xfe\xfa\xbb\xe0\xca\xd8\x24\x35\x93\xe4\x4c\xd2\x4b\x92\x5e\x92\xf4\xb2\x96\x4e\xd2\xd3\xca\x14\x89\x5f\x25\x7e\x95\xfc\x9b\xc4\xef\x12\x9e\xe6\xf9\xf9\x5d\x9b\x47\xd2\x1b\xda\x3e\xcc\x5b\xa6\x43\xdc\x74\x88\xef\xa3\x20\x8a\x12\x35\x8a\xb6\x92\x4c\x0d\x7a\x76\x53\x4c\x07\xb0\x2e\x55\x96\x2f\x23\xed\x5a\x4d\xa3\x48\x63\xc8\x88\x36\x1d\xc0\xa6\x03\xd8\x74\x00\x9b\x0e\x60\xd3\x01\x6c\x3a\x80\x4d\xb6\xb8\x9c\xf0\xc3\x85\x5d\x09\x64\xf8\x62\x3a\x5e\x75\x57\xb3\x98\x8e\x57\xd3\xf1\x6a\x3a\x5e\x4d\xc7\x9a\xe9\x58\x2b\xc2\x6e\x6a\x9c\xc4\xc7\xe4\x85\x8f\xc9\x0b\x2f\xba\x4a\x11\x5e\x2c\xbc\x5b\x5c\x8a\xf0\xae\x9c\xe1\x17\x39\xc3\x2f\x6a\x0b\xf5\x63\x5d\x54\x64\x35\x5b\x8a\xac\x66\x4b\x91\x35\x73\xd1\x25\x51\xf9\x31\x99\x2a\xda\x9b\x45\x55\xa3\x1f\x2b\x25\xdd\xe6\x2b\x45\x25\x78\x13\x1b\xb0\x26\xed\xdc\xb8\x9d\xab\x36\x2c\xaf\x45\x4b\x39\xde\x01\x91\x20\x19\xd0\xda\xb0\xe8\x0e\x5e\x69\xb2\xd0\x2b\xba\xad\x58\x74\x5b\xb1\xc8\x81\x7c\x91\x03\xf9\x89\x33\x97\xa1\x9b\x96\x21\xa1\x41\xde\x24\x14\x25\x34\x25\x50\x1e\xbc\xf1\x58\x3a\x4f\x0e\x9d\x27\x87\x2e\x93\x43\x97\xc9\xa0\x8b\xf9\x60\x97\xc9\xa1\xcb\x64\xd0\x3f\x3a\x77\x9d\xdf\xab\x94\x46\xf2\x2f\x12\xbf\x48\xf8\x2a\xe5\xa9\x92\x7f\x95\xf4\x9a\xa4\xd7\xaa\xd6\x37\x2b\x21\x70\x89\x79\x8b\xa0\x74\x3e\x31\x28\xb2\x8c\x5d\xcf\xe5\x48\x92\xda\x28\x51\x5b\x41\x46\xe5\x7d\x01\x19\x08\xda\x10\x91\x2d\x28\x75\xbb\xb8\x74\x15\xed\x6a\xcb\x51\xba\x8a\xf6\xae\xa2\xbd\xab\x68\xef\x2a\xda\xbb\x8a\xf6\xae\xc2\xa0\xeb\x50\xef\xc7\x8d\x08\x12\xb2\x12\x24\x51\xfb\xe1\xcb\xa8\x89\x2a\xa7\x9a\xf6\xac\x69\xbf\xd8\x0f\xb3\x6a\xbf\x88\x08\xe8\xaa\xac\x75\x9d\x2f\xba\xce\x17\x43\x44\xba\x58\xb7\x14\xde\x45\x2f\x43\xb4\x91\xc1\xa6\x0d\x45\x77\xe9\x8b\x6c\x53\x94\xc1\x96\x0a\x65\x28\xf7\x0d\xb1\x85\x28\xba\xc7\x5e\x74\x8f\xbd\xe8\x46\x46\x19\x62\x47\xb2\xbc\x32\x72\x31\x84\xd9\x86\x1a\xae\x0e\xd5\x45\x26\x41\xaa\xc6\x16\x61\x65\xc8\xa6\x67\x19\xb2\xe9\x59\x86\x9c\x39\x94\x21\x47\xce\x35\xf0\xb6\xd0\xba\x17\xd9\x30\x0b\xb6\xf7\x0b\x62\xf0\x19\x78\xe3\xac\x06\x5e\xe2\xba\xc3\xd5\xc0\x04\x31\x55\xd4\x63\xf5\x1a\xf8\x1c\xc7\x93\x20\x63\xc9\x20\xec\x5f\x83\x70\xaa\x13\xa4\x9a\x7c\xe8\xe1\x69\x54\x21\xb0\x19\xac\x1e\xb5\x3b\x41\xcb\x41\xcc\x5d\x79\xff\xa2\x46\x31\xe0\x8d\x6c\xc1\x1b\x69\xa7\xa9\xca\xe6\x45\x95\xcd\x88\x1a\x3f\x92\xc4\x55\x36\x27\xaa\x6c\x4e\x54\xd9\x9c\xa8\xb2\x19\x51\x65\xf3\xa1\xca\xe6\x43\x95\xcd\x87\x2a\x9b\x0f\x55\x36\x1f\x6a\x64\xe5\xac\x46\x9e\xcc\x27\x96\xfc\xd8\xf4\xbc\xea\xd6\x40\xd5\xad\x81\xaa\x5b\x03\x35\xca\x7c\x55\x75\xaf\xa0\xea\x4e\x40\x8d\x32\x97\x54\x5d\xe7\xd7\x28\x16\x7b\x35\xca\x5c\x52\xa3\x9a\xf3\xea\x4e\x40\xd5\x85\x7f\xd5\x85\x7f\xd5\x65\x7d\x8d\x6a\xe3\x1b\x75\xec\x45\xe5\xd9\x28\x4a\x5b\x15\xf3\x8c\x6a\xd2\x67\xc6\x47\x44\x55\x16\x8f\xd5\x58\xcb\xac\xc6\xd2\xb4\xee\xa5\x1d\x06\x60\xfd\xa0\xaa\xd9\x43\x35\x6d\x4d\x53\xdb\x68\x5d\x85\x55\x93\x93\xf6\x49\x20\xfb\xb2\x6a\x32\x67\x3a\xa1\x0a\x81\x9b\x22\xf1\xd8\x14\x3b\xe5\x2a\x76\xca\x35\xd1\x6e\x7a\x4d\x9f\x24\xc6\x6c\x27\xc6\xc6\x33\xb8\xa4\xce\x52\x56\xcc\x36\xaa\x98\x6d\x54\x31\xdb\xa8\x89\xcf\x43\x27\x96\xf2\xf0\x34\x58\x93\x74\x5c\x92\x45\xba\x3b\x27\x32\x25\x54\x21\xfc\x34\x11\xd7\x3a\xab\xd5\x7c\x56\xb3\x79\xbd\xb8\x54\xf9\xd0\xad\xaa\xfd\x48\xad\xa2\x61\xd5\x2a\x86\x83\xb5\x8a\x91\x45\xad\x62\x07\x50\x2b\xe7\x51\xd8\x80\x3d\xcb\xd1\x4d\x55\x73\x92\xaa\xb7\xa1\xbc\x50\xb8\xec\xa8\x55\xec\x0c\x6b\x95\xa5\x4f\xad\x3a\xdf\xd4\x35\x23\x19\x11\x8a\x12\xaa\x12\x86\x10\x68\x3f\xbc\xf2\xb1\x58\xd5\xf5\x56\xd5\x15\x59\x55\x53\x90\xaa\xd6\x23\x4e\xc0\x4e\x6e\x7a\xbe\xd0\x02\xb3\x56\x0b\x7c\x0c\xbd\xfc\xb1\xbc\x32\x74\x32\xdb\xad\x4d\xea\xd0\xf4\xb8\xa2\xe9\x71\x45\xd3\xb3\x87\xa6\x47\xe9\x4d\x8f\xd2\x9b\xaa\x0c\x2d\x88\x66\xd4\xf4\xb8\xa2\xe9\x71\x45\xd3\xe3\x8a\xa6\xc7\x15\x2d\x88\xd6\xdd\x82\xc8\x63\xf7\xb1\x8d\xec\xdc\x64\x87\xbe\x45\x5e\xc6\xb7\xc8\x77\x2f\x82\x4c\x01\x33\x3e\x32\x4d\x93\x49\xd7\x7d\x6d\x63\x25\xa2\x74\x96\x4e\xba\x4d\x37\xe8\x5b\x94\x05\x4b\x8b\xc2\x55\x4d\xcd\x08\x5a\x94\x25\x4d\x33\x39\x17\x68\xc6\x07\xd1\x4d\x4c\xbd\x1b\x9b\x7a\x37\x9d\xf9\x96\x5b\x70\xce\x92\xb7\xbf\x9a\x4a\xab\x96\x64\xa1\xda\x12\x2f\x54\x5b\x92\x32\xb0\x69\x5c\x33\x11\x4d\xcd\xb4\xda\x6a\x7a\xd8\x92\x72\x5d\x52\xae\x4b\xda\x96\x49\x8e\x32\x66\x1a\xcc\x53\x49\x79\x4a\xee\x0f\x34\xb9\x3f\xd0\xe4\xfe\x40\x93\x15\x56\xe3\x15\x56\xeb\x24\x1e\xd1\xaf\xc9\x84\x99\x61\xd2\x8e\x49\x62\xd3\xd0\x74\x7d\xd5\x74\x7d\xd5\xe4\xa2\x40\x8f\xb2\x74\xef\x51\x16\x4f\x3d\xca\x2c\xb2\x6e\x1a\xbf\x8b\x40\x81\xab\xa0\x37\x03\xda\xd0\xb1\x3e\x74\xac\x0f\x19\xeb\xee\xe6\x91\x31\x0e\xab\xce\xd7\x96\xba\xda\xb8\x74\xb5\x71\xe9\x79\xa9\x7f\x86\x04\x3a\xce\xef\x59\x16\x84\x3d\xcb\xbc\xd6\x79\xee\xec\x3a\x77\x76\xb9\x62\xd4\xe5\x8a\x91\x97\x81\x2a\xa5\x97\x37\x3b\x9b\x27\x76\x9d\x2a\x27\x81\xef\x91\x15\xed\xaa\x2a\x57\xc9\x2a\x31\x5b\x2f\x9a\xa2\x6e\x8d\xf6\x22\xca\x60\xaf\x32\x1d\xf4\xca\xe3\xb8\x57\xde\x44\x9c\x38\x4b\x02\x34\xc5\xf5\xaa\xd5\xae\x32\x9f\x4c\x02\xe9\x6f\x93\x50\xa4\x10\x3f\x15\x67\xb5\xa4\xeb\x95\xbf\x5e\xc5\xb8\xa1\x0f\x5e\x90\xf5\xc1\x36\x27\x7d\xd0\x52\xbc\xf3\x40\xed\x7c\x2f\xaf\x57\x6d\xd9\x9f\x2b\x80\x83\xd7\x7b\x7d\x88\xe4\xef\x43\xe6\x86\x2e\x7b\x29\x5d\x2c\x1e\x67\x8a\xf2\x5d\xba\x4a\x4d\x40\x9c\x90\x85\xc0\x2d\x3d\xe4\x58\x67\xb9\x6d\x17\xc2\x4f\x45\xb8\xea\x3a\xde\xbb\xee\xad\x0c\x36\xf2\x18\xe1\xa3\xe3\xa8\x21\x46\x1d\x23\xb0\x2d\xcd\x90\x4d\x93\x21\xe6\x8d\x43\x36\x49\x26\x36\xc6\xc4\xc2\x43\x54\xa8\x89\x8d\xf3\xe7\x55\xd6\x08\x22\x54\x9d\x50\x25\x4a\xd5\x10\x24\x5f\x46\xf8\x6d\x14\xd6\xbd\x47\x10\xdd\x7b\x04\x59\xb3\x3a\x41\x5a\x8e\xa7\xaf\x11\x64\x8f\x6a\x04\x99\xb9\x87\xea\x48\x43\x6f\x62\x8c\x20\x3b\x93\x63\x5f\x8b\x08\x14\x42\xb3\xcd\x9a\xa8\xf6\x29\xef\xe6\x0c\xdd\xcd\x19\x91\xce\x02\x96\x47\xdb\x81\x5f\x03\x7f\x26\xc9\x37\x22\x4b\xdf\x11\xd9\x98\x6f\x88\x6d\xca\x10\x5b\x8c\x21\xb6\x18\x43\x6c\x31\x86\xda\x5e\x0c\x31\x0f\x1d\x6a\x8b\x31\x54\xd5\x1b\xba\xe1\x32\x74\xc3\x65\xe8\xfe\xca\x88\xca\x89\x7a\xe6\x3f\xa2\xac\x39\x86\xea\x8f\xc3\xf8\xfa\xf9\x72\x14\x82\xe1\x79\x85\x30\xa2\x18\x70\x8d\xa8\xdd\x6f\x32\x3e\x4d\xa6\xce\x61\x3c\x35\xba\x1f\x14\xcc\x42\xef\x77\x0c\x93\x8d\xd3\xc1\xdb\x07\x43\x2f\xe1\x0d\x93\xbd\x99\x61\xa2\xb7\x8d\xcc\xec\x94\x99\x9d\x74\x36\x1f\x32\x9b\x0f\x99\xcd\xef\x93\x88\x80\xa9\x0d\x92\xc8\xb4\xcc\x9b\x91\x23\xf3\x76\xe3\xc8\xbc\xa5\x31\x32\x6f\x27\x8e\xcc\xb3\xd5\xc8\xbc\x72\x19\xe2\x3c\x65\x88\xf7\x94\x91\x85\xbf\x33\x1f\x04\x8c\xcc\x2b\x19\xf7\x2f\x23\xe5\xcd\x92\x21\x6b\x85\x43\x2e\x29\x0e\xf1\xb1\x32\x23\x94\xaa\x4d\x10\x38\x84\x08\x89\x2c\xc6\x27\x23\xcb\xa1\xe7\xc8\xca\xee\x59\x39\x27\xcb\x96\xfb\x28\x32\x81\x0d\xd5\xa2\x86\x9e\x42\x0f\x56\xcc\x86\x3a\x35\x19\x45\x76\x28\x3d\x8f\x2e\x04\xda\x0a\x1c\x45\x76\x28\x87\xba\xda\x18\x45\x8e\xbf\x86\xde\xea\x1c\xea\x5d\x63\xe8\xa5\x99\xa1\x67\xe1\xa3\xc8\xce\xdd\x28\x62\x9a\xe4\x21\x24\x51\x19\x6b\x7a\xdd\x64\xd4\x8f\x27\xbf\xfa\x91\xb5\xef\x10\x97\x16\x13\x1b\x63\x3e\xa4\x18\xea\x4f\x61\x54\x15\x62\xea\x61\x62\xa8\x8b\x89\x99\x28\x0b\x80\x2a\x5b\x92\x4e\x90\x6c\x65\x1a\xab\xca\x7f\x8d\x37\xe9\xdd\x2e\x18\x33\x6d\xac\x43\x0e\xbe\xc1\xef\x87\x16\x1c\x9d\x99\x42\x6e\xe4\x8f\xc6\xdb\x15\xbe\x40\x64\x4c\xdb\x0d\x43\xad\x9e\x47\x93\xdd\x76\x77\x23\x20\x59\x46\xcd\x53\x78\xa8\xc9\x76\xf0\x68\xa2\x49\x0f\xbd\xda\x3a\xf4\x6a\xeb\x68\xca\x76\x5d\xf4\xf5\xa1\xd7\x89\x06\xdf\x20\x1d\x4d\xb9\xb0\x29\x17\xee\x97\x1e\x02\x26\x49\x36\x14\x93\x40\x67\x20\x43\x75\xd9\xa1\x07\x87\x63\xa8\x28\x18\x5c\x2c\xbd\x66\x34\x86\x6a\x60\x7a\x4a\x38\xf4\x94\x70\xa8\xbe\x3c\x54\x5f\xf6\xb7\xa7\x21\x4d\x72\x05\x3c\x1a\xfb\x97\x75\x3f\x1e\x59\x70\x20\x27\x5d\x43\x8f\x15\x9d\x10\x24\xc7\x2c\x98\xfd\x63\x58\x14\xcf\x22\x26\x1a\x95\x47\xc9\x12\x85\xf4\x06\xcf\xe4\x87\x40\xce\x17\x2c\xf2\x4d\xa3\x49\xa0\x11\x38\x09\x34\x46\x26\xa1\x6a\xa2\xf5\x27\x8a\x26\xca\x0e\x19\x2c\xf2\xb1\xb5\x57\x2e\xfc\xd4\x3f\x68\xf5\xf8\xa0\xda\x29\x51\xb3\xe6\x25\xd9\xa2\xfc\xa4\x93\x7f\xf2\xca\x5a\xc0\xf8\xd3\x0e\xe2\x0a\xc3\xa2\xba\x95\x30\x3d\xe3\x72\xca\xd0\xe6\xb3\x9f\x9a\xda\x4f\x4d\x79\x45\xb0\x28\x5a\x1e\xfb\xa9\x05\x4f\xef\x8b\xc2\x2d\x66\x74\xee\x13\xcd\x94\xed\x8c\xf5\x2a\x0f\x91\x85\x20\x25\x11\x93\x4f\x27\x68\xae\x39\x0b\x81\x5d\xa5\x98\x9c\xcc\x79\x41\x35\x17\xe5\x10\xd9\x75\x75\x82\x16\x55\x79\x48\xad\x39\xe3\x8f\x4f\x8c\x68\x6a\x7a\xb9\x28\x3f\xb1\xd8\x19\x8c\xd9\x0f\xc7\xa8\x3d\xe6\xa2\xfc\xa4\x53\xb5\x69\x62\xd3\x94\x95\x3f\xd4\x4b\x47\xfc\x71\xd3\xb1\xd2\xb9\x8a\xda\x94\x60\x19\x2f\x57\x6f\xc2\x53\xf6\x16\x01\x94\xbd\x4d\x78\x6a\xc1\x22\x5c\xe9\x7c\x5e\xf0\xb8\x0f\x1d\xdc\xa7\x5f\x16\x0c\xe7\x9d\x18\x2f\xd1\x7d\x62\xe6\x96\x6f\xbf\x25\x72\x71\x26\x11\x1a\xc5\x2d\x48\xfc\xf1\x0b\x12\xd9\x31\xc8\x2c\x4e\xaa\xa0\x74\x6f\x42\xcd\x12\x22\x60\x7d\xd0\xdd\xe6\xc4\xe8\x14\x73\x62\x70\x2e\x39\x21\xfa\xc2\x8f\xf6\xa1\x6b\xfb\xb8\xdf\xec\x90\xec\x1f\x87\x9e\x02\x76\xec\x82\x1a\xbe\xd1\xcf\xbe\xe9\x21\x5c\x2e\xda\x84\xb7\x79\xb2\x09\x54\x85\x52\xb9\x0a\x59\xca\x8c\x8e\x29\xbd\x0c\x9d\x2b\x81\x8e\x3f\x77\x19\x61\xa9\x76\x28\x4f\x19\x3c\x94\xa7\x0d\x5e\x8a\x51\xc1\x23\xac\x83\x37\x21\x48\x45\x24\xa3\xe5\x6a\x18\x33\xaa\x41\x33\xaa\x01\xed\x51\x77\xba\xf9\x87\xc0\xad\x8c\x9b\x0b\x9b\xf0\x36\x0f\x0f\x21\x48\x14\xe6\x9c\x4a\xd2\xfe\x50\x9e\xb4\x3f\x94\xa7\x75\x1d\xca\x53\xbb\x4e\x59\xaa\x10\x60\xe8\x6e\x0a\x58\x42\x1d\x4a\xd0\x2a\x81\x36\xe6\x14\x13\x66\xab\xa6\x9c\x63\xcc\x39\x6c\x81\x71\x1e\xa8\x3f\xac\x62\x01\x87\x6c\x8c\x15\xc7\xb3\x3f\xa6\x80\x5f\x13\xfa\x2d\xf5\x91\x84\x03\xdc\x73\xcb\xcf\x85\xbc\xe3\xc4\x7c\x57\xed\x87\x1d\xec\x87\x1d\xe8\x4c\xca\xa7\x22\xc3\x22\x1b\xf9\xfa\x8f\x66\xec\xd3\x3b\x9a\xe9\xf3\x2b\xc6\x0f\x37\xc4\xf3\x68\xe4\x0d\x90\xde\x33\x46\x97\x80\x8e\xbb\xa7\xe0\x42\xd9\xe5\x0f\x38\x55\x2c\x54\xf9\x62\xa0\x87\x5b\xca\x7b\x15\xfc\x12\xb8\x54\x85\x7d\xe0\xba\x0b\x6c\x4c\xb2\xb1\xa0\xba\xcf\x37\xd8\xc5\xe8\x07\xd7\xf1\x4f\x7c\x25\xe0\x93\x3a\xfb\x95\x3d\x4e\x82\x9f\x16\x59\xfe\x76\x24\x84\x65\x4e\xc3\xb8\x25\xc4\xc7\x79\xb4\xc1\xcf\x52\xdc\x77\x5c\xc7\x85\x69\xf0\x67\xce\x22\x45\x6e\xeb\x14\xb7\x85\x65\xbe\x98\xfc\x2f\xc7\x94\xc8\x99\x76\x4c\xf9\x2b\x54\x80\xf3\x0c\x13\x04\x90\x14\x2a\xb7\x6d\x12\xaf\xf3\x4e\xd0\x18\xe4\x8d\xda\x09\xe8\x2a\x3b\xba\x4f\x0b\x7a\x47\x45\x7c\xac\xc7\xf7\x30\xc2\x79\x59\x25\xb2\xe7\xf2\x98\x65\xf2\xca\xe9\x43\xae\xcc\x52\xf1\x9c\x7e\x82\x17\x6c\xc9\x9c\xce\x2a\xc7\x80\x40\x25\xa8\xef\xed\xe7\x4b\xe8\xf4\xba\x0b\xbc\xc6\x7c\x08\xf7\x8d\xd3\x1b\x62\xd0\x83\x31\x4d\x2b\xde\xa5\xdc\x9d\x05\x49\x1e\xf7\xd9\xe5\x83\xa5\x5d\x86\xf0\x8c\x5f\x79\x85\x14\x0b\xbb\xc2\x9e\x98\xa6\xd4\x22\xce\xc3\x63\x21\x77\xd6\xf3\xbb\x64\x10\x65\x30\xad\x3b\x34\x98\x42\xe2\x14\x12\x7a\xa1\x8c\xf0\x58\xfd\xc2\xed\xbd\x4d\xb8\x08\x5d\xea\xe8\x76\xc9\x28\xc5\xaa\xb2\x4b\x85\xe7\xdc\x2e\x81\xca\x54\x8d\x5e\x84\x98\xb8\xa1\x7e\x53\xc9\x0d\xfa\x84\xe8\x22\x3c\xd6\x42\xde\xc9\xfd\xf5\x78\x4e\x5f\x5c\xa1\x3b\x21\xe3\xe3\x46\x93\x40\xc3\xdd\x43\x24\x25\x14\x21\xa0\x83\xec\x45\xc0\x91\x54\xab\xf4\x4e\xed\x5f\xa7\x96\xe9\x32\xe2\xab\xb6\x6e\x0b\xd2\x9f\x4d\x7b\x7c\x59\xca\x00\x5f\xb7\xf8\x3f\x04\x9a\x67\x9c\x80\x55\x69\xac\x4d\x37\x23\x9e\x68\xcc\x31\x2d\x7d\x95\xbf\xa2\xd2\xd7\x12\x3f\x92\x10\x97\x5d\x03\x16\xf8\x3c\xe8\x15\x2e\x26\x05\x3c\x36\x6d\xb6\x36\x64\xb4\xbb\x8f\xfd\xf1\xb6\x4d\xe2\x73\xba\x9f\x81\x80\xa3\x7d\x47\xb9\xa6\x66\x87\x90\x95\xf0\x76\xf2\x0f\xe5\x29\x6b\x9b\x50\x35\x44\x2c\x3f\xa9\xbc\xd5\xcf\xc9\xd9\x94\xf2\x56\x75\x87\x52\xa2\xa6\x03\x3a\xfd\xa1\x3c\xad\xef\x14\x27\x6b\xca\xb0\x10\x39\x94\xfc\x53\xab\xa6\x79\xc1\xea\x60\x53\x40\x59\xbc\xad\xf3\xb4\xa4\x49\xc2\xb7\x80\x16\x01\xfb\xbe\x2b\xf7\xf6\xc0\x8f\x07\xc6\xbe\x1f\xbd\xb1\x0b\x4d\x30\xab\x02\xdd\x58\x80\x76\x13\xf9\xd4\xc7\x79\x06\xf9\x40\x7c\xe4\xc0\x5f\x2a\xc7\xf7\x83\xe2\x79\x1f\x20\x5f\x48\xe1\x47\xdc\x06\x54\x2f\x38\x3e\x28\x72\x9f\x20\x9d\x70\x0e\x93\xcb\xc7\xff\xb1\x75\x25\xc9\x9a\x86\x20\x6c\xdf\x87\xf9\xeb\x13\xe7\xfb\x5f\xac\xcb\x39\x09\x6f\x19\x54\x44\x54\x54\x9c\xe2\xfc\x9f\xf1\x28\x67\xa2\xdb\x3e\x07\x7a\x7f\x58\xcd\x64\x86\x31\xdf\x7f\x36\x13\x65\x8c\xd9\x28\xe6\xfb\x17\x60\xa0\x4e\xf9\x75\xe2\x02\x6e\xc3\x09\xdf\x57\x75\xf1\x7d\x1d\x99\x2e\x24\x59\xe1\x4b\x95\x05\xb9\x5c\x6f\x11\x37\x61\x62\x56\x29\x31\x2c\x0c\x39\xa3\xcc\x69\x33\x67\x54\x29\x23\x43\x15\x06\xd4\x7c\x20\x5d\x9f\xff\x55\xe3\x46\x85\x51\xc6\x98\xf5\xc3\xb0\x4a\x31\xdf\xff\x6f\x33\x87\x37\x1f\x99\x81\x8d\xa2\x56\x8e\xca\xd2\xe4\xc4\x30\x3e\x68\xf0\x9d\xe3\x40\x95\xc2\xc0\x15\x3c\x21\x96\xcb\xf0\x0b\xa0\x09\xb3\x40\x8e\x9c\xa1\x4a\x0d\x67\xe4\x13\xb6\x8f\x22\x77\x4a\x6b\x6f\x01\x31\x21\x36\x25\xc3\x4f\xfb\x16\xe4\xc8\x11\x42\xe3\xee\x4b\x69\xa3\x6a\x18\x56\x33\x86\x35\x10\x30\xc2\x70\x3f\x10\x7c\x6d\x3a\xa1\x7d\x04\x51\x6b\x11\x9d\x3b\x13\x46\x4e\x1b\x3b\xc1\x04\x91\xd3\xf9\x1d\xf8\x41\x10\x38\xb1\x4e\xe9\x37\xc6\x09\x6b\xa2\xc8\xed\xa3\xd0\x56\x10\x1a\x96\xe8\xae\x02\x0e\x67\xb3\x4a\xa1\xd8\x1f\xf3\x2f\x21\x68\x08\x20\x55\x86\xef\xc4\xe2\x7c\x89\x9f\x10\x85\x61\x0f\xce\x64\x9c\xf2\x2f\x33\x02\x05\xe4\x5f\x21\xb1\xc8\xe2\xe4\xf7\xf3\xf2\x86\x58\x67\xf9\xfc\x44\x79\x61\x13\xc8\x9c\x51\x77\x03\x66\x82\x9d\x39\x37\x52\x49\xe8\x99\x0b\x4e\x42\x5a\x80\xc8\x05\xbf\x55\x5c\xb0\x30\xe4\xc8\x68\x07\x0b\xdb\xb2\x82\x5f\x71\x2e\xd8\x11\xc2\xa2\x6e\xc2\x04\x9c\x2b\xfe\x87\x36\x61\xe4\xd0\x54\x29\x14\xf3\xad\xe7\x07\xba\x1b\xda\x0d\x43\xf7\x02\x69\x85\xc2\x27\x60\x03\x34\x04\xd8\x87\xda\xb6\x9a\x37\xa2\x11\x82\x06\xd2\x7e\x3d\x60\x58\xc0\x01\x72\xc0\x4c\xa1\x85\x92\xc2\xe7\xa3\x13\x56\x12\x61\x0f\xea\x0f\x72\xda\x4e\x32\x85\xce\xf9\xa2\xf1\x1e\xb0\x52\x51\x3f\xca\x08\x9c\x08\x0b\x12\x2b\x32\x8d\x23\x94\x59\x61\x23\xc7\xaf\x82\x27\x02\x89\xf1\x93\xdf\x81\x4a\x24\x94\x11\x55\xe2\x82\x03\x58\xa7\x01\xac\x93\x41\xed\xf8\x79\xf4\x80\x68\x51\x3b\x0f\x59\x9d\x5b\x4d\xf8\x7e\x68\x06\xc3\x07\x37\xe2\x23\xff\x39\x3c\x61\xe3\xb4\x0d\x07\xea\xef\x87\xfd\x2f\xd0\x47\xa7\xf3\x8b\x6f\x6c\x5a\x01\x7f\x6f\x9b\x10\x9b\xda\x80\x89\x42\x79\x88\x0e\xe8\xf8\x5a\x18\x6b\x67\x60\x33\xc1\x85\x71\xe4\xdc\x03\xce\xda\x02\xfd\xaa\xba\x70\xe3\xfc\xc9\x8a\x5f\x7f\x7f\xba\xb0\x13\xa4\xd9\x8d\x51\x83\x08\x06\x5f\xd5\x2e\x98\x09\xd2\x34\xc6\xe0\x3c\xeb\x84\x34\x91\x31\xf8\x4c\x6a\x89\xf1\x71\xe2\x80\xed\x3f\x18\x7e\x65\xbb\x71\x63\x1c\x3f\xc1\x55\x8a\x59\x28\x3f\xc3\x81\x68\x62\xd1\x83\x15\xd1\x0b\x6a\x35\xf2\x54\x2f\xb2\xda\xe2\x2f\x61\x5b\x8c\x3f\x34\xfe\x21\x72\xd1\xc7\x68\x9f\x29\x31\xd9\x98\x70\xd6\xb1\x2f\x39\x8d\xc3\x03\x47\x8c\x8f\xae\xd9\x09\x25\x94\x7a\x4d\xfa\x15\x63\x18\x08\xee\xe9\xfb\xc3\x38\x50\xbd\xaf\xa6\x5f\xb8\xb0\x0b\xed\xfd\x47\x71\x09\xe7\x1c\xc2\x25\x9c\x6d\xd0\x4b\x38\x1b\x15\x97\x70\xb7\xb3\x81\xd2\x94\x72\x0e\x00\x01\xa5\x28\x25\x7f\x8e\xe2\x38\x67\xc7\xb9\xab\x80\x77\x73\xf2\x51\xb8\x98\x5d\xf4\xd0\x59\x6f\x16\x58\xaf\x16\x83\x54\x21\xd6\x52\x7e\x6e\xa8\xb8\xfe\xe8\xa0\x40\xc3\x9e\x93\xb9\xb1\x65\xee\x85\x99\x27\xad\x21\xf3\x34\x75\xe0\xc8\xdc\xe0\x9b\xdd\x85\x71\x7a\x39\x31\xf6\xab\x7c\xe6\x9b\x4f\x38\xe3\xfc\xc1\xff\xb5\x70\xe4\xfc\xb9\xe4\x65\x2f\x66\x1f\x44\xdb\x57\xc0\x13\x3e\x21\xe5\x55\x7e\xb1\x11\xc4\x29\x49\x28\x3f\x2a\x58\xf9\x65\x8e\x8c\x63\xdf\xc8\x28\x0a\xa6\x86\x3e\x70\x60\x8c\x33\x81\x21\x99\x31\x7b\x13\xd9\x2c\x7d\x82\xb1\x9a\xea\xcf\x08\x51\xaf\x3b\x2f\xe3\x5f\x88\x6b\x89\x50\xb9\xc3\x57\x1e\x26\x2b\xb7\x96\xca\x93\xcb\x50\xcf\x2f\xc9\x90\x73\x65\x8c\x73\x9a\x50\xa5\x21\x57\xb1\x4d\x95\xe7\x94\x03\x67\x2c\x67\xfb\x25\x42\x58\xd9\x0d\xd7\x11\xa1\xfd\x28\xa3\xf6\x8b\x1c\x4a\xd9\xb4\x1f\x2e\xf0\x07\x27\x34\xc0\xc7\x39\x7a\x23\x37\x66\xc5\x23\x53\x93\x1e\xd4\x70\xa7\x6b\x61\x6a\xb2\x8d\xe7\xcd\xa1\xf1\xf2\x6d\x62\x2e\x09\xb9\x2e\xe6\xe7\x33\x91\x71\x67\x7e\x46\xeb\xfc\x26\x23\xdb\xc0\x28\x6f\xff\x05\x46\x8d\x20\x8d\x63\xfd\x47\x83\x6a\xff\x25\x4e\x4b\x5d\xb5\xf3\xa0\xd7\xb9\x43\x75\x5a\x96\xcd\x0f\x70\x38\x94\x23\xe3\xec\x72\x08\xf9\x89\xd0\x41\x70\x54\x1c\x19\xa7\x24\xb8\x0a\xee\x8c\x33\x97\x6c\xbb\xf5\x01\x37\x2a\x0c\x57\x41\x3f\xbd\xdd\x2e\x16\x79\x2c\x99\x60\xe1\x87\xbd\xc2\xbe\x1f\xce\x18\xed\xfb\xa1\x09\x1d\x30\x71\x68\x23\x88\x86\x69\x40\xe6\xdc\x12\xb1\x6a\x9d\xf3\xfd\x0a\x05\xd3\x52\x69\xe2\x26\xe1\x2c\x28\x4d\x6d\x27\x16\x7e\x51\xf8\xe1\x24\x6b\x86\x0b\xff\x28\xfc\xc9\xd9\xf4\x1d\x97\x04\x60\x13\x0c\xf5\x68\x01\xad\xaa\x85\x1f\x6a\x35\xe0\xc2\x73\x9e\xc2\x63\xc8\xa1\xb8\x30\x19\x30\x12\xc4\x5e\x33\x20\x47\x2e\xcc\xaa\xb1\x4c\x38\x6b\x9d\xa7\x21\x39\x71\xc0\xe5\xdb\x3c\xd2\x97\xb9\x80\x1f\x16\x98\x67\xf7\x03\xa2\x7a\x8d\x4c\xaa\x19\x4b\x66\xbf\xca\xac\x1a\xb3\x42\xa3\x69\x3c\xbb\x1f\xb0\x53\x68\x10\xb1\x76\x3b\xb9\x82\x04\x91\x24\xe0\x68\x6c\x76\xd6\xe8\x0f\xe3\x7a\xcd\xee\xd6\x63\xb8\xb8\x4b\xb9\x13\xe7\x47\x93\x8e\x89\x33\xe3\x8c\xf1\x23\x7a\x28\x2c\xfe\xd0\x98\x58\xdc\x33\xea\x1b\x97\xfa\x5f\x64\xd7\x6a\xfc\xa1\xd3\xc8\x78\x65\x30\x60\xa3\x50\x1a\x69\xec\xba\x18\x1f\x8e\x2c\x58\x20\x43\x11\xd9\xbd\x35\x70\x53\x5c\x38\x7e\x97\x70\x95\xa7\xb3\x3c\xf6\x25\x12\x9f\x5c\xe5\x13\x37\x09\xe7\xfc\x0c\xcd\xf0\xc0\x38\xd7\xb4\xb4\x7f\x1a\x7c\xb0\x11\xc4\xc9\x9f\xa5\x5f\xe2\xc8\x68\xd1\x2d\xd1\xe0\x64\x09\xb6\x81\x27\xc4\x39\xac\x25\x9a\x1f\x8c\xd0\xce\xa1\xcc\x99\x2c\x6a\xa2\x55\xaf\x25\xa9\xb2\xc4\x23\xd9\xc4\xcc\x8d\x7b\x46\x92\x0e\x7e\x9d\xbf\x2f\x3c\x73\x49\x68\x5e\x3a\xb1\x84\x53\x95\x27\x9e\x5c\x0c\xa5\x7e\x2c\xaf\x05\xce\x8f\x5c\x4d\x13\x4b\x7a\xb2\x76\xbc\x56\x99\x9f\x7d\x51\x28\x75\xc3\x0c\xc7\xfa\x26\x2c\x89\xd2\xa2\x53\x6f\x9e\x2f\xfa\x08\xf7\x2e\xc1\xcc\x9b\x4d\x4c\x96\xa1\x28\x9f\xa1\xe7\x61\xea\xe5\x63\xdd\xc3\xd2\xd0\x2c\x6d\x7e\x85\x96\x04\x4b\xfe\x55\xd2\x37\x16\x9f\xfb\x66\x5e\x3b\x86\xa4\x48\x89\x2f\xe5\xdd\x15\x17\x1f\xbe\x1b\xfe\x0b\x9b\xc4\x8f\x45\x30\xeb\x83\xa6\x2c\x56\x7e\x81\x21\x8d\x80\x67\xa9\x75\x21\xf5\xeb\x42\xde\xfb\x01\x33\x85\x16\x4e\x5b\x24\xb4\x11\xa4\x41\xa9\xfc\x1a\xa7\x6d\x2c\x15\x99\xab\x79\x78\x93\xe4\xe0\xbe\x57\x64\xb6\x53\x78\x22\x6f\x85\x67\x95\x13\x47\xc1\x8d\xf9\x67\x89\x9f\x25\x9c\xfa\x6a\x91\x51\xad\xb0\x5f\x68\x62\x91\xb7\xb1\xbc\x26\xe5\xb3\xc0\xfc\xc8\x09\x31\xb0\x99\x60\x0d\x67\xdd\xf3\xec\xaa\xd2\xa8\x58\x79\xc6\x54\xc9\xeb\x68\xe7\xaf\xd5\x0b\x8d\x23\x93\xd1\x3b\x07\xf9\x6e\x68\xe2\xb4\xd4\xf1\x2a\xb9\x28\xad\x72\xf3\xa9\xb4\x73\x6d\x95\x9c\xc4\x53\x66\x13\xdc\x19\x93\xb5\xab\x62\x9d\xcf\xa5\x1a\x0c\xd7\x62\x4a\x39\x25\x3f\x5a\x27\xcc\xff\xec\x98\x9f\x51\xbf\x6a\xac\xe3\xc6\x7d\xb2\xed\x3e\x19\x2f\x8c\x14\x4a\x8d\xb7\xf1\x70\xd9\x7e\x99\x43\x69\xf4\x6c\x3c\x3e\x36\x9e\xd3\x34\xee\x93\x8d\xc7\xc7\xc6\x2a\x6f\x32\x20\xca\x6a\x78\x62\x4e\xce\xdb\xcd\x4d\x06\xc4\x36\x8f\x43\x11\xae\x12\x1f\xf7\x73\x66\x78\x23\x2d\x71\x27\x6a\xec\xf0\x98\x1f\x00\xb2\x3c\x3c\x87\x69\x78\x9c\x2b\xfe\xac\xd3\x12\xa3\xe3\xbe\xa8\xf5\x1f\xf5\xbf\xce\xd3\x9b\x4e\x5e\x1d\xe3\xc5\xb6\xf1\x4e\x8d\x75\x9e\x65\xf2\xce\xcd\x80\x2c\x53\xe5\xd0\xc6\x69\x69\xc6\xd2\x69\xa7\xc4\xba\x0c\xb3\x9d\xfd\xf4\xd6\xa5\x3e\x3b\x9e\x24\x5e\x18\x4f\x92\x4c\xcc\x05\x09\x92\x7d\x68\xa2\xcf\x8f\xe5\x61\xa3\xd6\x65\x42\xd2\xd9\xff\x38\x70\x0c\x82\x93\x60\xa9\x85\xc4\xd5\x60\x38\xee\xc7\x73\x8e\xf2\x42\xec\xf0\x03\x76\x82\x68\x9b\x22\x1f\xa3\x19\xb0\x12\xa4\xa9\xe1\xfc\xa9\x52\x71\x64\x8c\x4e\xae\xf8\xb1\x13\x63\x4a\x2a\xe1\x55\xd2\x57\x96\x3d\xa8\x3c\xb8\x88\x1a\x65\x0b\x1c\x4e\xdb\x8c\x13\x73\x7e\x86\x7e\xaf\x89\x45\x57\xb8\x5a\x99\x98\xe5\x37\xf4\xdf\xc6\x40\x1d\x62\xc0\xcc\xb0\x13\xc4\x95\xc4\x80\x89\x58\x15\x8e\x8c\xcb\xa4\x18\x68\x52\x31\x20\x67\x84\x93\x8a\x18\x78\x9e\x39\x71\x16\xdc\x28\x6b\x5a\xd1\xcf\xb3\xdd\x9c\x39\xf9\xd3\x27\x96\xfc\x5a\x10\xac\xe1\x5c\x36\xfb\x98\x1f\xd7\x5a\x38\xb5\x16\x1f\xce\x12\x2e\xfc\x12\x97\x87\xce\x15\xcd\x5f\x37\x09\x52\x6c\xa3\x71\x25\x1a\x4d\xfd\xa2\xd1\xde\x54\x34\x78\x77\x6e\xc2\xca\x69\x49\x0f\x46\xcb\xb0\x68\x64\xc5\xe6\x7f\xa2\x26\x58\xc2\xe3\x27\x98\x4b\x45\xa3\x50\x34\xe9\x7b\xc6\xcb\xaa\x68\xbc\x92\x8e\xc6\xa3\xc8\xd4\x12\x8b\x6b\x9f\x68\x11\xad\x6a\x8c\xe8\x26\x8f\x11\x1d\xe3\xf3\xfb\x4e\x60\x15\xe9\x50\x4d\x8c\x3f\xdc\xd6\xbc\x37\x03\x2e\x2c\xcc\x18\x87\x91\x28\xc7\xa6\x22\x2d\x9a\x23\x7b\x36\x06\xec\x2c\x15\x8e\x1b\x53\x4a\x13\x2c\xf1\x23\xcb\x4d\xe3\xfc\xc0\xb9\x08\x6e\x54\x6c\xee\x37\x91\xc7\xf5\xa1\x07\x91\x87\xfb\x45\xe4\xc9\xf3\xc4\x45\xd4\x9a\x18\x8b\x62\x69\xd3\x36\xca\x26\xed\xc0\x49\x30\x4e\xed\x62\xa2\x1a\x4e\x5c\x89\xec\xea\x88\x89\x36\x7c\x46\x52\xd4\x44\xa2\xa9\x5c\x4c\xb4\xf8\x8a\xec\xf9\x18\x90\x59\x51\x1f\x4b\x3f\x6a\xc5\x49\x6c\x5d\x5a\x57\xa2\x10\x27\x66\x1e\xa4\x8c\xe4\xbc\x88\xb2\x7b\x1c\xc5\x79\x11\x93\xd8\xb6\xbb\xcb\x1a\xae\x5a\xd0\xff\x34\x70\x64\x7e\xb4\xc9\x36\xb1\x86\x67\xc6\x38\x6a\xe4\x8c\x75\x92\x33\x6e\x4e\xcd\x0f\x23\x28\x10\x35\x91\xe5\x9c\x5b\xa6\x6d\x95\x9c\xd7\x4b\x34\x90\xf6\xe3\x6c\x83\xf2\xc6\x55\xc9\x0c\x2f\x2c\x4a\x90\x70\x74\xf3\xce\xff\x31\x24\x7e\x67\xe9\xc8\x38\x65\x39\x8e\x36\x0b\x13\xa4\x70\xa8\x98\x82\x1b\x4c\xb9\xfc\x24\xcc\x08\x4a\x4a\xf4\xb9\x8c\xa4\x1f\xc3\x42\x90\x4e\xfd\xf1\xee\x6e\x2e\x34\x58\xe4\x42\xc3\xc1\x80\x46\x90\x4e\xe3\x15\x51\x78\xe1\x99\x57\x96\x85\xfa\xc4\x9d\x71\x65\xf6\x34\xb3\xca\xf3\xe9\x59\xc1\x1a\x5e\x19\x77\x13\xcc\x7a\xb2\x8f\xe5\x31\x91\xdf\x3e\x56\x06\x2d\xfc\x27\x66\xcd\xd2\x42\x7f\x62\x96\x87\xfa\x55\x96\xcd\xeb\x81\xb3\x84\xa3\x09\xca\xf5\x57\x08\x65\x42\x38\x7a\x65\xde\xda\xce\xbc\xb5\x9d\x2b\xb7\x88\x4a\x4e\xdd\x5c\x69\x4d\x94\xd9\x31\x90\x2b\x1d\x56\x1a\xa1\xcc\x19\x07\xb3\x5c\x69\x4d\x34\x84\xfc\x82\xe0\x2e\x85\xe0\xe4\xe4\x95\xcd\xe2\x07\xc8\xe2\x07\x98\x98\x65\xa5\xa9\x74\xae\x7c\x50\x73\xfe\x87\x52\x58\x6b\x89\xe5\xa1\x45\xcb\xc4\x20\x5f\x31\x5c\xa4\x16\xd9\x7d\x99\xb8\x33\x2e\x12\x8e\x53\xe0\xf9\x4a\x37\xb8\xf9\x07\xc6\x25\x56\x91\xdd\x96\x77\x25\x6a\xc5\xaf\xf7\x8a\x1d\xe0\x46\xd8\x42\x61\xfc\x81\x9f\xb8\x16\xe1\x57\xf9\x40\x5c\x6d\x70\xd3\x6c\x42\x3a\xc1\x52\x3b\x9e\xb5\x69\x1f\x1e\x6b\x6e\x78\x8b\x6e\x42\xac\xd6\x79\xe9\xa4\x33\xc6\x0b\x0c\x8d\xee\x37\x6e\x0c\x82\xb5\xf0\x83\xb3\xe6\x2d\xc0\x0d\xbd\x09\xb7\x27\x7d\x47\x36\xdc\xad\x6c\x46\x07\x61\x1a\xdd\xf8\x9d\x98\x1a\x4c\x83\x8b\x55\x71\x3d\x3a\x4a\x30\x23\xaf\x28\x69\x0b\x5c\x37\x9e\xf0\x5e\x7b\x99\x88\xa6\x31\xad\x70\xcb\x6b\x0d\x2e\x2e\x4e\x48\x67\x25\x5b\xc3\x4b\x35\xf1\xb7\xef\xd4\x9c\xd0\x2e\xb1\x3b\x9f\x8c\x6c\xb2\xcd\xdf\x3f\x4c\xdd\xbf\x1f\x34\x92\xfe\x71\x4e\xfd\x5e\x18\x5a\x05\x99\x2f\xfd\x41\x70\x80\x0b\xd2\x71\xbf\x98\x07\xdc\x8c\x72\x32\xb4\x6f\xdd\xf0\x66\x5d\x9c\x6f\xc4\x01\x82\x2b\xe4\x71\x8e\x42\xc0\x35\x8b\x4c\x19\x6e\x1f\xc6\xfd\x62\x16\x64\x9b\xf1\x9e\x69\xdc\x4f\x6c\x41\xfc\xfe\xc3\x6e\xd3\x3b\xe9\xe3\x9c\xf4\x88\x37\x10\x4f\x9c\x7d\xdc\xf0\x07\xe6\x93\xb9\x1f\xad\xdc\x26\x8e\x8c\x51\xf4\x81\x95\x3f\x5c\x37\x5d\xb7\x70\x39\xc3\x8c\x17\xba\x16\x81\x39\x56\x49\x50\x51\xcd\x03\xbe\x1b\xb4\x0b\x63\x23\x9f\x18\x8f\x04\x85\x8f\x6b\x6d\x10\x68\x03\x65\x12\xe8\x38\x72\x10\x07\x47\x08\x89\x0b\x19\x12\x77\xe1\x10\xa4\xb9\x0f\x82\xf1\x59\xde\x86\xb7\xa8\xe3\x9c\xd8\xd1\x59\xd0\xc0\xa7\xb9\x27\x96\x70\x3e\x0c\xdc\xc9\x7b\x31\x30\xbc\x59\x70\x08\xd0\x8a\x26\x21\x14\x66\xc1\x87\x40\x27\x81\xce\xea\x46\x6e\x1d\xc6\xbd\x3e\x58\xe3\x8b\x5e\x03\x50\x65\x4e\xaf\x2a\xdc\x47\x9a\x77\xe2\xb1\x76\xa3\x31\xc7\x37\xb5\x37\x20\xe0\x01\xbc\x28\xed\x25\x66\xbc\xda\x19\xd7\x55\x72\xac\xbd\xa4\x27\x82\x93\x71\x7f\x9a\xeb\x51\x84\x8d\x55\x7d\x2e\x6d\xdf\x0c\x1a\x5a\xca\x19\x1d\x05\x4c\x9d\x4f\x05\x67\x23\x3b\x3b\xef\x53\x53\x0f\xc8\x64\xc4\xc3\x9d\x25\x9e\x0c\xe6\x85\x69\xd0\x61\x91\xe1\x29\x14\xb1\x81\x61\x7e\xa8\x33\x56\x07\xf7\x64\xeb\xa6\xdc\x6f\x38\xe2\x1a\xfa\x21\xdb\x12\x78\xe3\x72\x11\xa2\x10\x38\x9b\x22\x7d\xab\xd4\x1f\x1d\x97\x2a\x8d\x14\x5b\x8f\xb1\x39\x67\x06\x59\x82\x1a\xe4\xf4\xd5\x7a\x69\x1e\x34\x5f\x69\x31\x3d\xef\x01\x33\x44\xdb\x3e\xa6\xea\xac\xe8\xca\x23\xde\xdc\x74\x03\x3d\xd7\x2c\x8a\x7f\x13\x96\xad\xb2\x36\x7f\xe0\x9f\xcf\x13\x1a\x51\x72\x7b\x14\x31\x16\xad\x8a\x92\xce\x58\x18\x2f\x2c\x0c\x43\xa0\x33\x8d\x5d\xb4\xde\xba\x74\x91\xfe\x71\xb9\xe7\xe3\xb9\x74\x0a\x8e\x8e\x47\xdd\x0b\xab\xf1\xc2\xcc\xa1\x95\x61\x94\xd8\x26\xbc\xc9\x1e\x8f\x11\x95\x8f\xc8\x05\x9e\x2c\xdd\x0b\xaf\xbb\x38\x3d\x4a\x6f\xec\x99\x6b\xa9\x17\x3a\x33\xfd\x06\xd3\x7a\xc3\xe9\x30\xca\x17\x64\xc7\xfd\x13\xd7\xea\x98\xa2\x1b\xce\x37\xec\xe3\x2c\x47\x2b\x0c\x14\xde\x50\x04\xfb\x2a\x8b\x60\x32\x28\x8d\xf8\x68\xd5\x26\xbf\x69\xc4\x76\xdf\x3b\x84\x5a\x88\x32\xff\x63\x66\x52\x7d\xff\x3c\x3d\x4a\x03\x4a\x67\xed\xd9\x79\xd6\xea\xbb\xb0\x11\xce\x7c\x81\xd6\xde\xc1\xe0\x73\xc2\x2b\xf2\x06\xef\x39\x23\xf5\x5d\x48\x7b\xcb\xd3\xb7\x32\xcf\x40\x9c\x1e\xf0\x48\xbb\x53\xa4\xfd\xc7\xe6\x49\x35\x0f\xe5\x3c\xc7\xc4\xba\x8a\x10\xde\x4d\xa3\x32\xed\xf5\x3b\x82\xba\xf0\x73\x30\x6d\x7c\x5b\xe5\xfc\xc6\x64\xf4\xfe\x7d\xfb\x7c\x3e\x29\xfe\x9c\xd5\x6d\xad\xb9\xe6\x4b\x55\xe1\xdf\x58\x50\xbf\x55\xd2\x04\x87\xd1\x04\xa7\xbb\x4f\x50\x30\x24\x63\x9a\x3b\xa8\x85\xef\xf7\x46\x8f\x09\xa6\x97\x61\x96\xfc\xc0\x75\x05\xe2\xa0\xf5\x50\xd3\x8d\x9a\x29\xea\x7e\xf2\x68\xc2\xab\xb1\x89\x9e\x29\x0b\xdf\x0f\x26\x3d\xf3\xca\xd3\x7d\xcd\x68\xa0\xf4\x1a\xf3\x80\x38\xf9\x5c\xf8\xdb\x4d\x77\x45\x7e\x4d\x79\xe3\xbb\xe0\x98\x73\x27\x98\x9a\x6f\x6c\xa0\xa3\x80\x43\xc0\xc6\x19\xe2\xef\x57\x99\xc6\xb4\x78\xa0\x40\x28\xbe\x5e\x12\xd6\xbc\x60\x5f\x84\x1f\x81\xed\x3e\xd8\x34\x50\xc1\x12\x5a\x46\x36\x19\xee\x32\x6d\x98\x5e\xbd\x65\xbb\x36\x7e\xa0\x20\x29\xdf\x35\xb5\x83\xd3\x13\x3e\xdb\x6e\xa6\xe1\xc0\xdb\x97\x16\xbc\x17\xfb\x77\xbe\x8d\xd2\x8e\xc9\xcd\xf9\xc5\x11\x29\xe9\x36\x87\x45\x39\xaf\xd6\x01\x65\x3f\x5b\xf7\x28\xeb\xdd\xba\x8d\x4d\xf2\xc1\x0a\x9b\xf8\xa3\xf2\xcf\x5c\x63\xb8\xed\xec\x50\x4c\x25\x8b\xd9\xc5\x29\x22\xeb\x79\xfc\xfb\x50\x22\x6b\x2f\x26\xc9\x99\xd5\xf7\x06\x82\x8d\xb1\xe9\x64\x83\xe1\x77\xe0\x48\x35\x17\xa9\x55\x67\x78\xe8\x6a\xc1\x77\xfb\x65\xe3\xbb\x5b\x7d\x30\xca\x16\xcf\x01\xe6\x8c\xf8\x3c\xe6\x0a\x94\xfd\xde\xeb\xa3\x9c\x47\x07\x21\x0e\xd4\xdf\xa6\xec\x07\x5b\x81\x92\x9f\x26\x37\x9f\x94\x94\xb2\x9f\xea\x38\x94\x7a\x9e\x50\x7d\x84\x46\xc2\xd4\x1f\xb3\x80\x7e\x7c\x18\xec\xc7\x36\x1e\x65\x3f\x5b\xfe\x08\x91\x85\xaf\xe7\xe1\xf2\x75\x2b\xf3\x4d\x3d\x86\xd5\x69\xd8\x7b\x2a\xf5\xc9\xfd\x00\xcc\xee\xaf\xb5\xbf\x01\x71\xc1\x37\x4a\x85\xef\xf8\x3c\x76\xd2\x56\xc5\x42\xb5\xcc\x2d\x61\x80\x3b\xe7\x0f\x61\x9e\x6f\x8e\x67\x5c\xbc\xf8\xbc\x96\x7f\x09\xe7\xe9\xfb\x10\x7e\xe9\xfb\x4d\x5f\x30\xe3\x3d\x6b\x0b\x6b\x0a\xb3\x5f\x7f\x0c\x73\xd3\xd8\xe2\xfb\xb4\x0d\x29\x4d\x29\x2f\xd3\x4b\x29\x4a\xb1\xcf\x51\x1c\xe7\xe8\x52\x25\x97\xaa\xba\x38\xcd\xc5\x69\x8e\x73\x73\x32\x77\x27\x73\x57\xce\xe7\x91\x57\xa4\x28\x67\x73\xda\x30\xa7\x0d\x73\xda\x30\xa7\x0d\x73\xda\xb8\x56\xf3\x51\x9c\x7e\xec\xe9\x67\x7a\x1d\xee\x1a\xf0\x10\xde\x78\x19\xde\x7b\x9e\x75\xc2\xfe\x5e\x03\x0c\xeb\x50\xc0\x19\x78\xd6\x74\xfe\x36\xce\xb0\xfc\xc3\xa7\xd1\x07\x70\x70\xcc\xb6\xb9\x09\xf7\x3d\x98\x7d\xc7\x77\x3d\xd8\x68\x80\xf7\x35\xc8\x87\xf7\x2c\xe0\xe0\xfd\x02\xcf\xc5\xeb\xa5\xc3\x73\x65\xf8\x18\xd9\xcb\xfd\x2a\x6c\xe3\xc6\xf8\xcc\x22\x1f\xe1\x55\xcc\x21\x7c\x4a\x78\x49\xce\x98\xb9\x94\x35\x8d\xf6\xeb\x1c\xd3\xd3\xf9\x94\x37\x0f\xd7\x82\xba\x62\x25\x75\xc5\x82\x76\x69\x11\x9e\x65\x58\xf8\x4d\x02\x76\x82\x02\x35\x19\xcb\x9b\x92\x2d\x78\x07\xe8\x05\xcf\xb4\xf0\x95\xe4\xd8\xbc\x47\x78\xca\xe6\x41\x6a\x13\xee\x6a\xea\xc6\x68\x12\xe3\x4e\x36\x27\x21\xbd\xe3\xcc\x1b\x5f\x9f\xc6\xc6\x1f\x94\x28\x47\xd2\x08\x0f\x73\x9b\x50\x28\x81\xc1\x16\xf8\x25\x54\x68\x04\x39\xbf\xa1\x71\x65\xc8\x4a\xce\x89\x95\x9c\xf3\xdb\xf0\x3f\x58\xf8\x35\xec\x3e\x39\xc3\xd4\x66\xe1\xf7\xfa\xcf\x25\x90\xd6\xf2\x7b\xc0\xe6\x60\xc9\xe1\xb9\x96\x26\xa1\xc0\xf9\x99\x4b\xc0\xba\xcd\x05\x66\x5f\x0b\xbf\x19\xc0\xc2\x1f\x97\x51\x94\x90\xc1\x01\x76\x65\x10\x21\xdf\x31\xd0\xb0\x9f\x24\x84\x96\x9e\xb9\x69\x67\x6e\xda\x59\x9b\xf6\xdc\x6e\xbc\x0d\xc1\xee\xa0\x52\x27\x0a\x86\x1e\xba\xd0\x7e\xf0\x5a\x64\x68\x77\xbe\x9b\x17\x4a\xaf\x8f\xad\xb5\xe8\x11\x6b\x46\x3b\xd6\xaa\xad\xc3\x54\x30\x61\xbc\x94\x3d\xb5\x59\xf8\x7a\x1e\x0f\xbe\x6a\x59\xf8\x3a\x59\x16\x86\x41\x7f\xe1\x37\x3a\x4f\xfc\xda\xd6\xba\xbb\x79\x94\xb2\x33\xbf\xdd\x6b\xe3\x5b\xed\x57\xb8\xf3\x6e\x2b\x50\xf6\x53\xc8\x48\xd9\xbd\xf6\x15\x72\x3f\x10\x8b\x94\x6d\x08\xdb\x7d\xa2\x79\xf1\xe8\x05\xb5\x79\x2a\x62\xc5\xcc\xa4\xcd\x8e\xb7\x3c\x37\x7e\xed\x72\xe3\xf4\xd4\xd7\x1b\xb4\xca\xb6\x3f\x26\x78\xda\xea\x0d\xdb\x40\x9b\x57\x17\x9e\x7a\x7a\xc3\xbe\xdf\x70\x9c\xaa\x97\xf0\x66\x3a\xeb\x82\xaa\x3d\xeb\x7d\x08\xfb\x7f\xaa\x8b\xcb\x55\xe7\xc2\xdf\x69\x0c\xf3\x30\xd4\x2b\xcc\x7d\xe5\xba\x10\xf7\x3d\xed\x7f\x84\xab\xe7\x4d\xb0\x5b\x15\x87\xd0\x24\x46\x14\x09\x43\x54\xa6\xfb\x07\xbf\x47\x38\x33\xeb\x4b\x38\xd3\xdf\x47\x28\xc2\xb4\x24\x25\x7c\x92\xa4\x54\xc9\x76\x3f\x58\xfe\x08\x35\x48\x8c\xaa\xd9\x56\xd1\xee\xf9\xc2\x61\x13\xa0\x39\x0f\x98\x50\xbd\xf9\x3a\x24\x37\xac\xaf\x23\x2e\x08\x3d\x75\x13\xf6\x6f\x0f\x97\x60\x1f\x29\xb7\xbe\x39\x54\xbb\xdb\x27\xac\x88\xbd\x20\x7c\x5c\xb1\x83\x4e\x91\x8d\x9b\xd4\x1b\x61\x17\x6e\xaf\x41\xef\x2c\x8d\x5a\x54\xbd\xff\x3f\xa0\x0c\xa4\xea\x49\x69\x2a\x15\x2b\x7b\x52\x0c\x6b\x7d\x50\xf6\x1f\x25\x40\xd9\x8f\x06\x02\xe5\x8e\xe9\x87\xd4\x59\xcd\xef\xa9\x83\x4b\xb0\xd7\x67\x17\xa1\x70\x21\x61\x2d\x7a\x94\xf2\x89\x96\xb8\xab\x92\x49\x09\x1f\xd9\x6b\x58\xb2\xec\xf4\xf0\xac\xdf\xb4\xe7\xe5\x8d\x05\x6d\xed\x1e\xa1\xb5\xff\xd0\xdc\x8f\xc8\x54\xc2\x49\xb8\x5b\x54\x97\x60\xaf\xc8\x8b\x10\x34\x06\xf1\xa8\x68\x20\x67\x38\x96\xcf\x52\x61\x0d\x4c\x02\xea\x68\x70\x20\x63\x35\x08\x68\xac\xa6\xd3\xf9\x58\x97\x7e\x57\x84\x0b\x35\x46\xb4\x5c\x9d\x07\xd5\xdf\x84\x63\xc2\xe7\x4b\x59\x8f\x07\xdd\x3f\xb8\x2f\x3e\x53\xe6\x83\x77\x0f\x58\xf0\x3a\xf8\xfa\x1d\xc6\xa7\xaa\xfa\x9b\x37\xe4\x0d\x61\xe1\xda\x79\xb2\xbb\x07\xec\x53\x8d\x27\xa3\xf3\xdf\xf1\x23\xec\x67\xbe\x1f\x61\xbf\xf2\x0d\x84\xdd\x7d\x0e\xe1\xae\x67\x1e\xc1\x24\x86\x29\x8f\xe3\x66\xb8\x84\xe3\x65\xb8\x84\x24\x2a\xb9\x46\xf7\x11\x28\x97\xe9\x67\x43\x39\xc2\x77\xbe\xa8\x7f\x84\x40\x3c\xd6\x58\xf9\x32\x69\xa8\xd9\xf6\x26\xe7\xb7\x18\x89\xa2\x87\xef\x3c\x5a\xfa\x08\xd1\x24\xc6\xfe\x9a\x02\x08\x85\x64\x0a\x5a\x05\xe1\x7c\x39\x0b\x52\x1f\x8b\x71\x29\xc7\xf3\xd1\xcf\x8e\x5a\xe7\x6c\xca\x5d\xa4\x3d\x02\x35\xb9\x50\xa4\xcd\x2d\x33\x0a\x3c\x8d\x4b\x1f\xde\xcf\xb3\x40\xe1\x5a\x0b\x41\xab\x6d\xad\x5b\x28\xe3\xa0\x15\x67\xf7\x3f\xb8\x47\xe0\x7a\x5a\x53\xba\xc7\xf5\x79\xc3\x36\x4f\xa9\xb8\x37\x0f\xbe\x65\x53\x15\x96\x6b\x98\x6f\x16\xd5\x44\x88\x92\x44\x88\x68\x92\xc4\x48\xc9\xeb\x48\x3a\xa5\x90\x6c\x2d\xf9\x6c\x1b\x17\xed\x7e\x4e\xf7\x98\x06\x16\x6c\x2c\x57\x94\x50\x84\x60\x1a\x43\x34\x9c\xa5\xaf\x58\xfe\x45\xe5\x91\x94\x47\x52\x1e\x59\x05\x4b\xaa\x8f\xac\x4c\x45\xa7\xf9\x57\x3e\x2d\xad\x2b\xee\x99\x21\x5e\x82\xa8\x2c\xbf\x05\x3f\xe8\xcc\xe9\xc8\xa9\x20\xb8\xea\x0b\x4e\x09\xc1\x69\x21\x24\xd5\xdc\xf9\x8c\x08\x28\xfb\x57\x7f\xa0\x54\xc7\xa7\x3a\x3e\x8d\x95\xd3\xc5\xbc\x58\x17\xf3\x62\xe7\xdf\xde\x83\x1b\xb7\xfb\x7b\x07\x0c\xaa\xfd\x4c\x86\xa0\xa9\x34\xd1\xe6\x5d\xad\x3c\xc9\xba\x6b\x93\x3d\x89\x68\xd2\x18\xba\x56\xf5\x7d\xab\xf9\x11\x6a\x91\x24\x4d\xcb\xcb\x46\xcb\xfa\xfd\x4f\xf9\x51\xb8\x43\xc5\x4f\xda\x6d\xbc\x3f\xe8\x3e\x02\x2b\xad\xab\x61\xb3\xae\x86\xcd\xba\x1a\xb6\x49\x31\xc9\x88\x3b\x48\xd4\xe1\x21\xea\x68\x10\x3f\xe9\x20\x63\x3d\xaa\xd2\x17\xe5\x51\x68\x50\x8b\xdf\xf9\x3a\xf9\x11\xb8\x7f\x8c\x45\xef\xd7\x24\xcd\x5d\x17\x01\x45\xa5\x0f\x4e\x93\x21\xba\x54\x5c\x1f\x33\x95\xe7\xa3\x45\x08\xae\x94\xd2\x63\x66\x1c\xc7\xc7\xa9\x22\x54\x55\xdf\x5d\x01\x3d\x4a\x73\x79\x35\xc7\xa7\xa9\x4e\xaf\xcf\xf8\xc5\xe9\x2a\xe1\xf9\xf5\x05\x28\xa6\x32\x9b\x69\x5e\x77\xaf\x0a\x28\x5a\x0a\x73\x9a\x37\xa7\x55\x73\x5a\x35\x69\x5d\xc6\xc3\xd1\x9b\x52\xdf\xf0\xa0\x09\x78\xdc\x8d\x26\xc3\x44\xb4\x5f\xd4\x24\x22\xbc\x69\x2f\xb8\x3f\xe6\x3e\x42\xd3\x18\x4d\x63\xc8\x7c\xf8\x7e\xa2\x0b\x92\x7e\xca\xa4\xab\xec\xad\x09\x41\x9b\x8b\xdd\x3f\x82\x50\x25\x8d\xec\x89\xb9\x19\xb1\xb9\xee\x64\x6f\xab\x02\x28\x5a\xa6\xbb\x31\xf1\x94\xeb\xf8\x68\x93\x82\x2d\x56\xa0\x28\x67\x6d\x40\xe6\x66\xe0\xe7\x4f\x61\x88\xc1\x73\xd9\x18\x7f\x5c\xd3\xf1\x97\x84\x83\x34\x96\x28\xb3\xeb\x78\xbf\x05\x7e\x84\xac\x52\x48\xbf\x8f\x6a\xdd\xa2\x8c\x09\x31\xfe\x9a\x26\xe9\x2a\x47\xf8\x5c\x94\xa2\xb2\x7f\x9a\x4f\xf8\x5c\xf9\x5c\x01\x65\x3a\x10\xa3\x33\x77\xf1\xf9\x93\x80\x8f\xcb\x3d\x3b\xce\x29\x69\xaa\xa4\xca\x0b\xd9\xe5\x5e\x9c\xcc\xd5\xe5\xe5\xca\xae\x6d\x2a\x3a\x33\x15\xef\x8f\xd2\x48\xd1\xba\x53\xc3\xe5\x17\x7e\xf1\x3e\x37\x89\x14\xd6\x7c\xfa\x49\xd3\x4c\x3c\x1c\xcf\x3b\x67\x1a\x43\x9a\x4d\x92\x45\xd4\xbc\x5c\x46\xf2\xa7\xf3\xb9\xe6\x23\x68\x25\x24\x57\x09\xef\x9b\x67\xc8\x99\x16\x5a\x31\xb9\x5a\x49\x6e\xf0\x48\xf7\x2f\x32\xa0\x88\x9d\x49\x6f\x0b\x12\x28\x9f\xf2\xe9\x5a\x4c\xfb\x54\x9b\x16\xb4\x58\x5a\xbb\xc9\xd5\x6e\x72\xc3\x49\x72\x35\x97\xee\x57\xe8\xc8\xb9\x69\x1c\xa9\xdd\x2c\x76\x26\xff\x38\x85\x2e\x33\x06\xc1\x24\x46\xa0\xe9\x7e\xcc\x3a\x3a\x64\x1d\x1d\xb2\x8e\x0e\x59\x6b\x7f\xaf\x2a\x88\x49\x77\xd9\x34\x4d\x23\x5d\xbb\x3a\xb7\x05\xb8\x40\x2f\x85\x16\xef\xf7\x95\x08\xe0\xca\x93\xcd\x98\x9d\xef\x23\x3b\xe7\x47\x76\xde\x8f\xac\xab\xe8\xd8\x74\x68\x6e\xd2\xb1\xaa\xb3\xf9\xd5\xd5\x71\x75\x75\xdc\x74\x12\xdb\x54\xb9\xed\x27\xb3\xc2\xa6\xd3\xdc\xa6\xfd\xb5\x9d\x7f\x6b\x1f\xa1\x69\x2e\xd2\xab\xda\xaf\x29\xd3\xee\x08\x45\x08\xea\xaa\x6a\xce\xc8\x37\x37\x98\x37\x5d\x4d\xc6\xe6\xcc\x7e\x5b\xab\x49\x4e\xe5\xf4\x14\x92\x8f\xe3\xe4\x89\x2e\x95\xcc\x84\x9b\x9b\xb6\xb4\x39\x13\x96\x38\xbc\x76\x8c\xef\xff\x67\xa0\x74\xad\x05\x35\x27\xcd\x0d\x16\xcd\x19\xf9\xe6\x26\x17\xcd\xf9\xf7\x9a\x33\x39\xcd\x0d\x28\x5d\xbc\x16\xcb\xc1\xdb\x28\x89\xb4\xd7\x2e\x0e\x97\xd8\xd5\x9c\x74\x1d\xfa\xfb\xdd\x4f\xba\x04\x31\x16\xdd\x4d\x25\xbb\x6b\x25\xdd\xb5\x92\xbe\x3c\x0c\x4c\x89\x2e\x95\x93\x57\xfb\x71\x77\xed\xa6\xbb\x21\xa6\xbb\xf5\x52\x77\xab\xa3\xee\x5a\x49\x77\x6b\xa1\xee\x56\x3e\xdd\x0d\x5e\xdd\x4d\x5c\xfb\x3b\x63\x03\x14\xcd\x4b\x87\xb3\xae\xc3\x59\x52\xf7\x6c\x52\xf7\x6c\x52\xf7\x6c\x12\xf7\x6c\xec\xea\xbe\x88\x5d\xdd\x17\xe9\x93\xe5\x46\xfa\x64\xb9\x91\x3e\x19\x50\xd2\x27\x03\x4a\xfa\xc4\x55\x91\x3e\x99\xc9\x0e\x1e\x4d\x65\xe7\x59\x68\xfa\x74\xd2\x99\x3e\x1d\x85\x92\x5b\x51\x4f\x8a\x8b\xc3\xe6\x34\xed\x15\xb5\x68\xce\xe5\xde\x1c\xa5\xba\xbc\xd8\x8e\xa4\x4f\xa7\x98\xc9\xad\x8d\x93\x5b\x09\x27\xb7\xee\x4d\xdf\x1f\xd5\x22\x96\x25\xb9\xb5\x71\xfa\xee\x17\xd9\x48\xa1\xc1\x26\x05\x5e\xa6\xac\x7b\x49\x14\x2e\xa2\x04\x6d\x76\x41\x6c\x46\x0a\x32\x05\x49\x41\x86\xbc\x14\x7e\x4e\x8a\xa2\x31\x64\x88\x48\x41\x1c\x53\x29\x88\x5b\x26\x85\xbb\x7f\xb8\x09\xc6\x93\xa7\xe4\x3c\xee\xc9\x79\xdc\x93\xf3\xb8\xa7\xa0\xa3\x4c\xb2\x1f\xab\xc4\x54\x47\xba\xee\x4f\xe7\x6b\x72\x48\xd1\x24\x85\xd4\x94\x89\x6b\x32\x99\x8c\xf9\x83\xa0\xb9\x36\x95\x53\xfa\xa1\x2e\xe1\x93\xb9\x5e\xe6\x1c\x01\xc9\x5c\x2f\x73\x0b\xf6\x79\xab\xca\xa5\x32\x95\x46\x46\xb5\x64\x3a\xff\x4b\xa6\xf3\xbf\x14\x55\x53\xba\x4c\x9e\xbf\x7d\x12\xdb\xc8\x56\x6e\x56\x8f\x53\x7f\x52\x26\x3c\xd6\x0c\x42\x11\x02\xfb\x90\x52\x94\x91\x70\xc4\xe0\x3e\x1a\x7f\x2e\x17\x55\xae\x5b\x49\xa7\xe8\x4c\x98\x5b\x25\x27\xb7\xab\x98\xa2\x33\x58\x6e\xc7\x2f\xb9\xf5\x6e\x8a\xf7\x3f\x76\xa4\x68\xb9\xd5\x18\xb9\xdd\xc6\x14\x75\xba\x94\xa2\x0e\x7b\xc9\xad\x77\x53\xd4\xb9\xf1\xac\x5b\xcd\x4b\xd6\x51\x29\xaa\x5b\x2e\x45\x67\xe6\x74\xef\x29\xe9\xde\xd3\xda\xba\x2e\x94\xa2\x48\x0a\x19\xe5\x92\xf6\x2e\xdd\xf2\x49\x59\x5b\x66\xd6\x96\x99\xa5\x65\xba\xfd\xac\xa4\xfb\x59\x23\xdb\x26\x3c\xc4\x37\x96\xb2\xae\x8f\x53\xd6\xf5\x71\xca\xea\x87\x4b\x59\x96\xe2\x29\xcb\x76\x5c\x6a\xef\x38\xee\xa3\xb0\x2f\x26\xb5\xfb\x79\xf7\xa3\xdc\x43\x2b\x8f\x22\x9d\xba\xf1\xa2\x2e\xc9\xb6\x4a\x72\x8b\xba\x59\x6a\xaa\xe3\x5e\xc4\xe8\xf6\x22\xda\x5e\x27\xb1\x9e\x1c\xef\x7c\xe0\xc2\xef\x92\xcc\xc6\xb2\x41\x9b\x3f\x91\xaa\xf3\xd2\x33\x35\x9d\x3d\xcd\xcb\x66\x22\x13\xb7\xf9\x41\x28\x42\x60\xb3\xd2\x8b\xf6\x93\x5e\xd4\x70\xf6\xa2\x3d\xb0\x17\xd5\x71\x2f\x52\xbf\x23\x27\x32\x4f\x83\x40\xed\x75\x9e\xc9\x54\x15\x7f\x92\xc4\xd8\x38\xf5\x2a\xdb\x27\xbd\x4a\x33\xea\x55\x1c\x10\x83\x60\x92\x44\x8c\xce\x4c\xf3\xb9\x44\x4d\xf8\x72\x57\xe9\x55\x26\x04\xbd\xca\xd6\x6f\xaf\x3a\x89\x9b\x14\xd6\x6d\xd5\xa9\xf7\xa4\x7c\xca\xa7\x2b\x67\x59\xfa\xf5\xaa\xbd\xa7\x57\xed\x3d\x93\xa2\xaa\xd0\xda\x77\x1e\x84\x5e\x75\x3b\x63\x52\x9c\xcc\xc9\xc9\xc3\x76\xb3\x37\x9e\xc6\xcc\x23\xba\x98\x4f\x13\x0f\x75\x6f\xec\x7c\x1a\x11\x8a\x44\x60\x0b\x38\x08\xca\x33\x2a\xcf\xa8\x3c\x44\x70\xf5\x8f\x74\x75\x87\xf4\x26\xbb\x7e\xbd\x89\x83\xb3\x37\xb1\xe6\xbd\x89\x35\xef\x4d\xe6\x4a\xbd\xe9\xf2\x73\x9d\xe6\x94\x44\xd2\x04\x9b\x58\xeb\xc9\x45\x65\x93\x6d\xc0\xee\x5c\x1d\xdd\xb9\x3a\x7a\xd3\x45\x4b\x6f\xba\x7d\x37\x29\xaa\xa9\xc0\x93\xc6\x41\xa9\xca\x47\x2c\x78\x77\x16\xbc\xb7\x77\xee\x71\x53\xba\x6a\xb8\xcb\xf4\xb4\x77\x71\x21\x77\xdd\x6c\xef\xb2\xd9\xde\x9d\x7f\xa2\x37\xf5\xa7\xf5\xee\xda\x75\xd7\x25\x4d\xef\xef\x1a\xd6\xa5\xa8\xbd\xec\xce\x5e\x76\x3d\x03\xf4\xc9\x6a\xb9\x77\x75\xa4\x8f\x28\xdc\xc7\xd7\x85\x0f\xa5\xf0\x40\x35\x29\x12\x27\x68\xa3\x98\x24\x36\xb5\x93\xc4\x13\xc1\xfd\x3e\x51\xe6\xfc\xf5\x70\x93\x3b\xdd\xf4\x7d\x62\xa3\x27\xeb\xe2\x65\xe2\xe6\xb4\xc4\xe4\xe3\x48\x83\xc4\xb6\x7b\x91\xcc\x49\xde\x7d\xac\xee\x63\xb1\x31\x5e\xa4\x8f\x63\x99\xec\x60\x0d\x8a\x1c\xbd\xfa\x4c\xce\x5e\x7d\x41\xc7\xea\x49\xe2\x35\xf5\x22\x49\x91\x75\x73\x75\x52\x9a\x52\x64\x12\xbf\x48\x49\xe5\xee\x45\x79\xab\x06\x4c\x3d\x65\x8b\x54\x1c\x49\x9b\x9d\x5b\x80\x2d\x92\x97\x2a\x7a\xf6\xd1\xb3\x4f\x9e\x57\x72\xd5\x10\xf4\xb0\x9e\xa9\x91\x5a\x24\xc7\x5e\xce\x02\x84\xcf\xd4\x05\x12\x3e\x73\x67\xe1\x3e\xd3\x31\x76\x91\x9c\x9a\xcd\xeb\x4b\x86\xd9\x49\xf2\x2a\x34\xaf\x42\xf3\x2a\x14\x73\x33\x49\x5a\x6c\xd9\x1e\x1e\x84\xa4\x04\xed\x6f\x51\x66\x52\x61\x3e\x09\xca\x2a\x88\x32\x97\x1a\x14\xb1\x08\x51\x67\x07\x93\x64\x49\x93\x89\x45\x0c\x5f\x54\x93\x38\x48\x3c\xbe\xcd\xec\x3e\xe5\x54\xcd\x4b\xf0\x87\x50\x52\xb8\xf4\x93\xf2\xa7\x9f\x8b\x61\x1a\x43\x3b\x47\xfa\x69\x5d\x27\x67\x91\x93\xef\x9d\xc9\xf7\xce\xe4\x7a\x67\x92\xf5\xfd\x7a\xad\x48\x29\x26\x06\xab\xea\x41\xd1\xaf\xea\x49\xd1\xaf\x3a\x7b\x55\xd5\x5e\xc9\x36\xd7\x7b\xb7\x96\x94\x21\x86\x3e\xa9\x5b\x60\x30\xee\xd2\xf0\xaa\xba\x7c\x66\xa4\xe6\x23\xfd\x41\x4a\x5a\x32\x67\xad\xaa\xb7\x56\x6e\x37\x63\x90\x5c\xeb\x73\x8b\xc4\x7d\xd1\x26\x33\x73\x2d\x72\xd5\x4d\xc5\x99\x5f\xe4\x32\x07\x77\x56\x64\x91\xd8\x26\x04\xe7\x7c\xda\x97\x75\x20\xbf\x20\x6b\xc2\xf7\x20\x20\x66\xaf\xa7\x9a\x87\x44\x4d\xb3\x97\x55\xc6\x22\x7d\x2a\x91\x6c\x16\x2d\x92\x93\x5b\x15\x1c\xcc\x8d\x6d\xeb\x6b\x53\xc7\x2b\x79\x21\x92\x17\x55\xcc\xdb\x7a\xf7\xc1\x91\x64\x6e\x12\x8a\x2a\x2f\x89\xf2\xde\x9d\xbf\x97\x44\x96\x3f\x93\xa4\xa5\x73\xcb\xdf\x10\x8a\x3f\x3a\x5d\xfc\xd9\xe9\xe2\x86\xb1\x50\xbc\x8a\x8b\xd7\x54\xf1\x9a\x2a\x5e\x53\xc5\x6b\xaa\xca\x40\x10\xaa\x0c\x04\xa1\x8a\x1d\x0c\x55\xad\x5c\xa8\x64\xe5\xe8\x1e\xc5\x8c\x80\x97\x25\x16\xe1\x63\xd7\x46\x70\x07\xba\x83\xef\x4b\xa1\xea\x3c\x31\xe8\x5a\x3e\x84\xaa\x06\x6f\x50\xae\x4a\xe7\x53\x69\x2f\x6b\x8b\x86\xb7\x29\xcc\xe8\xfa\x84\xe1\xcd\xc5\x95\x16\xcf\xc8\x4f\x0c\xdd\xcd\x62\xc1\xbb\x18\xf3\x55\xf9\xa7\x87\x95\x1a\xf4\x60\x11\xaf\xb9\x2e\xfc\x6e\x3a\xf5\x77\xcb\x66\xb3\x4b\x4f\xd4\xf9\x21\x5e\x80\x1b\x30\xf3\xc7\xbb\x77\x13\xc4\x3e\x7c\xa7\xab\x6e\x42\xa2\xf4\x19\xdf\x78\xbb\x84\x08\x04\xb8\x03\x64\xdf\xbb\x5d\x1a\x26\xb4\x73\x73\xb2\x5d\x48\xd9\x59\xb4\x57\x3a\xfb\xae\xe2\x87\x36\x06\xdc\xa5\xd9\x79\x0d\x78\x9f\x82\x3d\xf8\x5c\x10\xda\xd8\x34\x7e\xe3\xf0\x7b\x05\x60\xe3\x24\xe1\xf7\x92\xf3\xc6\x39\x72\xfc\xfb\xe4\xf3\xc1\x1f\xa7\xbf\xef\xc2\x1c\x9c\x55\xde\xa2\x84\x24\x12\xde\x5b\x0c\x87\x70\x1f\xfd\xd8\x84\xfb\x28\xc8\x91\xe1\x78\x04\x6f\x19\x45\xe8\xf7\xe2\xe9\xd5\x52\x51\xb5\x91\x9c\x11\xae\xc3\x6e\xc2\x07\xb5\x46\x8f\xcb\x1d\xc2\x7d\xbe\xed\x12\xa8\x60\xf8\x64\xf9\x21\x04\x47\x68\x4a\xa8\x42\x88\x14\xa3\xc2\x95\x37\xdb\x77\x82\xb1\xf9\xbc\xf7\x75\xe6\x67\x84\x58\xaa\x70\x5f\x14\xaa\x13\x51\xd4\x77\x45\x7a\x7e\x52\xf8\xba\x44\x98\x2f\x65\x9f\x2c\xc2\xfc\xf4\x22\xd4\x61\x04\xd6\xd3\x50\x9b\x64\x73\xe1\x34\x6d\x1d\x52\x92\x52\xf6\x87\xcf\x40\xc9\x2e\x55\x7d\x14\x33\x96\xe4\x23\x68\x9d\x24\x33\xb4\xa8\xb6\x5e\x2c\x83\x5a\x7b\xaf\x2e\x9c\xf4\xb5\xcc\x1f\xfe\x9e\x90\x9b\xb0\xcc\xe2\x23\xec\x49\xdf\x23\xac\x39\xdf\xc1\xb7\x83\x6c\x7c\x3b\x48\xd8\x8f\xcd\x9d\xd6\x1d\xee\xe3\x74\x76\xf8\x1b\xd5\xd7\xce\xbf\x89\x40\xfb\xd4\xc1\x26\xdc\x77\xf5\x37\xbe\xaf\x16\x6c\xfc\x3a\xf4\x66\x90\x38\xdc\xe2\x1d\x41\x20\x8b\xb5\x5c\xb0\xf5\x3a\xe0\xdd\x99\xbb\xf8\xbb\x1a\x68\xf1\x87\x05\x3e\x6f\xd5\xee\xf2\xc2\x85\x40\xdb\x4f\x0f\xa2\xc2\x1b\xc3\x4a\x91\x6b\x91\xfa\xab\xf0\xaa\xc4\xc1\x1f\x2b\xf3\xbe\xa8\xb0\x65\x09\xf9\xae\x81\x81\xb2\x0f\x33\x3e\xca\x77\x8b\xdf\x22\x58\xd8\x8d\x8d\x79\x36\xc8\xb2\xc5\x7b\x19\xee\xe9\x0a\xb4\x39\x09\x7b\x34\xbe\x78\x8f\xc5\x17\x6f\x67\xe4\xc3\x05\x05\xb6\x77\xc6\x1d\x29\x1f\xa6\xb1\xef\xae\x0c\x1f\xe5\xcc\xad\x80\xb2\xa7\xc5\x8f\xc2\x05\x2f\x5c\x94\xf0\xb6\x6f\x80\xb2\xa7\x67\x40\xd9\xe7\xb2\x50\xe5\x4d\xa5\x4b\x4d\x4b\x90\x8d\x28\xe7\xfc\xc2\xc3\x41\x23\x84\x22\x84\xbd\x3a\x7d\x84\xed\x98\x7c\x04\x56\xed\x20\x28\x8f\xb3\x91\x00\x94\xbd\xd9\x80\x19\x7f\xc2\xf7\xec\x61\x3c\x0a\x57\xb2\x85\x73\x7f\x0b\x73\x4a\x2e\x6f\x57\xe6\xee\x0a\xdd\x55\xe2\xe3\x75\x41\x8a\x72\x3e\x0e\x16\xa0\x38\x75\x1e\xc7\x09\xc6\xe1\x92\xbf\xb3\x53\x40\x11\x5d\x74\xa7\x8b\xce\xad\xea\xde\xbd\x3a\x58\x3a\x8c\x49\xf7\xb2\xe7\xe2\x7b\x1c\xcf\xdc\x1e\x29\x26\xb2\x9e\xe5\x05\x48\x26\x3d\xa0\x5f\xbf\x24\x52\x3e\xe5\x93\x3e\xcd\x4b\xda\x6a\xbf\x1b\xd5\x48\x49\xd4\x8b\xbf\xeb\xa2\x03\x8a\xf4\x74\xe9\x7f\xe7\x1e\x11\xa7\x61\x69\xe0\x1e\x11\x52\x9a\x52\xb2\xcb\x3b\x3b\xce\xc5\x71\x2e\x8e\x73\x73\x94\xca\x43\xc1\x3b\x2b\x85\xa9\x9c\x3c\xdd\xc9\xd3\xeb\x9d\x30\x2c\x8a\xb4\xeb\xf8\x69\x2b\x86\x9b\x45\x40\x09\xca\xd9\x82\x96\x54\x5a\x3a\xdc\x47\x42\x8a\xcb\xcb\xd5\x9f\xb9\xda\x31\x57\x3b\x96\xa2\x96\xcb\xd5\x97\x58\xc3\x9c\xc4\xd6\xe5\xf4\xa3\x86\xb2\x1e\x5b\x79\x2a\xce\xd2\x65\x92\x0c\x49\x34\xe4\xb7\xf8\x2e\x38\x81\x08\xd9\x24\x07\xd6\x5a\xbe\xfe\xb7\x3b\x0a\xe2\x50\xdc\x22\x0f\xc5\xf8\x0a\xfd\xc1\x99\x33\xcd\x49\x47\xc6\x9c\xc4\x6a\xe6\x24\x63\x63\xbe\x7e\xb9\x69\x09\x3b\x3c\x3b\x7e\x08\x34\xa1\x3d\x0f\xb1\x6c\x0e\xbd\xd1\x84\xa2\xf3\x74\xaf\xd3\x64\xeb\x2e\x6a\x97\x1a\xcf\x6b\xb0\x17\xf2\x8c\xb8\xe3\x5b\x77\x66\xf8\xf8\x8c\xd9\x7d\xe4\x72\xa1\xb3\x3c\xb6\x85\xe0\x5d\x4b\xb3\xf3\x20\xe1\xeb\x7a\x48\xda\x96\x09\x48\xc7\xd4\x3e\xd2\xb6\x20\x9b\x30\x3f\xfc\x5a\xed\x0f\x28\x1f\xb1\x8e\xe1\x3c\x39\x08\x94\x6d\xd4\x0f\xe1\x2e\x22\x4f\x46\x5d\x09\xed\xcd\x0d\x80\x74\xcc\x2b\x92\x7c\x29\xf6\x05\xdf\x43\xba\xbf\xaf\x1c\xc2\x7d\x16\xe2\x12\x8e\x33\xe2\x10\xee\x93\x15\x57\xe2\x22\x31\xee\xd3\x20\x58\xec\xd3\xfe\x1e\xe9\x34\xc0\x47\x39\x2d\x10\xf4\xc7\x85\xaa\xf7\x0e\x0d\x50\x2a\x6b\xb4\xde\xe3\x3e\x40\x39\x6e\xac\x43\xba\xbf\x21\x1d\xc2\x7d\x61\xee\x12\x3e\x29\xc4\xfd\xfc\x72\x13\x46\xbb\x3c\xef\x50\x1c\x52\xd8\x37\xf3\x98\xb4\x6f\x75\x00\x65\x7b\xc5\x81\xf2\xa1\x2e\xde\x6f\x05\x97\x50\x24\x73\xec\x01\x8b\x87\xd4\x02\x2c\x81\x0f\x21\x72\x43\xb2\xe3\xad\x3d\xd5\xd2\xa8\xb3\x94\xf7\x66\x8a\xd9\xeb\xb2\x3b\x87\xca\x3d\xeb\x3c\x44\xbe\xb2\xbb\x8b\xdf\xbc\x21\xf4\xe0\xf8\x7b\x9f\x43\x4d\x70\xf5\x3c\xe7\xf3\xa7\x7b\xc6\x1f\x64\x1f\x7f\xe5\x3e\x03\x34\x01\xa4\xc1\x57\xc9\x26\x7c\xc6\x71\xa0\xeb\xaf\x5a\x51\xaf\xf7\x62\xa0\xbb\x5a\x9b\xc9\x1e\xff\x86\xd2\x56\x94\xa9\x70\x66\x85\x32\x2b\x94\x59\xa1\xcc\x0a\x66\x56\xee\x03\xe0\xb6\x56\x02\x47\x55\xeb\x23\xdc\x96\x5e\xe9\xe6\x2f\x9e\x8d\xf1\x3d\x64\x35\xbf\xb5\x2f\x98\x9c\x5c\x6c\xf1\xbe\xd2\xb5\xe2\xa2\xa1\xdc\x3f\x26\xac\x67\xa5\x1f\x61\x3a\x0b\x19\x9b\x46\xd8\xfb\x1c\x87\xd0\x20\xc5\x58\xe7\xbc\x92\x4d\x67\xd2\x6b\x61\x87\x70\xba\xfd\xc1\xa7\xd3\xdf\x04\xbb\xb7\x3c\x06\xab\x7b\x5f\x4c\xf9\xd9\xde\xe0\xba\xb0\x30\xd4\xd8\xfb\x2c\xc4\x0b\xa7\xdc\x9e\x9f\xe4\xe0\x24\xb8\x92\x34\x76\xee\x0c\xbd\xf0\xc6\xf1\x9b\x88\xd7\x24\x7e\x13\x79\xf7\x0e\xda\xc3\x49\xcb\x23\x0c\xce\xde\x0d\x94\x40\xb2\x0c\x49\x75\x92\x3f\x25\x14\x61\x9a\x35\xdb\xa2\x4c\x8b\x32\xad\xca\xb4\x2a\x0f\xd5\x4d\x50\xe5\x04\xd5\xc6\x39\x4a\xf0\x62\x74\xe1\x71\xd7\x4d\x8f\x60\x4a\xd0\x06\x63\xa6\x04\xcd\x36\x6a\x92\x98\x94\xa0\x72\xb0\xd6\x9f\xa7\xe8\xe2\xc2\xf8\xec\x20\x02\x21\x09\x21\x50\x5b\x8d\x5a\xf7\x95\xbb\x5a\x67\xd8\xb4\xe7\x35\xe9\x79\x4d\x7a\x5e\x93\xbe\xd0\xf6\xae\xf8\xe9\xd8\xd2\xb3\x83\xf4\xbc\x20\x5d\x2f\x68\xdf\x0b\xda\xb9\x06\xa1\x09\xc1\x94\xa7\x29\xd3\xa8\x3c\xa2\xf2\x38\xab\xc1\x4b\x68\xd4\x88\xc2\x3d\xea\xf2\x08\xb9\x48\x8c\x6c\xc2\xe3\xbc\xf8\x0a\x14\x56\x6f\x70\x5d\x31\x44\xd1\x50\x14\x0d\x45\xd5\x50\x54\x0d\x45\xd5\x50\x54\x0d\x45\xd5\xd0\x58\x62\x08\xe1\x38\xac\x5e\x8c\xa2\x04\xcd\x76\x1f\x73\x05\x82\xe6\xc2\x86\x30\x44\xb1\x84\x83\xe0\x92\x68\x59\x9a\x96\xa5\x69\x92\xae\x65\xe9\x2a\x69\x57\xa6\xe1\x53\xae\x5a\x77\xf1\xbe\xce\x8b\x14\xa7\x79\xa7\xfa\xe0\x74\x1f\x9c\xf2\x83\xd3\x7e\x70\xca\x0d\xd1\x71\x4e\x8e\xb3\x53\xb8\x58\xed\x49\x71\x9c\xb3\xe3\x5c\x1c\x67\x57\x2f\x62\xba\x27\xc5\x71\x76\x95\x17\x5c\xed\x05\x57\x7d\xc1\xd5\xdf\x39\xf4\x8b\x14\xcd\xcb\x5c\x0d\x9a\xab\x41\x73\x35\x68\xae\x06\xaf\x2f\x0b\x28\xda\x15\x4c\x6a\x39\x4b\x9f\xcd\xd2\x67\xf3\x8f\x07\x9c\x90\x65\x82\x12\xb2\xf6\xea\xac\x7d\x36\x6b\x9f\xcd\xda\x67\xb3\xf6\xc8\xac\x9d\x38\xab\xdd\xcb\xda\x45\xf3\x2f\x2b\xd3\xac\x4c\xab\x8a\xbe\xaf\x78\x00\x41\xb3\xcd\x9a\x6d\x55\x42\xd3\xd2\x36\x15\xac\x6b\xb6\x5d\x25\x3d\x87\x70\x50\xa9\x2a\xda\xb9\x48\x0d\x14\xa7\x46\xed\x7c\xf9\x9e\x5e\xc0\x38\x5a\x82\xeb\x44\x07\x8a\x6b\x06\x4e\x99\xda\xf9\xb2\xeb\x7c\xef\x96\x09\xc6\x69\x8e\xe2\xf2\x72\x1a\xd4\x8e\x95\x5d\x37\xca\x3a\x57\x1a\xcd\xee\x73\x0d\xf1\x53\xce\xda\xb1\xf2\xbd\x36\x8d\x14\xcd\xcb\x5c\x8b\x36\x57\x17\xe6\xea\xe2\x9c\xf2\xc1\x38\x2c\x73\x91\xee\x58\xa4\x3b\x16\xed\x6c\x45\x87\xd0\xa2\x43\x68\xd1\xee\x58\xdc\x24\x03\x96\x78\x83\x80\x8b\xbc\x81\x71\x99\x17\xe6\x0e\xcb\x5b\xdb\x4d\x0c\xcb\xd3\xc9\xb0\xbd\xb5\xe5\xc4\x32\x73\x83\xa7\x00\xa1\x9c\x34\x59\x0b\x45\x66\x6b\x83\x00\x25\x0f\xbc\xf0\x0a\xba\xf0\x0a\xb2\xf0\x0a\xb2\xf0\x0a\xb2\xf0\x1a\x18\xda\x65\x18\x2b\x15\xcc\xcd\x7e\x30\xc9\x0a\x3f\x23\x23\x18\x7e\xef\x18\xd8\x25\x70\xee\x26\xb9\x3f\x37\xec\xc1\x0d\xf9\x5d\x27\xec\x82\x91\xcb\x6a\x6c\xe9\x27\xe1\x6b\x58\x98\xcc\x09\x92\x2a\x27\x89\x78\xc9\x8b\x47\xca\x29\xca\xa0\x08\x83\x22\x0c\x8a\x63\x40\xda\xad\xbf\xbe\x3c\x87\x07\x06\xaa\xdb\x2a\xea\xad\xc2\xad\x4a\x6e\x55\xa4\xa9\x2a\x6e\x63\x7d\x36\xae\xdc\xce\xda\x6a\x3e\x35\xb3\x6f\x92\x7d\x13\xf1\x9a\x88\x1f\x3e\x6e\x3d\x41\xda\x6e\x70\x8d\x37\x68\xeb\x0d\xda\x7c\x83\xb6\xdf\xf0\x69\xae\xb4\x60\x59\x38\xa1\x16\xe6\x89\x3f\x49\x81\x26\x6b\x12\x52\xc6\x8a\x0a\x46\xa3\xee\x22\x50\xcb\x0b\xf6\x3b\xbb\x2d\x8f\xd0\x24\x09\x8d\x64\x9b\xa2\xa2\xd1\x88\xb8\x28\xc7\x09\xfc\x28\xb5\x68\x9c\xca\x3a\x88\xaa\xa5\xe8\x54\x1d\x55\xd5\x91\x96\x47\x8b\xc0\x5c\x13\xb7\xe6\x90\x54\xb3\x49\x35\x9b\xde\x06\xe1\xa3\xb0\xc9\x08\xda\x29\x43\x52\xdd\x3e\x6f\x70\x7c\xee\xcb\x53\x94\x84\x9e\x30\x3a\xbe\x72\xb4\x43\x66\x3e\x88\x99\x0f\x62\xe6\x03\xf9\xf3\x26\x46\xab\x52\xc8\x5d\x79\x5f\x39\xde\xf5\x5e\xd8\x4b\xd7\x59\x98\x4e\x92\xdb\x67\x0c\x45\xd4\x41\x40\x51\x07\x46\x51\x67\x02\x10\x75\x60\x70\x3e\x0e\x88\x0e\xc6\x8f\xbc\x9d\x26\xee\xc8\x23\xed\x65\x66\xf1\xfc\x24\xb7\x09\x78\xce\x29\xaa\xab\xd7\xe8\x2f\xce\x43\x78\x87\x02\xe3\xfc\xc8\xee\x83\xc2\x65\xf2\x01\x8f\xd0\xf7\x93\xe0\x21\x9c\x3d\xb8\xff\x01\x00\x00\xff\xff\x6e\x72\x5c\xbf\x2e\x72\x02\x00")
-
-func chinaCidrTxtBytes() ([]byte, error) {
-	return bindataRead(
-		_chinaCidrTxt,
-		"china-cidr.txt",
-	)
-}
-
-func chinaCidrTxt() (*asset, error) {
-	bytes, err := chinaCidrTxtBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "china-cidr.txt", size: 160302, mode: os.FileMode(493), modTime: time.Unix(1584685822, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-var _notChinaCidrTxt = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x5d\x59\xb2\x6d\xa9\x0a\xfc\x3f\x83\x59\x57\x11\xbb\xf9\x4f\xac\xc2\x3e\x33\x77\x45\xbd\x1b\xef\x24\x7b\xd9\x21\x22\x22\x6a\xfc\xc2\xf8\xef\x9f\xf9\x5f\xd8\x7f\xb6\xbf\x41\xf4\x41\xb4\xf9\x67\x2c\xe3\xef\xb0\xfe\xb6\xf6\x85\x7f\xb1\x4e\x50\xc6\x47\x71\x7c\x3f\xfe\x9b\x99\xcc\x3f\xdf\x37\x11\xbe\xb1\x95\xa7\xcf\x3f\xd3\xfb\x13\x3e\xf7\x53\x97\xf8\x95\xf9\x67\xcc\x7f\xf1\xcb\xeb\xcf\x32\x3f\x80\xaf\xdb\x17\xc3\xcc\x32\x6e\x60\x03\xa4\x05\x32\x81\xf0\x80\x79\x38\x4d\xeb\x2f\xe3\x18\xc6\xff\x4e\x13\x22\xff\x00\xed\x69\xeb\x97\xf9\x37\x54\xd1\x16\xeb\xe2\x4c\x8d\xd9\xb6\x2f\x76\xbb\x5c\x6a\x5f\x2c\xf3\xb7\xbe\x91\xfb\x61\xed\x40\xa9\x9c\xd6\x4c\x64\xa7\x9e\x13\xdd\x26\x0c\x64\xfd\xd4\xd5\x1d\xb8\x03\xb5\x18\xa9\xc7\xdf\x23\x85\x23\x33\xed\x7d\x53\xfc\xfd\x5d\xe1\xfb\x18\xe1\xa3\xbe\x13\xcf\x8a\xec\xf6\x2f\x2e\xd5\x02\x8d\x2e\x01\x3e\xb3\xcd\xa6\xd9\x94\xc3\x9b\x55\x77\x62\x5a\x00\x10\xd3\x17\x43\xfe\xdc\xff\xa5\x88\x38\xfc\x4b\x01\x70\xb2\x7f\xd6\x01\x87\x7f\x56\x2f\x7c\x02\x16\xd3\xac\xf8\xe2\xe6\x40\x4b\x0e\x4f\xca\x70\x85\xf2\x34\xb5\xcc\x0a\xb5\xd7\xa2\x5b\x81\xfa\x2f\x19\xe2\xf6\xcf\x1a\xe0\xe2\xff\xac\x00\x8e\xd6\xfe\xd9\x6b\x51\x39\xdd\xb6\x60\x3b\x7d\x3a\x61\xb4\xd3\xfd\x13\x3e\x39\xb3\xe8\xc8\x9a\xf2\x38\x68\xe6\x87\xb7\xb6\x07\x6c\xfd\xf3\xfd\x57\xf9\x6b\xfb\xaf\xfe\xd7\x3e\x0b\xfd\x3b\x42\xb4\xd0\x96\xbd\xf6\x5a\x3a\x52\x4c\x09\x5d\x7d\xb7\x3e\xbb\x63\xd6\x66\x8f\x0f\xd9\x5b\xbd\xf2\xf0\xea\x95\x8d\x67\xbd\x47\x23\xed\x4a\xc8\x68\xc5\x40\xaf\xbb\x8d\xc4\xca\x50\xae\xec\x4a\xe2\x2c\x63\x4b\x45\xf8\x8b\xe1\xb6\xb0\x5f\xe5\xd4\x90\x05\x50\xa3\xd5\x4d\x0f\xb7\x29\x27\x0f\xc7\x32\xfb\xed\x11\x86\x24\x55\x24\x8c\x9e\xa4\x2f\x1a\xb5\x72\x10\x94\x0d\xed\xa7\x54\x2d\xb6\x17\x29\xe5\xc8\xc7\xa1\x1c\xc5\x79\xf0\x19\xf5\x07\x1f\x29\xde\xf8\x6a\x8c\x8d\xaf\x3e\xd9\xf8\x6a\x9b\x81\x9f\x26\x32\x1a\x87\x76\x87\x68\xff\x5b\xa3\x61\x33\x3c\x61\x4f\xa4\xdb\x47\xe9\xef\x0c\xaf\xdd\x99\x5b\xb2\x77\xe6\x03\xd5\xdb\xed\x0b\x6d\x61\xee\x17\xcf\x8a\x5a\xb8\xb8\x9e\x86\x6d\xdc\x66\xc3\xd6\xf7\x58\xb9\x2b\x35\xf6\x77\x46\xd0\x96\xa1\x9d\xf0\xb6\xf8\x64\x74\xc6\xd8\xc1\x67\x08\x1e\x9c\x37\xc7\x5f\xc1\xa7\x4f\x1e\x65\x8f\xea\x47\xd8\xd2\x02\x69\x96\x3c\x61\x26\x53\x5a\x06\x93\x4e\x0d\x9c\x66\x4c\xc7\x29\x73\x80\xd9\xd7\x83\x45\x8e\xb3\xac\x7f\x71\x83\xb8\x40\x83\xbf\xfd\xe6\x1c\x8f\xbe\xdb\xa8\x63\x92\xab\xed\x06\x80\x62\xe2\x67\xaf\x6e\xed\x76\xae\x7f\x6f\x26\xf0\xcf\x6e\x37\x3b\xcd\xe6\x13\x5d\x95\xe5\x6b\xc2\x7d\xbf\x81\x36\xf7\x2b\x39\x33\x43\x90\x29\xbf\x13\x53\x3c\x59\x6c\x49\xb0\x8b\xaf\xa4\x6c\x7c\x45\x7e\xe3\x04\x2d\x9d\xd8\x38\xfd\x15\x88\x8d\x9b\xbf\xef\xcf\xcc\xb4\x1a\x97\xa8\xa9\xa9\x00\x43\x46\x2e\x19\x2a\xd6\xed\x73\xe8\x88\x6e\x5b\xb5\x86\x03\xc3\x63\xf2\x99\x0b\xef\xa7\xd5\x28\xa3\x02\x15\xea\x8e\x55\xe8\xe9\x0e\xaa\xf5\x29\x71\xbf\x2f\xad\x79\x8a\xe9\xf6\xb5\xc7\xaa\x3b\x9b\xfa\xfa\x11\x1b\x6a\xe1\xea\x61\x0b\x5f\x4c\xfe\x86\xe7\xc6\xbb\x1f\x27\x32\xfc\xd6\xee\x78\xb3\x70\xe7\x96\xa5\xd6\xe7\x84\x13\xf3\x33\x1b\x9d\x66\xa7\x91\x55\x97\x82\x3a\x15\x74\xe7\xa0\x89\xb8\x8a\x99\x7e\xf3\xd7\xab\x07\xd7\x3d\x5e\x2c\x7d\x76\xd2\xb6\x85\xee\x34\x33\xd0\xa9\x51\x1a\xe8\x76\x54\x9c\xe8\x34\x6e\xe6\x72\x1a\x67\x76\x5b\x64\xf1\xfd\x19\x5e\xe2\x30\xd1\xeb\xe5\x81\x8e\x1d\x66\x0b\x05\xe0\xda\x64\x03\x55\xbf\x43\xf5\xeb\x51\xbf\xf5\xcf\xca\x2b\xce\xef\xd4\x37\x1a\xe1\xd7\xba\x9a\x0d\xbc\x7d\x3b\x51\xbd\xac\x5a\xac\xb8\x32\xb3\xf1\x91\x99\x0d\x8f\xcc\x6c\xb8\x64\x33\x1e\x58\xb6\x7e\x38\x70\x2b\xcf\x03\xb7\xee\x3c\x70\xf6\xf8\x50\x8c\xb3\x19\x77\xa4\x2e\x74\xc6\xe9\x42\x6d\x2b\xab\x85\x2a\xfd\x56\x1a\xa0\x29\xc8\xef\xcb\x6e\xf7\xb7\xab\xa4\xac\x3e\x25\x65\xf5\xbb\xe6\xfa\x4a\x6f\x5b\xf9\x2d\x14\x5f\x49\x69\x0b\xe8\x83\xbb\x5b\xfa\x81\x8b\x73\x6d\xc3\xd3\x2d\xf5\x4b\x58\x1c\xc8\xe6\xca\xd3\xc3\x6d\x76\xde\x5a\x7d\x95\x90\xc3\x51\x0b\xa3\xf6\x0d\xf2\xc8\x6f\x2a\x58\x68\x29\x97\x95\x87\x5f\x0d\xb1\x50\x78\x1f\x62\x3d\x32\xb6\x3b\xfb\x61\xff\x49\xb5\xcd\xb2\x93\xe5\x95\x82\x89\x5a\xc0\xdf\xae\x15\x61\xf5\xeb\xc7\x88\x58\xbf\xf6\x46\x5c\xe9\x0d\xb8\xd2\x0b\xd4\xe6\x29\xf9\x91\xe7\x55\xa5\x03\x20\xbf\x7a\x87\x0c\xee\x0c\x52\x16\x0a\xd8\xa9\x47\x67\xed\x2f\x5d\x7e\x43\x31\x19\x18\x05\x25\x3e\x5b\x77\xa2\x46\xa5\x54\x42\x85\x04\x66\xe5\xfc\x18\x37\x72\x9a\x96\xd3\x2d\xa9\x9f\x39\xf0\x96\xd4\x0b\x7d\x5e\x20\xb7\xc8\x2d\xea\x30\x36\xeb\xcc\xa7\xbc\x11\x13\xb7\x51\x7c\x61\xc2\xf6\x47\xfb\x49\xbb\xeb\xb5\xea\x11\x1d\x5b\x15\x13\x68\x9c\x3a\xed\x70\x87\x46\xc4\x82\x52\x7a\xad\xf4\x5d\xe7\xcc\x25\x59\x44\x71\x8c\x16\x41\x1e\xaf\x51\xb9\x8b\x45\x11\x8f\x91\x18\x1d\x8b\x34\xa0\xe0\x08\x98\x35\x04\xb6\x5b\x64\x36\x5b\x24\xde\x58\x44\x09\x8e\x86\x9a\x21\x1a\xe9\x06\x8b\x5c\x6e\x8a\x47\x27\xd8\xc5\xe1\xe9\x9f\x81\x51\xfe\x27\x86\x01\x30\x0d\x76\xc8\x8d\xda\x9f\x50\xec\x23\xcc\x43\xbb\x1c\x14\x34\xc7\xc1\x13\x1d\xc7\xc8\x5d\x59\xaf\x7c\xde\xd2\x3a\xd9\x9d\x77\xad\xbd\x3f\x2b\x4d\x10\xf5\xcd\x80\x33\xbd\xbd\x29\x7c\xd6\x6f\xb7\x75\xfe\xbd\x75\x72\x2a\x4f\x9f\x8e\x19\xe2\xa4\x4e\xe5\x4b\xf5\xae\x21\x37\x6a\x6b\x0a\x18\xa8\x41\xa2\x54\x1f\x9f\x17\x3c\xdd\x9b\xca\x55\x84\x65\x02\x83\xfa\xe4\xf8\x59\xf6\x35\xc9\xac\x19\x65\x6b\x91\x51\x27\x0f\x50\x93\x3b\x93\xaf\xfa\x66\xac\xb1\xe5\x7c\xe4\xc5\x0e\x3e\xf2\xb2\xe1\x19\x96\x1b\x86\x5b\xb7\x99\xf6\x0c\xa5\x83\x4b\xa0\xbc\xee\xcc\x76\xf0\x99\xdb\x2e\xe6\xb2\xe3\x99\x46\xd3\x58\xb2\xd9\x67\x69\xaa\x8d\xb1\x7a\x04\x4a\x9f\x4b\x08\x20\x94\x39\x97\x02\x21\x8c\x55\xc8\xc5\x87\xf3\x07\xef\xb9\xf9\x62\xe3\x32\xcf\x54\xb7\xf1\x9d\xeb\x0e\x3e\x52\xbd\xf1\x96\xea\x89\xae\xda\x4a\xed\x49\xf5\x2a\xd9\x9e\x74\x3c\x67\x43\xaa\xd7\x72\x39\x1c\x38\xea\xe7\xe0\x63\x85\x5f\x1c\xfe\x87\x43\x79\x2c\xa7\x89\x52\xc6\xa2\x0a\x29\x36\x57\x89\x44\x99\x8b\xb7\x43\x39\x93\xf1\xc4\xd7\x9c\x4e\x0d\x4d\xb4\xd4\xd0\x7c\x4b\x0d\x4d\xbb\x69\xa3\xee\x45\x79\xea\xb0\x5c\x19\x45\x5d\x9f\x45\xea\x30\x4a\xfa\xb3\x4c\x52\x87\x91\xd4\x9f\x65\x32\xfe\xc6\xa9\x63\xe3\x2b\xa3\x9d\xa6\x8e\x0d\x8f\x8c\x76\x5c\x04\x4f\x14\xb0\x0c\x9c\x56\x2e\x3e\x23\xfb\xe0\xed\x0e\x9d\x3d\x7b\x57\x58\x0b\x1d\x0b\xdd\xc3\x33\x45\x8f\xc9\xba\x78\x32\x7f\x31\x2f\x8b\x2b\x1b\x39\xe4\x78\x9d\xaa\x0b\x99\x63\xfe\x47\xee\xd6\x6f\xd9\x6f\x2e\xe5\x9a\xe6\x1e\x80\xd1\x1e\x80\xd1\x1e\x1e\xa3\x3d\x3c\x46\x7b\x78\x8c\xf6\xf0\x18\xed\xe1\xab\x4b\x13\xaf\xd1\xf5\xf0\x1a\x5c\x0f\xcf\xb1\xb5\xa1\x6d\xa5\xb8\xe0\xe6\xc1\x00\x05\xf3\x75\x28\xb0\xd6\x65\x6b\x77\x7b\xb9\x6e\xe3\xfb\xe5\xbb\x87\xe8\x4b\x60\x97\x4b\x03\x9e\x01\xba\x2b\x01\x7c\xaa\xf5\xd9\x64\x1b\xfa\xe3\xe9\x84\x01\xb3\x8a\xa9\xdd\xde\x98\xb0\x5e\x26\xef\xd6\xee\x51\xb2\x4b\x5e\x9d\x70\xab\x99\x0d\x53\x9b\x37\xaa\xa6\x07\xac\xa6\x71\x51\x06\x45\x45\xcb\xc0\xbb\x3b\x2b\x97\x85\x02\x74\xdd\x95\xe7\x99\x4d\xa7\xde\x0e\x00\x6a\x13\x96\xe7\x8c\x0d\xb3\xec\xbf\x0d\x5b\x1e\xb7\x59\xe4\x5d\x16\x0e\xd4\xdb\xf7\x6a\x7e\xd6\xc7\xab\x59\xf1\x59\xb2\x0e\xeb\xbc\xdd\xa8\x06\xc3\x20\x5a\xa5\x46\x81\x51\xb3\x73\x8d\xaf\x8f\x27\x8c\xb7\xc2\x96\x8f\x09\xf0\x30\x76\xfa\xc4\x47\x29\x6f\xfc\xd8\x69\x6f\x0e\x1d\xc8\x91\x33\x30\x51\x7a\xc0\x89\x72\xa0\x63\xfd\x6e\x84\x5f\xc6\xde\xb1\x35\xcf\xd6\xbf\xad\x39\x73\xf8\x86\xe9\xd5\x76\x40\x6b\xd4\x56\x2b\x97\xbf\xab\xad\x47\x61\xbc\x65\xf4\xfc\x09\xd9\x3b\x3f\xf4\xa7\x8f\x26\x3e\xc6\xe9\xcd\xe8\x94\x63\xcf\x66\x99\x7f\xd3\x0f\xb7\xae\x06\xaa\xc1\xd0\x85\x35\x11\x7c\x16\xbf\x33\xcf\xbb\x5d\x05\x34\x01\x28\x20\x03\x05\x64\x5f\x09\x90\x75\x01\xef\xd5\x86\x67\xda\xf3\xe7\xd4\x5e\x79\xdc\x4d\x87\x01\xee\xb2\x67\xfd\xd2\x5e\x9b\x5a\x3a\xc6\x44\xba\x30\x30\xdc\x66\xc0\x81\x85\x7f\xcd\xf7\xd7\x5e\xa0\xc8\x5e\x9e\xbf\x70\xc0\x06\x35\xe8\x7d\xad\x01\xce\x6f\x9d\x39\x06\x63\xd5\xde\x42\x6c\x21\x4b\xd7\x3c\x9c\xf0\xe9\x67\x5b\x7b\x70\x0f\x39\x25\xcc\x85\x12\xe6\x65\xd1\x99\xb5\x31\xdf\x4f\x4a\xdd\x43\x69\x7d\xdf\xea\x9b\xd4\x26\x6e\x90\xf7\x95\xea\xb2\x10\x77\xb9\x79\xc4\x5f\xbd\x7e\x79\xa4\xd8\x05\x2d\x3c\xf5\x78\xff\xf3\xe5\x30\x3a\x52\xf5\xdc\x47\x43\x18\xc9\x7d\xe4\xe4\x3e\xf2\xe7\x3e\xda\x89\x8e\xdb\x61\xc3\xab\xae\x17\xbc\x02\xbe\xa0\x71\x81\xce\xb0\x38\x43\xce\xea\xca\xdf\x82\x9d\x61\x6b\x54\x50\xe3\xac\xae\x8b\xe0\xe0\xe3\xdd\x3d\xf8\xec\xaf\x1e\x9c\xb9\xde\x51\x6a\x7a\x1d\x39\x17\x4b\x79\x8d\xeb\x7e\xd7\xec\xf7\x77\xce\xef\x19\x12\x07\x63\xfa\x7c\xfd\xaa\x1b\x16\xac\xed\x35\xf1\x4f\x5a\xe7\xba\x6d\x03\xe6\x26\x6e\x9c\xf5\xb5\xd4\x36\x3e\x96\xda\xf9\xba\xd1\xaf\xed\x4c\x82\x3b\x31\x75\x4a\x06\x83\x6b\xe3\x6b\x72\x1d\xcc\x75\xb9\x0b\xd0\x83\x1b\xb7\xcc\x22\x37\xdc\xa8\x1f\xee\x6a\x7e\x23\xce\xbd\x80\x19\xb2\xb1\x61\xee\x05\xe6\xa4\x8d\x2b\xb6\xbd\x48\x2f\xd7\xbb\x73\x71\x20\xe6\x5e\xef\xbe\xc4\xfd\x55\x12\x93\xc8\xd5\xb7\x45\x7a\xb0\x0b\x26\x11\xae\x52\x79\xb4\x56\x36\x4e\xd8\x15\x8d\x45\xa6\xf1\x70\x69\xd7\xa9\xb0\x61\x8c\xfc\x35\x8f\x87\xb6\x5c\x1c\xb7\xdf\xef\x4e\xe8\xfb\xde\x02\x7f\x4f\x32\xd7\xc0\x8a\xdb\xb8\xc9\xef\xb5\x70\xfa\x22\xf5\xcb\x58\xfd\xce\x6a\xa5\x93\x18\xc0\xd6\xe0\xfe\x95\xea\xd2\xbf\x6c\x04\xcf\x52\xe4\xc0\x40\xbf\x72\x2f\x76\xe9\xc5\x2e\x8c\xea\xcb\xb5\x87\xe9\x4b\xe0\xdf\xdd\x29\xfd\xf5\xc8\x5c\xcc\xdf\x53\xaf\xa7\xb3\x79\x66\x07\x06\xce\xcc\x01\xa7\x61\x2d\x51\x5a\xd4\x51\xe9\x39\x62\x0f\x0c\xf4\x71\xe2\x82\x50\x69\xa4\x40\xba\x38\x85\xe7\x38\x3c\x18\x75\x4a\x0a\xa4\x53\xe6\xde\x31\xfd\x6a\xd2\x28\xe2\x49\x0a\xac\x24\x52\x00\xd3\x71\xe0\x88\x93\xd8\xf6\x59\x9d\xb4\x91\x86\x6c\x8a\xd7\x0b\xb9\x21\xb1\x20\x72\x67\xa7\xc8\x9d\xfd\x9c\x5c\xe7\x57\x14\xb3\x81\x51\x77\xa5\xce\x63\x2a\x75\x66\x02\xcb\x5d\xea\x7b\x0b\xee\x26\xce\x9c\xf6\xba\x59\x2e\x0e\x5c\x16\xea\xd9\xd4\x59\x13\xe2\xae\xcc\xc1\xa8\xe9\x3c\x7c\x38\x65\x78\xb8\x3b\x7e\x1b\x46\xfe\x98\xbf\x85\x82\x9c\x65\xcc\x59\x6c\x5c\xe4\xc4\x45\x4e\x9c\xe5\xc4\x59\x4e\xc6\xd7\xc8\x14\x0f\x6f\x1b\xe0\x62\xce\x9d\x26\x55\x8f\xdc\xaa\x48\xa3\xc3\x23\xe9\x4b\x8f\x34\xc5\x7a\xa4\xee\xf1\x48\xdd\xe3\x91\xeb\x1d\xb9\x77\x3c\x72\xef\x78\x64\x6d\x39\x30\x0e\x00\x8f\x2c\xf0\x03\xa3\xb5\x30\x6b\x8e\x5c\xb6\x8f\x73\x33\xaa\x9c\x71\xb3\x6d\x57\xdd\x0f\x44\xc1\xba\xbe\xb2\xf7\x35\x0d\x81\x63\x15\xdf\xc2\x8c\xf5\x9d\x1b\x1b\x04\x73\x79\xd1\xf8\xfb\xc6\x55\x37\x49\x4f\x63\x7f\x1a\xad\x5c\x3f\x9a\xf5\x9e\xef\x6f\xd7\x6f\x2d\x3d\xce\xd7\x69\x8b\x5f\x3c\xb0\xf0\xb7\x09\x66\xff\xf9\x8f\x3e\x36\xce\xea\xec\x2a\x1d\x68\xf4\x6b\x4c\x82\x9d\x53\xb3\xe8\x26\x61\x53\xe2\xf9\xce\xd3\x69\xd6\xfb\xbd\x73\x5d\x49\xd7\x6c\x71\xc7\xef\xc9\x18\xf0\xe7\xce\x39\x5f\x23\x93\x8b\x94\x26\x76\x91\x17\x56\xb8\x03\x93\x50\x14\x11\x8a\x22\x42\x51\xa9\x53\x0a\xcf\x52\x5e\x60\x11\xbc\xbe\x76\x4e\x2c\xbf\x92\x2e\x62\x1b\xcc\x2b\xab\x9f\xca\xa3\xb6\xf2\xa8\xad\x3c\xe2\x2b\xeb\xaa\x0a\x8b\xc8\x8d\x5d\x30\x8d\x94\x2a\x83\xba\xca\xa0\xae\xd2\xc5\x95\x4d\x1a\x3f\x5e\xb1\x83\x1b\xcd\xf4\xde\x48\xe9\xb6\x8f\x3a\xac\xf1\x10\x67\xd3\xcf\x1b\xab\xb2\xc6\x4c\x69\xcc\x14\xb1\xbc\xbc\xf1\x4a\xc8\x9b\xc8\x4a\x7b\xdb\xce\x17\x73\x7e\x2c\x99\x4d\x24\xb3\x7f\xf2\x2b\x49\x66\x97\x09\x82\xe7\x50\xe7\x39\xd4\x3b\x0f\xe8\xce\xa2\xd2\x3f\xc9\x19\x2d\x58\x97\x19\x73\x60\xaa\xb7\xce\x98\x03\x1b\xfd\x4e\x6b\x0d\x17\xc3\xcd\xfb\xdb\x6b\xbd\x38\x70\x7d\xd0\x40\xdf\x1e\x30\xc8\x0d\xc7\x4c\x0e\x68\xf2\xe4\x40\xd2\x70\xf7\xdb\xfb\x81\x68\xe4\x64\xb6\xf3\x32\xdb\x79\x39\xb0\xd4\xe7\xc0\x52\x9d\x21\xd6\xe1\x62\xe3\xef\x51\x5c\x06\x2e\x92\x5f\x95\xef\xbb\x7c\x8f\x8a\x6d\xe2\xc0\x4d\x0d\xd2\x74\xa9\xaf\x49\x7d\xa8\x1b\x72\x60\x71\xdc\x6e\x47\x64\x24\x08\x49\x26\xcb\x32\xc7\x8f\xb2\x66\xd3\x92\x1c\x9a\x0b\x5f\xef\xdb\x82\xd4\x0b\x91\x7b\x21\x4a\x35\x23\x4b\x4b\x8e\x2c\x2d\xd3\x9b\x5a\xa8\x2e\xda\x2c\x1a\x65\x99\x6d\x80\x6c\xb8\x5e\x9a\x89\xb1\xd5\x46\x1a\x21\x1b\xcf\x06\xd9\xa4\xa1\xc6\x0d\x35\x5a\x40\xe5\xbb\x2d\xb5\x7f\x64\x39\x36\xd6\xfd\xcb\x2d\x06\xa9\xd9\xb9\x34\x5d\xe0\xf4\x6b\x62\x28\x69\x89\x25\xec\x6b\xca\x4e\x5a\x30\x3b\x99\x41\x37\x6a\xe4\x42\x9c\xad\x33\xbb\x9e\x46\xad\x82\x54\x9a\xc6\x8c\xcb\x18\x71\x36\x0f\xb3\xb3\x31\x30\xb0\xe4\xcf\x32\xee\xbc\x5e\x9a\x58\xf8\x80\x33\xd5\xdc\xf3\x25\x48\xb2\x90\xc9\xec\x19\x30\xd0\xc7\xc4\xa6\xcc\xa2\xc1\xae\xa4\xcc\xbe\xa2\x9c\x79\x86\x7c\xfb\xd6\x0f\x07\x2e\x9a\xcc\x86\x2c\xce\xa4\x81\x49\xb7\x64\xf6\x98\xe0\x4e\xeb\xc5\xf2\x3b\xf1\x35\xf3\xd4\x35\x39\xc1\xe9\xdf\x20\xca\x9f\xe3\xb2\x33\xdf\x03\x08\x75\x80\x17\x84\xe0\x27\x46\x30\xc6\x3f\xf7\xb7\x17\x70\xf2\x3f\xfc\x99\x19\xbc\xe2\xf3\x0c\x37\xdb\x6d\xcd\xd7\x9d\x5e\xe6\x87\x6f\x53\x23\xdf\x33\x10\x3e\xc1\xf3\xfd\xe7\x0f\x3c\xbf\x19\x62\x68\x56\xe5\xaf\x63\x7b\x56\x17\x36\xa1\x32\x6e\x42\x65\x38\x1a\x31\x6a\xd1\xa0\xac\x52\xa0\xac\x02\x71\x33\x0b\x5e\x15\x30\xd1\x9d\x86\x77\x4d\xae\xc9\xbf\x71\x7d\xed\x9e\xb8\xbc\xa6\x4f\x7c\x5d\x24\x1b\x27\xf9\xfe\xae\x8e\x16\x7e\xe2\xbf\xb1\x61\x79\xe9\x6b\x84\x02\xe5\xf5\xf4\xd1\xfa\xf5\xaa\xa3\x05\x9d\xd3\x3a\x67\x7c\xed\xaa\x05\x73\x21\xf8\xa4\x7f\xe1\xbb\x80\xd8\xb5\xe2\x82\x6b\xa1\xac\x9f\x8a\xd8\x38\x73\xc5\x98\x87\x09\xa6\xd5\x0c\x31\x51\xf0\x7b\xe3\x96\xbc\x65\xd3\xc6\xd2\x52\xa3\xa6\xfa\x9b\xeb\x16\x6c\x90\xb9\x7f\xfc\x29\xe5\xe4\x6f\xa2\x5b\x30\x15\xfa\xf5\x9a\x2a\x0b\x12\x0b\x1d\xfc\x31\x1b\x47\xa3\x6a\x3c\x05\x72\x70\xe0\x5a\x77\x93\x56\x04\x2a\x8e\x25\xcd\xc1\xe6\x5b\x98\x25\xc9\x61\x56\x3e\x58\x7e\x4f\x67\x1f\xf0\x60\x2e\xdf\x84\x37\x46\xcc\xc9\x1f\x15\x9f\x59\x18\xf3\x87\x75\xcf\x6f\x0e\x5a\x90\x18\x99\x9f\xb5\xb1\xe0\x33\xa3\x37\x26\xc6\x65\x51\x16\xe8\xa6\xdf\x98\x86\x60\x86\xe5\xec\xc6\x2e\xe9\x0b\xb7\x84\xc5\x31\x83\x31\x7d\xbe\x6f\xf4\xfb\xb3\xf2\x0e\xe6\xfa\x98\x70\x8a\xc5\x39\x93\x6a\x3d\x41\x7f\xf0\x2b\xd5\xbe\xbc\x29\x6f\xc3\x46\xd0\x05\x06\xca\x99\xf8\x5e\x58\x80\xcb\xe1\xdb\x4d\xcc\x02\x5d\x44\xa0\x8b\xf4\x4b\x01\x0f\xd7\xc6\x5d\xbe\x27\x81\x2e\xb0\xa6\xcc\x37\x2a\xf1\xa5\xbe\x51\x79\x07\x0b\x97\x58\x91\xd6\xb7\x02\xdd\x90\x7f\x2d\x4e\x90\x94\x7e\x7d\x56\xc5\x49\x1b\x38\x2f\x12\xc8\xfa\xd6\x75\x0b\x92\x72\xac\x37\x72\xfb\xe6\xdd\x28\x33\x1e\x46\x55\xa4\x01\xe3\x4d\x4e\x65\xde\x44\x1e\x63\xff\x88\x69\xfd\x73\xec\xa3\xfe\xcc\xa5\xfc\xce\x19\xed\xac\x1a\xcd\xb9\xfd\xed\x00\x2d\x48\xad\xe8\xac\xe2\x71\x81\xb8\x30\xd7\xb2\xff\xd4\x92\x65\xa5\x83\xd5\xb9\xa2\xfa\x50\x21\x58\x20\xc1\x3c\x11\x29\x37\x2f\x9e\xf6\x3a\x59\x3a\xd1\x02\xcb\xed\x39\x1c\x71\xea\x6a\x81\xeb\x62\x81\xe5\xd6\x02\x8f\x7f\x0b\xac\x68\xed\xae\xea\xf2\xc5\x55\xca\x2b\x5c\x1e\xf5\xa8\x05\xe6\x95\xe1\xaa\xf1\xe0\xb3\xa1\x7e\x70\x7c\x8a\x79\x97\x3f\x37\xc2\x0b\x51\xca\x3c\x63\x39\x29\x91\xc6\xbd\x45\x12\x83\x13\x19\x7c\xcb\x47\xcb\x6e\x61\xa7\xc4\xa8\x16\x2c\x7e\x8d\xb3\x6e\x81\x7e\x25\x5e\x44\x70\xfd\x1f\xcc\x9f\x3f\xe7\x72\xc6\x68\xe2\x5b\x53\xee\x9b\xc8\x93\xa4\x45\xe1\x6d\x14\xde\x46\xe1\x6d\x04\x2b\x3b\xcf\x68\x3b\x1c\x3d\x66\x34\x7a\x06\x74\xfa\x95\x2b\x0b\x91\xdd\x13\xa7\x0f\xe7\x1d\x4b\x1f\x2a\x28\x4b\xa8\xc6\xcd\x58\x9d\x4d\x1c\x28\xb1\x33\x24\x95\x63\x89\x54\x8e\xa5\xb7\x76\xd9\x5f\x6b\x4d\x5c\xb1\xe4\x5e\x18\x5b\x90\xdf\x3b\x97\x4e\x06\xd9\xfc\x5e\x30\x35\xdd\x49\x17\x1b\x19\x60\x46\x06\xd8\xdc\xef\x27\x48\x15\x75\x52\xdb\x37\x02\x23\x1c\x88\x66\x24\xc6\x58\xec\x52\xb9\xd8\xc6\x59\x91\x8e\x7f\x31\x16\xaf\x09\x24\x88\x2e\x82\xed\x22\xd8\x2e\xb2\xe2\x3c\xf9\xcd\x18\x0a\xc9\xaf\x05\xe1\x03\xe7\xc7\x82\xee\x3f\x2c\xa6\xe9\x6f\xc6\x32\xc0\xcf\x99\x84\xcf\xd9\x86\x30\x36\xdd\x8c\x4d\x37\xcb\x64\x52\x58\x66\xd5\x92\x99\xe9\x99\x96\x15\x03\x72\xce\xac\x8a\xb3\x0c\x77\x31\xed\xac\xb0\xde\x2a\xa4\xb7\x20\x76\xf9\x36\x59\x7e\x27\xbd\x9d\x45\xea\x31\xd0\x6a\x61\x9c\x00\x8d\x8d\x23\x63\xe3\xc8\x0a\xb8\x31\x36\x0e\x8d\x7e\xe7\xfe\x2e\x3c\x21\x52\xb4\x47\xbe\xd1\x8a\x90\x3b\x69\x87\x8a\x4b\xd4\x68\x6c\xae\x60\x64\xc7\x86\x68\xef\x9e\x13\x0e\x50\x14\xda\xbf\x27\x40\x71\x7d\x8d\x01\x8a\x99\x02\x14\x33\x05\x55\x65\x0a\xaa\xda\xb9\x52\x6f\x54\xea\x8d\xe7\xc7\x0f\x07\x42\x13\xde\x7e\xc1\xfd\x35\x72\x0d\x61\xac\x0e\xd8\xf9\x57\x14\xa2\xe9\x86\x07\xed\x35\x7f\x07\xed\xf5\x36\x09\xa0\x6c\xe7\xaa\x21\xff\xae\xeb\xdd\x0e\xea\x58\xd3\x8e\x52\x32\x20\x68\xa4\x09\x03\xe5\x14\xa9\x21\x9d\x16\xc2\xcb\xe7\x1f\x28\x39\x2a\x91\x85\x25\x3f\x10\x42\x74\xac\xe7\xe7\x84\xbf\xdf\xa2\x48\x4d\x0c\x1a\x82\xdc\xf0\x1b\x06\x82\x30\xca\xaf\x93\xfe\xa5\xc5\x56\x0f\xc8\x59\xe1\x9c\xbd\xbc\xea\x82\x33\xb7\x02\xed\xa9\xf5\x7d\xe3\x9a\x62\x4b\x9e\x57\x3d\x3c\x1c\xe8\xfb\xd8\x31\xbf\xf8\x39\x21\x14\xb5\x4c\x86\xd3\xf3\x9a\x87\x03\x0d\xdb\x1d\xbf\x16\xe8\x63\x14\xf1\x4c\x76\xd3\x2c\x08\x66\x94\x89\x03\x67\x16\x61\xfd\xfd\x5c\xf2\xef\x77\x34\x7b\x39\xfc\x36\x4b\xf8\xed\x5a\x24\x52\xe5\x8c\x2b\x67\x5c\x39\xf0\x9a\x2f\x88\xb3\xd3\xc2\x9c\x19\xd7\xc5\xc8\x04\xb7\x9c\xd0\x46\x1c\xf0\x5e\x3d\x71\xb1\xd3\xef\x68\x81\x2e\x8c\xbf\x3b\xcb\xa6\x7f\xd4\x70\xff\xf4\xe3\x64\x92\x58\x7e\xa7\xb6\x66\xe1\x33\xba\x0f\xca\x57\xe2\x17\xeb\x0c\x60\xf9\x97\x22\x11\xe6\xf1\x16\x20\x58\x9b\xd6\xf7\x25\xac\x05\xc2\x86\x6d\x77\xec\x86\x27\x14\x78\xc1\x3b\xe6\x17\xbc\x43\x7e\xc2\xe3\xf8\x2c\x37\xa2\xb9\x0c\x90\xef\x59\x1f\x2f\xd7\xc9\x3a\x73\x84\xf0\xe8\x02\xee\xdc\xd5\xb4\xd7\xf4\x02\x5a\x37\x1e\xf8\x2a\x82\x13\x01\x1d\xe9\x3a\xfb\xeb\x3b\xdb\x42\x13\xc1\x3b\x17\xb6\xc0\x73\xf1\xae\xa6\xdd\x19\x62\xc1\x2b\x63\x1b\x86\x1f\xb6\xef\x45\xce\x23\xd4\x75\x58\x04\x08\xf3\x36\x07\xaf\x1f\x04\x1a\xd7\xeb\x17\x4e\x7f\x5e\x31\xbe\xfb\x1e\xe2\x5e\x8d\x84\xc0\xe7\xf2\x02\xf7\xf3\x62\x40\x07\x76\xaf\x29\xa9\xdd\xbe\xb8\xf3\xd9\x6a\x0c\xcd\x67\x0b\xde\xb1\xb8\xe1\xe9\xf2\x73\x41\xca\x28\xb3\x3d\x17\x7b\x85\xcb\x34\xbc\x7f\x57\x0f\xc5\x8d\xae\x1a\xea\xf7\x38\xf1\xfa\xf0\x09\x45\x87\x93\xcb\xde\x51\x0e\xf0\x40\x94\xc3\x81\xa8\x95\xd9\x5e\x89\x3f\x78\xa4\x7f\xc2\x6b\x13\x4d\x74\x27\xbb\x85\x8c\x12\x5e\xfe\x74\x38\x57\xbc\x27\xcc\x57\x13\x08\x3d\x5f\xf3\xdb\x59\xdb\x76\x3c\xc1\x36\x91\x11\x0a\xd0\xb8\x68\x1d\xda\x1d\xad\x51\xe9\xe3\xef\xdb\x3b\x0b\xde\xde\xd9\x5f\x97\xcb\xce\x01\x1f\xab\x31\xfa\xbd\xbf\xe3\x76\xab\xd0\xce\x2c\x7d\x83\xab\xaf\x29\xec\x56\xf6\xde\x04\xb4\x11\x36\x04\x2e\x3a\x98\xfb\xee\x0d\x1a\x62\xef\x72\x85\x89\xd0\x8d\xd2\x21\xec\xae\xfd\x65\x3c\xb6\x90\xe1\x2e\x9e\xfc\xee\xe2\x39\x1b\xef\xf7\xa3\x7e\x7d\xb8\x7e\xe1\x9e\x29\x37\x3c\x6b\x8c\x05\xef\x5a\x7a\xc3\x53\x97\x05\xb7\x66\x1a\xe0\x72\x69\xcc\x3a\x77\x1c\x0e\xe0\x00\xfa\xf2\x6c\xf6\x32\x34\xe7\xc3\xeb\xbc\xd7\xc3\xf3\xc0\xd3\x86\xc7\xa6\x5e\xf0\x9a\xd4\x1b\x62\x7d\x32\xd6\x07\x74\xfc\x81\x61\x89\xea\x69\xf6\x3d\x8e\xf8\x08\xeb\x2a\x99\x87\xd7\x4d\x32\xbb\x22\x6b\x9a\x18\xba\xe7\x11\xc2\x49\x9f\xdf\x69\xb9\x03\xed\x15\x07\x61\xdf\x07\x06\x6c\x15\x31\x3d\x3f\x3f\xc0\xe1\x48\xa3\xac\x4e\x24\xf3\xe3\x17\x34\x64\x13\xa2\x41\x45\xa7\x5f\x76\x9e\xd6\xc8\x86\xf7\xaa\xe4\x77\xf0\x24\x62\x2b\x97\xca\x05\xc2\x72\x2b\xe5\x1d\xd5\xbf\x13\x3b\xca\x78\xf6\x77\x7a\x29\xfd\x65\xc7\x91\x92\x1d\x67\x88\xec\xf7\xfc\x45\x4e\x57\xfb\x9d\x5d\xfa\xfd\x8d\xe1\xd8\xca\x86\x07\x42\xb3\xe1\xe1\xed\x6c\xa8\x24\xe6\xcc\x0f\xd1\x87\x07\x5f\x29\xf1\x1b\x60\x1b\x57\x7b\xde\xa1\xf0\xd9\xba\x86\xad\x7b\x6a\x6a\x7e\xd9\xbf\x70\x05\xe0\xe0\xd5\xff\x8e\x63\x38\x3b\x8e\xe1\x63\x8b\x50\xfd\xae\xf1\xfa\xf2\x1d\x1c\x5e\xf9\x3a\x38\x07\x0f\xc6\xfa\xbf\x8d\xdb\x9d\xfa\xaa\xbd\x8d\xaf\x7e\x58\x30\x19\x95\x75\xd4\xde\x86\x67\x70\x2d\xb4\xd5\xfe\x46\x47\x80\x77\xc2\x2d\x74\xb7\xca\x5b\x4a\xea\x77\xae\xc9\x19\x85\xd4\xab\x09\xda\x00\xa0\x09\x70\x46\xce\x38\x23\x67\x98\x91\xf3\xbb\x56\x25\xbf\xdb\x6a\xb2\xbf\x43\x74\xab\x3e\x4e\x5d\xf3\x54\xf4\x44\x11\xbb\xc2\xc3\x33\x54\x0f\x3e\xb6\xe3\xc1\x67\xa2\xbe\xb8\xbc\x96\x4f\xbc\x0f\x6a\xe5\xb5\x28\xdd\x45\xb5\xef\x35\xee\x4c\xe3\xab\x6d\x6f\x1e\xcf\x15\x47\x58\xfd\xde\x15\x42\x03\xdc\xd8\x92\xc5\xb6\xd7\x91\x0b\x9e\x25\x6d\x5e\xa6\xc4\x2d\xd6\x0a\x80\x92\x9f\x1f\x7e\xc1\x27\x3d\x0d\x36\xba\xdb\x86\x47\x76\x1a\x6c\xd2\xef\x6c\x2e\x9b\xda\xdd\x3a\xaf\x1b\xc4\x62\x97\x05\x03\x1e\x6b\xf6\xc0\xc3\xb0\xfd\x71\x7b\xf5\x81\xa5\xf7\x86\xcd\x19\x1a\xa5\x3d\x7e\xee\xdc\xae\xbc\xcc\x76\xbe\x9d\xfd\x01\xf0\x97\xd2\xa1\x1d\x0d\xd9\xd8\xe6\x61\x9f\xdb\xfa\x56\xf0\x43\x87\x2c\xde\x4d\x27\x03\x34\xf8\xac\x23\xaf\x5b\x83\x3a\xb4\x4a\x25\xc1\x8d\x79\xb9\xe1\xd1\xbb\xdc\xde\xdd\x29\x79\x89\x50\xc0\xdf\x40\x97\xb5\x77\x0e\x6f\x7d\xe9\x28\x61\x70\x0d\xc5\x44\x19\xaa\x89\xda\xb2\xa1\x25\x32\xc4\xc5\xfa\x14\xa7\x5c\xc6\xfa\x05\x09\xd3\x6e\x06\x82\xfb\x9c\x96\x1f\x61\x6b\xbb\x4b\x58\xea\x6e\xc1\xb3\xbc\x3b\x30\xdc\x5e\xbd\x67\x52\xec\xe6\xfe\x3a\xe1\xea\xd8\xb2\x90\x63\x4d\x13\x76\xb2\x25\xec\xe5\x77\x94\x65\xf1\x7b\xd6\xa8\x5c\x41\x9f\xd0\xaf\x24\x4d\x98\xae\x24\x9d\xf6\x6c\x95\xf5\x08\x7b\x62\xbb\x16\x79\x1c\x7f\xcf\xe8\xe4\xb0\xa6\x4c\x26\xf8\x64\x18\x10\x4c\xbe\x58\x17\xcb\x21\x41\xf3\x30\x4d\x92\x8a\x24\x71\x2d\xc5\x35\x49\x2d\x42\x68\x9a\x64\x5d\x87\xf8\x08\xa6\x35\xb5\x20\x79\x58\x52\x42\xd6\x24\xde\x24\x53\xa7\x24\xf1\xe3\xd6\x8f\xe5\x1c\xff\xce\x6d\x8f\x5f\x72\xc1\xf2\x7d\x0e\x82\x97\xd9\xf8\xb0\xa4\xe7\x56\xc7\xaf\x16\xc1\xf2\x7d\x97\xfa\x76\x29\x5f\x3a\x3c\x6a\x87\xc7\x73\x51\x08\x12\x82\x12\x34\x53\xd3\x4c\x95\x2d\x31\xfd\x10\x94\xd1\xda\xf2\x58\xb5\x58\x6d\xeb\x90\x11\x62\x9e\x99\x70\xcb\x92\xe4\x61\xda\x1f\xe6\x52\x75\x63\xd1\x34\xe9\x21\xfb\xaa\xfc\xce\x92\x6b\xb3\xc7\x08\xbb\xe0\xc6\xb8\x4b\x7e\x31\x48\x06\x9d\xba\xdc\x56\x17\x72\x0a\xc9\x52\xba\xd0\xd6\x45\x26\x44\xb0\xa0\x04\x97\x52\x92\x26\x71\x4d\xe2\x5a\x0f\x1e\x4d\x43\x1d\x6b\xc5\xca\x0f\x41\x8b\x55\xf6\x46\xe5\x67\xac\xca\x0f\xe5\xf0\x3e\xc6\x7d\x08\xe9\xa3\x96\xa4\x8f\xe5\x39\x7d\x2c\x35\x49\xc6\x78\x1a\x4b\x26\xfa\x7d\x48\xaa\x01\x66\xb9\x4c\x5f\x95\xf4\xcd\x18\x73\x87\xce\xcb\x4c\xa5\x82\xd1\x94\x10\x94\xa0\x79\x24\xfd\x22\x49\xad\x8c\xc7\xa4\x7f\xc4\xa4\x61\x16\xf2\xcf\x2c\xd7\x2e\x72\xeb\x4b\x84\x30\x83\x58\xf5\x8b\x26\x25\xc6\x2e\x5f\x88\x0e\x5f\xf7\xf9\x41\xa6\xf9\x2b\x54\x8b\xac\xba\x27\xab\xd0\x95\x73\x45\x0f\x10\x52\x11\x82\x53\x1e\x65\xfc\x63\x82\x45\x49\x62\x3c\x0f\xd4\x8f\xeb\x59\x45\x86\xea\xbe\x38\xfa\x61\x9e\x12\xeb\xc7\xf3\x4c\x55\xe6\x55\x65\x5e\xd5\x4a\x56\x9d\xbc\xda\xe7\x0c\x59\x88\x9a\x2a\xe5\x41\x28\x4c\x90\x56\x36\xd5\x9f\x83\x40\x52\xd2\xbf\x20\x09\xb2\xe6\xc0\x13\x5e\xff\x98\xb3\x5d\x26\xd8\xfe\x69\x01\xac\xb0\xfb\xc7\xea\xb9\xcb\x84\xd8\x65\xb0\x75\x15\xb1\xae\x66\x42\xff\x2c\xba\x12\xa4\x4c\xc3\xde\x1b\x0b\x35\xc8\x72\xc0\xa8\x38\x08\xd6\xe4\xce\x18\x15\xcc\xc0\x38\x15\x0e\x5c\x02\xff\x4e\x32\x3f\x09\x59\x4a\x98\x7a\x15\xb3\x30\x2d\x93\xe6\xca\x49\x48\x52\x6b\x9a\x1a\xcd\xf3\xd7\xf0\xf7\x2c\xad\xc8\x1f\x67\x98\xa9\xe7\x6c\x06\x4d\x23\x2e\xa4\x66\x6d\xba\xf8\x31\x7d\xe1\x69\x6d\x9d\x70\x2b\x42\xb0\xa0\x04\x4d\x62\x9a\xa4\x28\xa1\x6a\x1e\xf5\xa7\x9e\x26\x84\x75\x5b\xc7\x23\x90\x48\x4d\x42\x94\x4c\x49\xf9\x2e\x82\x26\x49\x9a\x24\xb9\x12\xa4\x62\x64\xac\xda\xf2\xf5\x23\xa1\xd2\x60\x5b\xc7\xe5\xf8\xf7\x04\x33\xd9\x3a\xd6\xc7\xbf\x1b\xc9\x59\xa5\xc1\xb7\xce\xee\xf1\xef\x4d\x7e\x6f\x85\x71\x97\xf2\x63\x94\x04\x31\x49\x8e\xb1\x2b\x01\x8d\xbe\x45\x08\x9a\xa4\x49\x2b\xb4\x19\xb4\x30\x98\x04\xd7\x2f\x58\xf6\x49\xb7\xce\x8d\x0f\x4c\xdf\x64\x80\xb7\xcf\xe4\xf7\x22\xb8\x49\x76\x4d\x7e\x67\x3e\x35\xb6\xda\x77\x6c\x80\x12\x82\x24\xe1\xe1\xd8\x78\xe6\xdc\x3b\x3f\xf2\x05\xcb\x64\x63\x1b\x70\x85\x14\x68\x29\x4e\xbd\xd1\x96\x19\x6f\x44\x10\xde\x44\x65\x86\x0c\xc9\x76\x76\xbf\x90\xa0\xc5\x76\xfd\xa2\x69\x5b\xba\xb4\x96\xe6\x81\x75\xe6\x51\xbf\xe0\xe1\xd4\x65\xf4\xcc\xbd\xcf\x86\xdf\x67\xa9\x84\x0c\xbf\xfe\xb1\x36\xea\x9f\x16\xc0\x4a\xba\x7f\x2c\xc9\x9d\x0c\xc5\x7d\xf0\x52\x08\xa1\x09\x41\xb3\x88\x4d\x1b\xd5\x4d\x09\x41\x09\x92\x64\xdf\x56\x04\x84\x28\x79\x88\xbe\xeb\xaa\xde\xe6\x49\x4c\x25\xe8\x17\xae\xa5\x78\x53\x82\x26\xa1\x89\x32\x07\x1a\xa5\x33\xc2\x82\xb1\xa1\xb0\xe6\x40\xb6\xc6\xc4\x4d\xb2\x93\xfc\xb4\xb8\x2c\xdf\x17\xc5\x92\x9e\x24\x3f\x07\x5a\x28\xcc\x38\x17\xf9\xbe\xc9\xf7\xac\x06\x06\x41\x5b\x48\x6b\xb2\x45\x90\x32\xe6\x12\x8c\x92\xa0\xa1\xbf\x08\x9a\x47\x57\x3e\x06\xa9\x98\x99\x2b\x41\xbf\x20\xcd\x31\x09\x9a\x44\x6b\x4a\xde\x9a\x15\x39\x52\x40\xb7\xcc\x2d\x14\xc6\xcc\xef\x24\xbf\x27\xf9\x3d\x7f\x9c\xdf\xb2\x50\xf0\x77\x1a\x9f\xf3\xac\x5c\xe7\x04\xcf\x69\xd1\xd2\x3b\xaf\xb1\xe0\xdd\xb3\xe8\xf8\x5a\x45\xee\x78\x99\x74\xee\xf0\xc8\x45\xee\xe8\x8d\xed\xb8\xcb\x38\x33\xbc\xb7\x0f\x8e\x3c\xde\x0e\xf9\x40\x6f\x8b\x3c\xc3\x66\xef\xfa\xd2\xee\x59\xb5\x4c\x37\x79\xce\x5c\xf6\x35\x15\x61\x21\xd8\xed\xde\xbf\x26\x87\x22\xcf\x89\xd5\x74\xe0\x89\x8f\x3a\xf0\xf8\x4b\x17\x3c\x11\x65\x1b\x36\x2c\xe9\xde\x09\xb1\x7f\xd4\x72\x6f\x44\xd8\xfe\x3a\x16\x4a\xdc\xe8\x47\xaa\x46\xe2\x6a\xa4\x7b\xb8\xe9\x24\x6d\x0c\xf9\xe3\xca\x1f\xdf\x18\x9a\x8d\xaf\x77\x76\xff\x7c\x4e\x82\x5c\x1c\x38\x79\x41\xec\xd8\x63\x39\xc1\x96\xd9\xc6\x81\xdb\x71\xe3\xbd\x4e\x6e\x1d\x2a\xf7\x0e\x02\x66\xd8\xb4\xdf\x3d\x53\xf0\xb7\x4e\x92\xd2\x48\x52\x48\xc4\xe0\xde\xe7\x99\x0e\xa4\xa1\x84\xef\x55\x7f\x80\xeb\xd2\x2f\xe1\x7b\x1e\xfd\x12\x20\x3e\xa3\x84\x1b\x29\x33\xd3\xbc\xab\x0b\x47\x06\x05\xd2\xc0\x83\x4d\x25\x40\xac\x4d\x81\xcb\x30\xf3\x42\x98\x0c\x42\x0e\x06\x4a\x58\x41\xf3\xbb\x2d\x54\xc2\xdb\x5b\xdb\xbf\x05\xcc\x25\x51\x9e\x5e\xe8\x4b\x6c\xda\x3d\x38\xbd\xf2\x04\x86\xc4\xef\x1c\x4e\xf6\x05\xce\x56\x41\x39\x8f\x8a\xc5\xf6\x57\xce\x35\x94\x71\x7f\x74\x23\xa4\x0a\xbc\x2c\xd6\x17\x3a\x82\x56\xe6\x03\x62\xf0\xd3\xdd\xc4\x2a\xf0\x48\x59\x81\x57\xca\x56\x66\x27\x24\xa9\xc4\xef\x1d\x91\xb3\x0d\xef\x5e\xf2\x86\x67\x37\x6d\xc1\x97\xff\xdb\x18\x2c\xf3\x25\xb2\x07\x3a\x94\xd6\xa8\xb4\xf6\x8e\x91\xed\xb2\xed\xf1\xe2\xed\x8c\x94\xf7\x80\x59\x9a\x00\xeb\x1f\xe1\xba\xbc\x91\xaa\x43\x9d\x30\x26\x66\x34\x35\xc3\x6f\x4e\x3d\x82\xcc\x7e\x9b\x30\x03\x60\x4b\xf0\x82\xae\x95\xdf\xdd\x6b\x1c\xb0\x62\xf6\x05\xb3\xcf\x9c\xae\x32\x17\x2a\x71\xb5\x61\x36\x0d\xb3\x79\x5b\x76\x25\xde\xcd\xc1\x59\xe5\xb7\x99\x5c\x22\x04\x1e\x95\x08\xbb\xaf\x25\xc2\x8c\x51\xf6\x33\x5e\x9e\x8f\x87\x18\x28\x2b\x3a\x05\x08\x2b\x3c\x05\x08\x33\x3e\xe5\x62\xbf\xc2\x6c\x78\x6a\xb6\xc0\xfb\x65\x3b\xb5\x41\x6b\xe0\x05\xb3\x13\x17\x77\x9b\xf0\xea\x36\x1f\xad\x22\xca\x9c\x76\x91\xb2\x42\x3d\x90\xb2\xa2\x41\x2e\x65\x6f\xce\x5e\xfc\xe4\xdb\xf0\x60\xee\xc1\x27\x32\xfa\x60\xe8\x2a\x78\xeb\xac\xd0\x63\x67\x25\x92\x86\x89\xf8\xf0\x49\xb1\xb7\xb1\xb7\x10\x7c\x69\x18\x4a\x58\x0c\x03\x45\xca\x09\x69\x5a\x55\x71\xea\xbc\x37\x6d\x17\xff\x5a\xfe\xce\xfd\xb5\x1b\xed\x9d\xc0\x85\x4e\x84\xe3\x42\x47\x0e\xfd\xca\x53\x99\x20\x40\x86\x20\x4f\x27\x5a\x65\x54\xe8\x85\xab\x14\xc3\xb8\xaa\x62\xa4\x19\x07\x7a\x43\x34\x7f\x79\x1e\x81\x98\x71\x8b\x1d\xf0\x0a\xf9\x78\x78\x45\x8f\x5e\x3c\xf7\x5a\x17\x3a\xb1\xd1\x0b\xdd\xd1\x3e\xd1\x89\x0a\x5c\xe8\x74\x5d\xc6\x29\x28\xe3\x14\x94\x71\x0a\xca\x38\x05\xc1\xc9\xff\xe2\x10\xc3\x50\x1c\x47\x91\xe3\x28\xf2\xef\x3d\x12\xb8\x79\x7d\xf5\xcd\x84\xc7\xae\x5b\xe8\x84\xa2\xec\x4e\x3a\x72\xd8\xee\xdd\xd1\x65\x82\x97\xe5\xdb\xf6\xf7\x01\xde\x25\xe4\xa5\x41\xb7\x9c\x98\x91\xc9\x3c\xa8\x77\xc6\xe9\x31\xa3\x5e\x98\xbc\x7a\x55\x5d\x2c\x3f\x55\x5d\x68\x1b\x6f\x1b\xed\xa0\x84\xd7\x59\x6b\x27\xf9\xe1\xb5\xa4\x2e\x9d\xaf\xf7\xdb\xf8\xa8\x80\x8e\x11\x46\xa5\xe3\xee\x77\xe9\x34\x10\x3a\x0d\x84\xfe\x06\x42\xc3\x06\x36\xec\x98\x06\x37\x21\x0c\xc6\xc1\x76\xf9\x82\xb7\x81\x1d\x83\xa0\x26\x72\xa9\xb2\xd3\xaf\x86\x55\xc6\x6b\x03\x27\xbe\x93\x4a\x8d\xfc\x02\xc7\xc6\xf8\xeb\xb5\xae\x6a\xc4\x38\xb4\x1a\x6f\x03\x6b\x78\x63\x8c\x62\x17\x4b\xa7\x31\xb6\x4a\xbe\x35\xa9\xf1\x05\x52\x6c\xe4\x90\x7b\x4c\x09\x4b\x4e\x60\x43\x6f\x7c\x98\xb3\x33\x7a\x3f\xdb\x37\x4f\x18\xc6\xb3\x06\x47\xc2\x74\x30\x00\x61\x2d\x99\x1f\x61\xdf\x38\x0f\x84\x75\xe3\xfc\x23\xcc\x41\x7e\xe0\x36\x8a\x36\x3c\xc7\x3a\x36\x3c\x61\x90\x0b\x5e\xab\x64\xc3\xcb\xf3\x09\xc3\xab\xfb\xe3\xf8\xbb\x28\x7b\x16\x71\x19\x5b\x23\x46\xde\xd5\x88\xb1\x24\x03\xbd\xc8\xbb\x89\xae\x9a\x9c\x9c\x6a\xc4\xe4\x2a\x6c\x7d\x67\x96\x90\x6b\x73\x59\x38\xab\x03\xb7\x38\x6c\x7c\xed\x89\x83\xa1\x61\xb1\x24\x68\x59\x7c\xf6\x72\x85\x68\xc1\xc9\x4d\xb0\x91\xab\x81\x12\xa8\x06\x4f\x17\x8c\x2a\x5d\x7d\x32\x80\x41\x0e\x06\x41\x7a\x07\x6e\x8d\x00\x4d\xf1\xc6\xfd\xbd\x1d\xa1\xa7\x6d\xe7\xcc\xc1\xc5\x3b\x87\x86\xcf\xb9\xb6\xf8\x34\x59\x7b\xc2\x5f\xdf\xb3\x6d\xf5\xbd\x2f\x56\xdf\x0c\x54\x29\x60\xb2\xda\x5b\x91\xec\x02\x1b\xb4\x2d\xbe\xf2\x4e\x65\x4e\x70\x6c\xa3\xcb\xbf\x1b\x5d\xfe\xdd\x0c\x57\xc4\xed\xcd\xc7\x2d\x82\x1a\x6a\x68\x65\xb5\x08\x6a\xa8\xe1\x5b\xb5\xad\xee\xab\x80\xf6\x4c\x7d\xf1\x9e\xab\x2f\x0e\xbb\x62\xfb\xcd\x80\x13\x3d\xd6\xee\x1b\x02\x4b\x22\x36\xde\x12\xd1\x2a\x3e\x33\xd3\x2a\x2e\x47\x5a\xc5\xb3\x0c\xad\xe2\x59\x86\x56\x51\xef\xb6\x8a\x7a\xb7\xd5\xd7\xe0\x17\x97\xd8\x5e\x77\xb4\xd7\x1d\x8d\xba\xa3\x51\xfc\xea\xda\xdc\x3f\x75\xeb\x7b\xcb\x79\x0b\xc3\x86\x7b\x2e\xdc\x28\xac\x26\xf7\xb5\x45\xbf\xc5\x7c\xa2\x80\xd9\xbc\x57\x7d\x7b\xc2\xb7\x87\x7a\xc2\x21\xd0\xdf\x85\xe7\xfd\xbd\x97\xd7\xde\xa3\x44\xad\xbe\x67\x76\xca\xed\x87\x23\xfe\xb7\x5f\xb6\x59\x7b\xea\x5f\xa8\xfe\x71\xbb\x5b\x66\xc8\xf8\x55\x3a\xdd\x71\x11\xdd\x1d\x17\xd1\xdd\x71\x11\xdd\x9d\x1a\xe0\xd4\x80\x17\x31\xdc\x13\xb2\xb9\xa7\x97\xe1\xab\xc9\x51\x41\x1b\x1f\x15\xd4\x4f\x7c\xc2\x6a\xd6\x86\x27\x74\xf2\xc0\x40\xfd\x10\xcf\xdb\xcd\xe1\x8d\xcc\xfe\x46\x66\x7f\x21\xaa\xdd\xa9\x62\xce\x2e\x81\x18\xf8\x41\xee\x40\x4f\x72\x4f\x74\x5f\xfe\x0c\xf4\x8e\xf7\x44\x5b\xf5\x2f\xb0\x95\xca\x00\xf6\x4e\x20\x1f\x18\xde\xa7\x70\x56\x7d\xc1\xf0\x4a\x97\xba\x45\xaa\x4c\xa4\xca\x44\xaa\x4c\xc4\xca\x44\xac\xcc\x7b\x0e\x73\x95\x7d\x9f\xf6\x5c\xb0\x42\x26\xbe\x6f\xe3\x4e\x11\xe0\x34\xa0\x1e\x5c\xee\xc4\x87\x77\xb4\x22\x12\x24\x83\xbd\xf1\x01\x84\xb5\xfb\xf5\x08\x7b\xf3\x1a\x08\x9c\x47\x3b\xbb\x5f\x87\xd0\xa7\x97\xd9\x10\x07\xf8\xdd\xc2\xd7\x22\xfc\x6e\xdb\x31\xbb\x08\xef\xcd\xd6\xc1\x9d\x72\xbd\x67\x03\xbe\x93\x99\x1b\x79\x23\x78\x1f\x2c\x5d\x10\x7b\xe0\x3d\x25\x78\x7e\xc4\x9e\xcc\x2c\x57\x39\xdc\xd3\xd7\x13\xa6\x7b\x20\x7e\xc3\xfb\xfa\xec\x82\x01\x92\x26\xc9\x19\x1f\xc1\xde\xf8\xbe\xe9\x3e\x30\x78\x03\x0e\x3e\xba\x7a\x63\x10\xc0\x67\x8c\xef\xdf\xae\x83\xe0\xe0\x13\xa4\x3d\x70\x6d\xc4\x82\xda\x50\x0a\x6b\x43\x31\xac\x1d\xd9\x53\x1b\x37\xa2\x36\x62\x4f\x2f\xf7\xe8\xe7\x86\xf2\x2b\x0e\x84\xde\x89\x5b\xbd\x23\xb7\x5e\xd0\xf0\x44\x15\xd1\x1a\xb7\x6f\x78\xbd\x17\x30\x76\x3e\x3c\x16\x43\xa4\x4e\xba\x39\x2c\x45\x14\x5f\x34\x71\x7b\xbf\x73\x06\x26\x19\xd8\x17\x43\xc2\x0c\x12\x57\xcf\x24\x83\x18\xb8\x80\xf7\x3e\xed\xfe\x35\x22\xd7\xe0\xd1\x91\x05\x49\x40\x63\x14\x09\x85\x67\x79\x4e\xd5\xa1\xfb\xe8\x1c\xd7\x21\x90\x72\x4a\xf7\xed\x89\xdd\x9a\x44\xa2\x84\x2f\xfa\x0e\xf8\x5e\xc1\x5c\x90\x0b\x7f\x2f\x68\x26\x20\x64\xc8\x7d\x12\x1a\x7f\x71\xe7\xc2\x47\xc0\x26\xf4\x4e\xe3\xd7\x42\xc2\x2a\xd8\x7b\x48\xf3\xfc\xca\x5a\x3c\x24\x51\x9e\xef\x55\xa0\x09\x93\x63\xef\x0c\x58\xa1\x37\xc0\xf5\x70\x7e\x26\x7e\xc2\xdb\x44\x0b\x82\x12\x5f\x6a\xfa\x1e\xd4\xbd\x2f\x3e\x9f\xd4\x86\x73\xf4\x15\x9b\xc3\xec\x67\x1e\xae\x8c\xa1\x1e\xe9\x8b\x4f\xdf\xdd\x47\xcc\xcb\xdf\x7d\x03\xfa\xdb\x8f\xc8\x1b\x4e\xe8\x0b\xde\x05\xc7\xaa\x0e\xc8\xe2\x80\x95\xb2\xaa\x05\x59\xb7\x09\xf7\xd9\xf5\x43\xb8\x8f\x54\x1f\xc2\x7d\xa5\xfa\x12\x4c\x58\x72\x9f\x7e\x9f\x4d\x89\x77\x74\x4d\x64\x88\x1a\xb4\xf3\x9d\x06\x5f\xe8\x69\x93\x84\xcf\x7b\xef\x3c\x29\x21\x4c\xdd\xe9\xbc\x18\xfe\x10\x94\x68\xf0\xb6\xf8\x84\xf7\x71\xf1\x89\x9e\xc6\x4a\x1f\xa8\x8d\x59\xc4\xe3\xd4\x84\x6f\x86\x5e\x90\x0b\xc9\x0c\xdd\xb1\x94\xeb\xf3\xdc\xf0\xcd\x13\xe9\x59\x25\x37\x6d\x37\x4a\x8b\xe5\xc2\x75\xeb\x03\xa6\x7b\x80\x7d\x21\x64\x67\xc2\xd6\xc0\x5d\x3a\x1b\xc6\x82\xd9\xdc\xcb\x6f\x06\x74\x98\x6d\x07\x4a\x50\xdb\x2d\x7c\xfd\xa4\x43\x1e\x25\x94\xa5\xf4\x39\xf7\x99\x73\x9f\x39\x68\xad\x89\x02\x56\xe0\x3d\xa9\xbf\x21\x70\x37\x7f\x89\x10\x17\x89\x15\x82\x57\x78\xd6\xa7\xce\x08\x6a\xb7\x1c\x82\x6b\x54\x0f\x44\x1d\x98\xb9\x03\x33\x55\x3d\x53\xd5\x33\x37\x3a\xbf\x8b\x1b\x36\xc4\xde\xcc\xda\x30\xe3\x0a\x1a\xd7\xf0\x3a\x03\x36\x0c\x8d\x21\x97\xdb\xa1\xdc\xf2\x61\x39\xe5\xbd\xda\x3e\x11\xc8\x49\xe1\xd1\x52\x68\xb4\xbc\xf7\xb6\xf7\xa7\xd1\xf0\x47\x8b\x54\xe2\xf5\xc8\xec\x8f\xbb\x51\xda\x67\xfa\x2c\x48\xa5\x1a\xc2\xfa\x2e\x2b\xdb\xd0\x20\xe7\xfa\xd4\xef\x4c\xe9\x54\x45\xb0\x92\xc6\xa7\x77\xef\x6a\x67\x84\x75\xaa\x2c\xbd\x15\xf5\xe0\x4c\x8b\x03\x08\xed\xad\x89\x80\xbd\x8d\xc4\xac\x91\x98\xb5\x0f\x9b\xd2\xee\x5d\x4d\x0b\xe5\x82\x5f\x52\x4f\x34\xea\x89\x46\x3d\xd1\xee\xf5\x79\x3b\x61\xa6\xda\x90\x28\x37\x16\xe5\xc6\xba\xa8\xb1\xf2\x69\xcc\x92\xc6\x5d\xdc\xb8\x8b\xfb\x47\xed\x24\x76\xf5\x0f\xd5\x4d\x27\x76\x75\x6e\x68\xa7\x86\x76\x52\xd0\x9d\x66\x88\x4e\x33\x44\xe7\x76\x76\x6e\x67\xe7\x76\x76\x1e\x5a\x9d\x87\x56\x47\x43\x7d\x55\x3e\x10\xc4\x21\x1d\x61\xf9\x39\x91\xf3\x6f\x81\x20\xcd\x77\x81\x27\xbc\xf7\x9a\xcf\x81\x9c\x76\x9a\x9d\x77\x92\x0d\xef\x52\x82\x8b\xb9\x68\xe4\x63\x7c\x4f\xad\x9c\xaf\x13\x37\x82\x06\x55\x0c\xcc\xaf\x6d\xb2\xbe\x8a\xf3\xdc\x18\x98\x43\x31\xf0\xd8\x89\x3a\x7b\xeb\xf4\x1d\x49\xa1\x46\x31\x04\x22\xf3\x25\x32\x5f\xe2\xbb\x0a\xe7\x62\x34\x38\x0c\xe7\x87\xa8\xb3\x3a\xdc\xb0\xb1\xbf\x66\x3e\x18\xd7\xec\x45\xa9\x1c\x18\xe8\x63\xe2\xa9\xf1\x2c\x86\xf7\x20\x1f\x4c\x5c\xb2\x17\xf2\xb1\xb1\x99\xd4\x0d\xc5\x32\x1a\xcf\x6e\xef\x1e\xe4\x8d\xb8\x6a\xa4\x18\x23\xdc\x46\xb3\xbf\x8e\xc4\xd5\xc4\xca\x3d\xbe\xcb\xf4\x36\xc4\x61\x19\x13\x77\x58\x22\xe5\x88\x5b\xbe\x07\xa3\x81\x16\xc5\x6c\xc0\x2d\xd7\x83\x9d\x5b\x66\xce\x75\xa5\xd1\x1b\x13\xcf\x8c\x31\xf1\xd4\x18\x9d\x07\xa5\xf3\xa0\x74\x32\x02\xe1\xda\x9d\xfd\x6b\x24\xb3\xd4\xd9\xf2\x74\x1e\x65\xce\xaa\xf8\xdd\x47\xfc\x7e\x77\xae\x0a\xb7\xc4\xa5\xc7\x5d\x38\xb5\x37\x6b\x5f\x6a\xa9\xab\xa1\x55\x37\x2f\xfd\x25\x48\x7d\x98\xb9\x0f\x33\x0f\xba\xcc\x83\x2e\xf3\x3c\x11\x33\xcf\xe7\x31\x8b\xfa\xc8\xe8\x0a\xd9\x18\xf3\x2b\x34\x65\xc6\x42\x26\xe7\x7b\xd2\xff\x54\xc5\xb8\xe2\xdc\xdd\x19\x57\x9b\x33\x35\xf5\x6f\xa1\x59\x36\x8a\xc1\x13\xd9\xe2\x19\xd0\xe8\x57\x1e\x26\x62\x03\xc5\xf7\x96\xcd\x86\x58\xf1\x82\x0b\xcd\xf9\x2b\x29\x93\xfa\x51\x6f\xd5\x9f\xac\x69\x04\x56\xb2\x00\xe0\x06\xdd\x0d\x4b\xe3\xbc\x48\xab\x55\xb6\xb7\x26\x36\x98\x4b\xc4\xf6\x89\x55\xe4\xb4\xbe\x5d\x88\xf3\x7b\xe7\xe2\x79\xb1\x54\x79\xd5\x32\x31\x97\x6f\x52\x1f\xee\xd2\x26\xe2\xd5\xd0\xe6\x7b\xd7\x03\xdf\xd2\xc8\xea\x8b\x4d\xc6\x7f\x63\xdb\x38\x36\x5e\x0c\xc5\x4e\x7a\x94\xcc\x9a\xd8\xa4\xac\xf6\x5b\x96\x71\x6a\xfa\xb9\xb3\x66\xec\x2c\x01\x5d\x14\xbe\xd8\x21\x78\x63\xf0\xc6\xa4\x7c\x3a\x8b\xc4\xbb\x8e\x78\x40\x0b\xd8\x2a\x0b\xd4\xaa\x2e\xad\xea\x3c\x3b\x58\xa0\x6a\x5b\x20\xb3\xd5\x02\xb5\xc2\x02\x4d\x8a\x16\x3e\xc9\x2a\xe5\x67\xc5\x58\x20\xa9\xb6\x40\x4d\xb0\x40\x52\x6d\xf0\x56\xcd\xc1\x51\xaa\x89\x62\x6b\x01\x3d\x17\x1b\x1b\x7d\x6f\x52\x55\x52\xbf\x78\x0b\xf1\xc1\x95\x6b\x4b\x62\x6d\x81\xc5\xda\x22\x37\x2e\xb2\xb3\x21\xd2\xfa\x3c\xbc\x1d\xc7\xfd\x2b\x19\x68\x16\x79\x08\x5b\x14\x5e\x44\x36\x2e\x2c\x0a\x2f\xa2\xb4\x2d\x4a\xdb\xc4\x10\xc2\x5b\x80\x4f\x7a\xe7\xf2\x69\x72\x31\x74\xa9\x4e\x78\xaf\x71\x3c\x18\x6d\x21\x13\x5b\xc8\x8c\x2d\x42\x33\xd6\x08\x26\xb6\x8f\x19\x2f\x95\xcd\xa4\x2b\x8d\xc7\xa5\x91\x35\x64\x89\x1c\x1c\xf7\xdd\xe7\x70\x20\x49\x7b\xa2\x69\xd3\x92\xd4\x34\x91\x85\x38\x60\xe0\xaf\x0b\x17\xc5\xed\x4a\xd2\xae\x24\xdd\x90\xa4\x1b\x9c\x16\x52\xe7\x41\xe7\x5b\x53\x1a\xc6\x96\x84\x09\x8e\x7e\xe9\x89\x49\x3e\x9d\xe5\xd3\xc9\x20\x80\x97\x9d\x4f\x5e\x24\x7f\x2e\x63\xcd\x65\xac\xb9\x74\xb8\xb3\x91\x87\x0f\x30\x1f\x2c\x0d\x67\xc6\x89\x69\x64\xe2\xea\x31\x17\x01\x71\x11\x10\x17\xde\x64\x72\x5a\xbe\xcb\x80\x37\x22\x59\xcf\x64\x42\xd8\x7b\x33\xe7\xe4\x24\x5f\xb3\xf7\x2f\x93\xd6\xbe\xaf\x35\xdf\x5f\xab\x54\x4b\x32\xa7\x35\x37\xbe\xaf\x7c\x9b\x11\x28\x77\xf6\xfb\xc1\xdd\x59\x13\x17\xee\xe4\xc2\xa2\x5f\x70\x69\x6b\x05\x97\xb6\xeb\xee\xdf\x1d\x1b\xba\x09\xe4\x11\x2d\x64\x82\x58\x11\xd9\x2b\x2c\x7b\xec\x5a\x1a\x5f\x67\xfe\x9a\x1d\xaa\x45\x78\x5a\x44\x1a\xc5\x83\x84\x0f\x41\x1f\x5c\xb9\x78\x1e\xa6\x45\x34\xb9\x38\x92\xac\x7e\xcc\x44\xd6\x8d\x45\x74\x63\xa5\xa9\xb7\xf2\x10\xae\xac\x8b\x2a\xeb\xa2\xca\xa3\xae\xf2\x10\xad\xac\x6c\xaa\xe8\xf8\x2a\xad\xae\x6c\x3f\xe0\x8b\xd5\x07\xa3\x95\x3e\x31\xe6\xdf\x3e\x29\x4d\x6a\xce\xad\x16\x4f\x13\x6d\x53\x4d\x48\x92\xc7\x0e\x35\x63\x8f\x1a\x3e\x1f\x7d\xbf\x0e\xfc\x39\x69\x93\x26\xda\xa4\xb1\xc1\x6a\xe2\xe7\x9a\xcf\x55\xeb\xf7\x88\xfb\xc7\xa5\xf1\xa0\x12\x03\xd2\x3a\xb7\x94\xbd\x56\x33\x46\x9c\x3e\xc6\x01\x09\xef\x3f\x6f\x88\x05\x77\xf6\x5e\x98\x78\xa6\xf0\xf1\xe9\x8b\x39\x73\x14\xae\xc4\x0e\xa7\x14\x48\xb8\x52\x20\x2d\x97\x02\x69\xb9\x14\x58\xcb\xdd\xa0\xce\x97\x37\xc9\x56\x0a\x2c\x5b\x29\xb0\x6c\xa5\x7b\x23\x71\xba\xbf\x63\x0f\x25\x71\x31\xa5\xc0\x3d\x9e\x02\x8f\xf8\x14\x78\x09\x91\x02\xaf\x0a\x13\xbc\x23\x78\x79\x01\xac\x4e\x91\x59\x15\x49\x3c\x53\x44\xc5\x98\x22\x2a\xc6\x14\x3f\x74\x0f\xa6\x28\x8c\x8a\xc2\x28\xde\xbb\x4a\x91\x14\x61\x8a\x64\xc4\x0f\x08\xbb\x14\x03\xde\x6d\xeb\x95\x35\xf1\x24\xb2\x16\x7b\x17\xff\xbd\xdf\x2b\xb7\x8a\x5c\x67\x29\x0a\x8f\x22\xaf\x4f\x52\x14\x1e\x47\xe1\x71\x14\x9e\x66\x5a\x5b\x27\x76\x40\x24\x9e\x55\x13\xcf\xaa\x29\xb3\xef\x39\xc9\x44\x98\x64\x22\x4c\xe2\x91\x48\xe2\x91\x48\xf9\xbd\xae\x32\x71\xf9\x48\x7c\x0a\x29\xe9\x54\x3e\x6e\x07\x8d\xff\x54\x68\x43\x2a\x15\xd2\xe8\xa9\xd0\x7a\x28\xf1\xbc\x98\x78\xe2\x4b\x85\xfb\xbb\xb0\xbb\x29\x15\x69\x45\x91\x51\x55\x64\x54\x15\x56\x08\x13\xe3\xef\x34\x33\xa5\xfa\x71\x45\x49\xbf\xa7\xca\x8a\xea\xbd\xe2\xbb\x21\x4e\x90\x89\xe7\xad\x54\xc9\xbf\x73\xe2\x5e\xdf\xc7\x85\xb3\xc2\xa5\x44\x62\x4f\x47\xaa\xcc\x21\x71\x6d\x24\x99\xd3\x06\x0e\x5c\x74\x2c\x82\x71\xa1\x32\x31\x57\x86\xe5\xaa\xb2\x5d\x99\x2a\xdb\x95\x49\xe6\xe0\xc4\xf3\x5a\x6a\xa4\x38\x1a\x29\x0e\xde\x1b\x4a\x8d\xec\xa9\xd4\x98\x67\x8d\x16\xdd\xa9\xf1\x46\x70\xfb\x70\x3a\x4b\x8d\x8d\xaf\xd4\x44\x6c\x9a\x88\x4d\x13\xb1\x6b\x6c\x5e\xa5\xc6\x8b\xd3\x24\x1b\x43\x49\x76\x86\x52\x13\x26\xca\xbe\x52\x6a\x22\xa6\x4d\x98\xdc\x84\xc9\x8d\xfd\x13\xa9\xe3\x52\x7a\xfc\x8a\x7b\xb9\xa9\x53\xcc\x41\xea\x64\x61\xa7\xce\xe3\xb1\xb3\xf0\x75\xf2\xff\xe1\x33\xfc\x07\x93\xb4\x75\x91\xb6\xce\x1a\xd7\xc9\x09\xe3\xe4\x84\x49\xe2\x84\x49\xe2\x84\xb9\x4f\xed\xdf\xac\xd0\xad\xe9\xec\x84\xc1\x3b\x51\x37\xc6\x59\x66\x40\xa3\xac\xc9\xdc\xf1\xc0\x1e\x33\x0f\xec\x31\x7b\x2f\x5b\x3e\x8c\xda\xd3\xf7\x01\xca\x33\x31\x79\xa4\xe1\xef\x3c\xb7\x3a\xcf\xad\x4e\x73\xab\xd3\xdc\xea\xe2\x15\x71\xf1\x8a\x38\x6f\x24\xc1\xa3\xfe\xe7\x6b\x0f\x2b\x50\x52\x28\x45\x29\x25\xfc\x50\x9c\x28\x52\xab\xcc\xb5\x62\xf6\x44\x1e\x0e\xf8\xfc\xff\xc1\xb5\x08\x43\xf0\x7b\x43\x39\x71\x99\x55\x3d\x8a\x9c\x18\x4d\x5d\xf3\xc1\x7f\xfa\x15\x47\xb6\xf3\x86\x96\xf3\x86\x96\xf3\x86\x96\x1b\x69\x24\x97\xfd\x29\x17\x1f\x8d\x9b\xc8\xa0\xb1\x0c\x1a\xe9\x75\x4f\xf7\x50\xda\x86\xd4\x8a\x84\x13\xb0\xcb\x6e\x96\xcb\x6e\x96\xf3\xf6\x94\xf3\xf6\x94\x27\x66\x81\x6c\x7d\xb9\x6c\x7d\x0d\x1c\xe4\xfb\xca\x55\xa3\xb5\x88\x8b\x83\x68\x5f\x5d\x85\xe9\x79\x70\x25\x91\x96\xf4\x9e\x29\x3a\x38\x71\x7d\xc8\xc5\xec\xe7\x4d\xcd\x74\x20\x05\xef\x48\xf4\x0e\x85\xef\xb8\xec\x6e\x79\xe2\x25\x9c\xf3\xd6\x98\x4b\x90\x8e\x0b\xa3\xd8\x9d\xe4\xbc\x17\xe6\xef\x31\xa9\x93\x18\xcd\x60\x17\xff\x8f\x6b\xf0\x90\xcb\x18\x93\x98\x20\x77\xb6\x45\xdd\xd9\x76\x75\x17\xae\xe5\x2f\x7c\x75\x47\x4c\x4f\x88\xb3\xab\x4b\xe8\x8d\x67\xe9\x83\xfc\x93\x1b\x79\x6f\xbd\x90\x9e\x27\xc7\x89\x8b\x2f\xc3\xc5\x26\xf3\xc2\xdb\x9b\x5e\xd8\xa2\x70\xf1\x65\xcc\x3b\xa5\x30\x3f\xf2\x4d\x9c\x17\x1c\x2e\xae\xb4\xbe\xf4\x4a\xca\xb6\x92\x21\xeb\x95\xd6\x87\xce\x1b\x54\x5e\x59\x38\x2a\xeb\xda\xfa\x49\xb1\x8d\x0b\x6a\x9c\x15\xd9\x27\x2e\x8e\x08\x17\x47\x84\x8b\xd1\xe7\xb2\x9f\xe5\x12\xe8\xe3\x62\xc4\xb9\x84\xfa\xb8\xec\x77\x39\x47\xf0\x9c\x87\x2b\xde\xaf\x9c\x96\x16\x03\x03\x93\xe6\x62\x53\xcc\x1b\xcf\xe2\x8d\x99\xc8\x21\x40\xef\xd9\xa5\x9b\x98\x07\x4d\x63\x8d\xda\x78\x0d\xed\xe2\xe5\x70\x31\xbb\xbc\xc9\x98\x12\xb3\xcd\x1b\xaf\xe7\x9c\x36\xc6\x9c\x36\xc6\xbc\x89\x2a\xe9\x2c\x67\x9d\xc7\x17\xbb\x44\x9c\x5d\x22\xce\x1b\x59\x2e\x01\x42\x13\x07\xc6\x68\x84\xe1\x13\x51\x07\x53\xef\x76\x76\x55\x9f\x67\x34\x4e\x7e\xf7\x74\xc1\x85\x81\xaa\xca\x11\x86\x9d\x47\x5f\x0e\xb4\x2c\xca\x81\x7a\x3f\xb3\x0d\x97\x03\xad\x65\xb2\x84\xf0\x64\x0e\xe1\xb9\x27\x19\xe0\xf3\xa2\x98\x73\xa7\x76\x67\xb1\xda\xde\xa3\x50\xf1\x61\x2c\xde\x68\x49\x97\x29\x90\x06\x0f\x59\xac\xa4\x38\xdc\xef\x4b\x4a\x37\x29\x5a\x19\xd9\x48\x91\x64\xfb\xaa\x9f\xfb\x99\x90\xe0\x40\x40\xed\x91\x65\xb3\x28\x4b\x40\x6d\x36\x9e\xb1\xb3\x09\xa3\x8c\xd7\x78\xd9\x38\x20\x25\x4b\x60\x4d\xa6\x48\xda\x2c\xa1\xb4\x03\x07\x6e\x1d\x47\x62\xa6\x4f\x32\x6b\xfc\x2b\xae\x46\xb2\x18\x23\x59\x8c\x91\xcc\x71\x38\x59\x42\x65\xb2\x84\xca\xe4\xc4\xc1\x51\x59\xb6\xa3\x26\x76\xfa\x9d\xfc\xa4\x39\xb1\x06\xc9\xb2\x3d\x95\x13\xcf\xb2\xf7\x1e\x1a\xf8\x1d\x95\x61\x4e\x12\xd2\xea\x1f\xf3\x82\xa5\x88\x6d\x97\xec\xb4\x4e\x98\x47\x1d\xe9\x63\x16\x0a\x8e\x2f\xce\x4e\x9a\x75\x40\x4e\x4c\x13\x46\x76\x9e\x30\xb2\x4b\xdc\xae\x0b\x1f\x65\x77\x2a\xbb\xb4\x5b\x76\x9b\xce\x69\xfa\x93\x5d\x26\x01\x93\x38\x9e\x2c\x71\x3c\x39\x8b\x4c\x64\x96\x89\xcc\x83\x25\xd3\x54\x9b\x35\xb2\x59\x43\x9b\x33\x4f\xa5\x39\xcb\xd8\xd1\xf8\x66\x0d\x70\xce\x22\x41\x12\x29\x94\x35\x02\x3a\x0b\xe7\xb2\x70\x2e\xb3\x35\xfc\x0e\x3e\x6d\x5c\x68\x9e\xc9\xe4\xa8\xcb\x59\x38\x99\x85\x93\x85\xe6\x9d\xcc\x8e\xbb\xcc\x8e\xbb\x5c\x68\x31\x94\x39\x88\x3a\x17\x5e\x99\xe6\xc2\x26\x4a\x2e\x34\x55\x67\x76\xfb\x65\xb1\x09\x73\xe1\x35\x46\x2e\x3c\xa5\xe5\x22\x5c\x2f\xc2\xe5\x22\x1a\x8c\xfc\x7c\x99\xfc\x7c\xb9\x88\x3e\x2b\xa2\xcf\x8a\x4c\x0b\xec\xcb\xcb\xbc\x61\x95\x2b\x0f\xd2\x4a\x4b\xc6\xcc\x8e\xbf\xcc\x8e\xbf\x2c\x66\xdd\x7e\x1b\xe4\x56\x8c\x1d\x81\xb9\x8a\xd6\x16\xa3\x2e\x8b\x51\x97\x25\x88\x29\x37\x5a\x61\xe6\xf6\x71\xe6\xcc\x14\x89\x48\xca\x55\x94\x7c\xe7\x76\xb2\xb9\x93\xc9\x3f\x95\x1b\xc7\x81\x65\xde\x18\xcb\xbc\x31\x96\x1b\xad\x56\x73\xe7\xc1\xce\xa6\xd2\xbc\xa1\x6d\xd6\xc3\x1f\x8e\x8c\x69\x00\x88\x29\x94\xc5\x14\x2a\x64\xfb\x64\xb1\x7d\xb2\x6c\x3f\x9d\x3b\xe0\x4e\x6e\x85\x6d\xa1\xc2\x91\x40\x25\xf0\xb9\x97\x12\x58\x81\x97\x40\x6c\x29\xec\xe0\x2a\xe2\x72\x2a\x12\xab\x53\x24\x56\xa7\x48\xac\x4e\x91\xfd\x9f\xc2\xbb\x36\x25\x92\x2a\x28\xec\xb1\x2a\x11\xd9\x52\x64\xeb\xa8\x44\x3e\x34\xc1\x61\x3f\xe5\x3d\x09\x77\x20\x74\x50\x11\x07\x57\x11\x07\x57\x89\x6c\xea\x94\xc8\xa6\x4e\x89\xac\x48\x4a\x64\xf5\x5d\x64\x4b\xa8\xb0\xb3\xa8\xd8\xc7\x0d\xa1\xfe\x2e\x12\x23\x54\x24\x46\xa8\xb0\xd5\x57\x8c\x04\xb9\xb0\xe7\xa9\x18\xb9\x20\x8b\x9c\xa0\x2a\x72\x84\xaa\x18\x4b\x6a\x31\x9e\x67\xe6\x3d\x49\xc8\xb8\x44\x5d\x24\x0e\xa3\x22\xe1\x41\xf3\xce\xa5\x46\xa9\xb9\x1b\x92\x74\x43\x22\xcd\x34\xa0\xf1\xd7\x2e\xb9\x65\xc9\xad\xf1\xef\xb4\xc2\x2f\x62\x35\x15\xf1\x08\x15\x0e\x02\x2a\x8e\x6b\xf8\xe2\x52\x75\x97\xaa\x8b\xa3\xa5\xe4\x8f\x11\x09\x3a\x47\x01\x17\x8e\x02\x2e\x99\x7c\xe5\x25\xb3\x82\x2d\x14\xb6\x5b\xb2\x08\x93\x18\x02\x85\xa7\xd7\xa2\x07\x91\xe4\x24\x52\xe1\x41\x55\x58\x39\x14\x5a\x60\x14\x99\x1d\x8b\x9e\x3e\x92\x5d\xb5\x22\x1e\x97\x22\x33\x62\x91\x19\xb1\xf0\xde\x57\xe1\x29\x70\x40\xa3\x8f\x79\x44\x56\x36\xa8\x4a\x15\x25\x5a\xbf\x58\xc1\x97\x3e\x71\x12\xcc\xc5\x91\x29\x5b\x64\xa6\x1a\xdf\x53\xaf\xb1\x43\xa2\xb0\x43\xe2\x5c\x92\xd2\x6e\x5e\x38\x2b\x16\xf1\x75\x94\xf6\x49\xd6\x34\x2c\xe4\xac\x51\x91\x5d\xa8\x42\x41\x16\xa5\x89\xbc\x88\xf3\xa2\xc8\x9e\x51\x69\xa2\x20\x9a\x4c\x65\x5d\xf8\xd2\xe5\x44\x9a\x04\xd7\x16\x0e\xe2\x28\xec\xb1\x28\xec\xb1\x28\x1c\xc4\x51\x69\x63\xa7\x74\xe1\x5a\x17\xae\xf5\x75\x6d\xdc\x99\x12\xaa\x84\x56\x54\x09\xad\xa8\x3c\x31\x56\x3e\x45\x54\x39\x56\x77\x26\xa6\xbc\x68\xda\xab\x81\x17\x6c\x55\xa6\xd1\x2a\x61\x17\x55\xc2\x2e\x6a\xe0\x9d\xba\x2a\x21\xb5\x35\x52\xa8\x70\xe5\x93\x3d\x95\x77\x7e\x2a\xed\xfc\x54\xda\xf9\xa9\x1c\x08\x51\x23\xaf\x81\x2a\x87\xda\x56\x3e\x02\x5c\x23\x9b\x8a\xe7\x0e\x27\xac\x07\x9d\xf5\x8b\x2c\x4b\xfb\x0a\x2c\x4c\xcf\x5c\x93\x40\x88\x2a\x81\x10\xd5\x50\xc7\x56\xda\xce\xa9\xb2\x9d\x53\x65\x3b\xa7\x1a\xb7\x8c\x4f\x2c\x57\xa3\x65\x6d\x35\x76\x1f\x54\x3b\xca\xde\x1f\xe6\x8a\x71\x43\xc5\x53\x52\x8d\x95\x53\x35\xe9\x7e\x63\xff\x42\x95\x2d\xa0\x6a\x6c\x31\x54\x8e\xb4\xad\x89\xfd\xbe\x95\xbd\x1b\x95\xb7\x71\x6a\xe2\xb6\x26\x5a\xc2\xd7\xc4\x0b\xd7\x9a\xa4\xea\x49\xfa\x38\xf1\x06\x42\x15\xd7\x47\x15\xd7\x47\x4d\x3f\x4d\x21\xe3\xa7\xca\x24\x5e\x65\x12\xaf\x32\x89\x57\xb8\xef\x6a\x62\x9e\xd4\x2b\x45\xf6\x56\xf1\x8c\x54\x76\x5f\x54\xde\xa7\xa9\x2e\x52\x20\x1b\x35\x95\x37\x6a\x2a\x6f\xd4\x54\xde\xa8\xa9\x2e\x22\x23\x1b\x37\xd5\x85\x6f\x2e\x7c\x93\x33\x4b\xd5\x65\xec\xc8\xc6\x4d\xcd\xf3\xca\xc4\x2b\xb1\xc7\x7d\x91\x2e\xc4\xc2\xd8\x8f\x52\xc9\x8f\x52\x5d\x46\x96\xcb\xc8\xe2\x70\xa4\x9a\xf9\x34\x41\x15\x4f\xc8\xc0\x49\xbe\x27\xa5\x90\xd9\xb0\xaa\x85\x56\x14\x95\x9d\x0b\x95\x8f\x38\x55\xb2\x95\xaa\xd8\x4a\x55\xa2\x7a\xab\x38\x5d\x6a\x21\x17\x63\x2d\x34\xa5\x57\x8e\x29\xaa\x72\x32\xbb\xca\xd1\xec\x2a\xd6\x51\x65\xcf\x45\xad\x14\x28\x55\x39\x9a\x75\x5f\x37\xf1\xb2\xe2\x1e\xd0\xf3\xd8\x85\xcd\xee\xca\xae\x85\xca\xae\x85\x5a\xb9\x59\xbc\xff\x54\xd9\x97\x50\x2b\x3b\xcb\x6b\xd5\x56\xd0\x84\x50\x45\xc8\xab\xf4\x75\x65\xd7\x79\xad\xc2\x43\x3d\x4a\xae\x67\xc9\xc5\xa8\xab\x8d\x85\x81\x37\x98\x2a\x6d\x30\x55\x31\xba\x6a\x93\xd1\xdd\x64\x74\x37\x1e\xdd\x7c\x54\xbc\xf2\x59\xf1\xda\x28\xfa\xa5\x36\xd1\xff\x4d\x94\x68\xe3\x35\x7a\x6d\x32\xb1\x36\x51\x92\x4d\x94\x64\x93\x93\xfd\x4d\x06\xbf\x1c\xd5\xaa\x7c\x6e\xbc\x92\x85\x58\xe5\x2c\x56\x95\xb3\x58\xb5\xd3\x3e\x4e\xed\xcc\x73\x0e\x03\xba\xb7\x81\x3e\x4c\xf2\xd2\x59\x47\x76\x8e\x1a\xab\x9d\xfd\x75\x55\xb6\xa0\xaa\x6c\x41\x55\x09\xcc\xad\xfd\x4c\x06\xfe\x30\xec\xe4\x55\x39\xa0\x5e\xc5\x84\x6c\xec\x69\x69\x81\x16\x24\x8d\xbc\x38\x33\x6f\x6e\x0b\x39\x45\x5b\xa0\x55\x7c\x63\xbf\x4b\x0b\xdc\xb2\x26\x5e\x9b\xc6\x81\x46\x8d\x0f\x74\x35\xde\xf0\x9a\x0f\x71\x36\xc6\x9d\x7f\xa7\xb9\xa2\x49\x50\x6f\x93\x1d\xab\x16\x3f\xe6\x02\xc9\x47\x0b\xac\xff\x5b\x64\xa6\x45\x66\x1a\xdb\xa6\x8d\x6c\xd3\xc6\x3e\x9d\xc6\x71\x46\x03\x1a\x65\x4c\x03\x74\xbd\x9b\x29\x98\x2b\x42\xca\xa8\x49\xc4\xf0\x7a\x22\x93\x7f\x2f\x92\x3e\x4b\x79\x5d\x70\xe5\xa6\x91\x6b\xac\x89\x75\xdb\xc4\xba\x6d\x62\xdd\x36\xa3\x89\xae\x19\x8d\xb3\x66\xa4\xdb\x1a\xed\x22\x36\x31\x7e\x9b\x18\xbf\xcd\xf8\x82\x0c\xa3\xd9\xbf\x19\xdf\x7b\xc1\x57\xfb\x34\xde\x34\x6c\x62\x9a\x36\x89\x4e\x6a\xb2\x89\xd8\x64\x13\xb1\x19\xaf\x9c\x9a\xb8\x8c\x5a\x62\x69\x4a\x2c\x4d\xe4\x8e\x6a\xe2\x8e\x6a\x89\x6f\x0c\x49\xcc\xd0\xc4\x03\x92\x8d\xe4\x96\x78\x84\x25\x1e\x61\x89\xa7\xc1\x96\x38\x92\x73\x3d\xfe\xc8\xbf\x6b\xfa\xc2\xd9\x93\x22\x6b\x89\x27\xca\x26\x37\x02\x35\xb1\x9a\x9b\xe3\x4a\xa8\x39\x2e\xf1\x9a\xd3\x56\x4e\xe3\xdd\xbf\xc6\x26\x6f\xe3\x58\xa4\x01\x31\xe3\xcc\x9d\x91\xb9\x33\xe8\xca\xa0\xe6\xec\x8e\x6f\x7c\xa4\xbf\x39\xf3\xd7\x99\xbf\x6c\x8f\x36\xb6\x47\x1b\x5f\x0d\x74\x6f\xff\x7e\xf5\xa0\x92\x78\xe3\xae\x89\x3b\xad\x65\x19\xe6\x59\x86\xb9\xd8\x9f\x8d\x23\xda\x1b\x47\xb4\x37\xda\x28\x6b\x62\x70\x0e\x4c\xdd\x96\x79\x1e\x6f\x6c\xdb\x36\xb6\x6d\x1b\x6f\x9c\x35\xf6\xec\xb5\xbb\x33\xe6\x07\xa3\x3b\x63\x54\x3b\x70\x51\xac\xbd\x8a\xdc\x49\x23\x21\xee\xad\x88\x44\x16\x36\xd3\x27\x86\xa9\xb5\x71\x40\x54\xa3\x80\xa8\xc6\x11\x50\x8d\x8f\x5f\x35\x3e\xc0\xdf\x2a\x37\xbb\x72\xb3\xc5\x20\x6d\x12\xa8\xde\x24\xe6\xa9\x49\xcc\x53\xab\x32\x32\xab\x8c\x4c\xd9\xee\x6a\x62\xc2\x36\x39\x0c\xd6\xaa\x74\x31\x1d\xee\x6a\x72\xa6\xbe\xc9\x99\xfa\x79\x47\x30\xb6\xa6\x31\xa3\x1a\xcb\x07\x9b\xbb\x4d\xae\x44\x62\x1b\xb4\xc9\xdd\x46\x8d\x07\x9c\x5e\x67\xa4\xf7\x19\xe9\x85\x46\xe2\x64\x6c\x4d\x46\x91\xc4\x40\x4d\x0c\x0b\xce\x26\x27\xc1\x9a\x84\xb2\xb7\x26\x93\x69\x93\xc9\xb4\xf3\x28\x64\x83\xb6\x91\x41\xdb\xe4\x5c\x59\x93\x73\x65\x8d\x2f\x43\x6a\xec\x92\x6c\xb2\xb7\xd7\xe4\x06\xa4\x89\x03\xe5\xc6\xaa\xbf\xb3\xed\xdf\x3a\xdb\xfe\x4d\x76\xf7\x9a\xd8\xbc\x3d\xd0\x24\xd6\xf9\xac\x59\x27\x1b\xb4\x49\xac\x7b\xeb\x2c\x5e\x9d\xc3\xa6\x3a\x87\x4d\x75\x0e\x7d\xef\x7c\x50\xad\x07\x72\x42\xf7\x20\xf7\x42\xc9\xa5\x00\x5d\xa2\xa6\x7a\x60\x81\x18\xb8\x82\x69\x3e\x31\x08\x48\x0f\xbc\x14\x98\x18\x04\xac\x47\x5a\x85\x74\xda\x3a\xec\x41\xae\xc9\x12\x03\xb7\x4b\x20\x7e\x17\x03\xb9\x47\xb9\x3b\x8b\xb7\x1e\x3b\x9b\xa9\x3d\x92\xbe\xea\x91\x76\x49\xba\x6c\x1d\x76\x31\x3b\xbb\x6c\x3d\x76\xb9\x71\xa0\x4b\x14\x7c\x17\xb3\xb3\xcb\xee\x61\x8f\x3f\x8c\x32\x69\x8b\x09\xe7\xc8\x2c\xed\x91\x47\x47\x67\x43\xb3\x93\xa1\xd9\xc5\xd0\xec\x46\x4e\xae\x2e\x97\x44\x76\x36\x25\x3b\xef\x55\x76\xf1\xb2\x76\x63\x03\xbf\x1b\xab\xfa\x2e\x7b\x9b\x5d\xf6\x36\xbb\x78\x61\xbb\xdc\x08\xd9\xc5\xf4\xec\x12\x78\xdf\x25\x02\xad\x4b\x04\x5a\x97\xbd\xd1\x9e\x48\x27\x75\x0e\xb5\xef\x09\x67\xc0\xce\x37\x4c\x76\xbe\x62\xb2\x73\x64\x7d\x4f\xd2\x30\xb9\xf9\xa9\xf3\xb6\x69\x4f\x64\xf0\x74\xb1\x3d\xbb\xd8\x9e\x5d\xb6\x51\xbb\x78\x6c\xbb\x78\x6c\xbb\xd8\x9e\x9d\x6c\xcf\x4e\xa1\x67\x3d\xc9\x40\x94\x3b\xa4\x3a\xdb\xa6\xdd\x99\x83\x4e\x16\x7a\x77\x1e\x67\xce\xda\x8b\x2d\xc8\x2e\x97\x32\x74\x76\xce\x0e\xc8\xbf\xd2\x72\xbb\xcb\x35\x0c\xfd\x5e\x9b\x90\x2e\xd6\xd2\x70\x7a\x9a\x98\x5b\xc2\x3c\xf4\x1f\x1e\xce\xeb\xf7\xaf\x36\xcc\xdf\x32\xa6\x2e\x7c\x77\xe3\x2e\x88\x99\x67\x16\x34\xba\x63\xa1\xbb\xf4\x80\xff\xf4\x00\xab\x07\xbe\xcc\xb3\xf3\xc6\x75\xcf\xdb\xa6\xb8\x15\x29\x9c\x36\x73\x45\xa8\x83\xf8\x0a\x86\x2e\x56\x7b\x67\xab\xbd\x8b\xd9\xdd\xe5\x60\x6a\x97\x83\xa9\x5d\x7c\xb9\x5d\x7c\xb9\x5d\x02\xdc\xba\x1c\x35\xd8\x2f\x49\x9c\xdc\xe5\x42\xaa\x2e\x17\x52\x75\xbe\x66\xa1\xf3\xb6\x79\xe7\xdb\x1f\x3a\xdf\xfe\x40\x77\x7f\x6f\xd8\xb8\x68\x1a\xec\x72\x8e\xa1\xf3\xae\x78\xa7\xbb\xb0\xba\x04\x9d\x4d\xcc\x89\x89\x29\x7c\x49\x42\xe7\x93\x08\x9d\xaf\xc6\xea\x6c\xa5\x0f\x08\xfb\x2c\x5d\x5c\xbd\xbd\x8a\x8e\xaa\x32\xa2\xe4\x80\x68\xaf\x62\xf6\x88\x1d\xde\xc5\xd5\xdb\x1b\xb3\x81\x7c\xbb\x5d\x7c\xbb\x5d\xc2\xcc\xba\x6c\xde\x77\xd9\xbc\xef\x8d\x16\xc5\x9d\x37\xef\x07\x0c\xf4\x2b\x35\x84\x37\xeb\x3b\x6f\xd6\x77\xf1\x32\x77\xf1\x32\x77\xb1\xab\xe7\xf7\x70\xb1\x53\x6f\x22\x2d\x62\x77\xf7\xf6\xc3\x26\x1e\x46\x4d\x14\x57\xe3\xed\xba\x2e\x9e\xe7\x2e\x9e\xe7\xae\x97\x93\x8a\x67\xb9\xcb\x11\xd2\x81\x33\x04\x62\x75\xf6\x16\xd3\x8d\xe5\xe9\x5e\x6d\xdf\x0f\x22\x6b\x41\xee\x2c\xed\xdc\x0b\x5d\xf4\xbd\x1c\x19\xbd\xb7\xdd\x23\x76\xc6\xce\xbf\xd3\xd6\x44\xef\xd2\x70\x89\x73\xe8\x7a\x9b\xa9\x5c\x67\xda\xe5\x12\xb2\xae\x77\x83\x05\x0e\x30\x18\x18\x8a\x1b\x10\x6a\x3b\x20\xdf\x33\x1a\xf8\x96\xbb\xc0\xd7\x4a\x0c\x8c\xdc\x9a\xb9\x07\xce\x9e\x2e\xba\x1b\xdf\x37\xfe\x9e\xd8\x3b\x08\x5d\xaa\xdb\xe5\x77\xbe\x9d\x32\xc8\x2d\x63\x83\xc0\xb7\x32\x06\x09\x92\x58\x2c\x09\x42\xc8\x52\x4d\xbe\xc3\x32\x04\xb9\x9c\x31\x90\xef\xfa\xbc\x00\x81\x98\xee\x76\x0c\x1c\x91\xb8\x2e\xf6\x17\x6c\x30\x33\xdf\x77\x08\x30\x87\x2e\x29\xe8\x3e\xc8\x99\x43\x96\x1c\x98\x53\x7a\xf5\x6a\x90\x25\xc1\x24\x30\x1f\xf8\x08\xe9\xc0\x68\x39\xd1\xcb\x0d\x3b\x3d\x5f\x34\x1b\xe5\xce\xd3\x20\x11\x0a\xf0\x56\x43\xbf\x04\xba\x25\x2f\x98\xdc\x84\xcb\x4b\x81\x7b\xa7\x3f\x12\xf8\x42\xc9\xc0\x17\xad\x06\xbe\x69\x35\xc8\xf9\x90\x49\xa0\xfb\x05\x43\xa2\x25\x61\x0c\x49\x2e\xfe\x4d\x72\xf3\x6f\x12\x26\x25\xbe\x36\x30\x24\xbe\x86\x36\x24\x9e\xb2\xe6\xbf\x2a\x35\x90\x8e\x4a\xca\xb5\x24\x5c\x63\x37\x73\x0c\xec\x67\x9e\xff\xe8\x0a\xc8\xe0\xd2\x48\x3a\x79\x3a\xdb\xc8\x5c\xd2\x5b\x5b\x83\x4b\x2b\x5d\x34\x87\x5e\xa6\x1a\x5c\xe5\x53\x2e\x67\x0d\x2e\x6d\x70\xd1\x25\x2e\xba\x44\xee\x10\x5b\x84\xc0\x29\x44\x35\xe8\x8d\xad\x41\xaf\x6c\x0d\xae\xd7\x47\x67\x96\xa6\xfc\xe9\xcf\x3c\xf0\xb3\x0c\x7c\xb9\xa8\x35\xc8\x4d\xad\x21\xab\x42\xcc\x32\xee\xe5\x1e\xd8\x90\x55\xfe\xb3\x0a\x4b\x56\x61\xc9\xaa\x32\xf5\x7e\xd8\x41\x68\xd2\x4c\x9a\xb6\x56\xbd\xa9\xa1\x45\x04\x88\x6f\x81\x0d\x7a\x0d\x6c\x28\xc2\xb8\x22\x8c\x2b\xb4\x06\x18\x98\xa5\xa1\x88\x34\xe8\xdd\xae\x41\x2e\x5b\x59\x84\xc0\x59\xf2\xbd\xaa\xe1\x9e\xc3\x48\x40\xd0\x2f\x78\x68\x16\xe5\xb6\x38\xb8\x27\x21\x69\x92\x2e\xac\x22\xa7\xe5\x22\x04\x4e\x22\xa2\x5d\x54\xb4\x8b\x8a\x76\x51\x35\xc6\x91\x20\x03\xeb\xef\x8d\xa1\x7c\x4d\x57\xca\x86\x2a\x86\x42\x15\xa5\x27\xb7\xe5\x86\xca\x06\xe5\x24\xb0\x54\x56\x19\xfe\x72\x65\x6e\xa8\xa2\xc2\xaa\xf6\x4d\xd5\xbe\xa9\x72\xb7\x78\xa8\xca\x57\x31\xd0\x87\x69\xc7\x7c\x6e\x32\x7c\x1b\x4b\x75\x55\xa9\x6e\xda\x2a\x3e\x2d\x32\x30\xdd\x67\x1c\xe8\xc1\x9e\x4d\xa0\xcb\x78\x83\xb8\xa8\x07\x41\x5a\xa1\x37\xe2\x86\x26\x57\x6e\x07\xbd\xf7\x36\xe8\xc5\xb7\x83\x90\xc9\x00\xb9\x27\x84\x4f\xc5\xf8\x88\xf0\xc0\x81\xab\x69\x6c\x1a\x76\x61\x5d\x17\xcd\xd7\xc5\x54\xec\x72\xdb\x7e\x17\x4e\xf5\x4f\xf3\x8f\xc1\xb9\x82\x5d\x12\x74\x49\x20\x63\xa6\xeb\x98\xd1\x1b\x7c\x83\x5e\xe1\x1b\xe4\xf0\xf2\xaa\x05\x32\x32\x06\x96\x90\x2e\x6c\x89\xec\x2b\x8f\x51\x2c\x66\x7d\x74\x20\x72\x40\xc7\xc4\xf2\x3d\x0d\xc4\xf9\x14\x00\xec\xd7\x2d\x42\x01\x3e\x45\xb5\xb9\xa3\xd8\xdc\xf3\x75\x81\x22\x59\xe0\x6d\x1f\x8b\x10\xa4\x54\xf4\x2c\x4d\x82\xb6\x9b\xe7\xff\x49\xd0\x3c\x68\x40\xc7\x20\x03\x3a\x4a\x34\x4b\x8c\x41\x6e\xb6\x8e\x72\x0f\xdd\x7d\xbc\xab\xbd\x04\x34\x60\xa3\xb8\xf0\x63\x94\x23\x38\xf1\xf7\x35\x84\x9f\xe7\x10\xe4\x3d\xa3\xc8\x97\xc2\x0d\x5c\xa5\x4e\x5a\x02\x6b\xf7\x18\x45\x52\xa3\x5c\x07\x1c\xa3\xdc\x07\x1c\xa3\x3e\x78\x14\xc5\x54\x8f\x6c\xaa\x47\x13\xb1\x33\xd6\xae\x51\x5c\xec\x83\x40\xa3\x2b\x9a\x3c\x49\xf1\xf3\x16\x43\x34\x31\x33\x06\x81\x54\xc8\x79\xf4\x00\x72\xe0\xd7\x1e\x4c\xac\xb1\x9f\x37\x18\x7e\x1e\x2b\x88\x49\xde\xa6\x90\xb7\x0e\xf4\xb1\x83\x48\xee\xf3\x01\x4d\xab\xc8\x76\xce\x7b\x0e\x01\xaa\xc0\x95\xd4\x07\x11\x7e\x5e\x44\x88\x72\xee\x3a\xee\x57\x0c\xf0\x77\x5a\xc4\xdf\x47\x0f\xec\x62\x6e\x95\x4b\xab\x9c\x5b\xe5\xe4\x3b\x8a\x51\x2c\xeb\x28\x96\x75\x94\x20\xe2\x18\xd5\x56\x8f\xae\x0f\x8e\xb8\x58\x5b\x3f\x8f\x29\x44\x39\xb4\x1d\xa3\x2b\x27\xd5\xf8\x8e\x72\x2b\x4c\x8c\xfe\xcb\x38\x9e\xfa\x22\xfb\x99\x63\x14\xfb\x3b\xe6\x4f\x4b\x70\x61\x15\x2f\xf4\x63\x16\xd6\xb3\x33\x7a\xe0\x26\x05\xf2\xc0\xca\xc2\x5b\x39\x0e\x3e\x08\xac\x7d\xb3\x32\x3f\x2b\xf3\x25\xd4\x39\xc6\xac\xfa\x22\xab\xbe\xc8\xaa\x2f\xb2\xf2\x3a\xab\xd4\xf2\x05\xc1\x31\x16\x51\x28\x85\x15\x4a\xd6\x89\xae\x08\x63\x8a\x30\xa6\x88\xd6\x2c\x67\x94\x24\x20\x38\xe7\xc0\x96\x74\x94\x60\x91\x49\xa8\xfa\x05\xcf\x18\xe5\xb7\x99\x32\xe2\x8b\x8e\xf8\xa2\x72\x2a\xc7\xb5\x63\xa4\x30\xea\x99\x83\x2b\x81\xcc\xaf\x58\x85\x17\x55\x26\xfd\x2a\xe3\xbb\xca\x9b\x3a\x55\x1e\xd5\x51\x5b\x38\xaa\x2d\x1c\xab\x32\x42\xed\xef\x28\xf6\x77\xe4\x98\xeb\xf5\xde\x6c\x11\x02\x33\xaa\x2a\xa3\xda\xc7\xa6\x48\xfb\xd8\x12\x69\x9f\x54\x49\x26\x67\xb1\xc6\xa3\x58\xe3\xb1\x09\x5b\x9a\xb0\xa5\x09\x9b\x9b\x4c\x72\x8d\x83\x05\x56\x05\xa5\x44\x99\xfc\xd5\xc0\x8f\x4d\xd8\xd6\x84\x6d\x7d\xa9\xf7\x57\xc6\x35\x21\xd3\x25\xb0\x02\x60\x87\x74\x8c\x9d\x07\x5a\xd3\x59\x52\x57\x0c\x93\xe0\x5c\x82\xe8\xe2\xae\xc6\x58\x57\x1d\xd3\x75\xf2\xee\x3a\x79\x77\x7d\xc9\xa9\xab\x88\x75\x35\xc6\xe4\xf6\x9d\xf8\x9e\xd0\x78\x35\x15\x73\xac\x8b\x37\xcf\x38\x6e\x65\x60\x92\x09\x63\xfb\xfb\xe7\xad\x8c\xd8\x75\x2c\x76\x59\x0a\x19\x47\x54\x0f\x4c\x1a\xdf\xc4\x62\xb7\x30\x8f\x00\xdc\x36\x0c\x5c\xa1\xc3\x4d\x5c\xd8\xa6\x8f\x79\x99\xbe\xe6\x65\xfa\x9c\x97\xc9\x75\xcc\x8b\x10\x24\x8f\x9f\x52\x48\xc1\xeb\x73\x1b\xf3\x75\x67\xe2\xb5\x49\xf8\x4a\xb4\x28\xbc\x16\x2f\xb6\x51\x74\x4c\xb4\xa0\xaf\x56\x05\xe1\xf5\x7c\xb2\x43\x72\xe4\xe1\x64\xf2\xb6\x98\x45\x61\x7e\x94\x65\xa9\xa9\xad\xac\x4f\x67\x44\x7d\x3b\x63\x12\x68\xc0\x98\x84\x54\xcf\x3c\x70\x7b\x29\x9a\x49\xcb\xf9\x99\xb2\xf7\x5e\x46\x7a\x04\x7e\x3c\x4c\xfd\xdc\xa6\x4f\x95\x99\x34\xd4\x78\xb9\x6b\xa6\x4f\x9f\xc9\xb5\x48\xf1\x3d\xc2\xf1\xca\x94\x0e\x36\xed\x60\x09\x35\x89\xc6\x16\xf9\x7b\xb7\x23\x02\xc1\x39\x01\x7f\x90\x84\x51\x62\x6f\x1b\xdb\xdb\x96\x64\x2c\x71\xc0\x4a\x94\xf7\x39\x66\x76\xb4\x76\x35\x79\xe9\xcc\xd4\x55\xae\x0f\x80\x2c\x82\x94\xc9\x6a\x4a\x5f\xf5\x88\xfa\xac\x47\x94\x77\x3d\x22\x3f\xec\x11\x4d\xbd\xe5\x26\xa7\x0b\xa3\xb9\xb0\x89\x9f\xeb\x18\x38\xcb\xf7\x2c\x0d\x6a\x4c\x9b\x1a\xd3\xe6\xe2\xba\x30\x71\xaf\x9b\x2c\x02\x4c\x16\x01\x26\xee\x75\x79\x72\x23\xf2\x9b\x1b\xd1\x5c\xdb\xec\x1c\x23\x30\x12\xb8\x60\x56\x0e\xe2\x1a\x7f\x2f\x71\xbc\x04\x3c\x64\xb2\x3c\xef\x27\x21\xd7\xf1\xbd\xed\x01\x5f\x90\x05\xf9\x1e\xe4\x80\x24\xac\x1b\xb2\xea\x06\x89\xf8\x88\xf7\xd1\x0e\xfc\x22\x48\xc5\x64\xdc\x65\xd5\x94\x85\x87\x05\x5d\x41\x39\x61\x60\xcc\x9c\x2b\xc2\xb9\xc2\x76\x90\x95\x6d\x87\xa5\x87\x7f\x3e\x88\x92\x23\x0f\x2b\x8e\x44\x99\x35\xa2\x16\x1e\xe7\x9c\x5f\xcc\x33\x87\x38\x03\xad\xb3\xfe\x6c\xf4\xb6\x36\xbe\xa8\xbe\x6b\x47\x16\x8f\x15\x9d\xdb\xd4\xe9\x6e\xba\x54\x30\x5d\x2a\x98\xc4\xdf\x4e\x82\x49\x9b\x58\xdc\xc4\x21\x99\xe4\x82\xbf\xb8\x5f\x7f\x38\xef\x9c\xe9\xf3\x0f\x51\xdf\x7f\x98\x04\x7e\x3a\x51\xe7\xe8\x24\xe1\xaf\x51\x1f\x4e\x88\x49\x77\xb7\x93\x5c\xa5\x33\x09\x24\xc1\x49\x1e\xbe\x8a\x49\x9e\x00\x4f\xfc\x7c\xa8\x3e\xb6\x10\x93\xce\xeb\x89\xa3\x66\xe3\x7c\x4a\x80\x7f\x67\x7b\x53\x9f\x55\x18\x04\x62\x76\xe2\xe0\xd7\x89\x83\x64\x50\x94\x90\xa5\x11\xc2\x18\x9d\xf6\xf5\x01\x85\xf8\x5e\x50\x38\x99\xf2\x59\xa9\x81\x69\xb6\x4b\x62\x16\x24\x36\x0b\x52\x94\xe9\x73\x12\x9c\x33\x24\xaf\x5a\x92\xdd\xee\x24\xbb\xdd\x49\x1e\xea\x9a\x84\x2a\x39\xf0\x04\x98\xd4\xf2\x98\x04\xcd\x83\xe6\x8e\x24\x4f\x93\xf2\x8e\x79\xd2\x1d\xf3\xa4\x3b\xe6\x89\x4f\x53\x0d\xcc\x2f\x8c\x8a\x93\x2e\xc9\x0e\x79\x4a\xbc\x77\x9c\xd4\x5f\x96\x92\xf6\xed\xcf\xa3\xa4\x49\x47\x52\xd2\x91\x94\x44\x23\x24\xd7\x87\x4e\x75\x0a\x4d\x4e\x03\x3c\xa9\x9b\x6e\x10\xf8\x99\x56\x3e\x60\x34\x70\xe0\xfc\x84\x93\xae\x6f\xa1\xba\xcc\x1c\x29\xeb\xcb\xaf\xea\x4f\x4a\xe2\x4f\x4a\x59\x44\x2a\x8b\x48\xa9\xbf\x29\xa9\xbf\x29\xc9\xab\x56\x93\x50\x24\x4f\x36\x90\x92\x6e\x20\x27\x9d\x35\x93\xce\x9a\x49\x67\xcd\xa4\xb3\xe6\x7e\xd6\xe3\xb2\x5f\x27\xd1\x54\x34\xcb\xa2\x62\xa2\x13\x87\xbe\xb8\x31\x09\x2c\x26\x3a\x71\xa4\x4a\x17\x78\xc7\x54\x45\x27\x54\xd1\x09\xfc\xaa\x68\x52\xe7\xd0\x20\xb0\xd0\xe8\xf3\x9f\xe7\xfd\x8d\x2b\x36\x1c\x22\x39\x31\x2e\x56\x46\x06\x78\x8d\xc8\x22\xb8\x14\xc1\x6f\xf2\xd6\x2f\xa6\xc6\x79\xf2\xea\x3e\xa9\x03\x29\x55\x9d\xbc\x06\x21\x70\x1e\xa6\xac\x93\x0e\xaa\xda\x41\x72\xcc\x69\x56\x4a\xb2\x64\xee\xdd\x7b\x83\xd2\x23\xf0\xac\x2c\x3b\xb0\xa9\xc9\xeb\xc7\x4d\x94\x13\xbd\xf9\x11\xf9\xd1\x8f\xa8\x6f\x63\x44\x7d\x1c\x23\xea\xeb\x18\x31\x35\x15\x29\x79\x7f\x63\x11\x4c\x09\x81\x5b\xc5\xab\xdc\xd4\xf4\x15\xe5\x41\x20\x46\x9c\x4b\x8e\x1e\xe6\x42\x79\xff\x36\xe9\xfe\x6d\xba\x3b\xc2\x90\x41\x92\x12\x84\xd5\x62\x31\xa5\x2e\xac\x96\x2d\xe0\x24\x67\x98\x16\x21\xc8\x17\xcc\xcc\xae\x92\xdb\x55\x72\xbb\xec\x09\xea\xab\x1e\x93\xc0\x86\x40\x17\x87\x59\x92\xdb\xa7\x16\x01\x47\xbd\x9f\x8b\x9a\x1e\xc6\xef\xf9\xad\x90\x99\x9c\x15\x7d\xd7\x29\x53\x6e\x95\x1a\x39\xb8\xe2\xc0\x25\xd0\x1c\xeb\x12\xe7\xe9\x81\x42\xea\xa3\xf3\x2b\xb0\x51\x5e\x20\x99\xe9\x49\x0d\xb9\x7a\xa5\xe4\x51\x92\xe8\x7c\x59\xc0\xc0\x55\x6b\xe4\xc2\x23\x1e\x2a\xae\x71\x97\xae\x71\x97\x93\x20\x7c\x67\xfb\xd7\xd5\x8b\xa5\xaf\xa1\x44\x7d\x0e\x65\x11\x88\xb7\xfb\xb6\x80\x07\x25\x3d\xeb\x1c\x57\xf3\x77\x12\x02\xe7\x40\x73\x84\x4b\x38\xa8\x3c\xa9\x12\xe5\x4d\x95\x89\x71\x2c\xca\x43\x29\x13\x07\xfe\x3d\x36\x69\x11\x9b\x85\xae\xb1\x9e\x2e\x97\x68\x45\x97\x5b\xb4\x16\x41\x6a\x11\xb5\x99\x74\xef\xc5\x24\x98\x66\xca\x4f\xa2\x6b\x44\xa8\xab\x8d\xec\x6a\x23\xcf\xe7\x43\x18\xd3\xd0\x71\x23\x85\xed\xf6\x69\x6a\xac\xa1\x49\xcf\x88\x45\xef\x62\xd1\x0f\x1c\xf8\xfb\x26\x98\x9f\xa3\x37\xe9\x19\x31\xe8\x5d\x0c\x7a\xbf\x9b\xe4\x2f\x43\xb6\xe7\xdc\xc4\xf7\x3b\x09\x52\x46\x6f\x92\xc5\x0f\xc7\xb2\x70\x41\x5e\xb6\x37\xed\x7c\xd3\xce\x37\xed\x7c\x0d\xbc\x75\x5d\x79\xcc\x62\xb5\x62\x2c\xa6\x83\xa0\x15\xeb\x18\x47\xe2\x26\x21\x79\x6e\x32\x79\xba\xc9\x36\x94\xeb\x72\xc6\xef\xdd\x0f\x90\x87\x32\x88\x27\x4f\xbf\x67\xf8\x90\x10\xb9\x62\xdb\x6c\x3b\x5f\xd0\x9b\x37\x51\x1f\xbd\x19\xbf\x53\x84\xcc\x7d\xd7\xe6\xa5\xe7\x3a\x49\x58\xb1\x9f\xab\xe7\xdf\xf7\xb4\x4d\x21\xcf\xe6\x44\x7d\x28\x27\xea\x4b\x39\x83\x40\x2b\x05\x97\xa0\x61\x97\xa0\xe1\xfb\x54\x0e\x11\x84\x07\x51\x2b\xc9\x33\xbc\xcb\x3d\x6f\x93\xc0\x7a\x48\x17\x6a\x9e\xb4\xb7\x74\xf5\xf7\xde\xf8\x39\x79\xb8\x4c\x8e\x1c\xcd\xec\xba\x70\xd3\x47\x7b\x16\x81\xa4\xfd\x44\x60\x3c\xcc\xd3\xa7\x84\x3b\x0f\x6c\x92\xbe\x31\x96\xd9\x55\xdc\xb3\x2e\xee\x59\x77\xe9\x0c\xa7\xb7\x25\xe3\x7b\x71\x07\x09\xc6\x29\x64\xd0\xbb\xd8\x52\x83\x40\xb6\x94\xe7\x8f\x65\x3c\xb3\x9f\xc7\x39\x9a\xc2\x5d\x3c\x5e\xae\x11\x1d\x2e\xe1\xd2\x2e\xe1\xd2\x9e\x85\x8d\x59\x84\x3c\xf3\xd2\xc7\xb3\x70\x25\x0b\x57\xf8\x3e\xba\x89\x25\x7d\x93\x06\x77\x69\x30\x07\x19\x4e\x42\xe0\x12\x45\x81\xeb\x82\xdc\xe5\xed\xca\x49\x68\xd2\x4a\x99\xad\x35\x86\xdb\x35\x86\xdb\x35\x06\xe4\xbc\x5a\x94\x2e\xd4\x5a\x72\xcf\x48\x34\x87\x17\xd1\x1e\x45\x2c\x12\x76\x50\xbb\x04\x73\x78\x91\x7e\x28\xdc\x0f\xe7\x66\xf2\xfd\xf9\x79\x9c\xe4\x54\x76\xbb\x7f\x8f\x66\x64\xf7\xaf\x17\xbe\xf1\x65\x10\x78\xa8\x14\xde\x60\x95\xa3\xe5\x03\x93\x73\xa2\xcb\x9c\xdd\x65\xce\xee\x1a\xb8\xd6\x35\x70\x4d\x8f\x7c\xc7\x2e\x37\x6d\x4e\x02\x59\xb2\x5d\x3d\x77\x5d\x3d\x77\xdd\xc4\xab\xd2\x75\x22\xeb\xf2\x8c\xfe\x22\x48\x4d\xd9\x00\x9b\x04\xaa\xa9\xf8\xe6\x3a\x5d\x75\x34\x20\x4b\x6f\x97\x49\xa2\xf3\x2d\x9f\x03\x93\x22\xec\x49\xd6\x11\xef\x54\x79\x04\x82\xcb\x17\x34\x05\x74\x3d\x11\xd3\xf5\x44\x4c\x4f\xca\x2b\x79\x1a\x25\xbe\x23\xe1\x48\x08\x92\x84\xd6\x05\x9d\x77\xf1\xba\xce\x12\xdd\xc5\x8e\xe8\xf2\x8e\x48\xec\x7a\x9e\xa4\xab\x0b\xb1\xf3\x3b\x27\x03\x27\x49\x40\x4b\xe4\x79\x8e\x1a\x4b\x10\xef\x5e\x97\xe3\x20\x9d\x3d\x8c\xdd\xff\xa7\x0d\xdc\xff\xf2\xc2\x48\xec\xea\xfe\xeb\x7a\x5c\xa4\x67\x1d\x0e\x72\x28\x3a\x76\x8e\x0d\xeb\xaa\x7d\xba\xee\xb2\x75\xf5\x28\x4e\x82\x73\x96\x64\x48\x74\xd1\x40\x5d\x34\x50\x2f\x62\x68\xdc\x03\xd1\x97\x55\x72\x8f\xf8\x24\x90\xa5\xdd\xf5\xc4\x48\xbf\xe7\x41\xfc\x11\x58\x30\x8b\xec\x29\xf4\xfb\x42\xad\x3f\x02\xd7\x54\x3d\x6b\x5d\x43\xb3\xce\x61\xec\xfa\xca\x60\x76\x6a\x40\xda\x2c\xd4\xb8\x50\x91\x54\xf5\xbc\x75\xf5\xbc\x8d\x24\x59\xf3\x70\x29\x45\xb4\x4f\x55\xe1\x6a\xec\x73\xea\x1c\xf0\xd5\xf9\xde\xa1\x81\xc9\x1a\xe8\x12\xef\xd5\x25\xde\xab\x37\x91\x09\x39\x05\x1d\xf5\x18\x74\xec\x4d\x86\x0f\xdf\x5e\x34\x70\x95\x1a\xb1\x11\xd5\x9b\x0e\x97\xa6\xc3\xa5\x69\xff\x36\xed\x5f\x39\x0c\x1d\xf5\x34\x74\xd4\xe3\xd0\x8b\x40\xfd\xd7\x75\x06\xea\x2a\x34\x9d\x85\x46\x5e\x7c\x8b\xef\xa4\x31\xe4\xc0\x83\xb2\xeb\xa0\xec\x2a\x45\x1a\xae\xd5\xbb\x2a\xd9\xae\x42\xa3\xa7\x30\xba\x04\x64\x8d\xf4\x98\x62\x60\x94\x82\x99\xff\x6b\xe7\x80\xb8\x2d\x6b\x41\xfc\x56\x16\xd8\x6f\x65\x81\xfd\x56\x26\x67\x94\x07\xa6\x15\xe6\x24\x04\xc9\x91\x5a\x39\xcb\xec\x94\x24\xd2\x62\x6e\x60\xd4\x0a\x03\x97\xc6\x98\xc4\xca\xf4\xf4\xee\x22\x50\x96\xec\xb9\x18\x38\x32\xdf\x8c\x67\x40\x0b\xb2\xec\x35\x3d\xea\x3a\x09\x89\xea\xc9\xb3\xbd\xc9\x59\x56\x93\xb3\xac\xb6\x1f\x5f\xc7\xf4\xf1\x97\x20\x25\x90\x20\x4f\x02\x5a\x03\x93\x80\x72\xb9\x08\x9a\x47\x97\x52\xa4\xe9\x89\xc7\x8f\x05\x8e\xe0\xb1\x40\x73\xbf\xe9\x79\xd7\x45\x08\x9c\x41\x0b\x9c\x41\xa1\x5a\xf3\xc4\x3e\xb0\x35\x4e\xdf\x0b\x7f\xdf\x4d\x2a\xc8\x62\x2c\x77\xc7\x4c\x02\xcb\xa9\x6c\x60\x9a\x9e\x66\x35\x3d\xcd\x6a\x41\x02\xea\x27\x21\x34\xae\x97\xc8\x7a\xfe\x84\x0f\x64\x61\x58\xe0\xb0\x1e\x0b\x99\x56\x12\x76\x0e\xd0\xbe\x9f\x59\x6a\x33\x1f\x3c\xb1\xc0\xf7\x7e\x9b\x1c\x80\xb5\x90\x65\xfc\xcb\x09\x5a\x0b\x59\x39\x99\x75\x28\xe5\xf3\x0e\x53\x02\x02\x78\x7b\xec\x1d\xaa\x85\x2f\x58\x4a\xe5\xfe\xc7\x49\x68\x5a\x4a\xd5\x9a\x16\xad\x58\xd1\x24\x25\x71\x3d\x64\x80\x66\xed\xc0\xac\x1d\x98\x55\xf4\xe5\xb4\x80\x05\x0e\x2f\xb2\x50\xa4\x07\x8b\xf4\x60\xe1\x1e\xa4\x60\x25\x0b\x12\xb6\x3f\x09\xac\xca\xf8\x0a\x49\x0b\x45\x94\x4a\x95\x1e\xaf\xd2\xe3\x15\xad\x4b\x0b\x62\x0e\x59\x28\x2a\xf6\x12\xf4\x6f\x7a\x54\xd4\x82\xd8\x60\xa6\xc7\x53\x27\x21\x9a\x7c\xc1\x3a\xa6\xea\xbc\x51\x55\xdf\xb6\xaf\x31\x0c\x5c\x07\x19\x49\x95\x77\x86\x2d\xb4\x4f\xf3\x63\x9d\xd4\x64\xa6\x6c\xda\x15\x4d\xbb\xa2\x49\x57\x34\x19\x4c\x7c\x63\x8d\x85\x2e\x5a\xab\x4b\xd7\x74\xee\x9a\xa6\x5d\xd3\xb4\x6b\x9a\x76\x4d\xd7\xd1\xda\x55\xef\x75\xd5\x7b\xb2\xc1\x37\x09\x5c\x4a\xd7\xd1\xda\x75\xb4\x76\x1d\x25\x9d\xf7\x64\x2d\x74\x9d\x12\xba\x4c\x09\xe7\xe8\xe6\xf9\x20\xf2\x53\x2f\x16\x79\x8b\x6e\x60\x6c\x68\xa4\xab\x58\x4c\x4f\x50\x9a\x9e\xa0\x9c\x04\xea\xcd\x28\x71\xe3\x93\x10\x34\x49\x90\x3a\x6f\xa1\xf5\x47\x08\x52\x2d\xf2\x42\x58\xe4\x8b\xb8\x07\x26\x75\x11\x29\x2a\xdc\xf4\xc8\xe5\x22\x48\x06\xcc\x37\x8e\xfd\x1a\x78\x18\xb3\xaf\x8a\x51\x39\xa3\xd6\x53\x54\xeb\x29\x46\xe5\x4c\x54\xce\xdc\xa7\xd6\xa0\x94\x22\xd5\x8c\x05\x9c\x83\x83\xc0\x03\x3e\x46\xe5\x5d\xfc\xe5\x1d\xdb\x32\x51\x62\xd0\x4c\xcf\x7e\x9a\x9e\xfd\x9c\x04\x6e\xbe\x49\x87\x98\x74\x88\x89\xdc\xb1\x3f\xcc\x22\xfb\xc3\x06\x26\x25\x10\x39\x14\xdd\xee\x51\xd0\x87\x5b\x93\xf2\x48\xed\xbc\xa3\xa1\xaf\x04\xe9\x0e\xd3\xee\x30\xe5\xbe\x78\xd8\x2c\xca\x33\xc0\x93\x50\xa5\x9d\x3c\xbf\x46\xf1\x9f\x2d\x42\x90\x2f\x4c\x78\xc1\xd3\x69\x94\xeb\x65\x2c\x26\xe1\x76\x62\xf1\x97\x4d\x93\x49\xe0\xb6\x27\x6d\x7b\xd2\x41\x9a\xb4\xde\x49\xeb\x9d\xb4\xde\xb2\x47\x62\x91\x23\xce\x2d\x3a\x57\xd3\x45\x26\xc4\x9c\x8d\x2e\x32\xe1\x22\x13\x12\x90\x6e\x7a\xba\xd3\xa2\xb8\xc2\x2c\xf2\x8e\xc7\xac\x42\xe3\x22\xa4\x4d\x72\xd8\xd3\x22\x5f\x58\x68\x91\x6f\x2c\xb4\x48\x21\xea\xa6\x87\x3d\xc7\xef\x3c\x70\xb2\x08\xb6\x98\x9a\x31\x8b\x62\x52\x5b\x75\x12\xd0\x90\x8c\x6a\x8c\xc6\x2c\x0b\xa2\xa8\xd6\xa9\x9e\xee\xb4\x48\xae\x7c\x8b\xe2\x4c\x33\x3d\xca\x69\x51\x6d\xd3\x28\x06\x56\x2c\x5a\x6f\x7e\xae\xcf\x22\x5f\xb6\x62\x91\x2f\x5b\x19\x98\x96\xaf\xb1\x68\x23\x8a\x0a\x79\x51\x15\x2d\xf7\x0f\x5a\x2c\x2a\xb1\x6a\xc6\x45\x79\x46\xd7\xf4\x5c\xa5\x45\x79\x9d\xcf\xf4\x94\xa3\x45\x8e\xa5\xb3\x28\xde\xb8\x45\xa0\x1e\x63\x2b\x4d\x0f\x29\x5a\xac\xaa\x21\xce\x13\xb0\xfe\xb0\x73\x86\x32\x10\x9a\x0c\x84\x2e\x62\xdc\x59\x8c\x9b\xd6\xa0\xe9\x84\xda\xa5\xbf\x3b\xaf\xd2\x63\x97\xc1\xde\xa5\x7b\xbb\x74\xaf\x9c\xec\xb3\xd8\x95\xad\x5d\xfb\xbb\xab\x52\xeb\x1c\x22\x6a\x72\x70\xcf\xf8\xe0\x9e\xc5\xae\x62\xde\xd9\xc9\x39\x12\xfc\x60\x6c\xa5\x89\x5f\xc7\xf8\xee\x39\xd3\x33\x74\x66\x72\x31\xc6\x24\x90\xc0\x99\xdc\x2d\x67\x7a\x86\x6e\x11\xb0\xb7\x2d\x4a\xad\x22\x2b\x56\x13\x63\xc7\x38\xd0\xdd\xee\x71\xb6\xc3\x18\x8b\x5a\xa4\xf1\x2a\xe0\x9e\x55\xf3\xf7\x3b\xa9\x1c\xa3\x48\x05\xd3\x43\x5e\x66\x12\x39\x30\x09\x9a\x03\xaf\x6d\x8d\x37\xdc\xcd\xf8\x42\x82\x81\x1b\xff\x2c\xa9\x99\xcd\xce\xae\x2d\x73\x31\xb4\xde\xf1\x27\x28\x8f\x66\x1b\x3d\xfe\x34\x08\x34\xf0\xe5\x0c\xcd\xc0\x28\x7e\xfb\x74\xd3\xde\x83\x9c\x05\xd2\xda\xcc\x5c\x99\x5a\xa4\xce\xfc\xa8\xa9\x9d\x13\x34\x37\x83\xa2\xc2\xc6\x47\x6e\x06\x6e\x92\x61\x93\x0c\xd9\xb0\x78\xa7\x7a\x5e\x0a\xb6\x93\x4d\x95\xb0\x1e\x93\x99\x84\x2a\xcd\xe2\xa1\x6f\x72\x7b\xd5\x24\x34\xca\xb4\xca\xc0\xae\xcc\xd9\xa2\x02\x5d\x54\xa0\xe5\xfa\xab\x91\x43\x91\x12\xc8\x18\x19\x98\xaa\x54\x79\x1e\xb7\x2a\xac\xac\xc2\xca\xca\xdb\x04\x93\x10\xa4\x44\x61\x76\x95\x59\xda\xaa\xf2\x45\x1e\x15\x9b\x04\x9a\x96\x4d\xa2\xb0\x07\xc1\xc2\x0f\xa1\x29\xa1\xe2\xe0\x56\x1f\x83\xc9\x23\xaf\x66\xec\x74\x30\x9e\xce\x4c\x9d\x0e\x26\x7b\x38\x33\x7d\x60\xcc\x82\xcc\xf1\xd4\x03\xf3\xd0\x97\xab\x65\x07\x81\xa6\x3b\xe3\x13\xf3\x33\x81\x4b\x09\xc2\x27\xb9\x12\x6b\x11\x9c\xbf\x10\x4e\x36\xf1\x11\x58\x53\xb1\x6d\x3a\x41\x35\x65\x6d\xfb\x65\x2d\xfb\x08\x8c\x63\xac\xcd\x78\xe6\xb6\xa6\xcc\x6e\xaa\x45\xd4\xfb\x21\x87\xd0\xcc\xba\x70\x9b\x43\xaa\x4d\x8f\xb5\x2d\x42\xd0\x3a\x36\x25\x04\xc9\x83\x96\x5a\xd6\x55\x0c\xbb\xaa\x5b\xd9\x3b\x9a\x49\x4c\xea\xc1\x9e\x4b\xeb\x3a\xed\xc8\xcb\x66\x36\xcf\x9d\x31\x26\xf6\x27\xf6\x9f\x24\xf6\x9f\x24\x0e\x90\x1e\x98\x8c\xf9\xc4\x01\xd0\x96\xe4\x04\xbd\xdd\xf3\x7d\x87\xf7\x49\xdd\x2b\x49\xdd\x2b\x49\x4d\x8a\x14\x84\x73\x49\x5e\xf4\xb5\x24\x8f\xa6\x4d\x02\x37\x93\x4e\xe3\x99\x9e\xc6\xb3\xc4\xaf\xf4\x9a\x1c\xbe\x33\x3d\x6b\x37\x08\x34\x00\x13\xdf\x57\x35\x30\x19\x4e\x49\x2e\x8e\xb5\x24\xc1\xc4\x93\x90\x35\x09\xad\x58\x53\xe4\x2b\xe9\x26\x81\x4c\x29\x3d\xaf\x37\x09\x34\x42\xf7\xe9\x3a\xca\xb2\xf1\xef\x6b\x01\xf0\xcf\x3a\x51\xac\xfd\xb3\x8a\x94\xf0\xcf\xf2\xc3\xe8\xfe\x4a\xf6\x69\x96\xcc\x3b\x3e\xb6\x6f\xc9\x84\xf9\x26\xcc\x17\xe3\x6c\x1e\xe0\xfb\xde\x02\x71\xd7\xb0\xdb\x3f\x2b\x44\xa9\xe5\x9f\x9d\x32\xd2\xa7\x59\x90\x65\x9c\xe4\x9a\xac\x49\xe0\xee\xd0\xbd\xc1\xc4\xcf\x9f\x99\x9c\xd8\x33\x39\xb1\x67\x29\x31\x5b\xf8\x05\xb4\x81\x69\xfd\x98\x64\xdb\x30\xf1\x5b\xc0\x33\x7b\x1e\x49\x49\x9c\xe9\x7a\x3a\xcf\x92\x44\x81\x9a\x9e\x01\x34\x3d\x03\x68\x7a\x06\x70\x11\xa4\xd9\x32\x96\x24\x10\xd4\x12\xbf\x24\x31\x70\xe3\x9f\x9d\x7f\x75\xf9\x99\x4c\xa1\xe4\x22\x1d\xf2\xf4\xef\x24\x44\x29\x9f\xac\xc2\xe4\xc2\x59\x7e\x58\x6d\xd5\xb7\x29\x21\x48\x99\xcc\x15\x17\x23\x70\x12\x4c\xf2\x60\x05\x90\xa5\x9d\x59\xda\x49\x71\xa1\x96\x5c\x25\xd6\x75\x68\xf3\x41\x49\xbb\x87\x14\xfd\x62\x56\x59\x7c\xa8\x71\x60\xde\xd0\x49\x59\x55\x54\xd6\x31\x21\x47\x14\x27\xa1\x4b\x2b\x79\xd9\x91\xe4\xd2\xdb\x45\x20\x01\x28\xda\x7f\x45\xa7\x88\xa2\x53\x44\xd1\xaa\xab\xc9\x9d\x24\x72\x69\x11\xa4\x58\x51\xa6\xea\x4c\x49\x45\x5b\x5b\x65\x32\xad\x74\x3e\xc8\x52\x15\xc5\xc6\x57\x56\x0d\xcc\x93\x44\x95\xb5\x45\xaa\xaa\x3a\xab\xf4\x21\x5f\x30\x65\x7a\xc2\x70\x12\x68\x5d\x98\xf8\xfe\x28\xe3\xa3\x7a\x13\x06\xc6\x2c\x54\x4d\xc6\x8a\x1c\x15\x9c\x04\x56\x4b\x4d\x7b\xaf\x69\xef\x35\x59\x1a\x27\xdd\xd0\x4a\x4d\x75\x6f\x93\x9d\x86\xd4\xd4\xf2\x50\xeb\x35\x35\x71\x00\xeb\x79\x40\xd3\xf3\x80\x2b\x0f\x62\x7f\x17\x95\xdf\x45\xe5\x77\x51\xf9\x9d\x99\xdb\x59\xd3\x75\x11\x17\x71\x39\xa5\xae\xe2\xd0\x55\x1c\xba\x88\x43\x17\x2b\x44\x9c\x52\xa9\xab\x12\x52\xaf\x54\x52\x0f\x91\x1e\x05\x34\x3d\x76\xb7\x08\x26\x5f\x28\x9b\x4c\xf9\x44\x77\x18\xd9\x39\xeb\x17\x20\x07\xe4\xdc\x3d\xa9\xf7\xbe\xa7\x49\x60\x1e\x58\xe3\xdf\x8b\xfc\x4e\xac\x75\xbe\x01\xd6\xee\x39\xbc\x97\x9e\xf8\xe4\x72\x59\xeb\x24\x90\x92\x72\xbe\x00\x76\xe6\x28\x2d\xe0\x49\xc5\xe5\x15\x36\x73\x79\x57\x78\x12\x68\x8d\xed\x72\x99\xab\xbd\x63\x75\xc0\x17\x12\x61\x97\xab\x2d\xec\x9c\xd3\xb3\x0b\x83\x66\x48\xd5\x8e\xec\x20\x74\x71\xc5\xb9\xb8\xe2\x5c\x0c\x69\x39\x95\x37\x31\x37\x52\x77\x15\x5d\x77\x15\x5d\x1e\x0b\x36\x3d\x98\xb7\x0a\x69\x4a\x70\x4e\xc2\xd3\x90\xeb\x36\xa3\xeb\x36\xa3\xd3\x65\xaf\xe6\xea\x43\x74\x7e\x64\xc1\xee\xa9\xb6\x97\x9e\xc6\x9e\x1b\xbb\x4e\x07\x46\x45\xec\x72\x2f\xab\xe9\x79\x32\xd3\xf3\x64\x8b\x80\x21\x36\xf3\xf8\x98\xcb\x17\x5d\x6a\xc1\x56\x83\xcb\x15\x58\xe6\x1a\xf2\xe6\xba\x5b\xa7\xc7\xc7\xcc\x75\xc7\xcf\x25\x5e\xdd\xf6\xc1\xae\x1d\x48\x39\x13\xb0\x98\xc9\x9d\x58\xe6\xba\x5d\xe7\xba\x5d\xe7\xba\x5d\xa7\x47\x9a\xcc\xd5\x98\xd5\x73\x52\x8b\x40\x8d\xe7\x10\x36\x77\x3a\xe9\x68\x2e\xb6\xa9\x8b\x6d\xea\x62\x9b\xba\x8b\x5a\x92\x97\xcf\xcc\xc5\x34\x75\xd9\xbf\xbb\x87\x7f\x30\x07\x1e\x3f\x1a\xd2\xe6\x1a\x8e\xe6\xae\x7c\x71\x71\x34\xb8\xeb\xf8\x71\xe5\xbe\x2b\xf7\xd5\x77\xeb\xf9\x33\xf4\x98\x7b\xa6\xa3\xf3\xe6\x7c\xf5\x95\x39\xef\x2b\xba\x84\xb0\xb9\x58\xc3\x9e\x85\xb3\x7c\x08\xca\x5c\x8c\x5f\xcf\xaa\xb0\xb3\x2a\xec\x2c\xac\x17\x73\xd8\xf9\x8e\x0f\xf3\xc2\x9a\x52\xb7\x10\x5d\xb7\x10\x5d\xb7\x10\x3d\xeb\x80\xce\x3a\xa0\xd5\xf4\x75\x71\x68\x3b\xdf\x21\x65\x72\xe4\xc8\xe4\xc8\x91\xb9\x3a\xb8\x5d\xdd\xd7\xae\xee\x6b\xd7\xfd\x3f\xd7\xfd\x3f\xaf\x3c\x98\x35\x76\xcc\x75\xd3\xd1\x75\xd3\xd1\xab\xaa\xa5\xaa\x6a\xa9\xaa\x5a\x52\x37\xee\x24\x50\x07\x35\x56\xd8\xba\xc9\xe8\xea\xd5\x75\x7e\x0e\x6c\x60\xd6\xe0\x4d\x34\x78\x13\x0d\xde\x44\x83\x37\x95\xb9\xa6\xdd\xa9\xb6\xb0\x37\xed\x0e\x8d\x2e\xf3\xa6\xdd\xd1\x94\x99\x4d\x87\xb3\x3c\x1c\x36\x08\xc2\x3b\x79\xfe\x60\x26\x51\x5e\x08\xb3\xfa\x27\x09\x78\xd5\xef\xf2\x3c\xc2\x48\xc0\x23\xba\xcb\x88\x16\x5b\xd9\xc5\x4b\xeb\x7c\x55\xd8\xfc\x9e\x07\x8b\x7a\x65\xbd\xab\xb2\x54\x27\xac\xcb\x4b\x62\x93\xc0\xa3\x5c\xb7\x58\x5d\x8d\x59\xef\x3a\xef\x48\x00\xff\x22\x04\x29\x96\xb9\x2b\x11\xfd\xf3\x92\x10\x10\xd5\x1c\xd8\x17\x94\xc5\xef\x9a\xc5\x9c\xcd\x62\xce\x66\x7e\xd0\x60\x60\x96\xcc\xac\xe6\xeb\x20\x04\xa9\x11\x2f\x15\xb2\x3c\x1c\xb0\x32\x6d\x5c\x2b\x1e\xc4\x59\xe3\xe0\xb2\x06\xfd\x67\x0d\xfa\xcf\x1a\xd8\x96\xd5\xd9\x9b\xe5\xfe\xd3\x49\xe0\xd6\x73\xf0\x5c\x8e\x6c\xdb\x67\x31\x59\xb3\xec\x1e\x67\xd9\x3d\xce\x51\x98\x19\x79\xa2\xc8\x51\x99\x1b\x95\xb9\x6a\xd2\x66\x35\x69\xb3\x9a\xc5\x59\x83\xdc\xb2\xda\xb8\x59\x6d\xdc\x1c\x65\xa5\x36\xbe\xe8\x52\x73\x16\xdd\xac\xfb\xe0\x99\xef\x89\x18\x98\xb4\xc0\x3e\x74\x8a\xe9\x5d\x2a\xc5\x76\x5c\x36\x5e\xb8\x65\x13\x66\x9b\x30\x5b\xac\xe8\x2c\x56\x74\x16\x2b\x3a\x9b\x72\xd2\x64\x9d\x95\xe5\xb9\xb4\x49\x60\x5e\x6b\x24\xdd\xcc\x54\xf2\xb0\x9f\x52\xba\xd4\x4b\xfa\x4b\x5e\x2c\x9e\x04\xe5\x25\xaf\x9b\xb3\x46\xd2\x65\x8d\x3a\xc8\xec\xc5\x1e\xbf\xd3\xa6\x6d\x4e\x6c\x15\x65\xbe\x59\x76\x60\xd2\xc1\x59\x5c\xd4\x99\x5d\xd4\x39\x69\xb3\x93\x36\x3b\xc9\xfe\x4e\xe6\x6b\xe9\x66\x16\x51\xaa\x24\x3a\x21\xe9\x78\xa6\x67\x8a\xe7\xef\x34\xd3\xe4\xa4\x5c\x11\xff\xf2\xc0\xc6\x98\x8c\xa4\x2c\x56\x7a\x76\x3e\x58\x94\xc5\x28\xcf\x2e\x32\xe9\xaa\x09\xd5\x28\xcf\x6a\x94\x67\x3d\x67\x92\x5d\x26\x9e\xec\x32\xf1\x64\x7e\x34\x61\x60\x34\x6f\xb2\xfa\x83\x33\x3f\x9a\x30\x30\x8f\x1c\x0d\x92\xcb\xe2\x0f\xce\xea\xff\xcd\xea\xff\xcd\x6a\xd1\xe6\xac\xf2\xa0\x0e\xe1\xac\xde\xdd\x9c\x65\x0f\x22\x67\x1d\x17\x19\xc7\xc5\xbc\x13\xc2\xc2\x5c\xe5\xb6\x87\x67\xb5\xfc\x60\xa8\xc4\xc2\x4f\x26\xd7\x9d\x12\xc7\x5c\xf5\x6f\x7b\x9a\xe2\x4a\x9b\xe0\x10\xfd\xfc\xec\xbb\x07\xec\x67\x3d\x5c\x9a\x0f\x26\xce\xad\x58\xac\xff\x12\xd5\x34\xb6\x7f\x89\xbe\xb0\x80\x5f\xcc\x90\xda\x7f\x29\x20\x81\x1b\xd7\xa4\xf1\x2e\xf8\x46\x47\xbe\x22\xad\xad\xed\xb8\x4b\x29\xbe\x76\xc3\x2e\x21\xd9\xda\xc0\x7b\xb5\xf2\xb5\xc7\xf7\x08\x76\xea\x6d\x46\x81\xc1\x9b\x80\x4c\xb5\x73\x7b\x77\xdd\x70\xf3\x6e\xd5\xe1\xc4\x5b\x6e\x2e\x9f\x53\x1a\x9b\xcd\xf1\x3c\x54\xbf\xf2\xbe\x37\xd7\x2e\x8e\x14\x47\x74\x8c\xfa\xdd\xfa\x82\xa5\x38\xc4\x17\xad\x46\x3c\x7b\x7f\x73\xb6\x70\x6f\xbd\xdb\xc1\x36\x4e\x24\x46\x3b\xc8\x1a\x39\x39\xe3\xba\x91\x93\x33\x30\x1c\x39\x39\xe3\x9f\x91\x93\x33\xfe\x79\x71\x32\x6f\x91\x3a\x3c\xf3\x27\x60\x7e\x63\xc8\x76\xfd\xcd\x8c\x1b\x60\x7c\xf7\xf8\x21\x84\xd7\xa4\xd5\x4d\xed\xb5\x69\x11\x6e\x6c\xcb\xe0\x56\x44\xf0\x0c\xd9\x81\x9e\x2c\xcf\xdf\x6e\x49\xe5\x8b\xaf\x62\x33\x8f\x77\x75\x44\xf9\xf6\x5d\x50\xbe\x01\xdc\x4e\x54\x3e\x08\x8a\x1b\xe0\x29\xb8\xf2\x9d\x37\xbd\x56\x9e\xeb\x39\xa7\x98\xf6\xd0\x79\x84\x3d\x74\x2e\xc1\xc3\xf9\x62\xae\x8c\x5e\x7e\x7e\x4e\xd9\xf8\x81\x6f\xa6\x5b\xbf\x42\xbd\xc6\x4a\xcd\xe8\xe3\x02\x7c\x39\x4b\x9b\x8b\x90\x83\x99\x78\x51\xed\x09\x60\xb9\xa2\xba\x0a\xa9\x19\xf5\xd2\xc4\xa0\x97\xf6\xef\xf6\x2a\x55\x61\x8c\x94\xf7\x5a\xe8\x82\xe7\xf4\xff\xfa\xf4\x8e\x98\xcd\x1b\x1c\x31\xfd\xab\xf0\x8e\x6e\xff\x2a\x30\x7a\xc5\xa0\x9e\x0a\xf7\x5b\x61\xff\xdb\x4f\xcf\x9d\x4c\x1a\x08\x6a\x85\xbf\xcb\x75\xcd\xc7\x95\xbb\xa1\xfe\xec\x4f\xa4\xd7\xaf\x54\xcf\xae\xf5\x3c\x6d\x5a\x3f\x36\xca\xb7\x82\x22\x9e\x2d\x02\x99\x9b\xf8\x59\x15\x13\xbe\x09\x7d\x7d\x7d\xe7\xeb\x09\xaf\x24\xac\xa4\x87\xe7\x13\x9d\xf7\x7d\xd7\x51\xa1\xcd\x9b\x25\x9d\xd0\x2e\x6f\x58\x1f\x43\xc5\xd3\xaf\xf3\x6a\xf0\x78\x1d\x40\xda\x9f\xc6\xf0\x25\x88\xe2\x5c\xf0\xac\x45\xf6\x51\xa6\x57\xa2\x39\x26\xb4\x57\x81\xfd\x1e\x1d\x20\x2a\xef\xdd\xa9\x5b\x0f\xbe\xdc\x59\xf0\x8e\xe5\xd1\xc8\xf4\x9e\xd6\x88\xf3\x42\xc4\x33\xbd\x2c\x14\x5e\x65\x1d\x9f\xa8\x5a\x18\x33\x76\xb0\xa3\xf6\xd7\x05\x3e\x2e\xd8\x36\xe6\x10\x5d\x6a\x74\x92\x02\x5f\x72\xa0\xaf\x3b\xa2\x4c\xbd\x54\x1a\xf2\xa5\x50\x99\xd5\x31\x5d\x2d\xdf\x3d\x7e\xba\x61\x22\xf8\x1c\x63\x0b\x63\x53\x2b\x86\x7d\x6e\x7c\xfd\x34\x1b\x5f\xaf\xd9\xc6\x55\xf0\x35\x9d\x36\x6e\x58\x5c\xa3\x8a\x57\xee\xd0\x7b\xb9\x8e\x1d\x18\x5e\xd5\xe0\xb2\x99\xf5\x9b\x26\xbd\x46\xdd\x82\x0d\xaa\xd1\x61\x35\xb5\xb2\xe2\xc4\x89\x98\x70\xaf\xfc\x5a\x92\x73\x47\xf3\xaa\x55\x2c\xd4\x31\xf7\x7a\xa2\xc5\xdf\x39\x2d\xc0\xaf\x15\xed\x87\x4d\x08\xaf\xe4\xc8\xfd\x1a\x6b\x22\x19\x1e\x58\x92\x27\x6a\x67\xac\x5c\x99\x9a\x60\x2e\x3d\xa7\xe7\x5e\xfe\x06\x97\x8c\x4f\x48\xa9\x4f\xb0\xd0\x16\xa4\x17\xc0\xb4\x2b\x4b\x01\x4c\x13\x43\x5b\xe3\x87\x7c\x89\x5f\xa9\x58\xf3\x89\x6f\xbb\xe3\x15\xe7\xfb\x1b\xd4\x7a\xe1\x6b\x00\x8c\x09\x31\x50\xce\x30\x4c\x66\x4e\x50\xc9\x89\x1f\x83\xe2\x9c\x8a\xdf\xc7\x0d\xfa\x2a\x7e\x3d\x72\x15\x7b\xc4\x2a\xf6\x40\x29\xa9\xa9\xad\x20\xa2\x9b\x94\x36\xbe\xee\x86\x85\xdf\x46\xc4\xc6\x54\xae\x51\xb9\x91\x99\x71\x66\x0f\x5b\x88\x98\xdc\x13\xd6\xf1\x3d\xef\xb4\x2b\x12\xe9\xb8\xc8\x26\x40\x51\xf8\x3c\xd4\xfe\x95\x8a\x5e\x8f\x62\x41\x47\x44\x3a\x59\x71\x08\x4f\xa3\xc5\xfb\xb4\xc7\xa9\x52\x21\xbe\xc5\x1a\xe9\xd7\x46\xad\x89\xad\x61\xf7\xcc\xd3\x0a\x57\x1d\x2c\x7c\x0d\xdb\x8d\xc3\xab\xdb\x35\xc5\x57\xde\x68\x8a\xef\x8f\x9f\xec\x2e\x9c\xa0\x4f\x26\x0e\x67\xba\xd8\x85\x9f\x83\xa3\x13\x3f\x17\xc4\x86\xd4\xc7\x66\x89\x3b\xd9\x2c\x31\xef\xcd\x92\xd4\x20\x49\x0d\xd2\x5b\x9e\x1f\xac\x45\xde\xab\xb9\x2f\xc1\xe5\x8b\xa7\xbd\xe3\x0b\x8d\x3a\x45\xc0\x1d\xf2\x13\xd2\xe0\x30\x9c\xa5\x23\x46\x3d\xbd\xdc\x60\x32\xd8\x04\x03\xf1\xb0\x77\xb9\xfb\xe6\x22\x18\x51\xf3\x77\xee\x93\x84\x7b\x49\xd1\xbe\x9a\x5e\xf5\xec\x9a\x46\x65\x22\x54\x94\xf3\x4b\x18\xf5\x86\x36\xe5\x40\x19\x53\x9e\xd7\x86\xf7\xa7\xf7\x1d\xe6\xf3\xab\x63\xd2\x08\xf6\xfa\x79\x84\xf4\xf0\xc4\xde\xf8\xd9\x10\xef\x6b\xdf\xf8\x8e\x05\x7b\x97\xce\xef\xbc\x50\xdb\xda\x7d\x1a\xe1\xfc\x0a\xd7\xdc\x9d\x43\xb0\x87\x73\x76\x2f\x85\x3e\xb5\x4c\x3f\xf5\x20\x76\xd0\xb5\xef\x1b\xdf\x4d\x85\x73\x2c\xf5\xe5\x7e\xaf\xc5\x49\xeb\xeb\x0e\xdc\x4c\x5f\x24\x35\x39\xf1\x65\x67\xc2\xf5\xcd\xfa\x0d\x34\x48\xfa\xde\x83\x5a\xf1\x60\x18\x25\x0b\x63\xde\x46\x79\x1b\xe7\xdd\x11\x19\x08\xeb\x2a\xe7\xfa\x2c\x06\x86\x07\xa2\x17\xbc\xae\xef\x05\x03\x94\xd9\x30\xdf\x44\x65\xde\x98\xfa\x53\x23\x08\x3c\xda\xf0\x8e\x90\x05\xaf\x0e\x59\x95\xb8\x2a\x64\xc1\x76\xd7\xd9\x97\xb0\x97\xd9\x17\xef\x55\xf6\x4b\xb0\x82\x79\x2f\x6e\x73\xcd\x1d\x13\x7a\x74\xf6\x39\xe2\xd3\x85\xe9\x23\x5f\xef\xc2\xc0\xd7\x8c\x97\xf9\xec\xaf\xb1\x57\x72\x42\x1e\x64\x63\xde\x66\x03\x77\xd0\xc0\x5d\x52\x77\xee\xd3\x12\xb0\xa7\x72\x97\xdc\xfa\x4f\x6e\xcf\x2d\x3a\x7a\x1d\x63\xef\x37\x86\xce\x3b\xef\xcd\x1c\x19\x49\x81\x3b\x6c\x11\x40\x14\xee\x52\x6d\x55\x87\x86\x5d\xe2\x61\xb7\x6e\x25\xbb\x3b\x09\x1b\x53\x65\x7a\xa5\xca\xf4\xc2\x02\x5b\xbf\x18\xd2\x93\x80\x73\x87\xd9\x29\xbb\x57\xa9\x6c\xc7\x8b\x42\x6e\x0e\xc0\x0d\x0b\xd4\x35\xef\x0e\x8e\x7e\x31\x4a\x76\x0c\x3c\x48\x8c\x5a\x73\x0f\xef\xdf\xc1\x88\xd7\x4a\x46\x3a\x49\x1f\x1e\x01\xe5\x8a\x4f\xe3\x47\x3d\x8d\x1f\xf1\xac\xfd\xad\x54\x92\x31\x15\xc1\x9a\xf2\x0f\x2d\xd6\xb5\xd2\x3a\x62\x7d\x1d\x95\xbb\xfa\x47\xa8\xd7\x97\xef\xc8\x4d\xbc\xf8\x5e\x48\x1b\xfd\x4b\xef\x35\xef\x81\x60\x98\xfb\x55\x08\x0b\x65\xc3\xfa\xc0\x05\xa3\xf1\x39\x4e\xed\xe4\x09\x4d\x99\xf8\xa9\x87\x09\xdf\x2a\x64\xc2\xb7\x0a\x59\x11\x36\x2f\xe3\xda\xb1\x7a\xb5\x61\x85\x2a\x31\xa8\x52\xb3\xd1\x36\x76\xb2\x8d\x67\x9e\x54\xbd\x3b\xf5\xad\x8f\x23\x5e\x32\xb2\xf1\x5b\xd9\x6e\x4c\xe9\xe9\xf9\xf2\x4d\x78\xf3\xcc\xc2\x9c\xe3\x3b\x76\x71\xd2\x3f\x59\xf0\xfd\x4a\xf7\x63\xc9\x9a\x72\x31\x81\x41\xe0\xd0\xfd\xa0\x71\x0a\x98\x71\x7c\xbd\x98\x7d\xb7\x49\x0e\xa1\x1b\x13\x40\x05\x39\xbe\x48\x7d\x32\x25\x15\x06\xae\xda\x2d\x94\x16\x50\x2a\x23\xda\x51\xce\x13\xbb\x3f\x2b\x60\x33\xdd\x49\x0e\xef\xc4\x5e\xee\xaf\x28\x23\x03\xa3\x44\x45\x87\x4d\xd8\x08\x2e\xe5\x33\x5a\x40\x9b\x39\x6b\x33\x5f\x2f\x2a\x70\x4d\xb8\x37\x3a\x46\x06\x1f\xc2\xb3\x72\x1d\xae\x1f\x3c\xf5\xe9\x4d\x38\xd5\x61\x85\xe1\x6c\x59\xf8\xbb\x58\x70\xb4\x36\xd3\xe0\xce\x38\xb8\x33\x8d\xd6\x4c\xa3\x35\xcb\xa8\xcb\x3c\xea\x32\x8f\xba\xfc\xe1\x2d\xfd\x0b\x3e\x93\x6d\xc2\xf0\x92\x3a\x0c\xa6\x4c\xbe\xa8\xfc\x79\xc2\x3a\xb8\x71\x1d\x1c\x97\xc6\x0b\x5e\xc1\xcf\x77\x58\xae\x1f\x4b\xc3\x9c\xc0\x37\xb9\xdf\xff\x7e\x08\xbc\x83\x03\x51\xed\x4a\xe7\x1a\x94\x4e\x5c\x28\x9d\xb8\xd0\x70\x73\x68\x61\xac\x6f\xcb\xf2\x73\x7e\xbd\x38\x3f\x86\x31\x96\xbf\x4e\x8c\x69\x0d\x2b\x89\x4b\xe3\x7c\x15\xc0\xfe\xf1\x2a\xa0\x95\x12\xdf\xbe\x3c\x17\x4f\xe0\xaf\x0d\x25\x22\x1a\xe7\x8c\x0b\xcd\x8c\x6f\x56\xd6\x4b\xc0\x16\xde\x67\x33\x77\x1b\x62\x29\xd8\x27\xe4\xd5\xc9\xec\xd5\x19\xb0\xb2\xdc\x16\x29\xac\x14\x62\x7e\xac\xc4\xa1\xd8\x0a\x25\x6f\x5c\x16\xfa\x16\x06\xac\x92\x79\xab\xdc\x92\x56\xb9\x25\xad\x82\xda\x2e\xdf\x7b\x3e\xe6\xbc\xed\x7e\x98\x38\xf7\x34\xae\xba\xe5\x1d\x8e\x09\x5f\x31\x77\x9f\x64\x16\x52\xbe\x08\x8b\xb1\xf2\x45\x68\x40\xb9\x33\xe4\x42\x38\x43\xd2\x36\xc2\x40\x52\x62\xe6\x22\x33\x97\x99\x03\xb5\x2b\x83\x66\x99\xe8\xc5\x2d\x6f\xfc\x1c\x11\x0b\xdf\x3d\xdb\x81\x3b\x55\x84\x3c\x1d\x85\x7c\x57\xe5\x2b\x18\x8e\xb8\xee\x2b\x81\x49\xa7\xdc\x01\xbc\x6a\x82\xb2\xb1\xd2\x62\x13\x0b\x9e\xb7\xd9\x79\x3d\x93\x6a\x61\xac\x67\xc1\x6b\xcf\x36\xbe\xc1\x45\xb1\xd0\xec\x5f\xbe\x4a\xec\xad\xc2\x5e\x74\x7e\x15\x52\x2d\x65\x8c\xf0\xf7\x4c\xf1\xc0\x78\xc6\x62\x61\x68\x63\x6b\x94\x53\x41\x6e\xf5\x00\x8b\xab\x72\xfd\x66\xed\x66\x8b\xcd\x69\x9d\xbb\xa9\x63\x68\xff\xc6\xc8\x9e\x8e\xc1\x56\x0b\xbf\x81\x56\xae\x3b\x6c\xd5\x0b\x4d\xfb\x72\xdd\x79\xe5\x66\x84\x15\x41\xb7\x5a\xe1\x19\xbd\xf0\x3a\xbf\xdc\x8b\xce\x77\x9d\xd6\x85\xe1\x4a\xc0\x5a\x46\xf2\x28\xef\x0f\xb0\x74\xb8\xa6\x3c\x6e\xc2\x1a\x55\xcb\x5a\x5d\x78\xef\x9a\xf9\x26\x54\x2d\xa3\x62\x0f\xf1\xdd\xd0\x87\x50\x1a\x16\x51\xb5\x12\x55\x2b\x51\xe1\x4d\x95\x58\xde\xf4\x7d\xea\xd0\x35\x07\xe9\x4e\xbe\x14\x74\x13\xc0\x62\x28\x70\x39\xe9\xce\x83\x6f\x01\x3d\x84\x04\x2d\x99\xaf\x67\xbd\x9e\x94\x45\xc8\x22\x00\x27\xee\x2d\x5a\xed\x7d\x8e\x95\xe6\xcb\xbe\x26\x01\x36\xab\x0a\x5f\x2e\xb5\x71\xc3\xfa\x19\x31\xc5\xd0\x68\x28\x2b\xcc\x6e\x06\x3c\xcf\x50\x07\xa0\xd8\x5e\xb9\x5f\xca\x3a\x49\x7c\xb0\xbf\xbe\xbe\x91\x7c\xbb\xc2\xb8\x4d\xb4\xbf\x2e\x9f\x87\xb9\x65\xfc\x08\xc9\x28\xff\xd9\xc0\x4a\x35\xd8\x27\x85\x81\x52\x7e\xbe\x71\x5f\xee\x86\x45\x69\x7e\xcf\x44\x03\x65\x9f\x89\x7e\x14\x6a\xc9\x8d\xe6\xd9\xb8\x82\x26\x9d\xa5\x34\xfe\xbd\x54\x6e\xf9\xd2\xa0\xc4\x9b\x72\xbd\x24\xaf\xf9\x0d\xeb\x39\x08\x8e\x0c\x6f\x33\xfa\x42\x2b\x3e\x18\xc0\x8d\xdb\x47\xa5\x91\x62\x9a\x8f\x39\x72\x7a\x50\x52\xf8\xa1\xfc\x7e\xd3\x94\xe2\xf6\x43\x29\x44\x69\x5f\xfa\x21\x70\x6d\x1a\x77\x6a\x2b\x30\x56\x07\xce\xcc\xca\x51\x46\xfe\x69\x4f\x0e\x52\x48\xd6\x52\x4b\x13\x42\xd7\x24\xdd\x85\x10\x4d\x3f\x89\xf6\xf3\x4d\x09\xd2\x9a\xc9\x6d\xf9\xa6\xe9\x37\x45\xab\x17\xab\xd6\x2f\x36\xd7\x54\xed\x27\xe7\xfe\x93\xcf\x4f\x2b\x2c\xe8\x37\x16\x0b\x49\xd1\xa0\xfc\xa4\x32\xad\x8f\x25\x2d\xdd\x58\x21\x0c\x8a\xff\x7c\x23\x3d\xd3\x3f\x13\x2c\x45\xf7\xcf\x34\x85\x08\x4c\x3f\x41\x3a\x8f\xe0\x9a\x69\x6e\x42\x28\xfa\x85\xf4\x40\xff\xaa\xd6\xa3\x6a\x1e\xed\xa7\xea\x3f\x9f\x58\xf8\x69\xae\x69\x41\x3a\xd4\xfa\xcf\xc0\xea\x3f\x8c\xec\x3a\xb0\xa6\x53\x56\xb8\x92\x7f\xf8\xc4\xcc\x9f\x86\x87\x10\x58\xc5\xf4\x30\x07\x0e\x11\x8a\x4b\x12\x96\xe6\x1e\xbe\xaa\x49\xaa\x26\x69\x5a\xac\x68\xe0\x3e\x2f\x77\x55\x8a\x73\x41\x27\x6e\xf0\x7d\x61\xce\x94\x38\x55\x68\x47\x42\x64\xa6\xf4\x19\x69\x4d\x95\x89\x3a\x86\x67\xaa\xf6\xf3\xcd\x0f\xa5\x05\xa5\x08\x23\xa2\x6a\xde\x99\xea\xa7\x74\x1e\xc3\x93\xf2\x9b\x4a\x59\x21\x82\x36\x29\x9a\xf3\x98\x2d\x90\x92\x3e\xc5\xdc\x09\xc6\x9a\xb6\x47\x15\xa9\x9e\x3e\xd3\x2c\xcc\xe5\x0b\x56\x2e\xd3\x8c\x94\x2f\x58\x91\x0c\x42\x11\x42\x71\xc9\x83\xa7\xc6\x41\x08\xf2\x45\x53\x82\x74\x46\xfa\xba\x56\xac\x6b\xc5\xa6\xa5\xc0\x75\xf7\xa0\xdf\xd4\x9f\x54\xdd\x34\x55\xe3\x7c\xfc\xd3\x5c\xc6\x04\x0c\x93\x5e\x77\x55\xf7\xf3\xa5\x9a\xf6\x43\xe1\x46\xe6\x8f\xe7\xe8\x41\x68\x42\x28\x5c\x50\x56\x56\x66\x55\x01\x59\xb4\x69\xcf\x5f\xd7\x4c\x63\xd0\x34\x31\x68\xa2\x28\xfd\x3e\x28\x41\xbf\xe9\x9a\x6a\xc8\x35\x34\x71\x1f\x08\x78\xe6\x40\x2f\x6c\x69\xf5\xac\x5a\x60\xb9\xea\xa1\x64\xd8\xaf\x38\x04\x53\x73\x6c\x90\xd8\x1e\x1b\x94\xb3\x6f\x05\xc9\x0a\x17\x65\x5f\xe3\xea\xda\xe7\x5c\xb6\xf1\x84\xb7\x62\x68\x95\x12\x0b\x27\x72\x19\x34\x33\x95\x2b\x25\xff\xa4\xca\x3f\xa9\xa4\xa7\xe2\x7c\x67\x81\xab\xec\x73\xec\x30\x03\xeb\x6f\x85\xb4\x8e\x53\xb4\x84\x12\x98\x83\xae\x72\xbe\x03\x8a\xb9\xfc\xac\x86\xd6\x24\xfd\x16\x17\x59\xd8\x17\xa9\xfd\x90\xdc\x7e\x48\xf9\x37\xfb\x12\x7e\xb2\x57\x76\x0e\xd2\x4f\x89\x53\xef\xca\x57\x3c\xfe\xe3\x7a\xe9\xe0\x97\x24\x09\x8b\xce\x44\x8b\xd4\x7e\x48\x55\x1a\x54\x7f\x04\xa8\x7e\xe6\x4a\x49\x3f\xa9\x78\x0e\x99\x14\x57\x4a\x54\xb9\x9b\x9b\x75\x4a\x32\x95\xb3\x26\xfa\x78\x50\x54\xa8\xe6\x55\x21\x9a\x6c\x0e\x43\xf9\xca\x7e\xb2\xfa\x11\x86\xa6\xf3\xfa\x24\xa9\xd0\xfe\xd8\xd0\x71\x5d\xc0\xcf\x8c\xf9\xb1\xa2\x63\x50\x93\x73\x86\x5b\xb0\x1c\xb7\x5f\xd1\x6e\x6b\x79\xd6\x28\x99\xa7\x19\xf4\x8c\x14\xd7\xac\xfd\x27\x55\xa9\x9a\xaa\x34\x4d\x55\x45\xea\xfa\x0f\xc7\xfb\xef\xe8\xef\xbf\x9d\x30\xaf\xaa\xd5\xac\x64\x3d\xbc\x48\x9c\xd7\xf4\x26\x12\x41\x54\xf5\x8e\x22\x66\x95\xd0\x75\x7d\x3a\x49\xa5\xfd\x54\xa0\xfc\x94\x26\x42\x30\x5d\x26\x3f\x14\x1e\x19\x2f\x7e\x1f\x28\xe9\x87\x62\x4d\xf3\x49\x3f\x65\xf1\x82\x72\x50\xd8\xd6\x9c\x94\x9f\x3a\x97\x9f\xb2\xea\x4f\x9d\xcb\x4f\xe9\xe5\xa7\x2c\x51\x1e\x73\xd7\xfb\xa7\xed\xd2\x8d\x6b\xdb\x9a\x49\x49\xb5\x40\x54\xcb\x69\x50\x64\x86\x58\x57\xb4\xff\x90\xc2\x4f\x4e\x51\x19\x99\x7e\xa5\xc6\xd9\x8a\x5f\x3b\x97\xc2\x49\xff\xa9\x93\xcf\xd9\xb9\x11\x25\x6a\x27\xf9\x8f\x5a\x5f\x1b\x86\x9a\xd0\x92\x69\xee\xa6\x35\xff\xf1\x6d\x4c\x92\xcc\x07\x31\x7f\x3f\x04\x95\xd2\xfc\x23\xa5\xf9\x47\x26\xf3\x97\xc2\x0f\xe5\xb7\xa8\x9f\x54\xde\x7e\x28\x45\x29\xe5\x27\xe7\xfc\x93\xaa\xfe\xe4\x5c\x7f\xf2\x69\x3f\xf5\x51\x49\xca\x3f\x32\x99\xd5\xf5\xb1\xf7\xa1\x98\xfb\xbf\x53\xef\xf8\x7f\x57\x8a\x98\x0d\x6b\x1b\xa5\x31\x5f\x65\x52\x88\xea\x42\x8b\x73\x5f\xe4\x27\xe7\x20\x6d\x2b\x6b\x1e\x8c\x52\xa3\xa0\x15\x98\x53\x40\x63\xd2\x6f\x35\x4d\x05\xa0\xfd\x0e\xa7\x36\xc7\x5c\x63\xca\x4f\x32\xd3\x21\xd7\xd4\xff\x37\x49\xec\x01\x9d\xa4\xfc\x53\x9e\xac\x2e\x16\x29\xfc\x7c\xa5\x63\xac\xa9\x5f\x70\x85\xcb\x72\xba\xae\x5d\xb3\x66\x3d\x4e\xe4\x32\x54\xfb\xa7\xc3\xb2\xff\x28\xe6\x2e\xde\x88\x99\x4f\xd3\xb2\x54\xc5\xfe\xcc\x9e\x33\x16\x22\x68\x61\x6a\x65\x98\xae\x23\x4c\xbc\xc0\xb3\x86\x62\x05\x98\x7f\x4d\x73\x11\xf6\xcc\x5d\x5b\xfd\xc6\x7e\x52\x89\xc2\x9b\x97\x80\xff\x50\x82\xe6\x1c\x79\x2d\x3d\x49\xec\x56\x5f\xa4\xf6\x43\xca\xf6\x4b\xfa\xcd\xab\xfc\xe6\x25\x5d\x62\xfe\x63\xb2\xda\xf2\x4a\x2b\xa9\xfd\xe6\xd5\x7e\xf3\x6a\x3f\x55\xd5\x35\xe2\xbc\x0f\x5d\x38\x9a\x7f\xb8\x9e\x45\x28\xed\x77\x75\x32\x49\x41\x33\xd2\xae\xd1\x35\xf5\xa0\x68\xd7\xe4\x9f\xae\xc9\x6a\x53\x58\xfe\xe5\x79\x56\xa7\xd7\x24\x29\x9f\xf2\x8f\xa9\x6b\x5b\xad\x36\x49\xe8\x3f\x5f\x89\xa9\x6b\x59\xbd\x4d\xb3\xc5\xe1\x97\x09\xf6\x53\x09\xd3\xf1\x92\x75\x7f\x62\x25\xfc\xa9\x84\xa9\xf8\x67\xf5\x89\x46\x2b\x9f\xf2\x66\xee\x8f\x50\xb2\xb5\x3f\xd4\x88\x12\xb4\x67\xd4\x3b\x60\x45\xb6\x02\x26\xc5\x35\xe7\x2c\xd2\x53\x74\x4a\x1c\x14\xd3\x54\x2a\xbe\x45\x0d\xb7\x75\x07\xfd\x0f\x89\xfd\x4e\xd1\xca\xcf\xc2\x68\x5e\x87\xcf\x6d\x2b\x63\x06\xd6\x64\xe5\x7f\xca\xfb\x69\xee\x8f\x60\x94\x65\x95\x6b\x5e\xff\x93\xbd\x30\xe1\x67\x2d\x3a\xaf\x79\xe7\xcc\xab\x0e\xbc\xf2\xb3\x2c\xb0\xaa\x13\xfe\xcc\xc7\x7f\xb3\x0e\x3f\x5f\xc9\x4a\x65\x5e\x8e\xaf\x99\x8b\x23\xc7\xea\xcf\x2a\x64\x66\x2e\xed\xad\xea\x65\x9d\x5f\xf5\xf6\xcb\x82\x9f\x8a\x5a\xfc\xfd\x4a\xd6\x1d\xeb\xaa\xfa\x9f\x12\x55\x7f\xcf\x0b\xe9\x7f\x49\xbf\xd9\x8b\xa5\x83\x41\x13\xf7\x1b\x14\x3d\x7f\xf7\xd2\x6c\x5c\x20\x7a\xe4\x5c\xdd\x70\xf7\x6b\xef\x79\x42\x7f\x84\x08\xc1\x2a\xf7\x52\x83\x51\xe4\x39\x77\x3a\x63\x0f\x2a\x05\xbd\xd7\x0f\x4f\xb1\x54\x3a\x6b\x58\x6f\x6c\xcd\xca\x04\xef\xb8\x58\xf0\xee\x69\xd7\x1b\xd2\x5a\xce\x6f\x10\xbf\xb0\xf0\xdb\x50\x9f\xf8\x45\x2f\xd4\x1b\xa8\xba\x13\x3b\xd5\x81\x02\x1b\xea\x0c\xbb\x79\xdc\x9c\x18\xb3\xaa\xc0\xa8\x89\xde\x46\x7b\xa5\xb0\xb2\x1d\x39\xfd\xea\x38\xdf\x46\x02\xbe\x04\xb8\x75\x7a\xe3\x7b\xf3\xe5\xc6\xef\x24\xdd\xc2\x58\xd6\xc0\x8d\xb2\xc7\xbb\x8e\x0f\x01\x99\x72\x0f\x8a\xd4\x9b\x01\xb5\x3c\x06\xbc\x76\xef\x10\x5e\x68\x4f\xfd\xf6\xb9\xf8\xd7\x04\xd7\x1c\xf0\x82\xba\x58\x39\x5c\xae\xbe\x20\x95\xfd\xb9\xc5\xcf\xef\xde\xf9\xc2\xef\xfe\xfe\x49\x30\xaa\x31\x3d\x07\x73\x08\x01\x99\x64\x11\x0e\xda\x4f\x02\x04\x3d\x36\x8a\xd7\x6c\x18\xaf\x59\x5f\x20\x6a\x98\xbf\xdd\x17\x52\xec\xc0\x37\x7e\xda\x67\x15\x73\x4d\xe1\xbd\x6e\x1b\xdb\x8d\x1f\x5b\x9f\x96\xfa\xee\x24\x59\xf0\x0d\xbc\x76\x43\xaf\xda\x42\xc0\xad\x06\x07\x10\xeb\xc4\x0d\x82\x83\xda\xdc\xe9\xbf\x33\xd0\x84\x37\xfe\xa2\xcd\x68\xc8\x54\xbe\xb8\xdd\x51\x93\xb0\x6f\xa3\x48\x17\x47\x4c\xd0\xde\x60\x6b\x14\x1e\xd5\xae\xe0\xee\x16\xc4\x10\xf0\x57\x94\xf9\xf6\x61\x34\x6f\xbb\x91\x54\x27\x1f\x7c\xed\xf8\x10\x9e\x84\xb6\x3b\x62\x76\x83\xb7\xe7\x9e\x09\x6f\x30\x2e\xfc\xc6\xc8\xc6\xe1\x75\xd3\xba\xb2\x0b\x9a\xb9\x08\x52\x05\x08\x10\x6e\x38\x2a\x5e\x9d\x20\xc6\xe8\x10\x28\x0f\x47\xa9\x5a\x65\x34\x48\xb0\xe3\x3e\x4f\x1d\xac\xc0\x31\xb9\x8d\x31\xbb\x39\x63\xe2\xf7\x95\x98\x62\x24\x24\xd1\x28\x26\xaa\x9d\x89\x13\x1a\x90\x48\xe8\x63\xe5\xce\x2d\x30\x0a\xf6\xed\xcd\xf0\xb1\x73\x61\xb5\xd3\x68\x62\xa1\x3b\x51\xa1\xa7\x1d\x6d\x1f\x58\x4f\x17\x16\x86\xf7\x9a\xc9\x8d\xef\x3d\x3b\x07\x9b\xe0\xc8\x85\x99\x9d\xd5\xd7\xc5\xc7\xae\xb8\x84\xb3\x2e\xb8\x84\x63\x53\x5c\xc2\xf1\xac\xbe\x3c\x35\xd3\xeb\x57\xbd\x94\xae\xb9\xde\x25\xfe\xab\x89\xd9\x19\x7c\x97\x92\x7f\x28\x05\x29\x31\x0a\x37\xf7\x94\xfb\x38\x84\x9a\x24\x36\x27\x55\x32\x70\x32\xc6\x24\x67\x33\xa6\x47\x0a\x78\x81\x9d\x87\x90\x94\xc7\x38\x38\x5a\xe6\xfe\x67\x71\xe8\x78\xbc\x75\x63\xc3\xe1\xd9\xef\x4b\x19\x7e\x09\x5b\x7f\x03\x81\x46\x70\x8f\x52\x85\x1e\xe1\x76\x98\x49\x80\xab\xb0\x36\x6e\xfc\x33\xb6\xb1\xc3\xc5\x5a\x1b\x17\xf9\xbd\x16\xac\x50\x9a\x53\xfe\xed\x84\x81\x13\xff\xde\x02\x97\x5e\x1b\x7f\xcf\x2a\xac\x53\x7c\x61\x7b\x11\x8a\xf9\xef\x9e\x56\x7e\x23\xee\xbd\x90\xb8\x87\xf7\x79\xd6\x71\x67\x77\x23\xff\xca\x86\x34\xd6\xe7\xb3\x3e\xeb\xb4\xdb\x99\x27\xee\x91\xf7\x9d\x20\x91\x36\xb8\x27\x68\x77\xe9\x78\x36\xad\x71\xa0\x60\x7b\x77\x03\x8f\x5f\xfb\x87\xaa\x79\xa0\x27\x8b\xfd\x83\x60\xd4\xfe\xd1\xa3\x8f\x0b\xdf\x16\xf5\x0f\x1b\xd4\x6f\xd4\xf6\x4e\x19\xe9\x37\x0a\x16\x5e\xf9\x3e\x4d\xb8\xf0\x53\x84\x0b\x3f\x6b\xa6\x7f\x7c\xb6\x7c\x62\xac\x47\xa5\xb2\xf0\x56\xcd\xd1\x3c\x98\xb7\xfb\x67\x85\x18\xe1\x58\x67\x3c\x9c\xd9\x3f\xeb\x70\x28\xbb\x7f\x09\x9e\x01\x5c\x10\xea\x9b\x22\xd7\x2f\x45\xac\x5f\x92\xd6\xa7\x40\xfc\x4f\x64\x2a\xf5\x2f\x2d\x59\x39\xbe\xf7\x4b\x38\x6b\xea\x4d\xc8\x77\x99\x7b\x09\x27\x3a\xe3\x11\x02\x7e\x81\x85\x38\xc6\x36\x4f\xf8\xc6\x46\x7f\xe7\x58\xec\x40\x6c\xbb\xb3\xfc\x38\x3c\xc3\xbb\x20\xcc\xca\x0b\x63\xdf\xd2\x1d\xdc\x03\x93\x68\xe2\xaa\xa3\xd3\x6a\xa0\xdf\xb0\xfa\xc5\xc5\x92\xb0\x07\xde\x45\x40\xe1\x62\xec\x91\x4a\x92\x5b\x49\x72\x2b\x49\x2e\x5c\x38\xb4\x4a\xa1\xbe\x2b\x89\xda\x5e\xa9\xba\x35\x33\x93\x9b\x71\xa5\x9a\x71\xa5\x9a\x61\xa5\x1a\x0d\x99\x46\x6c\x69\x26\x39\xf7\x75\x2e\xfb\x48\xe7\xc0\xcd\x18\xf7\x4c\xf8\x3d\x9f\x1d\x3b\x19\x7f\x9d\xcf\xa5\xf7\x77\x2e\x7d\x0f\xe5\x7b\x5f\xee\x19\x9d\xce\xc3\x2f\x8b\xf4\xc7\x1c\xf9\xf7\x40\xe3\x13\xaf\x9e\xd9\xb9\x93\x2e\x28\x01\x6f\x96\xd8\x04\xca\xff\x18\x42\x27\x7f\xc3\x1e\x8b\x05\xaf\x06\xdd\x84\x37\x1b\x2f\x5c\x80\x97\x03\x07\xce\x9d\x78\x3d\x09\x6f\xe9\xb2\x4b\x20\xf5\x34\x08\xa9\x60\x91\x0d\xab\x64\x46\x32\x85\x37\x38\x8d\x5f\x13\xc9\x26\xdd\x94\xd0\xe5\xc9\x98\x08\xb7\x43\xed\xbc\xf1\xe4\x58\xd7\x7b\x0e\xba\xbc\x1f\x13\xfb\xba\xde\x13\x9a\x77\x8f\xb6\xb6\x0b\x61\x1a\xef\x70\x0f\x27\x24\xa0\xfe\xe0\xcb\x3d\x0f\xe1\x1d\xcc\xdd\x99\x3a\xc8\xeb\xba\x56\xf3\x1a\x95\x87\x70\xa6\x6f\x0b\xb8\xfe\xb3\x70\x55\x73\x9e\x28\x07\x42\xf4\x65\x7d\x87\xec\x16\xba\xc3\xd5\xde\xed\x45\x2b\x61\x7d\x43\x6c\xbf\xd1\xba\x6b\x3c\x50\x97\xdf\x9e\x16\x58\x18\xb3\x6d\xf0\xb4\xe0\xfe\xfa\xc8\x9b\xad\xeb\x83\x7a\x79\x2d\xeb\x54\x2c\x5c\xd8\x35\x5a\xbd\x7b\x73\x27\x1d\x10\x0b\x1a\x18\x4b\x1a\xf8\x5a\x28\x1b\xbf\xde\x9f\x84\x59\xb5\xe9\xd6\xab\x44\xb1\x36\x7d\x71\x8f\xb2\xe2\xea\x37\x0e\x58\x46\xe5\xc6\x47\x78\x68\x60\x42\xc3\xf6\x44\xa7\xce\x89\x89\x18\x19\x93\x70\x32\xa6\x26\x2d\x6c\x6f\x9d\x78\x70\xc0\x16\xb6\x77\x53\xf0\xc1\x28\x36\x03\x1f\xab\xef\x35\xee\x38\x9b\x81\xb2\x37\xb5\x2c\xd0\x49\x56\x0b\x74\x92\x75\x37\x97\x79\x4a\x6f\x1e\xac\x5b\x24\x4e\x05\xe3\xf7\xe6\x12\x8b\x70\xc7\xdf\xfc\x0c\x5a\x1e\xf1\x04\xdc\x44\xaf\x5f\xe7\x6d\x6a\x2f\x13\x77\x42\xe5\x5d\xa4\xba\xe0\x75\x45\x2d\x78\xa7\xf5\x05\xef\xb4\x6e\xf1\x8c\x9b\xfd\x6d\x96\x0a\x65\xae\x51\xe6\x2a\xe1\x01\xb9\x01\xf1\x5c\xcc\xc2\x01\xb2\xc2\x89\x6f\x60\xbc\xcd\x75\x63\x4a\xed\x94\x3a\x21\xdb\xe8\x12\xd6\x8d\x1b\x34\xf9\xde\xb9\x7a\x52\x67\x6e\x06\x9e\xa7\x1b\xb8\x4f\x5e\x4f\xbb\xe8\xc0\xb5\x37\xb1\x3f\xa6\xb2\xf0\xda\xf7\x8d\x0b\x67\x76\x8e\xd6\x2c\x74\x56\x85\x0b\x9d\x25\xe1\x42\x6d\x47\x20\x1e\x74\xd6\xaa\x17\xef\x80\xfb\x8b\xb7\xc7\xf9\x60\x93\xef\x6d\x47\x2b\x2c\xdc\x4f\x68\xe0\x82\x31\x7c\xf8\x6b\xac\xaf\x62\x70\x08\xd8\x22\x9e\xf1\x1b\xa8\x63\x47\xc0\xcd\x00\x16\xf1\x5c\x9e\xbd\xeb\xad\xd2\x49\x47\x8c\xbb\x9e\xa7\x95\x11\x78\x9e\x2c\xa2\xf1\x61\x11\x3d\x4f\x33\x61\x64\xd1\x89\x01\xad\x8b\x4d\xc0\x0e\x1e\x4b\xdb\xb4\xa2\xa7\x22\x10\xfa\xe5\xc6\xc2\x7b\x6b\xf0\xe2\x15\x11\xb9\xa1\x3d\xee\x9c\x95\xf2\xee\xb7\xc8\xad\xbe\xc7\x69\xf7\xc7\xb5\x20\x17\xd0\x43\x73\x6e\x9b\x39\x7a\x25\xbe\xf3\xc9\xfb\x57\xf0\x45\x59\x7c\x3e\xe7\xc7\x87\x67\x85\xcc\xab\x6c\x98\x4f\xad\xb3\xd8\x0f\xc2\x0f\x5b\x60\xfc\xce\x04\x20\xca\x03\x83\x24\x0f\xe8\x4f\xad\x6c\xa6\xee\x58\xdc\x47\x88\x6b\x0d\x02\x6c\x5f\x4b\x8e\xed\xcc\xa5\x8e\x32\x96\x81\x01\xa9\x45\x93\x70\xed\xaa\x4b\x40\x5d\xca\x3c\xc1\x9b\x84\x2c\x92\x21\x34\x60\x76\xec\x29\x83\x9b\x35\x96\x6f\xfa\xd4\xcc\x3e\xfa\x05\x74\xb4\xbd\x85\xea\xe4\x83\xdd\x1b\x87\xea\x44\x8d\xd2\xe1\x3b\xac\x1b\x3f\x5d\xb1\xf0\xd3\x15\x1b\x3b\x7d\xff\x2e\xe9\x3e\x38\xf0\xf7\xf7\xde\xd9\xfd\xbb\x49\xfa\xeb\x8c\xdc\x38\x63\x7d\x70\xaf\x69\x60\x3c\x0b\xb9\xdf\x5e\x3f\xcc\xb4\x6b\x6e\x6d\x94\x91\x29\x60\xbf\x9b\x7d\xde\x39\x5f\x7c\x53\xc2\x8c\xac\x31\xfb\xe0\xda\x14\xb3\xaf\xe3\xc3\x1b\x0b\x03\x77\x7b\x83\x5b\xe5\x17\x7e\xee\x97\x8d\x03\xa7\x16\x86\x05\xec\x1f\xb8\xfe\x61\xfe\x08\x6e\x8e\x8d\x23\xf5\x96\x63\xbd\x23\xdc\x7d\x73\xbe\x26\x66\x06\xb8\x03\x72\x39\x15\x67\x4c\xf0\x56\x23\x87\xe0\xd0\x7d\x11\x6f\xd1\xbf\x84\x26\x49\xee\xbb\x81\x97\x60\x92\xe4\x46\x73\x1e\x82\x29\xe1\xba\xa4\xef\x17\xae\x84\xa4\x04\x2a\x16\xde\xf9\x31\xb8\x88\x6c\xf3\x02\xae\xd0\xd9\x37\x56\xdf\xd8\xff\x8d\xef\xf2\xe2\xdc\x68\xfd\xc4\x03\x0f\x3f\xef\x5f\xdf\x62\xed\x10\x02\xf4\x7a\x4c\x24\x42\xf7\x4a\xa6\x53\x3a\xf7\xb2\x35\x15\x7a\x47\x11\x8b\xb4\x74\xd9\x04\xfc\x1d\x9c\xe8\x13\x72\x53\x9d\x86\x45\x84\xdd\xc9\x09\x59\x64\x70\xa6\x30\x9e\x29\x8c\x67\x0a\xb8\x05\x6d\x65\x35\xc4\x3a\x01\x53\xae\x26\xdd\x2c\x65\xd1\x1e\xbf\x3a\x8c\x8b\x75\x11\x39\x63\x48\x0d\x7a\xba\x6e\x82\x71\xe1\x51\x7f\x8f\x34\xc2\x49\x0d\xdb\x7e\x3d\x07\x2a\xbb\x79\x78\x4a\xf7\xc6\xb5\x81\x3b\xf6\xcd\x58\x6b\x9b\x6a\x6d\xcb\xd4\xdb\xe6\xdc\x70\x97\xde\x9e\xaf\x05\xdd\x9a\xa6\x8f\x94\xb7\xb1\xa1\x68\x7c\x39\xff\xc6\x2d\x70\x4d\xef\x79\xe2\x99\xdb\x73\xb9\x58\xc2\x49\x24\xe1\x24\x32\x3f\x7c\xb6\x50\x7a\x0a\x73\x8e\xaf\x74\xaf\xde\xad\x0b\x35\x4c\x8a\x4a\x39\x91\x52\x5e\xf9\xdc\x2b\x11\x0f\xbe\x0a\x25\x7d\xf4\x56\xcc\xc2\x50\x4e\xa6\x2a\x66\xa9\x63\x86\xb7\xed\xed\x5d\xa3\xb6\x3e\x2e\x09\x51\xa7\xea\x77\xea\x82\x69\x87\xa1\xd6\x5e\x84\xe7\x3e\x38\x84\x37\x06\x17\x01\x7b\x2d\x06\x5c\x79\xec\x2c\xef\xa1\xfd\x43\x78\x8b\xc0\x43\xf0\xc7\xe2\x45\x48\xaf\xb3\x70\x47\x7f\xc2\xf4\xdd\x47\xfd\x6c\xbd\xe9\xbc\x1f\x3e\x4a\x8f\xf0\x0c\x81\xf4\xb6\xc7\x4f\x33\x61\x74\x27\xdc\x1e\xad\x90\x61\x91\x0c\x53\x96\x22\x5d\xeb\xf0\x2c\xdf\x4d\x78\xeb\xcc\x93\xc4\xe5\x0b\xc7\x66\x82\xe7\x6e\xfe\xde\xb9\xd5\xe8\x04\xb6\x44\x97\x46\x5a\xa2\x8b\x1f\x07\xac\xe5\xbd\x74\xb2\xf1\xdd\x90\xd9\x38\x61\x7d\x8f\x8a\xeb\x17\x16\xe7\x9f\x99\x47\x15\x17\xab\x89\xee\x97\x9a\x90\x04\xed\x5e\xf6\xb4\xea\x6a\xc1\xb1\xa5\x78\x79\xc4\xb9\x2e\xf5\x68\xdb\xc4\x1a\x2b\xd1\xe6\x88\x25\xf2\xa0\xd9\x7a\x27\x91\xe4\x13\x37\x4f\x0c\x5f\x62\xdc\xf5\x86\xd7\x1c\x03\x10\xc2\xe3\x94\xf1\x20\x9f\x2f\xba\xdc\x07\x27\x36\xe1\xc6\x2c\x18\xbe\xf0\xd2\x2f\x0e\x50\xa1\x6c\x9c\x1d\x37\x27\xe3\x3e\xb9\xe1\x8b\x32\x90\x1d\xb7\x30\xe3\x3b\xe7\x97\x00\xc2\x49\x51\x48\x96\x28\x0a\xe9\x94\x89\xb2\xb1\x08\xb7\x89\xfe\xbd\x0b\x90\xcc\xe1\x4e\x78\xbb\xf7\xec\x9d\x72\x32\x37\xcd\x59\x66\x38\xba\xe9\x10\x9e\xbd\xe0\xb8\x0d\x65\xfe\xc1\x74\xee\xdf\xdb\x26\x9a\x15\x82\x8c\xfd\x83\x4b\x58\x66\xd0\x2f\x32\xc8\xaf\xfb\x71\x7d\x0b\xb7\x06\x1a\xdd\xee\x67\xce\x97\x78\xce\x40\xe0\xe7\x7b\x5e\xf0\xb1\xf1\x3d\x94\xb2\x4a\x81\x6b\x35\xd7\x6f\xd0\x8d\xfe\x79\xc4\x72\xe8\x11\xd9\x83\x81\xdf\x5e\xe8\x6b\xe2\x38\xca\x33\xbd\xfe\x31\x50\x95\xfa\xc3\x7d\x50\x83\x6b\xc2\xb6\xb2\xf7\x16\x97\x9c\x2c\x9c\xaf\x1e\xdd\xbf\x37\x10\x83\xb6\xee\xf9\x3f\xbf\x57\x6a\x14\xdd\x29\xb4\x30\x16\x5e\x71\x5e\x58\x5f\x03\x6b\x6b\xf8\x80\x1f\x35\x7c\xc0\x0d\x74\x56\xbc\xab\x09\x8f\xc4\x04\xea\x4f\x78\x15\x62\x7f\xfb\x4c\x31\xdf\x4a\xf9\x95\x7a\x5e\x7c\x38\x95\xba\x2a\xb7\xbd\xcf\x49\xce\x42\xa3\x36\xcd\x23\x7a\x0d\xf2\x43\x0d\xee\xdf\x7d\xe1\xe1\xa5\xef\x38\x00\xfc\x9c\x26\x83\x1a\xc6\xf5\x96\x82\xed\x38\x5d\xf3\x7b\xf7\xd9\xc9\x14\x2e\x95\x99\x31\xe7\x15\xa5\x3b\xb2\xe8\x44\x96\x1d\x0c\x51\x99\x91\xe8\xe1\xcc\x73\xfe\x08\x06\x1d\x11\xd1\x0c\xf1\x67\x61\xe7\xf7\xf9\x33\x44\x36\xe1\x4d\x7a\x9b\xe0\x30\x0e\x16\x21\xbc\xce\x5d\x75\x68\x4f\xa8\x22\xdc\x76\x39\x21\xb7\x20\x93\xf8\xe3\xad\xf8\xe6\x6f\x12\xdb\x79\x77\x4e\xdb\xb9\xf6\x9d\x54\x0a\x5e\x03\x6e\xfc\x22\xcf\xd4\x1a\x24\xeb\xf0\x6a\xf4\xd6\x31\x9e\x44\xe9\xa0\x9f\xdd\xef\xbb\xb9\x27\x77\x17\xc9\x5a\x6f\xf4\xf5\x2f\xce\x38\x15\x24\xec\x43\x16\x44\xf2\x1f\x52\xf5\x9f\x84\x3b\x46\x1a\x48\x27\x9c\x9f\x48\x55\x13\x9a\xfd\x54\xe2\x9c\xe3\x42\x92\xdb\xcf\x57\x5e\x1e\x09\x14\x79\xe6\x1d\x76\xcb\xb8\xc3\x6e\x74\x67\xe3\x3c\x17\x80\x29\x51\x5b\xd3\x35\x90\x86\xd7\x40\xce\x12\x33\x75\x10\xcf\x3d\x0e\x73\xe0\xaa\x02\xdc\x11\x67\xf9\xfa\x3d\x56\xa1\xf0\xb4\x89\xe5\xaf\x50\x65\x0b\x55\x16\xee\x8a\x1b\xbf\x25\x42\xe4\x75\x98\x18\x2b\x10\xd1\xe8\xc9\xe4\xf0\xc8\x4f\xae\x0f\x0c\x58\x23\x72\x4d\xe6\x2f\x56\xaa\x13\x3e\x1e\x65\x99\xc7\x44\x7e\xcf\x4a\xac\x62\x87\x98\x3f\x0d\xb0\xf1\x5d\x4b\x6c\x7c\x47\xe7\xc2\x37\xd0\xef\x60\x93\xdf\x23\xfe\x4e\xed\x32\xd6\xa8\x19\xee\x25\x5e\x5c\x31\xb8\x3e\xd0\x56\xec\xe4\x53\xd0\xf9\x85\xe1\x9c\xaf\x93\x64\x17\x5d\x09\xf9\x97\x40\x05\xe2\xee\x45\x56\xc1\x35\x74\x29\x64\x76\x5a\x66\x7a\xdb\xc7\x1a\x4f\x45\x8d\xa6\xa2\x76\xfd\xf6\xf1\x6f\xf7\xde\xb7\x42\x60\xed\x5c\xc3\x78\xca\x07\x31\xee\x24\xfe\x9d\xc4\xbf\xa3\xf8\xbf\x68\xc5\xfe\x77\x9d\x32\xf0\x23\x4e\x4b\x8d\x17\x16\x9d\xad\x6f\xd8\xdf\x5e\xa5\x5c\x59\x0b\x13\xc2\xdb\x63\xd6\x31\x02\xd7\x5e\x54\x4d\x5e\xd9\x72\x21\x70\xfb\xbb\xf5\xa7\x5d\xf7\xb7\xa1\x30\x64\x26\xbc\xd7\x0a\xd2\x23\x3c\xc3\xe4\x10\xae\x55\xd0\xe5\xd5\x85\x4d\x00\x33\xba\xcb\xab\x0b\x9b\x70\x05\xa1\xbf\xe7\xdb\x4e\x1d\xb8\x71\x38\x59\xf4\x27\xb5\x1b\x5a\xfd\xfa\x75\x36\x6c\x5c\xb0\xfe\x10\x9c\xbc\x71\xc0\x9a\x14\x2e\xda\xa9\xe8\x63\x68\xdf\xdc\xf3\xb0\x9d\xce\x76\xd3\xc5\x5b\xd5\x1f\xbc\x77\xc9\xfa\x5d\x70\x1c\x4e\x39\xf7\x04\x4a\x78\x7f\x71\x6a\xa7\xe2\x4d\xdb\x09\x83\x6b\x13\x9e\x7d\xb3\x09\xcf\xbe\x39\x84\x00\x5d\x97\xe7\x23\x45\x0d\x6b\xbf\x6e\xa4\xeb\x0f\x3b\xf0\x8e\x76\x36\xfb\x7e\x75\x17\x4a\x9c\x26\x0c\xe7\xff\x9c\xae\x0b\x77\x65\xde\xdd\x2a\x39\x84\x7d\xd6\xe5\xe2\x7d\x10\xed\x61\xae\xdf\x3d\x56\xf5\x08\xfb\x54\xd5\x23\xec\x00\xb0\x47\xd8\x97\x9e\x00\xc1\x24\xc9\x3e\x15\x08\xa5\x14\xf9\xa2\x29\xdf\x9a\x56\x6c\x9f\x77\x4c\x61\xea\x46\xbb\x1e\xea\x43\xb8\x9b\x18\x9b\xf0\x9c\x18\x87\x70\x07\xc9\x26\x9c\x41\x32\xe1\xd3\x96\x29\x50\x4c\x65\x0a\x34\x11\xa5\x40\x9a\x24\x9d\xd7\xc4\x4e\x5d\x79\xfc\xa0\xbb\xab\xe3\x3b\xca\x4f\x84\x9f\xef\x61\xf7\x49\x60\x19\x78\xbe\xbf\xcd\x8a\x26\x32\x57\x59\x08\xe0\x2e\xc1\x47\xd9\x67\xc3\x80\xb0\xba\x68\x06\xfd\x81\xa7\x7c\xe3\x33\x86\x4f\x4c\xe0\x61\x45\xa4\xa0\xa1\x14\x99\x15\xf1\xb1\x22\x05\x7a\x02\x71\x72\xf8\xe9\xfc\x09\x9f\x66\x3c\x3d\x78\xbd\x19\x97\x70\x86\xd7\xa8\xc7\x79\xb0\xf6\xc1\x33\xf8\x53\x24\x5f\xc4\x80\x30\xdb\x25\xde\xa2\x4b\x91\x74\xc1\x84\x4f\x23\xce\x06\x77\xca\x8a\x7c\xf9\x87\x70\x55\xc3\x21\x5c\xd5\x70\x09\x58\xf7\x31\x89\x1e\xdb\xe3\xe0\xd3\x6d\xbb\x31\xfb\xf5\xc9\x86\x84\x7d\x2c\xed\x11\xf6\x48\x7b\x84\x15\x9c\xf9\xf0\x39\x71\x0e\x49\xfa\x4f\x26\x3b\xe6\xe6\x51\xb6\x74\x24\x23\x8f\x55\x32\xee\x6d\xe3\xde\x36\xe8\x6d\xd8\xf6\xcc\x90\x31\x72\x0d\x0f\x58\x6d\x7c\xb5\xdf\x49\x50\x80\xa9\x03\x3b\xb0\x6c\xe0\xf4\xc3\xb2\x73\x35\xc4\x8c\xa4\xa9\xe0\x3c\x3d\x84\xeb\x3c\xdd\x84\x3b\xe6\xed\x39\xdc\xca\x86\xd0\x02\xa3\x97\xc1\x4e\x66\xde\x24\xf7\xeb\xea\xb8\xe5\x1f\xf7\xd9\x25\x9c\xf9\x2d\xf9\x7b\xb9\xac\x6c\x08\x3a\x87\x57\x80\xc9\xc9\x52\x4e\x4e\x96\x72\x72\x60\xfd\xd9\x5e\x68\xab\x4c\xb8\xfb\x7a\x56\xa1\x71\x9b\xf0\xe8\xc7\x21\xdc\x65\xee\x21\xdc\x77\xf4\x2f\xe1\xd8\xc1\xf3\x7d\xa4\x8e\xaa\x75\x13\x9e\x6a\x5d\x84\xcb\xe6\xfc\x9e\x87\x2b\x1b\x3e\x0b\xe9\x3c\xb7\x74\x5a\x9d\xe9\x1d\xba\xc4\xf7\xa1\xa7\x0c\xad\x76\x3a\x7d\x95\xfe\x63\xeb\x4c\x92\xac\x59\x41\x28\x3c\xaf\xc5\xdc\x3f\xc5\x7e\xff\x1b\x7b\x91\xb6\x7c\x27\xdf\xa0\x22\xea\x70\x4d\x45\xec\x10\x11\xb9\x61\x8e\x79\xec\x52\x8f\xd5\x77\xe1\xe7\xb2\xe8\x9f\xb6\x8c\x54\x7f\x63\x66\xaf\xcf\xf0\xcb\x5a\xd5\x73\x53\x55\x1d\xde\x37\x5b\x47\x5a\xf0\xac\x4d\x03\x1e\x03\xd9\x84\xc7\x58\x3d\xe1\xe3\x72\x72\x92\xaa\x7e\x2b\x17\xab\xdf\xca\xad\x27\x98\x36\xc3\xe7\x32\xe5\x5b\x42\x71\xdd\x21\xe3\x6d\xcb\x01\x21\x7b\xff\xb6\xe5\x92\x91\x1b\xb3\x93\x70\x56\xa7\xc9\xec\x79\x61\x68\xc2\x2b\xe2\x09\xcf\x9c\xb7\xbd\xdd\x88\x8f\x1e\x7b\xdc\x01\x77\xe6\xdb\x19\x70\x37\xd1\xbd\x13\x57\xff\x96\x23\xe0\xae\x61\xf5\x5b\x84\xf5\xe8\xd3\xee\x07\xf5\xe7\x82\xd8\x4f\x9e\x5c\x8d\x06\x96\x0a\x9d\xe3\xa6\xd8\x7f\xd9\xb5\xd4\x8b\x6e\x43\x0d\xff\xaa\xfd\x5d\xff\xb9\x61\x7c\x76\x2f\xe9\xef\x38\x15\x2e\xd6\x1a\x58\x6b\xde\xc2\xb9\xbc\x09\x77\xcb\x34\xff\xee\xc2\xf2\x24\xdc\xcd\x34\xfd\x08\x77\x71\x4b\xae\x60\xf3\x36\xc2\x8b\x8e\x8f\xed\x44\xc9\xa3\x5b\xf7\x17\x5d\x73\xd6\x84\x77\xee\x9a\x10\x92\xb9\x3e\x25\x13\x16\xb0\x70\x3d\x4a\x06\xbc\x2f\xc9\xcf\x5f\xcf\xc1\xfc\xfc\x35\xe1\x57\xd7\x2b\xe6\xb7\xed\xc2\xe6\x05\xed\xfa\xee\xcc\xc6\xb1\x10\x8c\x49\x9d\xe0\xb1\xb9\x8b\xdd\x9f\x66\xc6\x7b\x5d\x24\x4d\xf4\xf8\x32\xfc\xe3\x0c\x2f\x74\x36\xc7\x01\xf9\x69\xe4\xb7\x19\x9d\x24\xb8\xdd\x78\x74\xbe\xe8\xbb\xa0\xce\xc4\x8d\xb0\xf0\xdb\x31\xa3\x84\xb9\x4d\xba\x84\xe9\x59\xba\xb1\x6b\x8f\xf3\xc1\x54\xe4\x3d\x65\xea\xd8\x8e\xb2\x2e\xcb\x5f\xca\xda\x3f\x5c\xc2\xd6\x49\x0e\x61\xdd\x51\x77\x84\xc7\x97\x33\xbd\xab\x5d\x82\xbd\x67\x71\xa5\xae\xdb\xfe\x8e\xb2\x6e\xdf\x7b\x4a\xf2\xf5\x1d\x86\x3a\x29\x66\x5d\xdc\x73\x04\x93\x14\xb1\x48\x1e\xf1\x93\xe2\x91\x14\x2b\x84\x82\xcb\xf4\xd1\x4c\xc2\xa7\x9c\x1d\xe7\xca\x53\x3e\xdc\x2e\xb7\x49\x47\xc9\xf3\x01\x6c\x4f\xd1\x4a\x7f\xc5\xb0\x63\x0c\x6f\x4a\xa0\x78\x87\xd7\xcc\x5c\x8b\x3a\x9e\xa6\x8c\x9d\x2a\x46\x87\x41\x6d\xc0\x80\x5e\xd8\x8c\x90\x3d\xb8\x32\xe7\x5a\x99\x38\xe1\xd7\xc6\xfe\xdd\x98\xb8\x93\x8d\xce\x82\x7a\x5b\xbb\xe0\x74\xf0\xd9\x55\xef\xdf\x83\x31\xc1\xf5\x4d\x8a\xce\xe2\x51\x16\x04\x33\xef\x9e\xe3\xfa\xbb\x2d\x82\x05\xff\xbd\x61\x28\x9b\x7b\x94\x25\xe1\x61\xe2\xf4\xf8\x2b\x3d\xeb\xc8\x6d\x89\x3b\xed\x6d\xe3\x9a\xa2\xa0\xb7\xd1\x5a\x11\x69\xad\x48\x8f\x38\xa7\x6e\xc2\x59\x3c\x17\x61\xaf\x9e\x03\x3e\x9e\x2b\xe7\x06\xbb\x2e\x5c\xad\x92\xd3\xe3\x5f\x95\x4c\x74\xaa\x4f\x74\xaa\x4f\xf7\x96\xc0\x4e\x6c\xcd\xd7\xcf\x9b\xee\x06\x0f\xa8\xbd\xf7\x33\x5d\x0c\x5f\xa5\x6a\x13\x8e\x3f\xcf\x21\xec\x35\xe9\x10\x76\xc3\x6c\xc2\xb3\xa7\x97\xf4\xdc\x4e\xb9\xb8\x77\xb7\xa4\xd2\x03\xdf\xa7\x44\x77\xfb\xf4\xdc\x83\xfd\xb2\xe0\x83\xaa\x3a\xd3\xe2\x0b\x1b\xeb\xd6\x90\xd8\x9e\x7c\x9e\xe6\xdf\xd0\xcc\x13\x9a\xe7\xcb\x6f\x94\xd3\x03\x8b\xdc\x80\x01\x7c\xb9\x3d\x4c\x7a\x70\x1b\x73\x1c\xdc\x36\x42\x08\x40\x7a\xae\xdf\x2b\x8c\x63\x5c\x9b\x5d\xfd\xb0\x39\x08\x4e\xde\x83\x70\x6e\x60\x6f\xc2\x31\xc3\x2c\x02\x3a\x2a\x9d\x1a\x16\xc1\x75\x54\x7f\x38\xb5\x33\x3c\x2e\x37\x9b\x70\xce\x41\x0f\x53\x4d\x78\x38\x6e\x84\x87\x90\x7d\x3d\x12\xeb\xd9\x58\x64\x05\xcc\xec\x06\x19\xbd\xd6\x9b\x77\xd2\xde\x0f\xbf\x35\x09\x57\x0b\x4f\xc7\xe9\xa0\x0c\xe0\x0a\x4f\xce\x79\xeb\xd5\xbd\x7d\x32\xd7\x70\xe9\xd5\x0e\x97\xb2\x16\x0f\x3e\x0e\xac\x13\x1f\x21\xde\xf3\xfb\xf9\xad\x53\x77\x5e\x84\xe2\x43\x04\x67\xd7\xee\x93\x92\xd7\x4f\xdf\x3c\x9b\xff\x2e\x56\xff\x1d\x2e\xfb\x2c\x7c\xf6\x94\x0b\x9f\x2d\xe5\xae\xcd\x69\xb2\x51\xee\xd9\x56\x4c\x58\x0d\xb0\x30\xf1\x7d\xa1\x77\xe1\xe3\x8d\xb6\xb0\x17\x4d\x72\x6f\x63\x2f\x28\x5f\x9f\x07\x64\x16\x3e\x17\x44\x26\xbe\xba\xec\xfa\xbd\x93\xb9\x7b\xa9\x2d\x25\x7f\x23\xec\xfd\xb5\x8b\x78\xdd\x0c\x37\xf1\x9d\xe0\xd2\x0d\x5d\x52\x16\x44\x5e\xfe\x55\xb1\x94\xfc\x53\x3e\x6b\xa7\xbb\x14\xdc\x94\xbc\xeb\xf3\x8b\xd0\xe2\x19\x2d\x6e\xfb\x2d\xc3\x55\xdd\x33\xb9\xb4\x05\x1f\xa4\x7e\xd0\x09\xbc\x51\x34\x25\xd8\x86\x92\x3b\xeb\x4f\x27\xe7\xeb\x88\xb6\x09\xe7\x80\x7e\x11\x7c\x4b\x59\x70\xcf\xfe\x6d\x9c\xae\xa8\x07\x36\x66\xd8\xce\x34\x9b\x38\x72\x79\x8e\x9b\x12\x0c\x84\x29\xc1\x40\x98\x12\xec\x01\x29\x71\x46\x4e\x9c\x91\x57\x4d\x7c\xff\x1f\x04\x3f\x00\x06\x21\x89\x9c\xaf\xef\xc0\x11\xcf\x59\xba\x86\xc3\x9b\x9b\x27\xcb\x69\xd3\x3a\x90\xdb\xd5\x27\xbc\x3e\x96\xf6\xf6\x7f\x74\x85\xec\x66\xa1\xf9\xbc\xd2\xd9\x32\x2d\x7c\x76\xb8\x0b\x1f\xa6\x0b\xd4\xb0\x54\xd8\xbc\xc5\x6f\xb0\x5e\xf4\xf8\xa4\xd9\x75\x93\xf2\xcb\x6e\xae\x98\x85\x9c\xbd\xe2\xc6\x0f\xf1\x1d\x50\x0b\x3f\x64\xfa\xce\x0e\x13\x1f\xc7\xa6\x85\x6f\x1c\xc6\x54\x7e\x16\xaa\xf3\x0c\xd9\x04\x73\x72\xb0\x50\xbd\x6c\xbd\x7a\xf8\xc2\x07\xb2\xf6\x8f\x3b\x8e\xe2\x50\x57\xef\x88\x71\x98\x69\x2e\x6f\xb3\x15\xeb\x21\x5d\x7c\x06\xc5\xc2\xe6\x98\x37\xbb\xd7\xea\x16\x7e\x6e\xa7\xf0\x47\x79\x83\xf3\x46\xe8\x8d\x7d\x9b\x50\x5c\x13\x0f\xc2\xed\x97\x9b\xf0\x91\x5e\x23\x87\xed\x61\x0d\xba\xc9\xef\x8d\x35\x68\x49\xf0\x49\x5f\xa7\xb7\xc9\x30\xeb\xd6\x8b\x8f\xd2\x31\xf1\xc9\xae\x1e\x5f\x94\x21\x8f\x7a\xb5\x87\xb2\xa0\x93\x47\xe5\x28\xae\xb0\xea\xa5\xca\x0e\xbd\x4d\x68\x4b\x2e\xee\xe4\x62\xc0\x88\x1e\x61\xf6\x6d\xd5\x3b\x73\x2d\x9c\x59\x87\x69\xca\xae\xbe\xce\xfb\x90\xe6\x52\x96\x19\x3e\xdd\xb0\x4e\x79\x22\x37\x95\xfa\x13\xf7\xf1\xa5\xeb\x9e\x15\xce\x39\x47\x9a\x87\xd5\x49\x38\x56\xf8\x85\x8f\x15\x7e\x7f\x70\x86\xd3\xc2\xdf\xaa\x28\xeb\x69\x9b\x09\x52\x77\x53\x0e\xac\x61\xa9\xf9\x2b\xc6\x6b\xc9\xdf\x8d\xd3\xfc\xab\x9f\x2f\xba\xaa\xd0\xd8\x5f\x27\xc2\x3b\x93\xe7\xc7\xaf\x84\xf9\xf1\x2b\xe1\x3a\x5e\xbd\x28\x20\xe5\x95\x5b\x7e\x7e\x57\x6a\xf9\xf9\x21\x7b\x72\xe2\x02\xd5\x0d\x4e\x8a\xff\xee\x38\x19\x8c\xee\xb9\x08\x7b\x66\xc9\x0f\x6c\x54\xd3\x24\xc0\xaf\x23\x7f\xf5\xcf\xcc\x6d\xc2\x19\xac\x87\xb0\x07\xeb\x22\x5c\x67\x82\x43\x78\xc8\xd0\x35\xb7\x6d\xc2\x31\xb8\x1d\x82\x7c\x72\xed\x77\xf9\xc1\xdb\xbe\x03\x1a\x6a\xe1\xac\x60\xc3\x80\xc1\xc4\x99\x89\x9d\xb1\x3f\x3f\xf0\xfb\x7e\x9b\xe1\x4c\x35\xe1\xe0\xe2\x78\xf7\x93\x61\x7e\x30\x19\xe6\x07\x7e\x0d\xf9\xc1\x34\x9e\x79\xa0\x9b\x79\xf3\x7a\xb0\xd9\x7d\x53\x70\xc0\x2f\xc2\x19\xf0\x1b\xdb\x15\xd1\xc0\xcf\x9a\xe4\xf2\x83\x79\x29\x3f\x98\x97\xf2\xde\xe4\xb8\xaf\x93\x7b\x20\x7a\x63\xe3\xef\xbe\xd9\xed\x84\x45\xb9\xe9\xab\xa4\x3f\x8b\xc8\xc6\xf2\x7d\x6b\x82\x95\x9f\x47\x32\xb8\xc7\x9b\x9b\x90\x34\x85\xb9\x6e\x35\x09\x9a\xe9\x39\x26\x39\x84\xad\x01\x6d\x42\x55\x39\x68\xc5\xd0\x79\xe9\xf0\xbe\x3f\xf1\x9d\x97\xdb\xc5\xcc\xfd\x5d\xe6\xfe\x2e\x3f\xd0\x12\xf3\x83\xd9\x75\x40\x8c\xd8\x91\xf7\xd9\x9a\xe4\xd1\x28\xe1\xac\xee\x0b\x9f\xcd\xc5\xc2\xe7\xa2\x4c\x36\x7f\xe2\x91\xcd\xe9\x71\xd9\xed\x26\x67\xb2\x1b\xc3\x62\xe3\x53\x69\xe3\x41\xcb\x2a\xe6\x1c\xb4\x2c\x7c\x2e\xae\x6f\xfc\x80\xed\x15\x12\x63\xe6\xef\x83\x40\x66\xf3\xce\x60\x2b\x82\xc7\x66\xd3\xfc\x09\x47\x36\xbf\x83\x7c\xb3\x75\xe3\xd1\xfc\x09\xc7\x8b\x9c\x20\x6d\x05\x89\x8c\x3b\x4e\x6c\x36\x58\x96\x76\x90\x8f\x5b\x8e\x0f\x19\x99\xd7\xfd\xc7\x9b\xb7\x8f\x40\x99\x0d\xc7\x86\xd9\x38\xf2\x0d\x46\xa0\x01\x1f\xe4\xec\x76\xc1\x03\x5e\x6d\x2f\x9f\x8b\x58\x13\x04\x0f\xa2\xeb\x54\xb8\x4d\x35\x5d\x1a\x76\xc5\xa2\x5f\x86\xe2\xcf\x5d\x34\x59\xf7\x32\x6e\x1e\x5e\xec\x11\x62\x8f\x10\x7b\xf4\x1b\xf7\x1c\x61\x08\x1c\x77\x2b\x90\x6b\x70\x1b\x9b\x1c\x61\xb1\xcb\x11\x16\xbb\x7c\x0c\x14\xf5\x6f\x9d\x74\xb8\x84\x77\xd5\x1f\xc8\xfd\x98\x77\x10\xe2\xdd\x3d\xf3\x0e\x66\xbc\xfb\xa7\x7b\xf4\xba\x2e\xe8\xba\x4a\x86\xd3\x78\xe6\x63\xdb\x39\x43\x19\xc8\x90\x52\x76\x7a\x53\x4e\xe0\x31\xf9\xdd\x4c\x4e\xd0\x1a\x60\x3f\xc9\xc9\x1f\x17\x8d\x1b\x1f\x5e\x22\x6e\xd1\x28\xf3\x65\x9c\x5b\xad\x49\x38\xd5\x2a\xb7\x97\xee\xe4\xae\x96\x05\x83\xac\xa0\x1e\xc5\xd7\x23\xa3\x1e\xf9\x06\x7e\x2c\x57\xb4\x67\x07\xb0\x09\x77\xec\x6f\xc2\x19\xfc\x8b\x70\xc2\xd1\xed\x7a\x94\x0f\xe1\x4c\xe5\x9b\x60\x9a\x62\x6f\xc5\x37\xe1\xce\x84\x9b\x60\x92\x47\xf5\x9f\xbc\xad\xf2\xb8\x2f\x72\xba\x97\xc0\x16\x3e\x9b\xd9\x85\xcf\x66\x76\xe1\xc7\x09\xdf\xcf\xeb\x85\x8a\x47\x81\x97\x41\x2e\x9c\x5e\x0a\xa7\x97\xc5\xea\x15\xea\x22\x5c\xa1\x6e\x82\x17\x88\x9f\x73\x0a\xe7\x9c\x82\x6b\x02\x03\x66\x74\x0c\xb8\x7f\x6f\xc9\x6c\x15\x3e\xdf\x68\xc9\x79\x20\x37\x9d\x54\x3f\x9d\x54\xaf\xd5\x56\x3f\x3d\x55\xef\x08\x9e\xaf\xd7\xc1\xfc\x2a\x21\x43\x67\x69\x78\x73\x4c\xfe\x37\x3f\x2e\xeb\x08\x47\x7a\xf3\x0c\x0b\x0e\x01\x55\xdc\xe4\x1e\x10\x55\xc0\x2c\x54\x31\xa4\x2b\x86\x42\xfd\x15\x56\x6a\x45\xd2\x9b\x7d\x64\xe1\x87\xf8\xfa\x03\x2d\x02\x44\x19\x5c\xac\x8a\xfd\x41\x91\x0f\xee\x41\xf8\x2e\xe2\x2e\xb6\xbb\xcc\xa6\x04\x54\x1e\x5a\xfa\x26\x78\xbe\xbd\x26\xfb\xee\xbf\x4f\x38\xd9\x78\x09\x57\x33\x5a\x84\x3b\xa7\x2e\xc2\x9d\x7c\x26\xe1\x4c\x3e\xf5\x2a\xed\xab\x01\xdc\x31\xc1\x80\x89\x30\x22\xf1\x60\xc7\xf3\x1f\xe3\xae\xb2\x27\x3c\xe4\xcf\x8e\x3b\xc1\x26\x9c\xdb\xb9\x9b\x10\x8a\x12\xa4\xd2\x76\x1c\xc0\x72\xc5\xe1\x7c\xae\x38\x9c\x1f\x30\xa9\x0c\xef\x41\xc2\x61\xa9\x49\xfe\xb1\xba\xfc\x33\xba\xa1\x3f\xb1\x1f\xa5\xa3\x83\x43\x8d\xac\x54\x23\x2b\xd5\xc8\x0a\x63\x63\xa6\x99\x22\xd3\x4c\x91\x2b\x27\x8b\x7a\x5d\x03\x16\x1b\xce\xba\x96\x11\x4f\x30\x23\x9e\x60\x46\x3c\xc1\xdc\xfd\x22\xd2\x9c\x76\x39\xee\xcc\xf8\xa6\x99\x04\xa7\x3f\x2e\xc2\xb1\x4d\x2e\xc2\xe9\x5c\xce\xc1\x7c\x72\x81\x8d\x58\xe7\x46\xac\x73\x23\x46\x1f\xfd\xe5\xab\xb3\xeb\xde\xbd\xc5\x20\x77\x7f\x50\x99\xe9\x5a\x9e\x79\x46\xbb\x2b\x75\x9b\x7f\x11\xce\xd5\xed\x3c\x3d\xd5\xd7\x43\x6f\xcf\x21\xa4\x72\xfc\x0b\x17\x65\xdc\xbd\x19\xc7\xed\x8e\x60\xeb\x2d\x20\x4f\x5a\x2f\x0a\x82\x34\x4f\xee\x47\x58\x41\x77\x2a\xb6\xf0\x11\xe8\xc4\x5b\x9e\x2f\xba\x93\x65\x79\xfc\x74\x5f\xce\x19\xf2\xaa\x43\x22\x74\x67\xc8\x99\x67\xc8\xd9\x79\xea\xcf\x6c\xcd\x85\x15\x9d\x70\xaf\x9e\x93\x9f\x7b\x72\xb1\xf1\x9e\x58\xcb\xf3\x4e\xc3\x7b\x24\x4d\xb4\xc7\xfe\x8b\x1e\xcf\xaf\x5b\x5c\xca\xe3\x17\x97\xf2\xf8\xc5\xe5\xe5\x20\xf9\xdf\x5c\x73\xbe\x28\x08\xeb\x77\x81\x9c\xf8\x4c\xea\x13\x9e\xb0\xa0\x0b\xda\x65\xbd\x85\x71\xef\x70\x3f\x25\xe1\x29\xd3\x8b\xc5\x51\x96\x6f\xcc\xa5\xcc\x88\x5b\x1b\x6f\x1b\xf3\xc6\xe9\x8a\xa4\xf9\xb0\xc6\x13\xbb\x06\x6e\x8f\xaf\x4e\x49\x5e\x0c\xee\x22\xd6\x14\x2d\xaa\xea\x2f\x62\x4d\x78\x96\xaf\x95\xba\xdc\x42\x8b\x33\x23\x2c\x18\x84\xe5\xb7\xda\xf3\x16\x9d\xa7\xcc\x11\xe0\x28\xcb\x39\xc7\x51\x96\x03\xcf\xa6\x34\x0a\xf8\x1c\xfe\x2d\x5c\x5c\xdf\x6a\xfe\xf0\xe4\xc5\xe6\x2b\xdc\xee\x85\xf3\x89\x8a\xeb\x86\xcd\xdd\x56\x9f\xf0\xde\x66\xdf\xb8\xdd\x1a\xda\x63\x57\x85\x5c\xf8\xa8\x90\x0b\x73\x0c\x98\x1f\x04\x3e\xea\x72\xa1\x07\x40\xe1\x95\x80\x82\x68\x8a\xe5\x84\x74\x5c\x49\x5d\x40\x96\xc5\xa5\x6f\xd2\xe6\xaf\xc0\x2f\x2e\xae\x9b\xf1\x26\xf8\xce\x64\x8f\x49\x87\xb7\xd1\x6d\x7c\xbd\xef\xfa\xbc\x73\xe8\x5a\x46\x41\x5d\x8f\x75\x66\x7d\x12\x9b\x6f\x08\x1f\x03\xba\xd0\xeb\xa0\x3c\xf0\x21\x2f\x0f\xa2\x1e\x14\xe7\x58\xb1\xb9\x73\x3d\x3c\xcc\x8d\x84\x87\x4e\xe8\x8c\x64\x56\xdc\x6d\x83\xf2\x60\xf2\x1b\x2c\x75\xcc\x11\x88\xf7\xb8\x08\xc7\x84\xb7\xf0\xb9\x78\xb1\x70\x75\xdd\xf6\xc5\x47\x13\x1c\x8c\xc5\xab\x6b\x2e\x7c\xf4\x97\x85\x1b\xe1\xd5\xd0\x36\xe1\x68\x68\x8b\x70\x5b\x71\xe2\x3b\x98\x17\xde\x1b\xa2\x9d\x41\xdd\xfa\x4a\x61\x84\xb8\xc2\x08\x71\x85\x11\xe2\xc6\xd7\x8d\x90\x89\x8b\x0f\x35\xb9\x09\x67\x8b\x53\xc2\xbd\xe6\x56\x16\x4c\xbe\x99\xbc\xb3\x4c\x09\x1c\x2a\x8c\x20\x57\x66\x3c\xb0\x23\x38\xdb\x0e\x0a\x43\x4c\xe6\xa3\x28\x14\x73\x3e\x11\xe5\xd8\xc7\x66\x9e\xfe\xc4\xa6\x30\x42\x5b\x71\x16\xac\x32\xa0\xdb\x5c\x17\x58\xb0\x8a\xf9\xbd\x7f\x41\x34\xb2\x82\x68\x64\xc5\xfc\xce\xbb\xd0\x24\x55\x68\x92\x2a\x34\x49\x95\xf8\x5b\x2e\x26\xa3\xeb\x44\x1f\x22\xe1\x45\x09\x29\x9d\x20\xa3\x0f\xcc\x50\x60\x47\x2a\xde\x8e\x54\xbc\x1d\xa9\xf8\x00\x41\xc5\xdb\xa5\x4a\xf4\x2f\xe4\x94\x6b\x45\x4a\x9b\x47\x37\x60\x22\x02\x30\x4c\x78\x7b\xe7\x4c\x7d\x96\x9a\x01\x4f\xe7\x8f\xf7\x95\x1e\xdb\xd0\xa7\xcd\xf1\x5a\xf9\x27\xbc\xe3\x6e\xc0\x3b\xec\xe6\xb7\xe7\x0c\x64\xe3\x87\xbf\x1f\xdb\xf5\xc2\x67\xa7\x34\xf1\xf5\x58\x5a\xf8\x21\xab\x6e\x66\x9c\xbf\x0b\xef\x57\x39\x2e\xd3\x80\x66\xe7\x09\xf2\x45\xb8\x1e\x22\x9b\x70\x95\x3b\x67\x73\xab\x0b\x9a\x17\x7b\x47\xa3\xfa\xbe\x19\xbd\x5f\xc7\x8b\xd0\x51\x10\xf8\x64\x63\x27\x88\xb3\xa7\x2b\x0b\xa2\x63\x05\x77\x72\x3c\x60\x45\xe2\x50\xbc\xfe\xb7\x6b\xdd\xae\x98\x26\xc1\x17\xe7\x4e\x3c\x4a\x84\xa3\x60\x89\x30\xe7\x0e\x88\xd1\x71\xa6\xaa\x55\xba\x73\xd3\x2b\x11\x16\xda\x01\x23\x12\x3b\x8f\xbf\xc2\x70\x3c\x25\x72\x22\x8a\xf0\xda\x2b\x11\x5e\x7b\x65\x8a\xcf\xae\x12\xb7\x09\xae\xb3\x59\xf2\x8f\x5d\x2c\xc2\xdd\x2a\x6d\x82\x6f\x7c\x73\x71\xe0\x4a\xc4\x35\x94\x12\xb1\x13\x2c\x0c\x17\x34\x20\xc4\xe4\xaf\x16\x97\xf4\xcb\xee\x70\x6c\xc2\xb3\x5e\x27\x0c\xed\x04\x45\xfb\x98\x6e\xc3\x62\x08\x2d\xe3\xef\x8b\x0c\x98\x21\xdd\xed\x47\xb3\x3b\xdd\x24\x9c\x35\x69\xde\xf5\x39\x21\xc7\x36\x3e\xdd\x64\xe2\x3b\xf9\xdf\xbb\x41\xa3\x12\xbc\x1b\x54\x78\x37\xa8\xf0\x6e\x50\x71\x77\x83\x0a\xac\xbb\x05\xd6\xdd\x02\xef\xa8\x02\xef\xa8\x02\xef\xa8\x29\x43\x37\x05\x0e\x7c\x55\x86\x01\x8f\x7f\xd2\x82\xa7\x6a\x13\x6e\x13\xe0\x82\x47\xdb\x2e\xf7\x1e\x7b\x99\xd0\x79\x56\x95\x82\xeb\xf7\x05\xc6\xe0\x02\x63\x70\xf1\xc6\xe0\x92\x61\x67\x2c\xbc\xb7\x54\x32\x4c\x07\x03\xba\x41\xbe\x9a\xe2\xd6\x76\x12\xee\x8c\x3f\xf1\x9d\xf2\x17\x3e\x55\xaa\xf7\x70\xa4\x2c\xe8\xba\x4e\xe5\x90\x77\xde\x16\xa5\xa0\xb1\x68\x7c\x7d\xbf\x6b\xcc\xd5\x6b\x35\x95\x5a\x4d\xe5\x54\x51\x39\x55\x54\x1c\xe6\x94\x3a\xfd\xba\xef\x70\x5e\x84\x3b\x9c\xeb\x72\x05\x3f\x55\x5e\x84\x3b\x9c\xeb\x35\x75\xd4\x09\x9d\xa9\xe3\x85\x0f\xea\xec\x4d\x1d\x03\xba\x29\xb2\xb9\x5b\x74\xa3\xf4\x86\x5b\x74\x03\x3a\x41\x34\x1c\xac\x97\xc6\x91\xd2\x38\x52\x1a\x47\x4a\x73\xc2\xaf\x54\x9c\x2a\xec\x26\x03\x06\x2f\xfd\x29\xb2\xd3\xe7\x9b\xbb\x0b\x38\x04\xb2\x08\x77\x27\xb1\x08\xe7\x58\xb7\xb4\x6b\x86\xc8\x0b\x26\x30\xee\x8c\x5f\xa5\xe1\x52\x5f\x69\xd4\x5a\x1b\xb5\xd6\x76\xb7\x0e\x4b\x24\xe5\xf2\x5e\xe9\x0b\x5e\xe9\x0b\x5e\xe9\x0b\x5e\x1f\xec\x33\xaa\xbb\xe0\x5d\x9c\x7b\x4b\x69\xdc\x73\x34\x18\x5c\x0a\x9f\xc2\x19\x10\x76\x8a\x49\xb8\x03\x6c\xe2\x3b\xc0\xd6\x07\xe5\x76\x88\x17\x3b\xd9\xbf\xf0\xcc\x37\x13\x9e\xf9\x66\x7d\xbc\xa4\x5e\xa7\xab\xf7\xed\xca\x9b\x70\xba\x72\x75\xce\xe0\x75\xc1\x04\x71\xb9\x76\xa9\xf4\x77\xaf\xf4\x77\xaf\xf3\x10\xfd\xba\x03\x6c\xc2\xe9\xdb\xd5\x1d\xb3\xaf\xc2\xdc\xfa\x54\x1f\xd8\x47\xeb\x03\xb1\x56\xfa\xb4\x57\xfa\xb4\xaf\x6a\x5c\x4b\xdf\x26\x1c\xf7\x88\x4d\x38\xa7\x00\x87\xb0\x4f\xa7\xea\xde\x56\xee\xe2\xdc\x0a\x31\x60\xf4\x72\x71\x8f\x52\xb4\x4b\x38\x03\xa0\x86\xdf\x0e\xdf\x3a\x5b\x62\xe1\x74\x0b\x33\x88\x26\xfc\xdc\xfb\x85\x2f\xba\x73\x44\x0d\xfe\x61\xcc\x1a\xfc\xa9\xf2\x9b\xd2\xb5\x48\xf8\x39\x93\xfd\x2a\xe3\x32\x39\xb1\xf2\x78\x6c\x34\x9b\xc7\x23\xa1\xe0\x4d\x52\x35\xf8\xd3\xe6\x1a\xfc\xe2\x54\x03\xe6\xb8\xca\xe8\x00\xd5\xae\x19\x6c\xf0\x61\xde\x0c\x56\xcd\x9b\xc1\xaa\xa1\x4c\x73\xcb\x5c\xe5\x66\xaf\xc6\x5f\xec\x4e\xe3\x5e\xf8\x96\x32\xf0\x29\xe5\x9e\x87\x97\x89\x5c\xe7\x89\xde\x71\xbe\x46\xa7\x18\x55\xec\xf6\xaa\x79\xf3\x4e\x35\x6f\xde\xa9\xe6\xcd\x3b\xd5\xfc\x0b\x8c\x35\xdd\xc9\xa3\xe2\x94\xbc\x46\xaf\xa3\x54\x1c\xe8\x57\x1c\xe8\xcf\xea\x5c\x65\x7c\xe1\xa3\x8b\x6f\xbc\x27\x86\x8d\xf7\xcc\x50\x11\x95\xaa\xe6\x9f\xd3\xd3\x6b\xf6\x1b\xcc\xf7\xb7\xab\xed\xd5\x8c\xb0\xd6\x35\xfb\x3b\x44\xe3\xc7\xbb\xa9\x19\x30\xf9\xb4\x3e\x8a\xf5\x0b\x83\x37\x87\x2e\x82\xff\xda\x29\x47\xe3\xeb\xc6\xcc\xea\x27\xb3\xb3\xc5\xab\x19\x81\xe2\x2a\xb5\xc4\x9a\xa1\x5e\xec\xaf\xef\xd0\x58\x84\x73\xba\x55\xa7\xd6\x74\x8c\x8e\xd5\x69\x51\x2b\xbd\x5b\x87\x6b\x86\xe7\xd8\x4a\x7c\xe7\xfc\x4d\x38\xe6\xbd\x4d\x38\xce\x55\x87\xf0\x38\x06\x6a\x71\x37\x1e\x36\x21\xf9\x4f\x6a\x11\x79\x56\xb6\x46\x2d\x6e\x57\xbc\x09\x90\x59\xf5\x4e\x19\x83\x40\x21\xd7\x22\x62\x6a\x09\x25\xf8\x05\x20\xe3\xd1\xc7\x95\xf8\x7e\xdd\xce\x1d\xb3\xb7\xac\xf6\xf8\x97\x1f\x1a\xee\xc8\x35\x7f\x47\xae\x6e\x5d\x77\xe5\xe9\x54\xdd\x99\xe3\x79\x83\x6f\x42\x3b\xb1\xbb\x16\xce\xe9\x62\xc7\xee\x8b\x6e\x5d\xdb\xf3\xeb\xce\xb1\x60\xc2\x63\x14\x9e\x70\x4f\x9c\xed\x39\x2f\x48\xe4\xfd\xdb\xe9\x29\x13\xde\x90\xe2\x2f\xbe\x0b\xec\xfa\xf5\xf4\x8c\x46\x5f\xd1\x46\x5f\xd1\x46\x2f\xcb\x01\x03\x7e\x75\xa1\xae\xdb\x83\x93\xd1\xf5\xeb\xed\x2f\x9b\x70\xfa\xcb\x22\x1c\x4d\x64\xe1\xa3\x89\x2c\x6c\x92\xc1\x35\x9b\xec\x1c\xbb\x31\xc7\xbb\xc4\x0c\x02\xab\x87\x20\xd1\x8b\xe0\x7f\x4f\x9d\x5d\x66\x10\x90\x61\xf6\xef\x1a\x0c\x02\x65\x82\x88\x8e\x6d\x2a\x80\xe7\xa6\xc0\xc2\xbe\xb9\x8e\x82\xb8\x93\x17\xdf\xb6\xef\xaf\x77\x55\x39\x84\xc7\x95\xcf\x4e\xe5\xc7\xc0\xf8\xb5\x3b\x33\xfe\x26\xa0\x11\x1a\x25\x84\xb7\x9e\xda\x3c\x7f\xb8\x7e\x03\x87\xe0\x38\xb8\x2f\x5b\xac\x2c\x0d\x31\xaf\x37\xc1\xd7\xc2\xbf\x8d\xd1\x78\xa8\x31\xe0\x03\x19\x34\x6d\x94\xa6\x8d\xd2\xba\xd3\x24\x1a\x8d\xfd\x6d\xde\xa3\xbd\x47\x11\x8b\x80\x8e\x63\x41\x39\x0c\xe0\x70\xe4\x10\xed\x0e\x65\x0b\x3e\xd0\xf7\x26\x1c\xa3\xc2\xf9\x64\xab\x5c\x8d\x57\x28\xf7\xef\xc9\x8d\x1f\x33\x13\xb1\x99\x09\x53\x4e\x4b\x6b\x74\x3d\x6e\x74\x3d\x6e\x2e\xd2\xd4\xfa\xd5\xe2\x3a\x86\x4a\x07\x17\xdf\x68\xce\x37\xa3\xf1\x30\xa5\xcd\x68\xe2\xe8\x36\x2f\xe1\x58\x25\x06\xce\xbe\x1b\xfb\x57\xdc\xf6\xe7\xbe\x09\x2d\x46\x36\xe1\x20\xa0\x09\x5f\x02\xfa\x5d\xcc\x6e\x20\x0d\x23\xb9\xe3\xe8\x1a\xcd\xeb\xdf\xba\x32\xbe\x96\xdb\xe6\xdc\x58\x1b\x0f\x6c\xda\x83\xe8\xd7\xab\x10\x76\xff\x98\x39\xde\x06\xe1\xd8\x72\x17\x1f\xb7\x26\x13\xdf\x8a\x98\x7f\x9e\x73\xfe\x7a\xd5\xa8\x66\x3f\xf3\x96\xe2\x85\xcf\x8a\xb8\xf0\x9d\xb0\xec\xe7\x62\xec\x8d\x47\x11\x7d\xde\xbe\x3b\x98\x77\x85\x7a\x11\x86\xf5\xcc\xb7\x3b\x2e\x7d\x73\x8f\x5f\x95\xcb\xab\xec\x35\xfb\x25\x3f\x87\x99\xbf\xc1\x39\x11\x8a\xca\x77\xc3\x38\x91\x6f\xc5\xfc\x20\x27\x1f\x9b\x6e\xc2\xc0\xc4\xcc\xd9\x7c\x85\x9d\x3f\x59\x33\x7f\xe4\xff\xa2\x8c\xdf\x12\xf3\x29\xee\x85\x87\x09\xd1\xa2\x6e\x4f\xf0\x22\x14\xea\x02\xe4\xbe\x28\x42\xe6\xee\xfd\xce\x66\x30\x96\x34\xe7\x02\xdc\xd6\xaf\x0d\xad\x57\x51\x8c\x0f\xd2\x34\x60\x22\x94\xc4\x81\x5d\x4e\xc4\xb6\x4f\x8a\x4f\x8f\xf5\xd3\xaf\xc1\xfa\xd1\x5c\xb8\xaa\xdd\x71\x50\x0b\x93\x8e\xe3\x6c\xc7\x6d\xc7\x79\xf2\xbd\xbb\xfe\xd0\xb9\xab\xf6\xca\x8a\x96\xb0\x58\xd1\x1f\x2c\x76\xfd\xa0\xcb\x07\x9d\x1f\xb0\x8b\xf8\x1d\x7f\xb3\x15\x4e\xfd\x4c\x8a\x9b\xd0\x84\x60\xe5\x97\xa6\x7b\x82\xa3\xe4\xf0\xa1\x18\x29\xf5\x17\x42\xfe\x92\xca\x87\xa4\x59\xbd\xa4\xf8\x25\x4d\x57\x11\x90\xbe\x79\x95\xfe\x21\xb5\x19\x02\xc4\x93\x7a\xfb\xa4\xea\xe5\x93\xfd\x8a\xd5\xe7\x48\xf6\x7c\xf8\xb2\xe7\x53\x47\xfb\x56\xdb\xbe\xd5\xde\x51\x92\x41\x32\xcf\xc4\xbd\x0e\xd0\x0c\x71\x5d\xdb\x78\xba\xda\xcd\x8b\xf1\xbc\xd5\x56\x27\x72\x4a\x54\x84\xa6\x3f\xbf\x3c\x77\xaf\x17\xbe\x0a\x5d\x1c\xd6\x02\xff\xfb\xf1\x1c\x19\x9d\x36\x3a\x6b\x42\xdf\xd8\x95\xec\xac\x09\x6f\xde\x5d\x79\xbe\x8b\xe0\xc4\xe7\x74\xfb\xfd\xd6\x3c\xd7\xf0\x50\x59\xd8\xa7\xee\x28\xb7\xa3\xdc\xd6\x90\x13\xa4\xd1\x83\x8b\xb1\xb4\xb0\x2f\xa7\x63\xf6\x8b\xde\xe8\xd0\x22\xa2\xb3\xb4\x08\x4b\x78\xcb\x23\x30\xee\x3d\xb8\xdc\x84\xab\x26\x4d\x82\x53\x72\xb2\x0f\xf1\xde\x0e\xe1\x54\xcc\x6d\xc3\xcb\x82\x6e\xe6\xcb\xde\x0c\xd4\xe0\x78\xdf\xbc\xe3\x7d\x73\x26\x90\x96\x87\xef\x87\x67\xf2\xf8\x82\xf4\x05\xcd\x97\xe8\x1d\x70\x5a\xe6\x64\x99\x61\x6a\x6a\xdc\xe7\xb7\x8c\xb8\xc1\x5b\x1a\x57\xe5\xdb\xf2\xda\x27\x55\x87\xb0\x8f\xb2\xde\xd2\x5f\x75\xe2\xc4\x1c\x3d\x04\xcf\x7d\x70\x57\x91\x17\xbe\x4b\x6a\xbe\x6a\xe1\xae\x7c\xf3\xfc\x1b\xe5\x69\x78\xc9\x65\x11\xee\x04\x3b\xf1\x09\xd8\xbf\xf1\x73\xdb\x77\x44\x05\x3e\xd1\x10\x37\xe1\xd8\x3d\x17\xc1\xb5\xaf\x0f\x13\x3c\x20\x24\xe6\xef\x16\x0f\x58\x91\x38\x14\xe1\x36\x14\xaf\xad\x6d\x69\x75\x27\x4f\x4b\x22\xbe\xf5\xe6\xc1\xa9\x90\x25\x8a\xcf\x12\xc4\x67\xd0\x64\x17\xa1\xf8\xee\x63\xde\x59\xe0\x88\xe4\xac\x82\xed\x97\xe2\x2f\x84\x5f\x9a\x01\xe7\x2f\x9e\x8e\x7f\x07\xaf\x07\x5a\x0f\x9e\xf7\x78\x17\x3c\x13\xe3\x80\xa7\x03\xb5\x9f\xbb\xa8\xdc\x66\x60\xf1\x2d\xae\xe6\x8d\x6e\xad\x79\xa3\x5b\xf3\x81\xee\x9a\x8b\xa7\xdd\x32\xf5\xe5\x0c\xab\x78\xcb\xb0\x8a\xb7\x0c\xab\xf8\x80\x99\x6d\x9b\xa4\xb9\xf6\x6b\x0c\x9b\xdf\x11\x5c\x3b\x1f\xcf\x56\x47\x79\xda\x96\xce\xa2\x0c\xdf\xbf\x06\xca\x8a\x2f\x7a\x29\xd3\x51\x72\xe3\x74\x65\x36\xb0\x5d\xa9\xbd\xf8\x74\xd1\x7b\x70\xb5\xb8\x72\x46\xd2\x86\x70\x7a\xad\xfd\xbc\xcd\x63\x36\xcd\xad\xe0\xc0\x77\xf0\x0c\x78\x95\x93\x99\xfa\xf4\xbc\xf5\xf1\x65\x62\xb6\xfa\xbe\xf2\x7c\x08\xeb\xc6\xf3\xed\x36\x6d\x8b\x61\xe1\x72\x44\xe7\x1e\x09\x18\xf0\xf1\x9d\xc1\x3f\x1d\x77\x24\xe2\x58\x0f\xd6\xc0\xfb\x8b\x3d\xf3\xe3\x03\xc7\xfd\x6e\x28\x4b\xbb\x9f\x9e\xc6\x2c\xbb\x23\xbf\x8b\x7a\xce\xf3\x31\x04\x23\x25\xed\xe6\x5d\x94\x1d\x3e\xd6\x51\x6e\xf3\x2e\xca\x69\xde\x81\x5d\xf3\x0e\xec\x9a\xd7\xce\xad\x9d\x5b\xea\xb5\xae\xb5\x7b\xd8\xb2\xd9\x34\x3f\x50\xa0\x34\x36\x1c\x13\xb5\x06\xe7\x89\xc6\x93\xc4\xd6\x70\x16\xde\x1a\x8e\x86\x5b\xc3\xe5\x81\xd6\x60\x6d\xeb\xc3\x4d\xf6\xc4\x41\x5a\xf0\x59\x75\x9a\xf0\xec\x42\x27\xdc\xeb\x4a\xbf\xef\x76\x96\x81\xee\x14\xda\xbd\xff\x5a\x7f\xdc\x40\x77\x27\x8c\x43\x02\xd9\x77\x0e\xbe\x05\xb1\x08\xbe\x73\xd8\xb9\x26\x15\xd0\xac\xeb\xd9\x69\x47\xb9\xb3\xda\xa1\x4c\x97\xf5\x55\xc3\x84\x1a\x9e\x07\xe7\x16\x2c\xfc\xd5\x50\xff\xb3\xc8\x2c\x58\x29\x3b\x4d\x7d\xb4\x92\x25\xcc\xa3\x94\x74\xff\x78\x68\xdb\xf8\x54\x77\xc2\xb3\x06\x2e\xf8\xdc\xcc\xc6\x0a\xf1\xdc\xc2\xed\x5c\x5c\x89\x17\xa7\xcb\x0c\x4c\x00\x5d\x4c\x00\x5d\x4c\x00\x1d\x26\x80\x0e\x13\x40\x87\x09\xa0\x9b\x3f\xb8\xeb\xe6\xef\x10\x6e\x61\x3f\xa8\xc4\xd5\x44\x36\x76\x22\xcc\xf9\xe7\x7e\x5d\xfd\x63\x71\xec\x6e\xa1\x74\xec\xca\xbb\x79\x1f\xa0\x8e\x57\x57\x3b\x8c\x0e\xdd\x99\x24\x96\x94\x73\xbe\xf7\xcb\x27\x3c\xf3\xcd\x82\xae\x45\x73\xfe\xa5\x04\xfe\xce\xa3\x1a\x0b\x06\xd4\xa5\x24\x7e\xdb\x90\x73\xf2\x7d\xe7\x31\x17\x8c\x69\x11\xae\xed\xa4\xe3\x79\xd4\x76\x08\xae\x89\xfc\x11\x56\x37\x7f\x84\xd5\xe1\x07\xda\xf1\x7c\xec\xae\x63\xbb\x19\xf9\x17\x1b\x57\x93\x3c\xe0\xbb\x7a\xae\xc6\x63\x3f\x4e\x08\xe7\x39\x23\xa4\x30\xdf\x91\x2d\x81\x6f\xe7\xad\xd7\x0d\x77\x5e\x3b\x9f\x8c\xed\x7c\x32\x76\x4b\xed\x98\x90\x8e\x18\x7d\x61\xef\xde\xde\x0d\x93\x17\x9b\xef\x80\xb1\x5e\xa7\xad\x85\x83\x1b\x26\xc1\xd9\x12\x06\x2c\x28\xdf\x39\xfe\x74\x83\xaf\xdf\x80\x99\x89\x13\xbb\xde\x7c\x19\xe9\x71\xdc\xef\x77\x95\x0e\x37\x83\x90\x3d\xfb\x3e\x0a\xc7\x22\x78\x61\xba\x13\xe0\x5d\x1b\xdf\xb6\xc1\x9b\x3e\xb6\x78\xfc\x94\x94\x1e\x66\xd7\x85\xe5\xd8\x85\xe5\xe8\x03\x77\x1c\x82\xef\xc8\x23\x7c\x2c\x44\xde\xa7\x58\x4f\xa5\xd2\xe3\x0e\x01\x37\x01\x7d\x28\x3d\x92\x69\x62\x1f\x4a\x98\x0d\x82\x0b\xb4\xb7\xbe\x66\x2f\x49\xde\x8a\x3e\xa6\x30\xa7\xed\x2e\xec\x39\x2e\xd1\x4f\x44\xde\x8d\xbf\x1b\xdc\xf8\xbb\x33\xc0\xad\x6f\x39\x35\xf9\xd7\x6e\x3b\x5f\xbb\xed\xd3\xec\xd5\x8b\x9f\xd4\xed\x6e\x13\xba\x33\x8b\xb5\x35\x2b\x73\x70\x38\xad\xa0\xd3\xc2\xd6\x0d\xfe\x45\x9d\xc6\xbd\x2d\x01\xf4\x14\xef\xb2\xbf\x30\x7a\x4a\x89\x98\x29\x67\x06\xbb\x85\xde\xc5\xc6\x1d\x10\x4e\x7c\x36\x40\x13\xde\x8a\xc5\x63\xbe\x6e\x13\xb9\x41\x76\x5c\x15\xf6\x64\x4e\x18\x31\xcd\xf9\x28\xf7\x9d\x51\xee\x97\xf4\x50\xc7\x41\x38\x07\xd6\x9b\xf0\x24\xbf\x4a\xfa\x68\x20\xbb\x85\x4e\xd7\x88\x67\x29\x5c\x8c\x3b\x25\x68\x8a\xe0\x16\xb7\x70\xba\x22\x72\xee\xd0\x1d\xee\xd0\x3d\x7a\x57\xfd\x1e\xb1\x84\x45\x7f\x5f\xec\x45\xc1\x0b\x0b\xf7\xc5\x26\xbe\x93\xf9\x80\x77\x32\x1f\xb0\xba\x36\x32\xf7\xfe\x46\x8f\xee\x84\xcd\x13\xee\x12\x14\x71\xc2\xd6\xe9\x82\xdc\x23\xac\x16\x9d\xb1\x12\x7a\x44\x7c\xa1\x01\x13\x64\xe7\xa2\x37\xae\x82\xd6\x41\xd5\x50\xd8\x1d\x69\xea\xe7\x87\xf0\xf8\xb6\x79\x9c\x43\xfc\x26\x9c\xa3\xdd\x43\x78\x48\x08\x6c\xdf\x67\x06\x3e\x9b\x43\x32\xfd\x4e\x1c\xf4\xf9\x49\xba\x53\x6e\x5d\xd0\x0d\xf0\xc4\x15\x62\x1b\x88\x56\x5b\x18\x5a\xdc\x1b\xb5\x7b\x84\xc9\x64\x40\x36\xfa\x13\xbe\xe2\x49\xbe\x9d\xe7\xd9\xdb\x62\xdb\x09\x70\x5d\x66\xf3\xa4\x75\xe3\x6d\xd5\xe5\x7a\xfc\xef\xda\x5e\x2d\x64\x11\xee\x02\xb0\x08\x77\x01\xd8\x84\x33\x64\x16\xe1\x2e\x00\x9b\x20\x32\xb5\xf3\x5c\xd9\x21\x48\x1e\xf7\xf4\xf7\x70\xda\x94\x70\x46\x57\xfb\x6d\x3f\x1e\x8f\xef\x94\x33\xf1\x19\xba\x0d\xcf\x57\x0c\xe8\xe4\xdd\x70\x32\xd2\x1b\x62\x4b\xf7\x06\x8f\xca\xee\x5c\x50\xbb\x33\x72\x74\x17\xaf\xb0\xf3\x81\x87\x9e\xb0\x83\xeb\x09\x3b\xb8\x01\x9d\x8a\x94\xee\x9a\x5f\x9c\xb0\xa4\x47\xde\x07\x40\xd7\x8b\xfe\xe7\x00\x62\xbf\xf0\xbf\x45\xd7\xe0\xd7\xd6\xe9\x97\xba\xe4\xe4\xa6\x94\x45\xb8\xb3\xe6\x22\xdc\x59\x73\x95\x77\x36\x55\xfd\x57\x6f\x88\xa6\x81\x8e\xd8\xbb\x8b\x1e\xdb\xbb\x8b\x1e\xdb\xfd\xcb\x03\xbd\x71\xac\x34\xc4\xac\x1c\xd0\x90\xd8\xd9\x43\x07\x37\xdd\xb7\x2c\xdf\xb6\x5b\x84\xbb\xd2\xb5\xfb\xb6\xde\xea\x28\x2f\x36\xa9\xdd\xb9\x20\xfc\x56\xc1\x3b\x9b\x4e\x7c\xe6\x83\x7b\x33\x7d\xd6\xd0\x4f\xeb\x08\xd6\xdf\xbb\xbb\xe4\x34\x84\xe4\x78\x7c\xe1\x9d\xc5\x5f\x74\x27\xf1\x17\x9d\x83\x84\x89\xce\x0e\x65\xa0\x78\xdb\xe1\xde\xd8\x58\x9f\xfa\x1b\x1b\xbd\xe3\xc6\x46\xef\x30\x3a\xf4\x4e\xf5\x82\xb7\xd9\x3b\x6e\xb3\x77\xdc\x66\xef\xdd\xc7\xbf\x7b\x25\xd2\x7c\x21\xa5\xf8\x46\x2f\xd9\xcf\x63\x13\x3f\x8e\xe1\x92\xef\x7c\x3d\xa7\xc4\x3d\xc4\x27\xda\x03\x7c\x4d\x97\xb3\x51\xd6\x2a\xb5\xc6\x61\xc7\x3d\x90\xde\x71\x0f\x64\xc0\xec\x5b\xcc\x5d\x7b\x69\x97\x70\x67\xc0\x45\x38\x67\xf1\x9b\x70\x2c\x41\x87\x50\x6e\xb3\x4c\xc2\xd2\xeb\x27\xaf\x27\x8a\xfb\x82\x5b\x77\x5a\x70\x0f\xaf\x0d\xed\x26\x0e\xfb\xd7\xb4\xe0\xbe\x37\x3c\x91\x17\x49\xb8\x22\x99\x65\xae\x2e\xb6\x61\xba\xb2\x3c\xdb\xff\x71\xc3\x76\x4b\x64\x95\xe7\xbf\x0b\x77\x32\xd8\xdc\xb4\x9b\x4d\x98\x2e\x8d\x9b\xb9\xe4\x73\x75\x3b\xf1\x05\xb7\x58\x17\xdc\xcb\xca\x82\xd1\xd5\x2b\xde\xe3\xa5\x0d\x9d\xfc\xd2\x75\x26\xda\xd0\x75\x1a\x77\xb1\x69\xc2\xb0\xad\x32\x0b\x7a\x36\xf2\x75\xe1\x59\x70\x8f\xf5\x09\x5d\x7d\xca\x2f\x5d\x16\x96\x17\xa0\xad\x64\xa7\x57\x6f\xe8\x7e\x2d\x27\xc6\xff\x44\xd1\x71\x53\x8e\x92\x3d\x51\xbb\xd5\x28\x47\x59\x5b\x3f\x95\x2b\xe7\xf2\x6b\xc8\xb2\xa1\xb8\x5a\x80\x90\xb2\x3f\x1e\xad\x8e\x96\x0e\xc4\xaf\x1d\xf9\xf4\xc4\x2f\x93\xff\x31\xec\xd0\xf5\x0b\x06\x43\xe2\x6d\x89\x5a\x70\x1b\xa2\x36\x7c\x7c\xad\x8f\x5f\xcc\x86\x6e\x1c\x55\xdf\x51\x0b\xfb\x57\xdb\x77\x06\x26\x70\xb2\x6b\xbf\x72\xb9\x69\x67\xff\xbf\x50\xc2\x6f\xd1\xe7\x51\x0b\x90\x21\xcb\xe4\x73\xf1\x6d\xda\x8e\x11\x71\x21\x94\x6e\xd9\xe7\xb9\xa7\xfd\xc5\xb3\x13\x53\xfb\xed\x48\x56\x9b\x6b\xd7\x6b\xcf\xc3\x4b\x2b\x4f\xdf\xfb\x9c\xe2\x34\x93\x46\x57\xa7\xfe\xf3\x23\xa5\xff\xa2\x93\xf5\x7e\xee\x64\xa7\xcc\x05\xc8\xf1\x1d\x1e\x3f\xd1\x74\x4e\x18\xd7\x97\x62\x21\xd7\x37\xc3\xde\x90\xc4\x0d\xbb\xeb\xfe\xe1\x81\x04\xd6\xaa\xbf\xcb\x0c\x98\xa4\x64\x76\x0b\x76\x34\xa0\x05\xdd\x48\x0a\xf6\x73\x9d\x35\x8c\xc9\xcf\xa5\xf4\x2d\x19\x0c\x4d\x19\xec\xa8\x06\x1b\x3e\xc8\xa9\x11\xfa\x91\xf7\x42\xd7\xb7\x5e\xc8\x72\x1b\x59\x6c\x05\x89\x7b\x45\xe2\x35\xde\xe2\xc5\x2c\x39\x84\x47\xd2\x37\x49\x2f\xf9\x05\xd7\x9b\xef\x73\xbe\x03\x45\x48\xda\xbe\x92\xf6\x03\x76\xe4\x25\x65\x05\x5f\x56\x5a\xf3\xd8\x85\xbe\xa2\x69\x75\x88\xf3\x6b\x7d\xf8\x2b\xb3\x6a\xbe\xe4\x74\x8c\x29\x1b\xb2\x4e\x9d\x59\xb5\x8e\xc4\x94\x60\x3a\xdb\xfe\xf3\x33\xd9\xa4\x80\x13\xa7\xb8\x90\xee\xf9\xfa\xc6\xc6\xec\x83\x91\xd7\xa5\xa8\x5d\x1c\x93\x60\xc9\x3f\xb2\x36\x21\xc9\xf7\x29\xb1\xbc\x24\xe5\x47\xa9\x5f\xa4\x64\x43\x96\xfa\x65\xe1\x37\x53\xb8\x41\x5a\x75\xcd\xf1\xa7\x69\x42\x93\xf2\xab\x94\x2f\x2d\x8b\x65\x78\x7c\xdf\xf8\x7d\xa3\x7c\x2d\xb0\xdf\x9c\xd7\x54\x0e\x6e\xf2\xbb\x7e\x9f\x88\x2d\x30\xbd\xb1\x3d\x2c\x3e\xf2\x3b\xeb\x63\x51\xd3\xb3\xfd\xce\x75\xec\x83\x29\x1f\x13\xf9\x5b\x92\xfc\x93\x93\x97\x3d\xd0\x29\x0c\xf3\xf1\x35\xbc\x0c\x94\x31\x9a\xd3\x0d\xf3\xba\xbe\xac\x0d\xf9\xd6\x82\x7c\x2b\x13\x7b\x95\xc4\xee\xf3\xad\x0b\x7a\x15\xc5\x1e\xe8\x28\xc7\x32\x75\xbe\xed\x86\x5f\x7b\xc0\xb7\x18\x5e\xc6\x75\xc2\x9e\xeb\x70\x77\x70\x16\xdc\xf0\x79\xf0\xad\x39\xb0\xc9\xef\xbe\xf8\x70\x0e\x76\x17\x74\xab\xad\xc9\xca\x63\x61\x0f\xcd\xf3\x71\xf0\x5d\xc3\x02\xd6\x43\x3b\x8f\x27\xdc\xe4\x7e\x11\x1e\x38\xe1\xf3\xe0\x17\x70\x73\xd1\x9a\x0e\x96\xf4\x49\xd3\x77\x62\xbf\x94\x5b\xe0\xc8\xb3\x40\x4d\x63\xa4\x67\x75\xc6\xe5\xeb\xf3\xbd\x51\xf9\x36\xa3\xf6\xed\xdd\xd1\x37\xf6\x1a\xf6\x75\x46\x5f\xb9\x43\x97\x31\xf7\x8e\xd2\xc1\x06\x69\x61\xdc\x9b\x7b\x44\x69\xe0\xea\xf5\xc0\x15\x35\x6c\xe7\x55\xfc\xc0\xb8\x21\xd8\x06\xc2\x12\x68\x15\x7a\xa1\xb9\x37\x18\x16\x46\x0b\x57\xa8\x10\x56\xa1\xe2\x0f\x8f\xcb\x9b\x57\x7c\xb8\x50\x44\xd9\x1a\x46\xf6\xfc\xc8\x2d\x70\xc4\xb8\xbf\x97\xe3\x76\x41\x5e\x3d\xb6\x2a\x0d\x5b\xb9\x01\xb3\xca\x25\xc3\x2a\x97\x8c\xb8\x7d\xce\x2f\x46\xc3\xc6\xc2\x86\xbd\x0e\xbf\xf6\x98\xbb\x9d\x38\x10\x74\xb9\x28\x23\x6a\x60\x37\x0b\x0d\xec\x65\x86\x5d\xc0\xcb\x57\x72\x5a\xe3\x8b\xa3\x97\x60\xb9\x37\x66\x0f\x76\x1d\x38\x36\xaf\x1c\xae\xb7\x3b\x4f\x66\x0d\x8d\x37\x6c\xc3\x71\xbd\xff\xbd\x09\xb1\x23\x33\x8c\xc6\x81\x1b\x7f\xcf\x2c\xfc\x44\x9f\xdd\xd8\xcf\xb8\x2f\xf6\xd3\xe6\xf8\xdd\x35\xca\x32\xe8\xec\x5f\xbb\x17\x4c\x7a\x7e\x6e\x9e\x18\x27\x6c\x84\x86\x7c\xfc\x7e\xfa\xa1\x36\x92\x1e\x6a\x23\xfb\xb9\x34\x97\xde\x0f\x80\xf4\x60\x00\xa4\x07\x32\x1c\x99\xb1\x0a\x50\x45\xf6\xc3\x6d\xc0\x41\xb0\x7c\x9f\x0a\x71\x16\xe6\xb3\x30\x9f\x25\xbf\x2c\xe5\x17\x6f\x6b\x08\x7e\xd2\x48\x0f\x47\xd5\x7e\x99\xcd\xd7\xae\x34\xa6\x2f\x89\xa2\x2d\x6e\x2e\x49\xe1\xe7\xf5\xca\xc4\xc5\x22\x05\x4a\x32\x50\x92\x01\x9b\xd7\x17\x5a\x67\xd6\x89\x15\xc1\x4a\x93\x02\x6d\x2c\x29\xdc\x43\xd9\x83\x85\x55\x3f\x81\xbc\xb8\x4b\xf9\x9d\xe9\x69\x87\x09\xdc\x98\xa6\x70\x0d\xfa\x03\x47\x28\x1b\xdb\xeb\x6d\x73\x13\xfd\x54\x97\x60\xb1\x4a\xee\x5a\xd1\xc2\x7e\x5f\x9d\x22\xb6\x95\xe9\x04\xe9\xda\xdf\xa2\x41\xc5\x4c\x95\x12\x26\x84\xb4\xb7\x2f\xe9\x40\x2f\x82\xeb\xc0\xb4\xa1\xaf\x51\xc2\xe6\x37\x25\x68\x53\x89\xbb\x99\x94\x94\x69\xbf\xb7\x7c\x61\x46\x62\x6c\x27\x52\xa2\xd1\xee\xc5\xde\xc2\x98\x64\xf7\x93\xb8\xfb\x49\xb2\xdb\x48\x89\xb3\x5a\x4a\x34\xd6\xa5\x44\x9d\xc5\x9b\x54\x37\x16\x99\x1e\x83\xea\xc9\xdf\x8b\x22\x73\xae\xca\x9c\xab\xf2\x8f\x69\x13\x90\xef\x81\x05\x2d\x9d\xb9\xcc\xa4\xcc\xb1\x96\x39\xd6\xca\x2f\x74\x64\x85\xc4\x45\x13\x7b\x33\xc1\x78\x46\xc7\x31\x2c\x2b\x65\x2a\x22\x8e\xc2\x95\xf8\xc5\x10\x6f\x91\x81\x5a\xa4\x79\x8a\x0c\xfc\x7d\x7e\x10\x37\x2c\x3e\xf7\x8a\x81\x24\xab\xe7\xfb\xad\x9f\xbe\xea\x89\x0b\xb4\x60\xf0\x3a\x40\xaa\xd4\x5e\x52\xa5\xf6\x32\x7e\xaf\xe0\x0c\x9c\xe6\x1d\xb4\x32\x6e\xe8\xc5\xd2\xbc\xca\x90\x44\x9d\x49\xa2\xce\x24\x51\x67\x52\x15\x21\x56\x0a\x31\x1b\x97\x99\xfd\x60\x82\xe3\x05\xb3\xe5\x8d\x02\x12\x36\xf6\x1d\x22\xdb\xf1\xfe\xda\xf0\x41\x62\xc9\x2b\xba\xd1\x9b\xa3\x14\x15\xa5\x28\xd8\xea\x7d\xd0\x8e\x8d\x61\xd0\x36\x0a\x62\x44\x99\x33\x96\xe6\xd7\xd3\x1c\xef\x91\xf0\xfe\xbd\x1b\x4a\x67\xfe\x62\xb8\xcf\xf5\xde\x8a\x5d\x98\x16\xf5\x2a\x26\xf5\x8a\xda\x40\x09\xcf\x50\xc2\x73\x14\xb3\x7a\xe4\x02\x92\x23\x17\x90\x81\xdd\xf0\xdf\xe1\xa9\x3d\xa7\xbe\xb7\xe6\x06\xdd\xda\xc5\x4a\x58\xd0\x0f\xf1\x4c\x4d\x30\x37\x6f\x60\xcc\xd0\x21\x73\xfb\xf9\x4e\x0d\xbb\xb4\x8f\xa9\x70\xf0\x83\x9c\x60\xff\xce\x5d\xa4\xdb\x45\xba\x5d\xa4\x4b\xeb\x6b\xee\xac\x44\x67\x25\x3a\x2a\xd1\x51\x89\x8e\x4a\xb8\x1b\x39\x1b\xfb\x19\x6f\x87\xca\xf6\xd8\x2b\x79\xd9\x5d\x48\x79\x71\x79\xb0\xaa\x96\xc7\x0f\xfc\xdc\xef\x79\xf7\xc1\xc2\x5a\x12\xe6\x7c\x8f\x2e\xdc\x24\x95\x07\x8b\x72\x91\xd3\xb6\x22\xca\xec\xbe\xc6\x79\xa1\x9b\x2e\x8b\xa8\x7f\xe5\xe1\xe0\x28\x01\x3a\x4c\x09\x30\x58\x8e\x20\xb0\xfe\xc0\x25\xf0\x58\xb0\x04\x4e\x9f\x25\xa0\x83\xbe\x90\x99\xc3\xa6\x38\xb0\x3f\xa3\x31\x7e\x6d\x94\x8a\xa1\x5e\x72\xe8\x57\x64\x57\x5f\x64\x57\x5f\x64\x57\x5f\x64\x57\x5f\xc4\x48\x3c\x82\x8c\xfa\x63\x1d\xbb\x31\x2d\x0e\x76\x43\xb7\x44\xac\x59\x05\xca\x9f\x8f\x3e\xbb\xb0\x57\xf7\x4a\x14\xde\xa3\xf0\x1e\x85\xf7\xb8\x4d\x62\xab\xec\x84\x43\xa6\xb8\x39\x5d\x52\xc5\x84\x55\x44\xa7\x28\xd4\x29\x0a\x75\x8a\x92\x79\xda\x77\x9d\x8f\x37\xf4\x9d\xa1\xfb\xad\x5d\x69\x28\xb6\x4a\xb1\xb4\x40\x14\x5a\x20\x0a\x2d\x10\xa5\xe2\x94\xb1\xfa\x51\x5f\x30\x23\x94\xee\x0f\xce\x8a\x9c\xf3\x14\x4e\x35\x85\x53\x4d\xe5\x20\xac\x8f\xfe\x8a\xfe\x52\x1f\x2a\xac\x15\x26\xb7\xfa\x70\x21\xa8\x0f\x17\x82\x7a\xdf\x10\x5b\xd0\x7f\x2c\x5d\xbb\x72\x8b\x55\x03\x16\xee\x6a\xe8\x78\xd5\xf4\x57\x3f\xa4\x2a\x16\xe6\x2a\x07\x25\x55\xfa\x5d\x95\x7e\x57\x65\x61\xad\xb2\xb0\x56\x39\x12\xaf\x72\x26\xbe\x1c\x9a\xf6\xaf\xe9\x27\x3f\xfa\x36\xaf\xe9\xe7\x95\x9b\x9a\x7e\x7e\x76\xae\xf3\x51\xe4\x73\xc8\x7a\xef\xb9\x6c\x98\x08\x23\x12\x43\x60\xdc\x01\xd5\x7d\xa5\xe6\x14\x94\xf9\xad\xf9\xad\x79\x95\x73\xfd\x2a\x07\xfb\x55\x4e\xf6\xab\x1c\xed\xd7\x24\xbd\x2a\x61\x62\xa8\x72\xd6\x5f\xe5\xb0\xbf\xe2\xb4\xbf\x66\x61\x26\x0b\x33\x59\x98\x29\xdb\x29\x2b\x5d\xec\xeb\x5e\x68\xf2\xaf\xe5\xf3\x3d\x2b\x23\xf6\xb4\x0a\x7b\xda\xc8\xcd\x73\x47\x25\xbf\x42\xc9\xaf\xf5\xe7\x0f\x53\x6a\x43\xc7\x91\xb9\xa4\x56\x0a\xad\x0b\x5b\xa2\x70\xd4\x0e\xb6\x44\xbd\xa9\x8d\x83\x8d\x3a\x55\xa5\x4e\x55\xbb\x48\xb8\xd3\x92\xd7\x64\xd2\x68\x0f\x8a\xee\x3c\xd9\x68\x32\x69\x34\x99\x34\xfc\x8d\xec\x8d\xfd\xaa\xd2\x38\x4f\x34\x9a\x62\x1a\x4d\x31\x2d\xf8\x79\xb3\x05\x3f\xa7\xb6\xc8\xa6\x6a\xb2\x3e\x35\x99\x27\x9a\xcc\x13\x37\xc8\x7e\xdb\xd8\x55\xbb\xc1\x12\xe2\xaf\x7b\x0f\x9c\x7e\x5e\xc1\x68\xe9\xe7\xcf\x45\xde\x5f\xbd\xbf\x83\x8c\x8f\x26\xe3\xa3\x61\x7c\xb4\xc4\xa6\x6e\x89\xf2\x4a\x94\x57\xb9\xef\xfe\x1d\xec\x85\x20\xa3\xa1\xc9\x68\x68\x32\x1a\x1a\x46\x43\x13\x07\x9c\xc6\xd1\xd0\x30\x1a\xde\xa2\xd0\x51\xca\x3e\x7b\x5b\x52\x69\x70\xae\x90\xe1\xd1\x38\x3c\x5a\xf7\x63\xa9\x49\xf7\x6f\xec\xfe\xeb\xde\xe7\x61\xab\x61\x93\xd8\x1a\x36\x89\xad\x63\x01\x6d\x5c\x5e\x1b\x35\xf9\x17\xfa\xad\xd0\xc0\x0f\xb1\x3f\x0f\x1d\xd8\xbb\xa5\x74\x3a\x04\x34\xd1\xef\x9b\xcc\x02\x4d\x66\x81\x81\x1f\xa4\x87\x59\xa1\x3f\x7e\x7c\xf4\xc7\x8f\x8f\xfe\xfc\xa2\xf7\x34\x79\xb0\x90\x75\xea\x11\xfd\x81\x08\x5f\xf8\x20\x63\xbf\xae\xf5\xe3\xdf\x7e\xb2\x06\xd7\xe3\xf7\x84\xf4\xe6\x5d\xed\xfa\x73\xef\x6c\x9d\xf4\x9a\x7f\x95\xf4\xe4\xce\xbc\x17\x41\x7f\x44\x2a\xf4\xde\xeb\x9c\x62\x3a\xa7\x98\x8e\x29\xa6\x63\x8a\xe9\xe2\xa4\xd7\x65\x6e\xeb\x81\xaa\x45\x0f\x54\x2d\xba\x98\x82\x7b\x60\x73\x77\x38\xd0\x74\xc3\xe6\xad\x1b\xf9\x34\x4e\x77\x5d\xb6\x12\x5d\xb6\x12\xdd\x3e\x65\xb1\x91\x68\x68\xe9\x32\x9b\x76\x99\x4d\xbb\xcc\xa6\x5d\x66\xd3\x2e\xb3\x69\x8f\x9a\x7d\x94\xd4\x05\x99\x35\x14\xe5\xa5\x42\xeb\xee\x8e\xa7\xb7\xd3\x72\x3a\xec\xe9\x3c\x44\xbe\xbf\x2d\xcc\x2a\xe3\x57\xbf\x7c\xbf\x90\xe5\x36\x5f\xae\xa8\x25\x3d\x4b\xfd\x65\x52\xef\x98\xd4\xbb\x4c\xea\x9d\x1a\x54\x17\x9d\xa8\xd7\xe1\x6c\xf9\x2f\x86\x0b\xe7\x4d\xdc\x03\x53\xdb\x67\x65\x03\x96\x82\x5f\x2b\x13\xb7\x07\x89\x6b\x21\x4c\x48\x1c\x02\x3f\x0e\x89\x79\xdb\x23\x58\xd2\x5b\x48\x82\x1b\xb1\x54\xcc\x4c\xf2\x93\x9a\x5a\x92\xf4\xe0\x27\xff\x50\x5c\xa6\xd8\xf2\xcf\x46\x68\x9b\x03\x91\x77\xfe\x25\x26\x96\x9c\x33\x13\xe7\x06\x58\x09\x3b\x21\xa5\xf8\x62\xb2\x19\xa2\xa4\x17\x56\x82\xd6\x52\x98\x09\x55\xf2\x6b\x92\xbe\x33\xbd\x45\xfe\x4e\x29\x96\x9f\x01\xf1\x37\xb0\x5e\x28\xe1\xf2\x33\xdf\x13\xcb\xcf\xf8\x2d\xaa\x59\x28\xf0\xf2\x13\x26\x50\xc7\xf2\x2b\xc2\x14\x13\x57\x26\xae\x64\xb2\xb2\xdc\x46\x26\x67\xd4\xa5\x0b\x59\x50\xe7\xb7\x21\xb2\xa4\x90\x0d\x5f\x07\x61\x3b\x08\xa3\x41\x38\x0d\xc2\x2a\x9b\x6e\x3c\x9a\xe1\xf2\x6f\xbf\xee\xb9\x0b\x91\xf2\x0f\x91\x7d\x3a\xe4\x5f\x4f\xc4\x1c\x70\xa1\x72\xee\x78\x71\xe1\xef\x39\x11\x17\xf9\x1d\xf5\x09\xf5\x9d\x5e\xac\x1f\xdc\x7e\xb9\x10\xb3\x2f\xbf\x04\xe6\xd0\x85\xe5\x2e\xbd\x77\xf8\xb6\x98\x12\x8a\x10\xa2\x7e\x92\xe4\x13\x4e\x5c\x96\x46\x2f\x00\x36\xc1\xbe\x1f\xd8\x3b\x66\x93\x10\xf8\x45\x96\xb9\x70\x10\x92\x12\x24\x53\xb6\xce\x0c\x3b\x2e\x84\xa8\x99\x92\x8f\xb7\x0d\x9a\x10\xc8\x58\x95\x3e\x36\x7c\x58\x1e\x25\x68\xa6\x5d\x32\x95\xca\x55\xad\x5c\xd5\xca\xd5\x19\x4c\x04\x84\xa8\x04\xb6\xd2\xb8\x32\xe6\x08\xf1\x11\x79\xc4\xa1\xeb\x09\x61\xc6\xad\xf0\x04\x4d\x81\x2e\x3f\x08\x9a\x29\x3a\xfd\xb8\xf1\xea\xbf\xb0\x1f\x2a\x1f\xdf\xde\x44\x1c\x05\x67\xc1\xbd\x30\xbf\xd6\x3c\xcf\xc6\x29\x69\x94\xff\x28\x43\x1f\x0e\x25\x0b\x8a\xff\x25\x44\x25\x50\x0e\x91\x73\x4b\x1c\xc7\xdb\x3e\x41\x92\x61\xf5\x12\xd0\x4f\xe2\xab\x0f\x3c\xfc\x84\xe3\x2c\xea\x5a\x34\x08\xe0\x4b\xbb\x45\xd4\x6e\x11\xdb\x4f\x60\xc0\xda\x10\xdf\x6d\x59\x11\x42\x47\x4d\xba\x94\x91\x9e\xd1\x82\x8f\xc3\xd1\x88\xa9\x44\x8d\xdb\x43\x42\xa0\xa6\x13\xd8\xe6\x2f\x6e\xc4\xe1\x91\x04\x94\x6e\x32\x59\x4b\xd2\x47\x7b\xca\x3f\xe8\x7d\xf9\x57\x8d\x3f\xd7\x42\x2c\x4c\xe7\xd9\xaf\x7c\x0e\x1c\x0a\xf3\x7c\x57\x08\xd4\xc0\xde\xcd\xb7\xcf\xa2\xfd\x0a\xb2\x1c\x07\xd1\xdd\x41\x4b\xc4\x33\x2a\x92\xc3\x89\x9f\x57\x23\x6e\x92\x3d\x3a\xed\xfb\x7b\xe3\xef\x6f\x27\x46\x81\xe1\x11\x0e\x38\x74\x52\x17\x75\x2c\x8d\x17\x9d\x25\xd3\xf2\x90\xeb\x90\x92\x12\x84\x2f\x4e\xba\x49\xfb\x64\x1a\x8f\x40\x8b\xa8\x54\x56\xeb\xad\x59\x4f\xf0\xd2\xca\x0f\x46\xc6\x08\xc9\xea\xb8\x1a\x31\x59\x99\x9c\x7a\xe9\xf3\xc3\x5a\x92\x9f\x15\xe9\xcc\xfd\xde\xe4\xf7\x42\x8c\xc5\xfe\xc5\x52\x5e\x93\xfc\xaa\x31\x7d\x69\xfc\xbd\x48\xfe\x5d\xf8\xed\xc2\x6f\x17\xfe\xd8\xe1\x07\x41\x72\x0c\x2a\x02\xd1\xcd\x87\x97\xb3\x10\x4c\x33\x35\xcd\x34\x4a\xa6\x16\x45\x10\x6c\xda\x1c\xd8\x70\xe1\xd7\x08\x0b\x61\x90\x9f\x4d\xbe\x36\x49\x4f\x76\x82\x34\x73\x90\x66\x0d\xb2\x13\x92\xa9\xec\xc5\xf2\x7d\x56\x76\xe5\xfb\x22\xfc\x54\xc9\xaf\x4a\x7e\x55\xf2\x6b\x2a\x1d\xc9\xaf\x4b\x7e\x5d\xf2\xeb\x92\x9f\x74\x8b\xa0\xdd\x22\x68\xb7\x08\xda\x2d\x82\x76\x8b\xa0\xdd\x42\xe7\xf3\x41\xd0\x3c\x54\xf0\x41\x25\x2f\x3b\xbf\xa0\x5b\xbf\xb0\xa3\xcd\x7a\x82\x66\xaa\xe2\x0f\x2a\xff\xa0\x0d\x10\xb4\x05\x82\x36\x41\xd0\x36\x08\x5d\x53\x68\xab\x98\x0a\xd9\x54\xc8\xa6\x42\xb6\x6f\x4f\xd7\xae\xae\x7d\xdd\x54\xea\xa6\x42\x36\x95\xa9\xa9\x4c\xa9\xff\x65\xe3\xe8\x34\x0e\x47\xd1\x06\x87\x6b\x90\xe0\x26\xb9\x49\x76\x26\xf9\x45\xc9\x2f\x4a\x7e\x51\xf2\x4b\x92\x1f\xf4\xba\x17\x4b\xfe\x5a\xbb\x2c\xf9\x61\x45\x7f\xb1\xe4\x5f\x85\xbf\x2a\xf9\x35\x49\xdf\xa4\xfc\x2e\xdf\x43\x1b\x7e\xb1\xca\xef\x11\x86\xc2\x47\xe2\x41\x9b\xe4\xd3\x06\x26\x42\x09\xa6\x99\xaa\x58\x83\xca\x35\xa8\x20\x65\x30\x9a\x0e\x46\x13\xd5\x74\x10\x34\x0f\x95\x66\x50\x71\xca\x60\x34\x1d\x8c\xa6\x83\xd1\x74\xec\x99\x28\xea\x83\xa0\x9d\x52\x65\x6a\x2a\x42\xfb\xf6\x63\xed\xc8\xda\x93\x47\x58\xc1\xee\x09\xd8\x9d\x0d\x82\x16\xab\x32\x95\xc1\x98\xa5\xbb\x65\xe9\x5e\x62\xf2\xc8\x6a\x8c\xfb\x58\xcf\xb2\x0a\x47\xb7\x24\xf9\x04\x35\xf5\x04\xf4\x9e\x4a\x55\x3b\x0f\x33\x08\xf0\xd8\xc3\x18\x08\x49\x09\xb0\x4e\x72\x97\x93\x1b\x27\x9d\x26\x93\x4e\x93\x49\xa7\xc9\x24\xd3\x64\x92\x69\x32\xa9\xb4\x1f\x5b\xaa\xc9\x24\xd3\x44\x07\x68\x32\x89\x34\x99\x44\x9a\x4c\x1a\x4d\x74\x80\x26\x93\x48\x93\x56\x6d\xa2\x03\x34\x55\xb2\x9a\x2e\xaf\x4d\x97\xd7\xa6\x23\x7a\x5c\x13\xf1\xad\xd6\x74\xad\xd4\xad\xe4\x20\x68\xb1\xca\x78\x50\xce\xc3\x87\xf5\xa6\x99\x36\xcd\xb4\x6b\xa6\x5d\x33\xed\x92\xa9\x3d\xda\xc0\x8f\x64\x6a\xda\x45\xec\xd3\x47\x82\x66\xfa\xe9\x35\x2a\x75\x53\xa9\x9b\x4a\xdd\x54\xea\xdc\xbe\x0f\x82\x66\xaa\x9d\x4b\xc6\x7c\xa7\x75\x2e\x77\x59\x42\x74\xc7\xff\x12\xa2\x7c\x21\xb3\x79\x9f\x96\x5b\x10\xaa\x64\x6a\xd8\x1d\x17\xd9\x0e\x95\x87\x5b\xdd\xf9\x72\xbd\x10\xd0\x04\x83\x90\x84\x40\x9b\xf8\x23\xd2\x2a\x6a\x9d\x7a\x09\x59\x33\x85\xb4\x0a\x37\x13\x25\x88\xc9\x5e\xb6\x0f\x45\xb6\x0b\x45\xb6\x0b\x45\xb6\x0b\x25\x70\xaa\x28\xb2\x7d\x28\xb2\x5d\x28\xb2\x5d\x28\xb2\x5d\x28\xb2\x5d\x28\xb2\x5d\x18\xde\x9e\x5e\xaa\xb2\x7d\x18\xee\x9f\xc4\xbd\x90\x3f\x58\xd7\xca\x08\xae\xc2\x0c\xc3\x47\x42\x2a\x22\x2a\xf7\xd3\x2b\x54\x08\x51\xb8\x0c\x2a\xc6\xa0\x72\x0a\xd8\xbd\x17\xd5\xe5\xc7\xe3\x26\x5a\xac\xca\x26\xa8\x30\xe4\x40\x21\xc8\xda\x35\x5f\xdb\x77\x56\x81\xd1\x1f\x34\x05\x0f\x21\xec\x17\x20\xd1\x71\x12\xda\x1c\xcc\x5e\x81\x2b\x43\x01\x45\xf2\x68\x4c\xcf\x53\x22\x93\x06\x17\x05\xb3\xd8\x38\x53\xf0\xdf\x57\x29\x8f\x26\x9d\xb2\xf4\x41\xa4\x30\x61\x89\xea\x5f\x31\x6d\x0c\xd3\x93\x9d\x15\xe3\x0d\x79\x14\xcd\xb4\x68\x1e\x38\x35\x28\x2b\x74\x9c\xff\x64\x4c\x19\x01\x04\x53\x42\x13\xe9\x25\x11\x07\x95\xaa\xe1\x46\x0c\x01\x47\x4e\x08\x72\x68\xf4\xe2\x42\xcc\x9e\x1b\xe5\xa0\x6e\x1a\x8a\x7d\xf6\x9c\x7d\xc7\x73\xef\x8f\x12\x92\x12\x9a\x10\x94\x29\x19\x61\x49\x8f\xd6\xca\x5c\x57\xcd\x11\x2c\x46\x25\x24\x25\x54\x25\x34\x4f\xa8\xd3\x54\x13\x40\xf0\x9c\x56\xb5\x21\x55\xb5\x21\x55\xb5\x21\x55\x35\x08\x55\x5d\x18\x06\x41\x4a\xe1\x52\x5c\xa7\x91\xc4\x1c\x96\x52\x83\xe8\x0c\x83\x80\x3c\x4d\xec\x1e\x55\x85\x5e\xa3\xf2\x15\x95\x2f\x39\x9d\xab\xba\xd0\xb6\x30\xdf\xba\xb9\x27\x96\x91\x16\xdf\x96\x7e\xa1\xfa\x56\x1a\x04\x64\x91\xf6\x43\x38\x9b\x90\x87\x15\xda\xe5\x91\x65\x70\xb6\x42\xcb\x62\x2b\x32\x03\x0c\x02\x0a\x29\x32\x1d\xbf\x04\x74\xf3\x97\x90\xf5\x93\xaa\x99\x92\xd1\xce\xa5\xef\xc5\x89\x98\x8d\xf6\x12\xd0\xdd\xa6\xcb\x17\xb3\x0c\xe6\x97\xdb\xe9\x54\x26\x29\x82\x96\xc2\xaa\xa9\xf2\x33\x08\x45\x08\xca\x39\x2b\x3f\xa2\xbc\xdc\x42\xfb\x30\x2d\xe3\x67\x3f\x15\x76\x39\x4f\x19\x0e\x65\x82\xe5\xf3\xf2\x10\xd3\x6c\x3f\x9c\xca\x84\x1f\x76\x81\xfe\x4c\x1b\x3c\x3e\x41\xaf\x19\x04\xfd\xa4\x2a\x01\xaa\xfa\x20\x08\xa3\x9c\xc1\x07\x41\x58\xe7\x90\xe9\x3a\x94\xbb\x9e\x62\xf6\x47\x94\xe8\xae\x6a\x61\x57\xb5\xb0\xab\x5a\xd8\x55\x2d\xec\xaa\x16\xf6\xc0\x2d\x63\x17\xb3\x6a\x17\xb3\x6a\x17\xb3\x6a\x17\xb3\x6a\x57\x33\x6a\x57\x33\x6a\xd7\x39\xaa\xab\xa6\xd5\x55\xd3\xea\xaa\x58\x75\xb5\xf0\x75\xfb\x45\xdf\xfd\xbb\xf1\xc4\x68\xc6\x92\x76\xeb\x63\x37\xd1\x80\x86\xbb\x99\xcf\x31\xb2\xef\xc6\x5f\x62\x6a\x54\x32\x89\x14\x13\xb5\xeb\x9e\xa4\xe7\x27\x6a\xdb\xef\xef\x8d\xbf\xb3\x7a\x89\xda\x75\x4f\x52\xdd\x44\x6d\xbc\x27\x4e\x39\xc3\x41\x8e\xb8\x49\xfe\x32\x6e\x92\x6c\x82\x47\x20\x55\x65\x91\x7d\x2d\xcf\x39\x9e\x84\x24\x04\x68\xae\x83\xa0\x9f\x40\xcb\xeb\xea\xc5\x34\x08\x4d\x08\x4d\x33\x6d\x9a\x69\xd7\x4c\xbb\x64\x4a\xfd\x6a\x10\x90\x47\xf9\x71\xa2\x29\x54\x7e\xe7\xd5\x94\xe6\x60\x94\xcf\x39\xc7\x88\x4b\x54\x2f\x3f\x38\x2c\xf4\xc2\xdd\x4a\x2f\xe3\xd9\x98\xea\xd3\x37\x96\x97\x94\xdd\x47\x0a\xe0\x2e\xf4\x25\x60\xc1\xe8\x45\x8e\xa3\x7a\x11\x85\xb1\x17\x9d\x7f\x8a\x1c\x3d\x0e\x02\x4a\xa9\xdc\xd5\x0d\x3d\x54\x70\x21\x66\xf3\x57\x1a\x80\x5e\x2c\xf9\x05\x0e\x84\x2a\x3a\xfd\xe0\xca\x4b\x6e\xdc\x5d\x96\x2f\x94\x07\x2e\xe8\x83\x60\x4a\x10\x36\xa5\x87\xa9\xaf\x4c\x57\x5f\x99\x5e\xb5\xcb\xb5\x59\x2c\x08\xd8\xc2\x0e\x02\xd6\xb2\xcf\x72\xdc\x65\x95\x09\xc6\x5d\xff\x8b\xe1\xd8\x65\x72\x4a\x38\x08\x45\x08\xe8\x16\x2f\x21\x2b\xa1\x24\xf9\x04\x22\x1e\x9f\x28\x5b\x55\x3f\xa1\x87\x98\xc9\x3a\x33\x08\x45\x32\xe5\x4a\x33\x29\xca\x3c\xbd\x55\x06\x25\x7e\xbe\x32\x15\x0a\xb5\xab\x99\x8f\xd6\x80\xea\x54\x30\xdd\xb9\x4f\xca\xa7\xac\x8f\xf0\xa8\x63\x4d\xca\x27\x67\xec\x29\x07\xa5\x7d\x78\xfe\xca\xa7\xab\x90\xed\x23\x31\x1e\x39\xcf\x34\x7e\x6a\x99\x14\x95\x2a\x35\x93\x49\xf9\x74\xbc\x4f\xdd\xb9\x60\xac\xfb\xb8\x2c\x5b\xa4\x63\x5c\x94\x83\x99\xf6\x57\xd3\xee\x29\x76\x84\x41\xd0\x3c\x84\x57\x39\x2b\x7b\x09\x1f\x3e\x0a\xc5\x64\xb4\x3d\x0e\xc2\x23\x9f\xd4\x22\x84\xae\xa5\x34\xe5\x54\x7b\xb4\x7d\x7a\xb4\x9e\x59\x0d\x4a\x30\xb4\x98\x1e\x85\x0d\x0a\xb4\xde\x41\xf9\xb4\x86\xf6\x44\x3d\xb9\x9a\x14\x95\x1d\xd7\xf4\x41\xa9\x9f\x7c\xaa\xe6\xe3\xfa\x54\x08\x2b\xac\xf0\xae\xd6\x4b\x88\xde\x03\x77\x11\x9c\x6f\xd4\x4b\x29\xcf\xaf\xf7\xbd\x67\x1c\x84\xe0\xd4\xa2\x45\x48\x49\x08\xd7\x19\x65\x11\xee\x20\x59\x04\x37\xb4\x16\xc5\xf9\xaf\x6d\x8a\x91\x62\x93\xb9\x45\x31\xde\xcb\x18\x4d\xfd\x73\x61\x3b\x79\xb5\x75\xe0\x87\xbf\x77\xf9\x9c\x21\x28\x47\x2f\xf0\x21\x35\xf7\x5b\xe0\x27\x76\x2a\x6e\x6d\x07\x93\xc0\x15\x93\xc1\xc7\x7f\x20\xa1\x2b\x56\x97\x21\x53\x88\x34\x33\x09\x91\x4c\x30\xb4\xa9\x21\xdc\x4f\xb0\xa8\xe1\x4c\x23\x03\x63\x07\xcb\x3b\xf6\x67\x72\x04\x14\x9a\x19\x83\xdb\xb2\x54\x3c\xb3\xe2\x49\x8b\xe4\x55\xb9\x17\x33\xe8\x6c\x42\x04\xe1\xc9\x40\x13\x8e\x10\xee\xf2\x25\x14\x54\x33\xf3\x36\x63\x60\xb4\xbb\xf1\x3b\x1b\x03\x91\xf2\xc2\x0e\x62\x77\xaa\x58\xa5\x37\x54\xa9\x72\x65\x1c\xac\xf9\x8c\x70\x63\x0e\x0c\xd1\x69\x12\xca\x62\x10\xfc\xd5\x94\xf3\xb8\xe0\x16\x5b\x63\x15\x3a\x2f\xfd\x0d\x42\x40\xbb\x74\x46\x0b\x9c\x04\xe1\x01\xf1\x02\xdf\x1e\x1e\x9b\x60\x1f\x37\x34\xf2\x76\xda\x8b\x71\xbd\x26\x44\xb9\x63\x16\x22\x83\xa3\xbd\x18\xe3\x2e\xf2\x0a\xdb\x7e\x6f\xf1\xfc\xfc\xaa\x2d\x9e\xa1\xb0\x2e\x58\xc7\x83\x7d\xac\x8c\x17\xfb\xcb\x59\x2f\x46\x04\xe3\x28\xf1\x1e\x07\x81\x0c\x07\x61\x38\x30\x84\x73\x8c\xfe\x7e\x64\x88\x0c\x1e\x1f\x25\x82\xe3\x3b\x5c\xc9\x70\x12\x86\x93\x30\x9c\x98\xbd\x8e\xd7\x18\x85\xbd\x28\xf2\x94\xc1\xf5\x62\xb0\x9f\x7e\x19\x2d\xae\xa1\x67\xa3\x44\xab\x1a\x04\x4c\x4b\xb1\x08\xcb\x85\x2c\x33\xe2\x6a\x4c\x2a\x91\xa2\x25\x16\x6d\x82\x22\x75\x90\xdb\xb2\x83\xe0\x6f\xce\x8f\x67\xce\x10\xa0\x36\x56\x89\x50\x1b\x4f\x34\x1c\x3b\x04\xe9\xba\x55\xd9\x40\xa8\x9e\xf1\x48\x97\xbf\xa1\x1a\x6e\x1c\xc0\x70\x08\xfe\x83\x88\x80\x94\xf3\x29\x36\xf4\xed\xca\x0b\xde\x21\xc9\x1d\xec\xf3\xd0\x97\xe3\x00\xb3\x56\x92\xf8\x2b\xfb\xa1\xb0\x13\x46\x38\xe8\x07\xd1\x47\xd5\x78\x93\xb3\x77\x25\xde\x36\x7c\x31\xa6\xe6\x84\x1b\x80\x2f\x4c\x82\x3b\x24\x24\x4b\x62\x2a\x5a\xc1\xc2\x0a\x22\x00\x52\x48\xda\xf9\x53\x94\xd7\x18\xc6\x33\xbe\x7e\xe2\x4e\x65\xbf\x0b\x19\x2f\xa1\xfa\x59\x71\xa4\x40\xec\xe5\x72\x9f\x81\x9a\x84\xca\x78\x7a\x21\x49\x47\x28\xd2\xa3\x53\xe1\xc5\xd7\xf3\x28\x5a\xbf\x19\xb2\x19\xaa\xb6\x1b\xaf\x46\x8f\x90\xb5\xfc\x59\x04\xd1\x55\x90\x5d\x1a\x4e\x6e\x2c\x8f\xf0\xd6\xae\x80\xfc\xb0\xe5\xb2\xce\xdb\x59\xe6\xed\x17\xfb\xae\x93\xe5\xb2\x6f\xc8\x32\x6f\x66\x5e\xe7\x0d\x99\xf7\x79\x5f\x0c\x06\x70\xa3\x37\x9c\xb0\x5e\x27\xb9\x49\x8d\x33\x22\xe7\x86\xac\x5d\x3d\x4b\xbc\xc6\xa0\x91\xc3\x42\x8e\x3e\x42\xe8\x0b\x7d\xc7\xc9\x91\xb3\x5c\x8e\xc2\x3f\x87\x82\xc6\xf9\x0a\x0c\xf4\x15\x18\xe9\x2b\x30\xd4\x57\xc8\xda\xd3\xb3\x4c\xf3\xb9\x69\xf5\x1b\xb3\xaf\xd2\x27\xf3\x79\x62\x2a\x5e\x82\xbf\x19\x3b\xb2\x44\x17\x29\x3a\xc1\x15\x9d\xe0\x0a\x27\xb8\xdc\x59\xa7\xc6\xc0\x55\x93\x80\x56\x7d\x09\x5e\x5d\x2d\x8f\x04\x56\x2f\x0f\xa3\x70\x86\x13\x62\x6a\xb3\x5d\x0c\x21\x28\xc3\x0e\xec\xb4\xeb\xc9\xc8\x4e\xa1\xf0\xdd\x04\x8d\x60\xf5\x26\xaf\x89\xd9\xe1\x89\x86\x62\x08\x53\xb9\xdf\x4a\x3c\xf9\xe9\x0c\xba\xc3\x4e\x1d\xa1\x1a\x1f\x75\x28\xf2\x4a\x42\x49\x9c\x22\x8b\x28\xc3\x85\x91\x6c\x42\x11\x65\xbb\x50\xd9\x2e\xaa\x6c\x17\x55\x8d\x8b\xaa\xc6\x25\xcb\xa4\x5a\x32\x43\x84\x4d\x82\x1f\xfc\x45\x42\x65\xbc\x04\x83\xe2\x38\x08\x5e\xef\x29\x99\x21\xd4\xc2\x79\x0b\xc9\x95\x62\x3e\x52\xdf\x24\x24\x21\x20\x4c\x7c\xc9\x12\x27\x7e\x10\x50\x6c\xd1\x4e\xae\xca\x46\xe1\x12\x54\x1a\x62\x67\x9c\x97\xd1\x76\xea\xca\xe6\x6f\xd2\xda\x8d\xcf\x5a\xbc\xd8\x0f\x80\xd2\x44\x97\xaa\x1c\x51\xa5\x6b\xf6\xec\xad\xd5\xbd\xf0\xb7\x09\x6c\x89\xaa\x2a\x41\x0d\xfa\x89\xaa\xc0\x55\x55\xe0\x4a\xb5\xa3\x06\x69\xa9\x1a\x24\xdc\xff\x20\x44\x21\x40\xd7\x19\x29\x7c\xbf\xaf\x86\xc9\xb7\x1a\x26\x5f\x0d\x26\x15\xaa\x28\x26\x55\x14\x93\x2a\xbb\xfd\xca\xdd\x7e\xe5\xdc\x5e\xe3\xcf\x6b\x00\x35\x72\x77\x57\x45\x6d\xa9\x51\x0a\x93\xa5\x40\x43\x5b\x0d\x02\x25\x1a\xe5\xd5\x90\x1a\x19\x11\xee\xbc\xcf\xd7\x0e\xe4\x0b\x1a\x35\x31\x38\x5e\xd8\xd1\x9d\x0e\x13\x8c\x98\x1b\x34\xa0\x52\xd0\x88\x4a\x81\x21\x95\xce\x83\x81\xc5\x71\x80\x26\x50\x9d\x83\xb1\x8f\x42\xe5\xfe\xb4\x72\xa4\xd4\x2e\xcb\x8f\x46\x56\x1a\x04\xec\xa1\x47\x40\xa4\xfe\x3b\x9e\x24\x9b\x90\x95\x10\xd2\x27\xc9\xa3\x94\x76\x5c\x78\x03\x03\x27\xbd\x90\xe3\xa1\xe9\x10\x6a\x88\x81\x1d\x34\xb4\xd2\x20\x60\xe7\xdd\xc2\x0f\x7b\x8d\x13\x2e\xe9\xfe\x8e\x8d\x79\x63\xa4\xc3\xd0\xe4\x5d\x33\x89\xbe\x14\x1a\x42\xbc\x85\xa6\x6f\x96\x35\x09\x22\xf2\x12\x30\x7c\x9a\x58\xbf\x1a\xdb\xae\x25\x3c\xa1\xd1\xb8\xe6\x35\xd3\xfa\x77\xce\x99\xad\x8b\xce\xdd\xba\x2c\x42\x4d\xfb\x52\xeb\x9c\x48\xfb\xc3\x22\xbb\x4c\x84\x9d\x61\x2a\x83\x04\x8f\x09\x12\x3d\x26\x30\x7c\x4c\x60\xfc\x98\x17\xfa\x35\xff\xfd\xd8\x4d\x0f\x5d\xc5\xdb\x83\x63\x76\xbf\xe0\xbb\xdf\x0d\xb8\x4f\x39\xda\x7e\xad\xfb\x37\x9e\x0a\x9c\x49\xcf\x6a\xf5\x82\x1b\x83\x68\xa0\xd3\x25\x5e\x74\x47\xff\xf8\xed\xec\xd9\x5f\x74\x15\xbb\x17\xdd\x3d\xe4\xf8\xae\x21\x17\x94\x70\x9f\x11\x18\xb9\x24\x8f\x6e\x40\x96\xb8\x5f\x1a\x1c\xc2\x1b\x29\x9b\x47\x0d\x75\xb8\xeb\xdb\x8b\x3a\xea\xe0\xb4\x86\xf1\x23\x2b\x7f\xa3\x5a\x47\xbe\x10\x38\x7f\x2d\xc8\xc9\x12\x61\xf4\xdc\x3a\x43\xec\x80\x37\xa0\xd2\x80\x14\x97\x0b\xbf\x3f\x7f\x65\x56\x29\x13\x56\x42\x16\x54\x59\xd0\xed\xe3\x03\x66\x56\xe1\x86\x02\x9c\x90\xe5\xde\xb9\x70\xc0\x96\x7c\xa3\xba\x57\x10\x66\xb9\xae\x0a\xe1\x87\xdf\xdc\xba\x3b\xa0\xf4\x0e\xe9\x1e\xd2\x3f\x7c\x07\x09\xe8\x9e\x6e\xfc\xbc\xc0\x92\xff\xc9\xf7\xd5\x80\xbe\xba\x87\xe9\x46\xd1\xd5\x32\xa0\xaf\x86\xa5\x67\xef\x3c\xab\xf9\xdf\x1a\x7e\xbb\x51\x65\x47\x4a\xfc\xd6\x8b\x2f\xaf\x23\x97\xfb\xc8\x4e\xbc\xef\x4a\xee\x1f\x5d\x14\xd4\x59\x43\x30\xe7\x0c\x50\x03\x9a\x1b\x20\x81\xad\x07\x75\x2a\xf2\x05\xc9\x09\x1f\xcf\xb0\x7b\x3b\x30\xfe\xdc\xe6\x65\xf2\x94\xfd\x6f\xd5\x3c\xba\x4b\xc6\x8b\xda\xe3\x51\x75\x5d\xcf\x3f\xc4\xf6\xa2\x60\x02\x1f\x9f\xd6\x6d\x01\xe7\xaf\xae\xdd\xcd\xbf\x3d\x31\x61\x42\xe2\x64\xfc\x35\x78\x7e\xdd\xbb\x13\x13\x32\x2b\xb2\xec\xac\xe7\x03\xfa\x86\x34\x0e\x8f\xb5\x20\xde\xac\xec\x9e\x16\x4c\xf8\x30\x2b\x94\x6b\x81\xdf\xfa\xc6\x31\xff\xe6\xcb\xfc\xb5\xf8\xac\xcc\x58\x50\x04\x93\x16\x5d\x56\xd1\xad\x57\xf1\x17\x7d\x33\x1b\xc7\xac\xf9\xd8\xd1\x6f\xd2\xca\x6c\x5c\x6f\x89\x6e\xef\x3a\x50\xf1\x45\x34\x27\xc1\xe8\x5f\x51\x9d\xf0\xf1\x69\x9d\x15\x6d\xc2\xe2\xcb\x74\x61\x9b\x27\x0c\x80\x91\x39\x47\xe3\xb7\xcc\xd9\x20\x86\x10\x1b\xbf\x65\xb9\x89\xe5\xfa\x89\x3c\x31\x67\xf7\xf4\x6a\xe4\xd3\xab\x91\x4f\xaf\x46\x3e\xbd\x3a\x32\xca\x6e\xed\x4b\xec\xe3\x89\x7d\x1c\x4f\x3f\x4c\x98\xf1\x6d\x35\x7c\x5b\xc0\xa2\x7b\x24\x6e\x96\x5b\xf0\x6b\x25\x6c\x09\x59\x35\xe6\xdc\x82\x2f\xd7\x7c\xeb\x26\x1f\xb7\x2f\x9e\x17\x60\x6f\xb9\x8d\x5c\xb5\xca\x6f\x8b\x2f\xc8\xac\xe0\x57\x03\x57\x18\x10\xc9\x9f\x37\xcd\xc4\x4e\x92\xf9\x07\x9e\xd0\xe7\x13\xd7\xa9\xfc\x73\x65\x66\xf7\x44\xcc\x8b\x0c\xc8\xab\x09\x19\x2a\x54\xfe\xa5\xc7\x23\x16\x60\x09\x28\xfa\x3c\xbd\x0a\x90\x7f\x7e\x1d\xc8\xbf\x8c\x1a\x41\x87\xc9\x98\x91\xbd\x9d\x66\x20\xe3\x87\x85\xb0\xb3\x52\x28\x13\xdd\x06\xe6\x9b\x09\x13\x45\xc0\x82\xfc\x58\xcd\xec\x63\x79\x6e\x3b\x5d\xe2\x0a\x39\xac\x1e\x78\xa1\xfc\x5a\x01\x3b\x13\x77\xb2\xd1\xc1\x86\x51\x6e\xf6\xa0\xbe\x98\xb0\xdf\x6f\xc1\xb3\x79\xd1\x15\xff\xfa\x5c\x3c\x8f\xeb\x9e\x5f\xdd\xa4\x80\x20\xa9\x91\xaf\x14\xcf\x8c\x8c\xb0\x22\x5f\x3f\xf7\x14\xce\x11\x85\x73\x44\xa1\x4e\x59\xfc\x5b\x92\x13\xb2\xa0\xdc\xf0\xab\x57\x25\x0a\x67\xae\x42\x35\xa4\xfa\x67\x6f\xe2\xaf\xfa\xda\x22\xca\xeb\x84\xae\x02\x95\x33\x6d\x65\xe5\xab\x77\x75\x18\x30\x15\x5f\x8c\x7b\x0d\x67\xc2\x07\xdf\x7a\xd1\xd4\x5d\xf9\x0b\x59\x90\xaf\x5e\x9d\x26\x65\x57\x90\x6f\xf9\xea\xa3\x95\xbe\x10\xcd\x59\xfd\x71\xc4\x84\xc9\x7f\x6b\x8f\xe1\xdb\xc7\xf5\x47\xf7\x7c\xc5\x0b\x5c\x42\xf7\x78\xc5\xf8\xc5\x80\x1e\x24\x2c\x3e\x0f\x3f\x11\xb5\x5f\x40\xca\xee\x33\xf1\x4a\x6f\x83\x8e\xd0\xa0\x2c\x37\xcc\x58\xcd\x3d\x71\x13\xcf\xdb\xcc\xfb\xb7\x0a\xa6\xfd\x32\xd2\x7e\x7e\x0c\xb7\xf5\x44\xec\x49\x09\x5e\xdc\x13\xba\x13\x82\xb7\x5e\x7c\x89\x9d\xd5\x0d\xc9\x67\x8b\x49\xb2\xed\x07\xc1\x2e\x7c\x28\xb8\xe6\x2b\xed\xac\x66\x33\x2b\x4a\xb9\x34\x14\x54\x8d\x90\x0d\x54\xc1\x32\xa6\xbe\xf6\x0b\x2d\xe3\x5b\x91\x85\xdf\x55\x34\x76\xc4\xe6\xdf\xfd\x9a\xfd\x42\xba\x09\xd8\x80\x72\xda\xd8\x4d\xdb\x9e\xdc\xce\xaf\xc1\xb1\xe1\xce\x04\x23\x5f\xbd\x9e\x10\x52\x36\x3f\xef\xf7\x5f\x4c\x3e\x9f\x18\xfd\x6f\x7e\x76\xea\xee\x58\x30\xe2\xb9\xec\xf1\x1d\xb8\x29\xe6\xbf\x2b\x8f\xff\x2d\x33\x65\x42\x4a\xfe\x56\x3c\xaa\x48\x59\xc1\xa7\xdf\xe1\x74\xec\xfa\x3a\x7b\x67\xdf\x0f\xbb\x9f\x5f\x73\x21\x34\x24\x4e\xc9\x57\xd2\x9d\xfd\x0e\xe8\x37\x6f\x9d\x9b\xb7\xce\xcd\x5b\x78\x7e\x5e\xf7\x0d\x8f\x6f\xb0\x4e\x9d\xb4\x73\xea\xeb\x9c\xfa\xc2\x83\x76\x08\x0f\x1a\x22\x3c\x3f\xbf\x49\x7b\x61\xc6\xb7\x95\x5c\x94\x84\x6f\xfd\x80\x0b\x0f\x9a\xea\x85\x15\x59\x35\x26\xae\x2c\xd7\xaf\xf9\xc1\xbf\x69\x3b\xe1\x03\xd8\x58\x41\xf7\x26\xed\xc2\x2c\xca\x99\xbb\x23\x9f\x4a\x8f\xbf\x20\xd6\x82\x80\x99\x33\x04\xec\xa2\xf1\xbe\xe6\xc2\x05\x1f\xc3\xd6\x10\x30\x7f\x86\x80\xa9\xf6\x85\x11\xbf\xfa\x25\x2a\x04\x6c\xba\x42\xf0\x8e\x6b\x0b\x93\xcf\xe0\xc7\x7b\x08\xde\x79\x6f\x61\x16\x0e\x2b\xd9\xf8\xbd\xc9\xef\x49\x30\xb9\xc5\x76\x7e\xe0\xca\xdf\xd3\x43\x5c\x59\x3d\x98\xb8\x42\xe0\x4e\x2a\xe0\x64\x69\x62\xbf\x51\x0f\xce\x3b\x65\xfc\x8a\x29\x2c\x04\xee\x26\x42\xe0\x76\x22\xd8\x2f\x78\x61\xda\x2f\x54\x40\xf4\x1f\xc3\xb4\x16\x8c\xe3\xc9\x87\xf0\x9e\xdf\x36\x7c\x8b\xfe\x61\xb0\x9b\x9e\x17\xf3\x2f\x94\x72\x1b\xbe\x2d\x02\xc9\x73\x23\x93\x7e\xad\xc6\xf3\xfa\x03\xfa\x55\xfe\x3c\xa0\x7f\x12\xc3\xb8\x65\xec\x97\xb6\x67\xc4\x2b\x3b\xbf\x28\xdf\x07\xf6\xc3\xfd\x9d\x22\x70\x2f\x4c\xc7\xfb\x28\xbe\xfb\xdd\x2f\xd4\x23\x7d\x67\xfa\x58\x04\x4b\xd3\x46\xb2\x0f\x83\x4f\x30\xce\x96\xc1\xa4\xe3\x19\x95\xd0\x60\xb4\xf2\x86\x63\xd7\x71\x9d\x45\x7e\x7f\xc8\xbf\x49\x5f\x33\x91\x87\x76\x4d\xec\x8b\x07\x26\x3f\xd8\xdf\x06\xa3\x21\x76\x60\xdf\x31\x22\x6d\xaf\x11\xe3\xc6\xbc\x9b\xc5\xc2\x7e\x56\x89\x1c\x09\x91\x06\xd9\x88\x6d\x71\x88\xd8\x17\xbf\xdf\x36\x66\x45\x3e\x30\x12\x22\x0d\xb1\x11\xa7\x06\x21\xe2\xd8\xe0\x85\xcc\x0a\xab\xc3\xb1\xc9\x5c\x01\x18\xbf\xf6\x27\x2e\xef\xcf\x5e\xd7\x1b\xf2\x22\xdf\xd8\x33\xbf\x38\xb3\x9a\x21\x49\x7a\xe1\x8e\x16\xdd\xe8\x8f\x26\x17\x4e\x14\x8c\x37\x93\xbc\x38\x92\x3f\xb6\x77\xfa\x61\xa6\x4b\x68\xdf\x48\xdb\xc8\xc8\xac\xb0\x51\xbc\x3a\x17\xb6\x07\x69\xda\x50\x8a\x42\x67\x49\xd4\x24\x12\x35\x89\xf4\x93\x9c\xcb\x83\x9c\x31\xb7\x25\xea\x0a\x09\x7b\x82\x90\x60\xff\x78\x61\x44\x62\x4e\x40\x89\x16\xc8\x81\x8b\xfc\x9e\xe5\x7b\xf9\xdd\x2b\xa0\x21\x71\x23\x11\x92\x4c\x50\x49\x26\xa8\x69\x05\xdb\xd1\x1a\x0e\xe1\x99\xd7\xd6\x16\x2e\xf2\x01\x45\x85\xfd\x45\x48\xb4\x60\x0f\x9c\xa4\x07\xc4\x0f\x03\xfb\x2a\xdf\x25\xac\xab\x13\x87\xb0\xef\xc0\x5c\xc2\x23\x29\xf6\x25\xae\x43\xd8\x77\x60\x0e\xe1\xdc\xbe\xba\x94\x7d\x9f\xe5\x50\xf6\x8d\xbd\x4b\xb0\x4f\x2e\x45\x73\xd9\x17\x28\x1d\x25\x7d\x28\x4d\x29\xf9\xc3\x4d\xfe\xe4\x5c\x1e\x61\xe7\x5c\x07\xb9\x69\xba\xd6\x73\xdc\xab\x6a\xfc\xaa\x7d\x28\x2c\x4b\x3a\xe1\x9b\xe2\xd3\x28\xc6\x56\xe9\x6c\x47\x93\x8e\x6d\xd2\xb1\xb9\xd2\x24\x1a\x39\x42\xf2\x8e\xcc\x13\x1b\x07\x9d\x19\xfb\x91\xc9\x54\x62\x81\x03\xc5\x12\xfb\xa9\x61\xfe\x17\xcb\x6b\x48\xb2\xb8\xbc\xd8\x8f\x72\x5a\x5c\x43\xa6\x06\x93\xa9\xc1\x64\x6a\x30\x99\x0b\x44\xe6\x69\x20\x6d\xb2\x81\x46\xd9\x90\x79\x80\x11\x32\x67\x9b\xcc\x93\xbe\xcc\xd5\x25\xcb\xec\x92\x65\x76\xc9\xa2\xce\x64\x99\x2d\xb2\x7f\xc6\x7e\xe1\xc4\xfc\x22\xb9\xa1\x7a\x93\x79\xc4\x30\xb0\xfc\x8e\x2d\x9b\x58\x80\x83\x98\x80\xc7\x63\x01\x14\x3b\xd5\x8f\x2c\xcb\x57\x96\xe5\x2b\x4f\x6f\x1f\x7c\xcf\xf2\xd8\x69\xb2\x7f\x71\x74\xe8\x26\x0d\x48\x78\x43\x07\xca\xd2\x81\xca\xd8\x9e\x79\x98\x90\x17\x2a\x56\x78\xd0\x5c\x7e\xde\x6a\x19\xca\x0f\x52\x2e\x5c\xf8\xca\x0f\x6d\x56\xb8\xf0\x95\x5f\xec\xf8\x35\x33\xe7\xcc\x9c\xbd\xad\xe3\x85\x0f\x13\x33\xab\x10\x99\x3a\x08\x5f\xd7\x15\x67\xfe\x8c\x45\xb6\x88\x6a\x5b\xa4\x6f\x15\x7f\x05\x6c\xe2\x26\xb8\x4a\x7a\x2c\x44\x45\x16\xa2\x22\x0b\x51\x91\x09\x46\x2c\xd2\xa3\x3c\x56\xc7\xa4\x51\x4c\x5b\x25\x52\x5a\x66\x14\x97\x49\x4b\x18\x34\xc1\xf2\xe9\x7b\xe6\x8f\x95\xc3\xb4\x7a\xfb\x5f\x59\x5b\x83\x62\x57\xa9\x58\x57\x78\x35\x54\x6f\x07\x0e\xf5\x17\x51\x8c\xf1\x47\xe8\xd8\x95\x3a\x76\xfd\xf9\x63\xcd\x17\xb2\x50\x08\x68\xbc\x6b\xe0\x7f\x35\x66\x95\x84\xc5\x07\x10\xf3\x46\xa5\xa9\xa7\x72\x6b\x5a\xa9\xbe\x55\xaa\x6f\x95\xca\x7c\xa5\xa9\xa7\x8a\xe5\xa0\x52\x1f\xaf\x9c\x7e\x2b\xa7\xdf\x4a\x23\x6f\xa8\xd2\xc5\xe5\x08\xe1\xc5\x91\x9c\xe2\x30\x26\x54\xd1\xc5\xaa\x0c\x81\x2a\x43\xa0\xca\x10\xa8\xd3\xeb\x17\xfc\x65\xc9\x8f\xe5\x73\x4d\xae\xb2\x26\x57\x19\x32\x55\x86\x4c\x95\xdd\x40\x15\x6f\x92\x2a\x0a\x7e\x15\x05\xbf\xfa\xd7\x82\x17\x36\xf9\xdd\xcb\xbb\xfd\x88\xbc\xac\xda\x2f\x03\xa1\x62\xed\x87\x7a\x35\xf6\xf9\xc6\x4d\x4b\xc3\xd8\x69\xbf\xc8\x62\xd0\xc7\x1b\xbb\x6d\x83\x25\xf9\xe5\x49\x18\x4e\x48\x9c\x59\xac\xf7\xa3\x7a\x61\x44\xb9\xe8\xb6\x8d\xea\x09\x0f\x43\x5e\x68\x80\x5d\xbe\x65\x05\xa9\x80\x34\x1a\x99\x07\x2e\xf2\x3b\x2b\x89\xc3\xba\xd0\xc4\x2d\xe8\xc5\x81\xbf\x4b\xdb\x70\x1d\x68\xb2\x0e\xb4\xad\x53\xdc\xf4\xd8\xe2\x36\xd9\xe2\x36\xba\xba\x85\xb6\x1d\x63\x1c\x96\xf4\xdd\xa4\x37\x78\x93\x4c\xa3\x3b\xc3\xc0\xe4\x8f\x8a\x6f\x13\x93\x4c\xf3\x57\x51\xd7\xef\x49\xb0\x97\xf7\x7a\x79\xf2\xfe\x0a\x5b\x62\x13\xb5\xb8\x73\xde\xef\xe8\xbb\x1d\xf3\x7e\xff\xb1\x18\x4c\xf4\x9d\x13\x7d\xe7\x80\xe9\x34\x97\x74\x9a\x4b\xf6\x69\xca\x81\x50\xbc\xfb\x0f\x16\xb0\xfe\x4b\x64\x23\x33\xe7\x24\x15\xa8\xf8\x36\x93\xc9\xc2\xac\x0a\xb3\x2a\x64\xd2\x9f\x9a\xbd\xbf\x32\xab\xe5\x37\x74\x30\x86\x0c\xcf\x68\xde\xbc\xf8\x2b\x6c\x26\x3c\xde\x09\xe7\x68\xc5\x15\x45\xd9\xd2\x76\x2e\x87\x3c\x2f\x8e\xf2\xbd\xb4\x0d\xc7\x5b\x17\x35\xad\xcb\xf8\xea\x32\xbe\x3a\x4f\xc1\x07\xce\x82\x1b\x31\x74\xc8\xbe\xf7\x00\x37\xbf\x42\x61\xc1\x21\x6e\x60\x36\xea\x70\xa9\xf7\xb8\x4b\x7d\x60\x6b\xef\x74\x1a\x0d\x5d\x16\xa9\xfe\x19\x21\x34\x69\x76\xba\xc2\x85\x2e\xe3\xb3\xcb\xa2\xd6\xfd\x7d\xb3\xf5\x3b\x5b\xdf\x44\x9e\xb4\x5a\x76\x7f\x35\x6c\x0d\x9e\x24\x58\x06\xa6\x97\xa7\x6d\x6f\xea\xb4\xa1\x77\xa9\xa3\x93\xb4\x3d\xd8\x6a\x1a\x9d\xad\xdf\x9c\x1a\x32\x86\x57\xe3\x03\x3b\xe6\x0b\x33\x8a\xf5\xca\xbc\x3d\x3f\x3f\x27\xbe\xd0\x08\x59\x03\xef\x5d\x6c\x0f\xce\x19\x5e\x48\x36\x1a\x79\xae\xac\xa0\xdf\x3f\xbe\x90\x3c\xb7\x86\x9c\x1b\x7f\x75\xe1\x1e\x06\xf6\x3b\x6d\xa3\x8f\xb7\xf1\x94\xcf\x1e\x1e\xfe\x9b\xb8\x79\x8f\xcc\xc9\x59\x90\x66\xc3\x09\x97\x3d\xe2\xad\x2a\xae\xdf\x2f\x8e\x52\x5e\x94\xf2\x4c\xca\x8b\x52\x5e\x62\x63\xd3\xa5\xf5\xa1\xaa\x67\x0f\x47\x91\x89\xe3\xb6\x3d\x5c\x15\xed\xa1\xe1\x79\xe0\x80\xf2\x2d\x2a\x66\x7d\x2d\xb2\xbe\xf4\x15\x7d\xe8\x2c\x3a\xb0\x9b\x25\x2c\xf8\xa5\xcd\x82\xdf\xd2\x58\xf0\x9e\x28\x46\x77\x6f\x0b\x70\x61\x19\xc1\x97\x90\xd8\x8f\x7d\x0b\x1c\x4f\x74\x08\x37\x7a\x84\xbf\x2c\x3d\xcc\xaa\x90\xc5\xcc\xc4\x06\xae\x0a\xeb\xe3\x8f\x18\x5e\xc8\x2a\x24\x66\xe5\x0f\x72\x2d\x70\xc4\x04\xac\x7b\x6f\x41\xe4\xd9\xeb\x82\x2f\x24\x1b\x8d\x72\x0d\x5a\xff\xc0\xa2\x70\xd8\x36\xb0\xa4\x37\x96\x86\xf3\xea\x81\x59\x31\x9c\x9d\x58\xe0\x76\x6a\x86\xc9\xc2\xf7\x86\x01\x1e\xe8\x2e\x6a\x81\xfe\xa2\x16\xa4\x4f\x07\xe9\xd3\x81\x9a\xe6\xc0\x9e\x5f\xc3\xbe\xc1\x70\x08\x6c\x72\x08\x6c\x81\x7e\x5c\x03\xfb\x0e\xcd\xb3\x59\xe3\xd9\xac\x19\xf6\x33\x66\xec\x2e\x06\x83\xa3\x19\xce\xfd\x5f\xf8\x20\x67\xf4\x00\x1e\xc6\x9a\xc1\xff\xe4\x85\x64\xd2\x6f\x3b\x6c\xbe\x1d\xef\x61\x43\x56\x5e\xef\x3e\x71\x87\xae\xf0\xd0\x58\x26\x9d\xc5\x64\xc2\x34\xe9\x6c\x26\x13\xee\x8b\xc9\x6a\x10\x91\x41\x6d\x32\xa3\x7f\xa9\xad\x1b\xd6\x3e\x7d\x91\xf2\xfd\xde\x9e\x21\x8c\x16\x36\xc9\x4f\xea\x57\xa4\xfc\x22\xfc\x4a\xab\x05\xef\xae\x71\xa2\x2c\xb9\xef\x71\x57\xc0\xa8\xa6\x98\xf1\x2a\x87\x99\xf4\x3f\xbd\x3e\x60\xb4\xd7\x0f\xcc\xc6\x85\xf9\xca\x8c\x17\x49\x06\x96\xfe\xec\xb7\x49\xb6\x8f\x49\xd3\x81\xf2\xab\x6f\x7c\x1e\xde\x9a\xbf\x96\x3a\x60\xc3\x8f\x09\x5f\x1a\xb2\x0d\x28\x13\x3a\x4c\xa4\x0e\x13\xb1\xf5\xb0\xc8\x4b\x29\x11\x7b\xf7\x97\x85\x84\x5f\x1b\xd9\xaf\xcc\xb9\xb2\xae\x18\x26\x91\x5a\x4a\xe4\x30\x89\xdc\x7d\x5b\x94\x61\x23\x17\x1e\xde\xcf\xbb\x24\x67\x61\x1c\x05\x91\x07\x02\x16\xe9\x89\x38\xb0\x14\x17\x22\xf3\x8f\x92\x1e\xa3\x20\xd2\xff\xc1\xe4\x3c\xdb\xe4\x3c\xdb\xe4\x0a\x83\x45\xb9\x7a\x13\xb7\xf2\x7f\xb1\x88\x3a\x88\xac\xe1\xfa\x3d\xbe\x97\xdf\x9b\xfe\x2e\xfc\x75\x11\x7f\x97\xef\x3b\xbf\x37\x69\x8e\x20\xed\xc1\x51\x18\x79\x35\xcd\xce\x2b\xf8\x0e\x53\x3e\x26\x23\xc3\xfc\x01\xcc\x08\x49\x06\xe8\x2b\x93\x7e\x92\x15\x94\xf6\x44\x3d\x29\xe1\xfc\xc2\x12\xce\x2f\x5e\x28\xbf\x3e\xcc\xaa\x23\x71\x22\x53\x98\xa8\x12\xc7\x3a\x4f\xf9\x8d\xa7\xfc\x2f\x4c\xf8\xd5\x6f\x25\x2d\xb9\x08\x1a\x13\x92\xc9\x42\x26\x33\x99\xf4\x16\xe7\x37\x31\xd9\xa8\x8d\x89\x29\x2b\x7f\xea\x64\x09\xe6\x37\x4b\x70\x68\x7a\x21\x79\x86\x79\xc0\x12\xcd\x03\x03\xb3\xca\xd8\xb5\x24\xee\x5a\x12\xbc\xa5\x46\xe6\x14\x01\x87\x6f\x92\x45\x36\xd1\xef\x6e\x60\x61\x16\x8b\x40\xa2\x37\xcb\xc0\x64\x96\x1a\x54\x12\x0d\x2a\xf1\x0a\xc1\xc0\xc2\x6f\x11\xe1\x64\xe1\x4f\x5a\x3c\x14\xfd\x9e\x4d\xc1\xe1\x2f\xce\x0d\x26\xce\x0d\xa3\x23\xb3\xdd\x61\x3b\xb4\x24\xf7\xf7\xe4\xa0\xdd\x12\x9d\xa4\x4d\x0e\xcd\x2d\xd1\x4d\x7a\x60\xb6\x0f\xfc\xa6\x07\x96\x71\x17\x9a\x0c\x4b\xf6\x71\x2a\x01\x89\xee\x5b\xe3\x09\x71\xf7\x79\xe6\x14\x90\xa1\xd0\xca\x01\xfa\xc0\x94\x85\x61\x5b\x92\xb9\xc2\xf3\xd6\x92\xf1\xda\x92\xf1\x8c\x7c\xbc\x42\xce\x6f\x2b\x72\xf6\x86\x73\xcb\xf0\xf6\xb7\xcc\xe1\x96\x71\xfc\x63\x99\xbb\xa3\xcc\x61\x9e\x69\x4f\xc8\xd4\x60\x33\x97\x66\x1e\xcd\x33\x9a\xe4\xc2\x26\x38\x50\xd8\x91\x7c\x73\x69\xce\xb4\xeb\x99\x9c\xf5\x0f\x5c\x25\xbf\x24\x58\xca\x8f\x64\x1f\x76\x3f\xcb\xbc\xfe\x32\xb0\xf4\x15\x7f\x9e\x69\x99\x76\x79\xcb\x32\xd6\x33\x0f\xc7\x46\xfa\x22\xbf\xb3\xbf\xc0\x57\xc0\xc4\x57\x60\x60\xa9\x6f\x95\xfc\x9a\xc8\x57\xfa\x45\x90\x8e\x11\xb0\xd9\x95\x7b\x60\xe3\x7b\x19\x1c\xd2\xfc\x41\xda\xdf\xa4\x7d\x39\xb6\xb3\xdc\xaa\xcd\x62\x71\xc9\x3e\xf6\xcc\xc2\x94\x07\xce\x9b\xc7\x90\x11\x1c\x9b\x60\x2f\xaf\x02\x5d\x00\xbe\x0d\x26\xbe\x0d\x96\x69\xc3\x1c\x38\x22\x2f\xb0\x56\xa8\x9f\xd3\xf5\xe1\x85\xfc\x16\x1a\x0e\xdd\x17\xac\xd0\x6a\x52\x68\x35\x29\x3f\x6f\x3a\xb5\xed\xcd\x70\x0a\x82\x09\xaa\xe0\x58\xd8\x0a\xcd\x8e\x85\x66\xc7\xf2\x2b\xfc\x16\x13\x41\xe1\x8e\xba\x70\x47\x5d\xb8\xa3\x2e\xdc\x42\x17\x6e\xa1\x0b\x95\x83\xf2\x6b\x2c\x17\xea\x66\xa1\x79\xa6\x70\xab\x50\xb8\xfd\x2e\x7b\x2b\x70\x7e\xee\xe4\x83\x16\xcc\x22\x16\xcc\x22\xd6\x9b\xc2\x73\xbd\x81\xd9\x52\xd4\x15\x8a\xe8\x0a\x85\xb7\x9c\x4d\xae\x1a\x5a\x91\xad\x83\x5c\x54\xb4\xc2\xcb\x7a\xe3\xfb\x22\x58\xaa\x2f\xbd\x01\xe7\x12\x56\x78\x5b\x66\x60\xf9\x1e\xba\x46\x91\x0d\xbb\xf8\x97\x0c\x2c\xe5\x55\xa9\x6f\x0d\x82\x65\x58\x34\x69\x0f\x6c\x22\x0b\xa3\x61\x0c\x4c\xf9\x9a\x34\x37\xad\x5b\xe2\xcf\x32\x30\xeb\x0f\x57\xf1\x81\x59\x3f\x93\xfe\x61\x81\xf5\x31\x7b\xe4\xf7\x22\xdf\x67\x49\x1f\x05\x0b\x3f\x26\xe9\xa5\xfd\xe1\x5f\x63\x45\x2c\xc6\x85\xe7\x32\x03\x53\xde\xb4\x38\x57\xef\xeb\x65\x70\x91\xb1\x0a\x2b\x42\x85\x15\xa1\xfe\x30\x8c\xea\x2f\x48\x5a\x49\xcc\x52\x30\x44\xea\xcf\x98\x18\x3a\x51\xa5\x4e\x54\x11\xd7\xe6\x85\x2c\x37\x31\x2b\xac\xcd\x15\x97\xbd\x5f\x58\xf0\x6d\x61\xce\x50\xaf\x2a\xfc\x12\xac\x22\xa0\x8e\x55\xea\x53\x95\xfa\x54\xc5\xb5\x10\xab\x34\x2f\x57\x4e\x85\x55\xe6\xaf\x8a\x33\x58\xa3\x2b\x8e\xd1\x15\xc7\x2a\x6e\x61\x8d\xbc\x9a\x60\x16\x1d\x1e\x76\x06\x1e\xd7\x54\x5e\x58\x1a\x98\xc5\x05\x7b\x24\xbd\x34\xb4\x49\x2f\xe9\x94\x13\x42\xa9\x0c\xcc\x26\xa1\x32\x21\x9e\x36\x56\x7d\xe4\xba\x95\xde\x6f\xc4\xe4\x9a\xf0\xc0\xac\xaf\xa5\x47\xf2\xa7\xb0\x39\x78\xaa\x8f\x47\xb8\xb0\xe4\x8f\xee\xd8\x30\xb8\x1a\x94\x8d\x2a\x86\x87\x17\xfb\xba\x36\x0e\xa1\xc6\x23\x99\x46\x5d\xa3\x51\xd7\x68\xd4\x35\x1a\x2d\x0f\x8d\x96\x87\x46\x5d\xa3\x51\x9b\x68\x1c\x33\x8d\x63\xa6\xf1\x0c\xa6\xfd\x32\x61\x65\xb9\x30\x1e\x36\x1a\x22\x1a\xf5\x94\x46\x9b\x46\xa3\x6d\x81\x9e\x3e\xd6\x68\x78\x6c\x54\x3d\x1a\x55\x8f\x46\xcb\x43\x63\xa0\x1d\x5e\x76\xb6\xc6\xf1\xd5\x38\xbe\xc4\x89\xc7\x9a\x6c\x5e\xe4\x82\xb3\xc9\x0d\x67\x6b\x62\x98\x68\x62\x98\x68\xa2\x0c\x34\x39\xea\x69\xf4\x9c\xb3\x26\x86\x89\x26\x76\xc3\x26\x31\x7b\xce\x6d\x6d\x87\x29\x48\x1e\x05\x89\xd3\x91\x89\xd3\x91\xc9\x95\xed\x81\xe5\xf7\xaa\x58\xea\x57\x85\xbf\x26\xfc\x49\x43\xc3\x53\xd0\xc4\x89\xc9\x9a\x2c\xfe\x4d\x02\xfe\x34\x99\x2f\xc4\x89\xc9\x9a\x44\x13\x7a\x31\xe5\x6d\xb1\x09\x66\xfd\x4c\xda\x0f\x8e\xd8\xeb\x05\x00\x97\x1a\x4b\x39\xfc\x96\xac\xe3\x70\xb7\xd3\xc2\xd9\x11\x83\xc0\x3a\x3c\xfb\xac\xf3\x84\xae\xff\xa0\x91\x74\x5a\x28\x3a\x2d\x14\x74\x63\x7a\xa1\x14\xd4\x91\x15\xec\x58\x74\x63\x32\xba\x31\x59\xe7\x39\x04\x2f\x95\x1b\xef\x9f\xdb\xbe\x56\x7e\x21\x2b\x58\x99\xb8\x92\xc9\xc6\x82\xb0\x61\xa6\xdb\xd2\x9b\x98\x6c\x34\x16\x84\xb5\xba\xe3\x86\xf4\x0b\xe5\xd7\xc0\x5f\x59\x2e\xee\x6c\x0e\x1c\x04\x27\xb6\x5a\x90\xef\x03\x19\xe7\xdc\xd1\xe5\x8c\xa0\x8b\xa1\x40\x1c\x94\xac\xf3\x4a\xd5\xc0\xd2\x89\x5a\x91\xfc\xa5\xfc\xc6\x26\x42\xd0\xa2\x81\xd9\xb1\xa8\x0b\x74\x06\x91\x19\xe9\xd9\x12\x88\x5c\x74\x9f\xc3\xb8\xe9\xa1\xab\x74\x51\xd4\x3b\x2f\x55\x9c\xf7\x35\x5c\x7e\xf6\xc8\xf7\x52\x7e\x7c\xe4\x7b\xfd\x9d\xed\xc9\x33\x0b\x71\x98\x1a\x98\xc3\x10\x0e\x50\xa6\x0e\x50\x33\xce\xe9\x49\x1f\xe1\x01\x15\xb7\xcb\xd3\xf9\xd1\xd7\x25\xd2\xe5\x29\xee\x28\x92\x37\x71\xc1\xaf\xde\x78\xbc\x9f\x04\xd9\xc1\xb5\x1e\x4c\x00\x91\x81\x25\x23\xfd\x94\x22\xfd\x94\x5e\x58\x91\xd8\x77\xa8\xf8\x60\x4d\x7f\xa1\x01\xe2\x80\x20\x3e\x3c\x20\x88\x0f\x55\xdb\x48\xb7\xa6\xf8\x30\x5c\xda\x43\xc5\x35\x4a\x38\x82\xf1\xbb\xf1\x77\x1f\x45\xe2\x3c\x83\x72\xc5\xcf\xc0\x67\x12\x0e\x32\x4a\x3c\xc8\xf8\xf0\x58\x7d\xfc\x2e\xb5\xc9\x14\x15\x76\xd5\xe3\x7b\x4a\x16\xbb\xf2\x81\xa5\xbc\xca\xfe\x11\x44\xf6\x41\x84\x8f\x13\x81\xf3\x0e\x8c\xfb\xbd\xb1\x7c\x0c\xc6\x88\x38\xd6\x0b\x3f\x92\x5f\x21\xbf\xfe\x98\x7d\xe4\x27\x3d\x16\x41\xe9\x1e\x2a\xfe\x03\xb3\xbe\xb0\x22\x0e\xcc\xf2\x70\x03\x6a\x60\x13\x2c\xe5\xfb\xb5\x2b\x06\xec\x42\x63\xc0\x9a\x79\x1e\xa9\x09\x1b\x3e\x18\x40\xd0\xfb\xcf\x0b\x37\x37\x2b\x16\xe4\x0f\x36\x5f\xe8\xd9\x0c\x58\x15\x23\xdd\xa7\xce\x5b\x3a\x87\x0d\x44\x1a\x0c\x0c\x27\x18\xb0\x0c\xbe\x30\x22\xe7\xc6\xfa\x36\xb2\xe1\xad\x77\x91\x41\x3a\x23\x9d\xab\x5e\x58\xf0\x2d\x23\x17\x8a\xb7\xd4\x9b\x59\x93\xe4\x94\x0f\x9c\xb1\xa2\x38\x63\x45\x09\xb1\x11\x83\x0c\xbc\xc0\x55\x2f\x8a\x33\x56\x14\x67\xac\x17\x57\x93\xfc\x1a\xcb\x97\x36\xe0\x40\x0c\x0c\x2a\x3b\xb0\xe4\xdf\x1e\xf9\x5d\xea\xdf\xe4\xfb\x96\xe4\x77\xe1\xaf\xb1\x8b\x98\x88\x17\xab\xf0\xc0\x94\x0f\xcc\x59\x31\xc8\xc0\x0e\x5c\x95\x07\x66\x7d\x38\x70\x4f\x68\x55\x87\x85\x3f\x91\x3f\x56\xd1\x17\xcb\xd8\x62\x18\x4e\x89\xf3\x3a\xb0\xff\xdd\x70\xbf\x35\xc2\x59\x6d\x24\x4e\x48\xec\xc3\x88\x44\x83\x25\xea\x85\x86\xc4\xa8\xa8\xc1\x17\xf8\x85\x64\x03\xd3\xbd\x61\xb3\x1b\x0d\x56\xf8\x48\x37\xb7\x17\x46\xfc\x5a\x59\x50\x65\x41\xe8\x4e\xf4\x6b\x8b\xf4\x6b\x7b\x13\xb3\x46\x5e\xbb\x7d\x21\x7f\xc5\x0a\x60\x1c\xd6\x46\x2b\x56\x64\xc0\x92\x68\x32\xea\x8d\x86\xa8\x28\x6e\x6a\xd1\x78\x0a\x18\xc5\xed\x6d\x60\x29\xde\x2b\x63\x51\x02\x90\x44\x89\x83\x1b\x8d\xb7\x01\xa2\xc9\x7a\x2f\xb1\x6f\xa3\x04\xbf\x8d\x26\xc3\xd0\x64\xfd\x34\x2a\xdb\xd1\x78\x0a\x18\x4d\xf4\x05\x13\x7d\xc1\x64\xbd\x35\x3a\xe0\x0c\xcc\xf4\x88\x52\x16\x8d\x56\xf2\x28\x61\x72\x07\xae\x92\x9e\xe5\x71\x98\x47\x1f\xec\x33\x1a\x9d\x3c\xa3\x04\xd2\x1d\x38\xc9\x50\xf1\xdc\x46\x7f\x85\x2d\x46\x7f\x85\x2d\x46\x7f\x5e\x17\x23\xc2\x83\xc6\xc8\xd1\x1c\x61\x05\x8b\x11\xde\x39\x31\x72\xbc\x32\x14\x4a\x1c\x16\x38\xb0\x64\x84\x0f\xbe\xcd\x2c\xd7\xef\x73\x63\xc4\x59\x5e\x8c\xb0\xaf\xbd\x90\x5c\x45\x11\x05\x65\x51\x28\x8c\x4a\xae\x2a\xb9\x42\xf0\xe0\x08\x67\x9d\x18\xe1\xac\x13\xe9\x8d\x17\x23\xc7\x67\xc4\x4d\x9f\x18\xe9\x20\x13\xa3\xac\xb2\x91\x7b\xd5\x81\x29\x6b\xec\x6d\xcf\xab\x77\x1e\x27\x36\xa4\x51\x2a\xb0\xb3\x45\x09\x27\x13\x23\xbd\x50\xa3\xf8\xdf\xc5\xc8\xcb\x3a\x51\xe2\xc3\x44\x89\x0f\x33\xd2\xb3\x37\x70\xd5\x17\xff\xbd\x28\xfe\x7b\x31\xd2\x6e\x15\xa3\x4c\x07\x51\x56\xfd\x28\xab\xb0\xf8\xdf\x0d\x4c\xfe\x4c\xe4\xc9\x55\x37\xf2\xd0\x2a\x46\x19\xce\x51\x06\xa8\xc4\xb3\x89\x12\xcf\x66\x60\xca\xc3\x92\xa4\x4f\xec\x1f\x26\x7d\xde\xa2\x8e\xcc\x26\xd8\xb7\x7f\xc2\xf4\x22\x2e\x7a\x03\xfb\xda\x27\x4e\x0a\x89\x93\x42\xf2\x6e\xb2\x31\xfd\xbc\x1c\x13\xa6\x1a\x3a\xf7\x45\x3a\xf7\x8d\x77\x0e\x01\x11\xda\x3a\xe1\x0e\xf8\x79\xf5\xf1\xf0\x00\x71\x32\x48\xcf\xcb\x05\x73\x86\xaa\x90\x60\x53\x8f\x74\xee\x8b\x74\xee\x8b\x74\xee\x8b\x8c\xe8\x13\x13\xb7\x0b\x89\x9a\x43\xe2\x6c\x92\xb8\xbe\x27\x38\x10\xc5\x44\x45\x22\x51\x91\x48\x74\x02\x8a\xf4\xd6\x7b\xa1\xfc\x1a\xf9\xb1\xb7\x6d\x46\x89\x1d\x34\xb0\x14\x96\x8a\x60\x66\xcf\xbd\xba\xc4\x0a\x8a\x89\x86\xb5\x28\xde\x82\x51\x22\x86\xbf\x58\xba\x1f\x4e\xcc\xa3\x04\xfe\x8e\xe2\xcd\x17\x25\xd4\x50\x14\x6f\xbc\x28\xde\x78\x51\xbc\xf1\xa2\x84\x1a\x8a\x12\x6a\x28\x8a\xf7\x5d\x94\x70\xe1\xe7\xc1\xce\x9b\x1f\x8c\xe0\x2f\x8e\xe4\x9f\x83\x37\xf1\x04\x79\x8c\x13\x19\x8e\x98\x9c\x13\xbd\x66\x5e\x9c\x1f\xf9\x9d\xf2\x85\x47\x5d\xce\xde\x48\xbe\x1f\xd6\x2b\x13\xd9\x7d\x66\x6f\x2e\xc2\xfb\x59\x9b\xb9\x06\xbf\x68\xe5\x5a\xfc\x6f\xfe\x0d\xd3\xc5\x91\x1b\x09\x39\xfb\x63\xbb\x9c\x7f\xe4\xc6\x47\x0f\xcf\x30\xc3\xbf\x30\x00\xfa\x55\x33\x4b\x34\xf3\x0c\x6d\xe5\x4d\xcc\x52\x11\xf7\x5b\x62\x98\x6f\xa7\xbb\x03\x7d\x8b\x67\x3a\xfb\x65\xc6\xe5\xc9\x8c\xcb\xf3\x42\xf2\x8c\x2b\xc0\x39\x43\x31\x78\x21\x99\xc6\x01\x73\xce\xb4\xc2\x65\xf1\xfe\xcb\x99\x07\xca\x03\x4b\xe9\x41\xf2\x33\x56\x05\x63\x3b\x4b\x64\x9e\x2c\x91\x79\x72\xa6\xf7\x4c\xce\xbc\xeb\x94\x33\x0f\xb8\xb2\x78\xef\xe5\x4c\x3d\x7b\x60\xb6\x03\x02\x07\xe6\xcc\xed\x71\xd6\x60\xe7\x99\x7a\xf7\xc0\x95\xf9\xd9\x23\xd8\xcb\xaf\xfc\xe4\x57\x7f\x60\x94\xb3\x84\xd9\x97\xb8\x3f\x39\x73\x73\x3d\xb0\xef\xcd\xe5\xc7\xb2\xfc\xb7\x05\xa3\xa0\xfc\xf8\x9d\xd7\x26\x32\x1d\xdf\x32\x1d\xdf\x32\x83\xfc\x64\x06\xf9\xc9\x0c\xf2\x93\x0b\x4c\xd3\x2f\x64\xb9\xde\x15\x33\x17\x1c\x64\xbd\x90\xd5\xf1\xb3\x54\xde\xfe\x77\xf7\xd7\x8a\x5f\xfd\x14\x9b\x0b\x2c\x69\xaf\x64\x1e\x7c\xeb\x8d\xde\x99\x6e\x71\xb9\x60\x17\xfd\x42\x11\x32\xeb\xdb\x58\x5f\x18\xb9\x73\x91\xe1\x55\xb0\xae\xe6\x42\xab\x76\x2e\x54\xd3\x07\x0e\xd2\x6e\x92\x1e\x6f\x0f\x14\x79\x7c\xa0\xc8\xeb\x03\x85\xc7\xbd\x59\x7c\xc3\xb2\xf8\x86\xbd\x58\x44\x03\xab\xf4\x8b\x9b\x09\xd6\xf4\x85\xf9\x37\xfd\xbe\x08\xa6\x74\xe1\xbb\x3a\xb0\xf4\xd4\xce\xae\x6a\x8f\xc8\xa7\x77\xf9\x9d\xf5\x45\x18\xfa\x2c\xbe\x63\x59\x7c\xc7\xb2\xf8\x86\xe5\xc2\x23\xaa\x5c\x78\x44\x95\x0b\x8f\xa8\xc6\xf7\x4d\xf2\x67\x2f\x86\x71\x2c\x8b\xef\x58\x16\x5f\xb1\x2c\xbe\x62\x59\x7c\xc5\x72\xe1\x65\xb7\x5c\xa8\xb6\x67\x89\xe5\x94\x25\x96\xd3\x18\xbe\x3e\xbf\xe5\x6d\x76\x90\xd4\xcd\xeb\xbd\xb9\x62\x36\xaa\xbf\x8a\xdf\xd0\x4d\x2b\xa7\xa3\xca\xe9\x88\xc1\x9b\x32\x83\x37\x65\x06\x6f\xca\x0c\xde\x94\x19\xbc\x29\x57\x4e\x38\x95\x2f\x92\x54\xc4\xf4\xc8\x8c\xf4\xf4\x42\x56\x01\x23\xb1\xe2\x56\x4e\xae\xd0\xf2\x5f\x68\x84\xac\x51\x61\xce\xde\x66\xf0\xca\xb1\xe1\x5b\xa8\x0a\x0c\x0b\xf5\x42\x96\x4b\xdd\x80\xae\x69\x99\xae\x69\x2f\x0c\x48\x4c\x55\x41\x7c\xd1\x06\x66\xad\x70\x04\x97\x2b\x6d\x10\xb9\xca\xe4\x26\xbe\x69\xb9\xf2\x52\x4d\x96\xb7\x29\x72\xa5\x89\x2f\x57\x6e\x13\x72\x95\xc9\x4f\x7c\xd7\xb2\x3c\x6e\x31\xf2\xa7\x64\xb1\x47\xcf\x95\xd7\xb2\xb3\xf8\xa2\x8d\x17\x9e\xa5\xaf\x05\x4a\x97\xaa\x45\xe5\xcd\xe9\xf1\xbd\xe4\x27\xbd\xd3\xa4\x7b\xe2\x8e\x5d\xae\xb4\x9c\x67\x89\x3a\xb5\xde\x5d\xde\xe8\xf1\x8a\x77\x6e\x1e\x15\xb9\x73\x5c\xc4\x98\x5b\x0c\x11\xf2\x0a\xaf\x6d\x17\x83\x67\x5b\x31\xbf\x7d\x2f\xe6\x5d\x62\xde\x2f\x59\x8c\x1f\xd9\xc5\x78\xd3\xa3\xc8\xd5\xe4\x62\x5c\xfd\x8a\x5c\x6d\x2e\x12\x74\xba\x18\x2f\x41\x16\x09\x62\x5d\x8c\x51\x18\x8b\x3c\x72\x56\x8c\xab\x63\x31\xae\x8e\xc5\x78\x13\xa2\xc8\x55\xe5\x22\x57\x95\x8b\xd8\x70\x8b\xf1\xa6\x44\x31\x76\x80\x62\xec\x00\x23\x7d\x11\xec\x66\xdc\xfb\x80\xf3\xb3\x31\xdf\xae\xc9\x74\xde\xdc\x2f\x55\xaf\xf7\x69\xb0\x4f\xf3\xaf\x6a\x2f\x4e\x28\x19\x9c\xae\x56\xd1\x43\x2a\xde\xf9\xa9\xe6\x77\x72\x05\x7b\xbe\xfd\xd8\x73\x3e\x35\x40\x39\x72\x9f\xa3\x8a\xbe\x72\x9e\xd4\xdd\x8f\xcc\x14\xb6\x68\x2d\x74\x57\xab\x85\x12\xaa\xb2\x3e\xd7\x4a\x7d\xa5\x56\x9e\x02\xd4\x2a\xf5\xc4\x0b\x3f\x55\x62\x1d\x56\x59\x6d\x6b\xc3\xe9\xd4\x7e\x9e\xf7\x64\x85\xe7\x28\x6a\xe5\x3d\xf9\xda\xe4\xa5\x9e\xc6\xd9\xb0\x36\xce\x86\xb5\x61\xcc\xd6\x26\xd9\x4b\xb4\xb0\xf6\xf8\xb5\xfc\xbe\x2c\x5c\x37\x46\x66\x1d\x13\x40\xed\x98\x00\xda\xa3\xd0\xc9\xa4\x3d\x7e\x57\xd2\x1e\xb8\x9f\x35\x3e\xb2\xd1\x1e\x1a\x9d\xdb\x03\x2e\x1a\x1f\xce\x68\x7c\x38\xe3\xfd\xd8\x6b\x02\xed\xe1\x01\x52\x7b\x28\x90\x26\x81\x62\x1a\xde\xd7\x6c\x81\x43\xb1\xc9\x83\x93\x4d\x5e\x9c\x6c\xf2\xe4\xe4\x79\x3f\xf8\xc0\xc8\xd4\xe8\x38\x0d\x53\x68\xc3\x14\xda\x0c\xcf\x21\xf1\xd1\xc9\xf3\xaa\x70\xdf\x10\xc5\xf0\x38\xb4\x99\xf0\x6c\xc2\xb3\x3c\x83\xd8\x70\xb0\xd3\x10\xbe\xa0\xf1\x40\xa5\x45\xf4\xf4\xc6\x38\x08\x4d\x82\xb9\xb7\x28\x05\x47\x0a\x2b\xf9\x29\xa2\x49\x30\xf6\x56\x68\x8c\x6a\xf2\x24\x57\x93\x21\xdf\x24\x7c\x6a\x2b\x52\x78\x41\x20\xcd\x56\xe8\xe4\x35\xb0\x7f\xcd\x09\x83\xb8\x55\x3f\x6b\x36\xc6\x2f\x6c\x8d\x8d\xd3\xa4\x60\x89\x3f\xd8\x1a\x8f\x68\x9a\x3e\x30\x44\xef\xc4\xfd\xe4\xf3\xfd\x15\x4d\x2f\x43\x14\xe1\xf6\x1a\xdc\x56\xcf\x4b\xd0\xf7\x57\xf2\x25\x6e\x8e\x2f\xc6\xd0\x90\x77\x75\x9a\x3c\xac\xd3\x3a\x2d\x38\xad\xd3\x82\xd3\x3a\x8f\x76\x9a\xc4\x75\xeb\x8f\xe7\xb6\x73\x4e\xe8\xf4\x7d\xeb\x9c\x8a\x3a\xfc\x7a\xfa\x43\x81\xf6\x00\x09\x76\x71\x4c\xe1\xa3\xd3\xf1\xd5\x9c\xdd\x5a\xdd\x8d\x2b\x43\xa7\xca\xd4\x39\x12\x3b\x55\xa6\x8e\xf1\xde\xcd\x6f\x95\xba\xc9\x33\x45\x49\xd8\x48\x2c\x28\xb1\x20\x3e\x7a\xd8\xb3\xfe\x0a\x36\x32\xd8\xc8\x54\x9f\x7b\xe6\x11\x54\xcf\x74\xdf\xec\xdd\x5f\x96\xe8\xcd\x8f\x84\x8e\x55\xbe\x33\x48\x63\xa7\xef\x73\x67\x80\xc7\xde\x61\x0b\xed\xec\xd7\x63\x77\xe3\x2a\x24\xeb\x56\xef\x8c\x8a\xfb\x3c\x8c\xda\xf8\xe8\xab\x4e\x0f\x03\x35\x3e\x0f\xe3\x4c\xbf\x38\x08\x76\xcc\xbd\x98\x2f\x0d\x3f\xc9\xb3\x37\xb6\x4b\x57\x0e\xe3\x84\xf5\x0a\xe9\xfd\x9a\x31\x33\x9f\x87\x0a\x65\x78\xd8\x9c\xe3\x05\x06\x96\x26\x0f\x2b\xb1\x73\xbc\x18\x4f\x80\x3c\x85\x0c\x64\xf6\xb5\x41\x40\x4c\xd1\x87\x17\xb2\xc2\x7d\xca\xa9\x1f\xc2\xe3\x19\x68\xac\x70\x15\x06\xc3\xa3\x0f\x14\x89\x9f\x60\xd8\xcf\x56\x9c\x04\x09\x55\x0e\x94\x68\xa0\x44\x83\x44\x67\x1b\x04\x04\x41\x1c\x04\x1f\x35\xf2\x13\x8f\x3f\x48\x2c\x82\x10\x92\x44\x13\xbe\x6f\x5b\xb4\x43\x40\xb7\x0b\x4d\x13\x34\x48\x29\x14\x56\x22\xb3\x12\xfa\x02\x40\x68\xbc\x04\x15\x4e\xb0\xd5\xc3\x62\x93\x58\x8e\x83\xe0\x96\xb1\x10\x78\xc3\x20\xec\xe8\xad\x2e\x03\x04\xcb\x3c\x81\x55\x1d\x2e\xcc\x2f\xe2\x61\xab\x2e\xcd\xd6\xd9\xf3\x02\x5f\xb2\x1b\xdf\x17\xe6\xef\x0d\x85\xe1\x46\x0b\xbd\x04\xbe\x3f\xd6\xe5\xa5\xaf\x0e\xc3\x6c\x38\x51\x93\x2e\xe6\x9b\x3d\x51\x5e\xa0\x8a\x18\xb9\x2b\x48\xda\x6e\x13\xc3\x6e\x3a\x58\x63\xe2\xe4\xb7\x38\xe1\x04\x2f\xda\xed\x6d\x54\x70\xc2\x09\xf0\x64\x97\x57\x3c\xd6\x44\xcd\x21\x9c\x5b\x33\x9e\xc0\xe7\x98\x9a\xbe\xc7\xa4\xd1\x8a\xf5\xa6\x52\xb8\x57\xa3\xe2\x25\x20\xf2\x79\x7c\x58\xcb\x4e\x91\xc8\x65\x98\x70\xef\xd2\xac\x22\x97\xef\xde\x79\xc2\x87\x63\x35\xea\xab\x70\x91\x4b\xfb\x8b\xf9\x7e\x91\x0e\xcc\xa8\x03\x33\xea\xc0\x8c\x78\x94\x3a\xc4\x48\x0e\x4c\x39\x30\xe1\x40\xdf\xee\x88\x32\x1f\x47\xce\xc7\x51\x47\x6e\xcc\xfa\x0a\x8e\x79\x9d\x7a\x78\xac\xb9\xe4\x89\xbd\x8c\x27\xa2\x23\x33\xcc\xd6\x51\x5f\x95\x48\xfa\x6a\x56\xd2\x67\xb3\x92\xd1\x46\xf5\x12\x38\x21\x27\xd9\x15\xbc\x04\x08\x25\x51\xb5\x19\x1f\x08\x13\x78\x50\x22\xe9\x53\x5c\x09\x86\x87\xf1\x52\xaf\x87\x9c\x1b\x53\x96\x36\x4a\x59\xd8\x91\x47\x4c\x92\x84\x18\x4f\xfd\xc7\x27\x65\x3a\xc3\xec\x27\x99\xb8\x52\x47\x93\xa6\xa6\xe5\x37\xce\xf5\x49\xa2\x94\xef\x03\xef\xf5\x7d\x66\x93\xa6\xae\xd9\x89\xc6\x92\xed\x83\xd9\xe8\x59\x5f\x62\xcb\xb2\xa1\x09\x19\xc6\x98\x90\xb1\x9d\x0a\x59\x27\xa7\x2c\x93\x53\x8e\xf2\x2a\x0c\x37\x73\x2f\x46\x7d\xb1\x2f\x0c\xcb\xd0\x78\x12\x63\x83\xf4\x32\xce\x18\xec\x85\xea\x43\xe6\x14\x93\x55\xf8\xb9\x09\xaf\x4d\x78\xe5\x23\xbd\xa3\x3c\x08\xab\x3c\xfa\xb8\xc7\xa3\xaf\x7b\x3c\x32\x4f\x16\x31\x2c\xbc\x04\x1b\x41\x70\xce\xb3\x45\x8b\x72\x5f\x70\x5a\x84\xfb\x0e\x54\x79\xe8\x55\xbd\x53\xd8\x93\x34\x17\xff\xae\xd2\x2e\xaa\x1b\x73\x1a\x83\xe2\x9f\x15\x90\x4a\x52\x4a\xb4\xf3\x66\xd3\xfe\xac\x9c\xd7\x97\x8a\xea\x4a\x2f\x01\xf3\x77\x79\xe4\x55\x9a\x41\x28\x5f\x51\x8c\x67\x9e\xaa\xd4\xe2\xbe\xf3\x54\xf4\x15\xcb\xa2\xcf\x58\x16\x71\xf2\x7f\x09\x7c\xa3\x85\x06\x94\x99\x03\x33\xf0\x9d\xb2\x20\xea\xee\x48\xed\x8f\x2d\x27\x21\x28\x01\x3d\xa7\x28\x47\x1c\x55\x85\xa3\xaa\x50\x2d\x2d\xfa\xb2\x66\x91\xd7\x4b\x0a\x4f\xc0\x46\xc0\x20\x5f\x9d\x22\xa3\xbe\xa8\x22\x5d\xaa\x08\xa8\x52\x40\x55\x76\x3e\x55\x76\x3e\xf5\xa1\xfa\x53\xb9\xd6\x17\x0e\xc4\xa2\x03\xb1\xc8\x40\x2c\xed\x53\x3c\x3f\xa8\xb2\xb8\x57\x79\x05\xb7\xea\xcb\xa5\x55\x2c\x6a\x2f\x81\x39\x48\x97\xa8\xd2\x25\x2a\xbb\x44\x65\x97\xa8\xfa\xd6\x69\xd5\xc7\x4e\x07\xc1\x8f\xfb\x97\x80\x00\xec\x15\x4e\x90\xa1\xb2\x0f\xd4\x20\xe3\xab\xc2\x97\xf1\x85\xd0\x25\x6a\x92\xfa\x24\xa9\x4f\x62\x7d\xd2\x0f\x2f\xb2\xc0\xb6\x3f\x3e\xa6\xfc\x93\x44\xdf\xaf\xba\xd5\xab\x45\xde\xb8\x52\x23\xff\x4b\xc0\xda\xd4\xc2\xd8\xe1\xba\x37\xe7\x16\xc5\xbd\xe2\xb7\x28\xf7\xf1\xb8\x16\x78\xef\xe0\x25\xf0\xd5\x0f\x9a\x65\xc6\x38\x75\xcd\xbe\x0c\xd7\xbb\x67\x56\x6e\x38\x2b\xd7\x9b\xaa\xe3\x48\x0f\x0c\x4e\x1d\xe2\xbf\x68\xa4\xdc\x17\xe6\x36\xa5\x69\xad\xdc\x2b\x79\x9b\xd2\x35\x1f\xf7\x3a\xe0\xa6\xdc\x79\x7a\x51\xc6\x64\x9e\x1d\x09\x0f\xaa\x48\xb4\xea\x41\xe0\xc3\x25\x62\xa9\x1a\x73\x9d\x13\x4a\xe3\x16\xba\xc9\x0e\xbb\xc9\x21\x47\x68\x38\xe5\x18\x0e\x1b\x4e\xa6\x8d\xbb\xd5\xa6\x3d\xad\xc9\x66\xb8\x35\xa6\x97\x73\x8f\xb0\xad\x91\xf1\x40\x23\xc4\xb6\x4f\xcc\x9e\x41\xec\x9e\x41\x0c\x9f\x81\x96\xcf\x70\x8c\x9d\xae\x74\xbe\x34\xd7\xe4\x16\xf7\x60\x08\xdb\x8e\x63\x82\xec\x07\x3f\xcc\x91\x9b\x80\xf6\x79\x68\xe1\xe1\xc1\xee\x24\x78\x26\x8e\xa9\xd2\x95\xc9\xad\x4e\x57\xcb\x53\x7f\xf4\x79\x0d\xb9\xc6\x38\x09\x7e\xe4\xf5\xe0\xbd\x3b\x5e\x18\x09\x0b\x21\x34\xb7\x1e\xb8\xa2\xf5\xc0\x15\xad\x73\xfa\xed\x32\x5b\xbf\xc9\xf9\x7a\x45\x90\x57\xda\x46\x0a\xc9\x51\x1e\x04\x09\xd2\x95\xba\x04\x4a\x0f\x5d\x9f\x91\xed\x51\xc5\x16\xd1\xd7\xbb\x6e\x09\xc5\xae\x1b\xc4\xb0\x3b\xee\xc4\xfa\x9f\xb9\xb7\xe9\x99\x63\xa1\x17\x11\x1a\xfd\xf2\xec\x91\xcb\x85\x83\x80\x98\x00\x8f\xa8\x4e\x83\x80\xa8\x00\xdb\xc8\x58\x17\xe4\x7c\xd9\x39\x5f\x76\xce\x97\xbd\x68\xf5\xe5\xad\xbc\x5e\x18\xc1\xfd\x31\x89\x9f\xf1\x98\x04\xfc\x79\x4c\x1f\x68\x90\x0d\xa6\x6d\x23\x69\xbd\x15\xf2\xad\x3a\x85\x92\x54\x4a\x78\x93\x01\x8d\x60\x0f\x1a\xe1\xfd\x03\x34\x7d\xc1\xa1\xf2\xeb\xc2\xe4\xb2\x01\x7d\x09\xbe\x4d\xdf\xed\x34\x2b\xdc\xe4\x31\x93\x26\xf1\x2a\x1f\x39\xb9\x7d\x09\x5e\xc8\xf3\x8b\xf2\xab\xe7\xbd\xd7\x4d\xb9\x4f\xcf\x6e\xca\xd5\xf0\x37\xe5\x6a\xf8\x9b\xd2\xf4\xa3\xa4\xf9\x9e\x9d\xcc\x20\xe4\xb1\xe0\x28\xa9\x17\x64\x93\xc3\xe0\xa6\x82\xf2\xec\x85\x6d\x12\xd0\x91\x9b\xbe\xf3\xf1\x12\xaa\xa6\x28\x7e\x2d\xdc\xa4\xfb\x12\xee\xa9\xd2\xa3\x95\xac\x0d\x0c\xd7\x30\x16\xe7\x07\x94\x44\x49\x0c\x05\x12\xf9\xcc\xc8\x36\xae\xa8\xaa\x55\x28\x5a\x05\x04\x05\x7f\x34\x1a\xcf\x16\xa7\xd4\x29\x8f\x15\x1c\x45\x0f\xa1\x37\xe5\xb8\x24\xe5\xaf\xb8\xb6\xea\x3a\xb2\xba\x8e\xac\xce\x91\xd5\x74\x64\x35\x6a\x43\x93\x60\xd2\x79\xeb\xb7\x02\x35\x68\xa3\xbc\xda\xf6\x47\xbc\x2d\x69\x85\xda\x65\x3f\xc0\x32\x62\xe7\x55\xff\xe7\x60\xcc\x34\x81\x66\x26\x0b\x1a\x06\xf6\xbe\x21\x7b\x53\xf8\xda\x07\xce\x0a\x81\xb3\x42\x90\x89\xdf\x02\x0d\x31\xa6\x6f\x28\x5a\x10\xb5\xc9\x42\x65\x79\x9c\x47\x82\x58\xef\x2c\x64\x7d\xd5\x23\xeb\xb3\x1e\x1a\xa9\x76\x10\xf0\x76\x01\xac\xd4\x66\x22\xd2\x46\x58\x95\x83\x2a\xcd\xaf\xef\x34\x9a\xc5\x1f\xdf\xb6\x88\x0c\x13\x68\x91\x71\x02\xc7\x3d\x8d\x11\x1f\xf7\x34\xf3\x26\x95\xdb\xb9\x0f\xe9\xd8\x47\x0e\xe9\xed\x67\x15\xa4\xe7\x74\xbc\x41\x48\x5a\x9a\x53\x16\xed\xda\x5f\xcd\x11\xfc\x08\x55\x1b\xb2\xa9\x0d\xd9\xd4\x86\x6c\xb4\x21\x5b\x84\x6e\x6d\xdb\xf4\xef\x04\x82\x6e\x24\xa6\x7f\xb3\x28\x4f\xa0\x44\xae\x1e\x23\x70\xfe\xff\x49\x34\xef\x8d\xc5\x25\x95\x8f\x90\x6b\xff\xa4\x6a\xcf\x47\xee\x2d\x7d\xe4\xde\xed\x4c\xdd\xbc\x79\x63\x34\x42\xdb\x36\x51\xbf\xa5\x8c\xaa\xbf\x20\x0c\xb0\x25\x33\x7f\xf2\x57\x7d\x12\xae\x01\x25\x5c\x11\x4a\xfb\xfa\xd0\x90\x58\x9a\x17\x4a\xce\xb0\x4a\x3f\x6c\x93\x07\x3c\x8b\x75\xfa\x55\x64\x5b\x5c\x5b\xa4\x7d\x13\x63\x23\x0c\xbf\x89\x6f\xdf\x9f\xf8\x76\xfd\x85\x8f\xaa\xb6\xf0\xe9\x6b\x0b\x1f\x0b\xd2\xbc\x90\xe6\x14\xb3\xe4\x4f\x09\xfb\x21\xb8\x7a\xe2\x24\x33\x9d\x13\xf3\x59\x5a\x83\x94\x6b\xf1\xa2\xac\xd9\x0b\x8f\x1b\xfd\x89\xef\x36\x60\xe2\xbb\x7b\x5c\xf8\xb9\xf5\xaa\xc9\x45\xef\x98\xf0\x0c\xe8\xf2\xb3\xe0\x63\x8d\x4d\x7c\x4d\x84\x13\x9f\x29\xac\xdc\x19\xa7\x2c\xe8\x6c\xf9\x2b\x8a\xeb\x6e\x91\xb5\x31\xdf\x95\x2c\xf7\x4c\xed\xf9\x5b\x2a\xd8\xef\xf9\xf7\x66\x9b\xd7\xff\xb3\xdd\x76\xb2\x25\x53\x7b\x7c\x23\x9f\x83\xd5\xdd\x26\x10\x95\x3b\xc8\x6d\x97\xd0\x9c\x70\x26\xc1\x49\x67\x10\xee\x35\xca\x25\x10\xc5\xc7\xce\x36\xdc\xbc\xd7\x85\x89\x7e\xb1\xf1\xf7\x7b\x2f\x79\xe1\x33\x41\x4d\x7c\x3b\x4d\x3d\xce\x93\x75\x22\x77\xce\x58\xf7\xe0\x9b\xf2\x3a\x13\xd1\x6a\x98\x2d\x98\xdb\x8e\xae\x9b\x4c\xc2\x9d\x97\x26\xbe\x17\x68\x16\x4e\x97\xef\xb3\xad\xb6\x0d\x4f\x57\xaf\x3f\xbf\xc3\xaf\xa7\xef\xce\x0f\xf7\x08\x5d\xc8\x2d\x50\xf5\xe7\x8d\x8c\xb3\xea\xb7\x75\x96\xe8\xca\x2d\x65\xe0\x63\x40\x3b\xa2\x3e\xaa\xc9\xa1\x6c\xfb\xca\x21\x6c\x25\xf9\x7e\xb3\x74\xe4\xb6\x56\xd0\xbe\x66\xd2\x8d\xb7\x66\xdb\xce\x0a\x3b\x32\x6c\x5c\x61\xdb\xed\xe0\x69\x42\xdf\xc1\x1b\x3b\x78\x63\x07\x6f\xae\x83\x57\x74\xea\x7a\xa6\x82\xd9\x23\xba\xf9\x36\xef\x10\x60\x43\xab\xd6\xeb\x13\xd6\x36\xbc\x6b\xcd\x80\x77\xa9\x99\xd0\xd0\xc2\x27\x3a\xca\x54\xcd\x76\x39\xdd\x0d\xc0\xc6\x8e\xd6\xa6\x47\xfb\x6e\xc4\x29\x2f\xc7\xd2\x24\x5c\x9e\x26\xbe\x4c\xad\x0f\x4e\x2b\x4f\x7c\xa6\xe1\x09\xcf\x2c\x3c\xe1\xe1\x79\x7d\xbc\x7b\xc4\x6e\xac\xd3\x21\x0e\x61\xab\xda\x87\xf0\xac\x05\xf0\x12\xe6\x22\x19\x9e\x9f\x1d\x93\xc8\x00\xd1\x83\xb3\x55\x0f\xc3\x25\x6d\xd5\x3a\x3c\xbf\xe8\x81\x17\xc0\x80\xa7\xfa\x33\xc7\x5d\xf9\xf0\xfc\xf2\xb5\xc9\x0f\x74\x2c\xf2\x03\x8d\x6b\x79\x63\x3d\x3f\x30\x01\xae\x7e\x7d\x7e\x9d\xbd\x7a\xc2\x3d\x47\x4d\x54\x6f\x35\xf2\xba\x44\x36\x64\xb4\xf1\x52\xe6\x07\xf4\xec\xe6\x7b\x8d\x65\x22\xd7\x43\x2a\x98\xaf\x60\xbe\x9e\x55\x21\x0c\xff\xdf\x2b\x1d\xbf\x0c\x4f\x58\x5c\xd2\xab\x33\x8c\x4c\x20\xc8\x0a\xce\x9c\x5b\xd0\x44\xc7\x4a\x18\xa6\x0f\xe0\xfd\x30\x48\x99\xa1\x38\xcf\xea\x85\x8f\x67\xf5\xc6\xcf\xfd\xdd\x1e\x5f\x01\xa7\x54\xbf\xa8\xe2\xb7\xc2\x92\x9c\x4f\x49\x78\xf6\xb3\x46\xbb\x9b\x44\xe9\x27\x91\x92\x47\xa1\x09\x85\x66\xbb\xf7\x87\x26\x0c\xe7\x5c\x62\xe1\x68\x3f\x8b\xcf\xe9\x2d\x9b\x72\xfb\x4f\x1c\x51\xb4\x42\xb6\xdd\xf1\x17\xc5\xd2\xf3\xa1\x34\x50\xde\x92\x89\xed\x12\xfc\x59\xf4\xc4\xae\x21\xdc\x49\x74\x78\xbc\xb6\x16\x9e\x73\x5d\x65\x55\x81\xf5\x85\x90\xcf\x9d\xbb\x25\xd6\xda\x7d\x4f\xa8\x7e\x53\xbc\xb0\xe7\xa8\x3d\x3e\x67\x67\xe9\x0e\x33\xd6\xe1\x2d\xa7\x81\xdb\xd0\x32\x33\x3a\x76\xf0\xd5\xc9\x1a\x5a\x37\xd4\xe2\x6b\x77\xce\xb9\x57\x8f\xdb\x4b\xc1\xec\x70\x6e\xc2\x0f\xcf\x59\x4d\xe3\xe6\x90\x9d\xb7\x3b\x97\xef\xed\x4d\xe7\xb9\xc2\x10\x3a\xb6\xfb\xb7\x12\x61\xbc\xbf\x18\xb7\x61\xe4\xe0\x65\x16\x39\x78\x19\x45\x0e\x9e\x26\x91\x05\xb7\xc9\x76\xc2\xd3\xbb\xc3\xcf\xad\x8c\x21\xfc\xdc\xc2\x38\x5d\xf4\x6e\xe5\xfd\x02\x12\x9e\xbb\x68\xee\xc1\xf6\x40\x70\xbd\xa0\x3e\x9d\x8d\xd2\x31\xf3\xd2\x7a\x7b\xf8\xef\x85\xf5\x59\xab\xfb\xc5\x73\xb3\x7a\xf0\xd8\x0c\xf9\x04\xfb\xac\xdd\x11\x1e\x8a\x2c\x04\x91\x69\x08\x69\x67\x9a\xde\x19\x70\xd8\x76\x80\xd3\xfe\x60\xe0\x65\x5d\xdb\xf0\xb2\x98\xd6\xfd\x89\xb2\xd1\x56\xca\x26\x3a\xd3\x54\x38\xdb\xaf\xf9\x9b\xdb\x97\xbe\xc8\xc9\x29\xfc\xec\x2b\xa6\x3b\xf5\x0c\x78\xfc\xae\x16\xdc\x1a\xea\xaa\x5f\xb9\xa5\xbe\xd0\xb1\x34\x6b\xff\x4e\x22\x10\xb9\xad\x13\xc1\x4b\xb0\x46\x81\x99\x15\x0a\x28\x2b\x36\x08\x2c\xa4\x04\x89\x8d\x36\x6a\x0e\x07\x23\x5e\x27\x73\x07\x97\x07\x22\xde\x3b\xe0\x89\xeb\xed\xe5\xe9\x5e\x67\x99\xe8\x68\x2b\x13\xde\xf5\x7c\xe6\xe4\x04\x99\x1e\xca\x39\x05\xdf\x0a\xc9\xfc\x80\x49\xc9\x0f\x98\xe4\xc6\xc4\x78\x26\xd7\x56\x3f\xee\x9e\x70\x7b\xc9\x22\x9c\x7e\x52\xcd\x85\x0b\x5a\xf8\xe8\x7e\x13\xbb\x59\x72\xe2\x3b\xb9\x85\xa3\xb5\x23\xf7\xf0\x9c\x26\xd9\x94\xf0\x7c\x28\xe9\x43\x69\x4a\x31\xfb\x50\x9a\x54\x2d\xd8\xa7\xac\xf8\xc9\xa7\x9c\x5e\xb6\x29\x29\x7d\x28\x9f\xb2\xea\x27\x9f\xf6\x49\xd3\x3e\xa5\xf7\x4f\x4d\xfb\xa7\xa6\x5d\x73\xb6\x47\x73\xb6\x8f\x0c\xed\x23\x43\xfb\xc8\xd0\x3e\x32\xb4\x8f\x0c\xed\x23\xb1\x77\xd0\xad\x21\x73\x28\x9f\xb2\x9c\x54\x31\x65\xd7\xe4\x7b\x67\xc5\xfc\x51\xfd\x09\xca\xce\xcb\xcd\x09\x03\xbb\x49\x61\x97\x95\xb4\x95\x2d\x25\xe5\xf0\xb6\xd7\x70\xae\x3f\x61\x76\x42\xb8\xab\xe6\xcc\xf5\x18\x50\x6c\x25\xc7\x8c\x17\x62\xf9\x85\x5f\x8f\x7b\xd0\x2f\x7c\x64\x34\xf1\x3e\xe7\x70\x84\x67\x73\xb4\x09\x4d\x52\x04\xfd\xe4\x36\x4e\xc8\xe6\xde\x32\xdb\x84\x73\xaa\x79\x52\x14\x49\x71\x2c\x0a\x87\x90\xe4\x93\x73\x6b\xee\x10\xcc\xa7\x48\x77\xf3\x3e\x70\xbe\x21\xa8\x36\x4e\xbe\x88\x7c\x03\x60\xec\xdf\x3b\xd3\x77\xf9\xfe\x3a\x63\x1f\xc2\x27\x05\x8a\x28\xee\xb2\xf7\x6c\xa9\xb5\xf7\x0d\xe1\x2a\x2d\x0b\xd6\x82\x96\x2c\xae\x9d\xed\xfa\x1d\xd7\xbf\x63\xa5\xdf\x7d\xd1\xa0\x3b\x99\xb7\x9b\x05\xf3\xb6\x87\x60\xfe\x1e\xea\x8b\x9c\x62\x64\xe7\x66\xec\xcc\xb3\x78\x9b\x5a\xd8\xb6\xa8\xf7\xff\xe4\xfe\x8f\x77\x5b\x1c\x8c\x55\xb2\xab\xf0\x4d\xbe\x8e\xcf\xeb\x64\xec\x78\xf6\xae\x1a\x46\xf3\xac\x1d\xbf\xeb\x97\xb7\x86\xf0\xde\x13\xda\x74\x0a\x9d\xbd\xae\x9d\xc5\x22\xce\xdf\xdd\xa8\x68\xbf\xee\x3c\xc9\x27\xbc\xf1\x45\x16\xbe\xfb\xaf\x76\x2f\xb8\x38\x7c\xb4\xcb\x86\xfb\x2c\x03\xba\x05\xad\x8d\xdb\x23\x36\xc7\x87\x79\x42\x54\x42\x6e\x9b\xf5\x45\x28\x45\x52\xd4\xc7\x13\xea\xcf\xc2\x9a\xca\x2e\x01\x79\x0c\x8f\x79\x64\xf2\x52\x5c\x2e\xd4\xde\x1b\xb5\xf7\x46\xed\xbd\x51\x7b\x6f\xd4\xde\x1b\xb5\xf7\xc6\x79\xa8\xdd\x1b\x31\x4b\x46\x5e\x21\x6f\x54\xc8\x27\x1b\x6e\x33\xe3\xac\x2b\x33\x33\x28\xc7\x6d\x44\xdd\xba\xfe\xb3\x9b\x70\x6e\xf2\x1f\xc2\x27\xc5\x99\x70\x36\x21\x4b\x8a\xbb\xdf\x6d\xe3\xa6\xb7\xef\x04\x96\xdc\x88\xeb\xd8\xb7\x75\x3f\xae\xd7\x15\x92\x5b\x59\x73\xce\xcf\xa1\x63\xeb\xd5\xb1\xdc\x74\x2c\x37\x1d\xcb\x4d\x1f\x4f\xfb\xef\xa6\xea\xe7\x02\xe0\x60\xb6\xff\x9c\xf3\xcd\xba\x11\xe2\x0a\x4c\xbe\x08\x3f\x53\x74\x6f\x56\x0b\xfd\x5c\x14\x2c\x3b\x4f\x37\xfe\x07\x3e\x47\x81\x13\x9e\xd0\x9b\xa1\x4f\x27\xe4\xb1\xb2\x78\xbc\x0e\x43\x0f\x0e\x7b\xe9\xdd\x84\xb3\xf2\x4e\x42\x78\x7e\x4c\x31\x6c\x00\x4a\x48\x42\x58\xce\x65\x9b\x10\xb4\x98\x50\x7e\x1d\xdf\x8c\xe7\x91\x85\x50\x99\x62\xf8\x31\x03\x07\x4d\x10\x34\xc5\xd6\x46\x2e\xa1\x08\x61\xeb\x22\x97\xf0\x68\x0a\xcd\x34\x69\xa6\x49\x33\xcd\xca\x58\xd6\x3c\xaa\xf9\x66\x18\x4f\x95\x7e\x2a\xf3\xa9\x4d\xf8\x54\x27\x7c\xea\x13\x3e\x15\x0a\x9f\x0a\x84\xad\xde\x38\xca\x27\xe7\xac\x72\x18\x27\xa5\xa4\xd4\x4f\xe9\xf5\x53\x7a\x7b\x94\xd2\x59\x56\x97\xfe\xf4\x12\x92\x10\x24\xdb\xbe\xdd\x2a\x2e\xa1\x6b\x8a\xae\x99\x86\xf4\xa1\x44\x0e\x8e\x47\x9a\xf6\x25\x14\x21\x90\xd7\xa8\x7d\xff\x25\x34\x21\x14\xcd\x94\x8d\xff\x12\x34\xd3\xb3\x0d\xbf\x94\xa0\xb9\x9c\xcd\xfe\xa5\x7c\xd8\x0f\x1f\xfe\xc3\xa7\x02\xe1\xc3\xdf\x58\xf7\x48\xf9\x72\x58\xb5\x9a\x67\xbb\x72\x29\x5d\xbf\x3a\x1b\x0f\x47\x59\xfb\xe3\x4b\x31\x2d\xdd\xe2\x27\x9f\xa8\x52\x97\x69\x6b\x3e\xcb\xf0\xa1\x7c\x72\xfe\x48\xc3\xa4\x3d\x6d\xdd\x9e\xb8\x38\x68\x02\x4b\x92\x42\xd8\xb5\x5f\xd4\x4f\x84\x59\xd3\x7e\x66\xbf\xa6\x84\xb3\xcb\xbd\x94\xaa\xb9\x04\x8e\x82\x19\xfb\xfb\x43\xd1\x7c\xf6\xc5\x0e\x9f\x8f\xe6\x6c\xa1\x48\x9a\xa3\xfa\x5c\x8a\x69\x59\xda\x28\xf1\x67\x82\xc3\xa3\x04\x96\x13\x55\xdc\xc3\x2c\xc8\x14\xdb\x2e\x78\x0b\xd1\x14\x59\x33\x2d\x49\x09\xca\x18\x67\x9c\x11\x2f\x5b\x53\x68\xdd\x9a\x16\xdb\xb4\x94\xa6\xa5\x74\xad\x5c\x78\x3e\x12\xfa\x8a\xe8\x23\x92\xf0\x91\x73\xc8\x9f\x34\x5c\x93\x47\x9a\xcf\x57\x85\xda\x41\xfc\xf4\xa8\x78\xbc\x34\x3c\x45\x73\xd6\x1e\x35\xfc\xac\x94\xf2\x69\x25\x1d\xf8\xf1\x33\xcc\x3f\xda\x89\xdf\xab\x5f\x8a\x0c\xea\xf4\x93\x79\x3e\x69\x73\xa7\x8f\xbc\xd2\x47\x3a\xe9\x23\x8b\xf4\x19\x5d\xe9\xda\x51\x2e\x25\x28\x37\x16\xb4\x2c\xad\x79\xfa\x4c\x55\xc3\xa3\x48\xcb\x92\x7a\xe6\x1f\x25\x91\x65\xb4\xcd\xab\x61\x48\x20\xd2\xcc\xda\x65\xf3\xb5\x20\xbb\x4c\x58\xe9\xf1\x92\x26\x28\x4d\x27\xbc\xa6\xcb\x66\xd3\x65\xb3\xe9\x90\x6c\xa2\x43\xc6\xf6\x6b\x9a\x87\xac\xa3\x4d\xd6\xfc\x97\xa0\xa5\xc8\xc4\xd6\x3e\x2b\x6d\xfb\x0c\xaf\xf1\x12\xe3\x27\xcd\x27\x9f\x58\x94\xf2\xa9\xf3\x76\x2c\x73\x94\x4f\x9d\x74\x52\x6f\x9f\x6e\xd7\x3e\xc3\xab\x7d\x3a\x59\xfb\x0c\x94\xae\xcb\x54\xff\x49\xad\xba\xa8\xcf\xb1\x8b\x72\x1c\xfb\x2f\xb1\x02\x5d\x3b\x50\xbf\x86\x2a\x47\x29\x9a\x46\xfa\x54\xff\xc8\x7c\xbc\x20\xa7\x69\xe2\x27\x4d\xfa\xa4\xc9\xca\xb1\xb6\x42\xff\x0c\xec\xfe\xd1\x80\xfa\xf1\x0a\xf4\x94\x4f\x2d\xea\x27\x9f\xfe\x29\xab\x7f\x78\xee\x9f\x76\xa1\x7c\x86\xe9\xde\x4b\x39\xe9\x0e\x2c\x3d\xb2\x06\xbe\x84\x24\x84\xa6\x99\x72\x23\xf8\x12\x4c\x08\x32\x14\x06\xc5\x24\x17\x59\x8d\xe6\x49\x82\x7e\x45\xc5\x74\x7c\xa5\x85\x87\x0f\xc3\x21\x3d\x4a\xc9\x9f\xaf\x38\x6b\xa4\x8f\xf2\x9a\x3e\x6a\xe8\xa0\x28\x87\x32\x80\xd2\x47\xe9\x4c\xcf\x3d\x1a\x72\x14\xad\xbb\xcc\xc9\x29\x0c\x5f\x87\x0e\xc2\x27\x05\x33\x09\xb2\x25\x4d\x41\x5b\x3b\xc8\x20\x4b\x41\xe6\xca\x34\x7c\xca\x59\xec\x87\xb1\xac\x99\x56\x25\xc8\x6e\x28\x19\x97\x8f\x64\xbf\x22\xd8\x94\x20\x9c\xdb\x60\x83\x59\x3c\x52\x06\x17\x9c\x64\xda\x4f\xed\xd3\x09\x3f\xaa\xf0\x4b\x09\x9f\xaf\x3e\xcc\x59\xd2\xb2\xb5\x89\xa3\x56\x20\xca\x42\xf6\x12\x9a\x10\xb8\xcb\x48\xf7\x38\xec\x10\x94\xdd\x8f\x4e\x97\xe2\xa7\xf7\xc7\x4f\xcf\x8e\x3a\x29\xa5\x71\x7b\x52\xd3\x54\xe5\x4f\x7b\xf6\x70\x9d\x95\x7c\xec\x53\x4d\xd1\x3f\x5e\x8a\x8c\x3d\xb5\xcf\xbc\x84\x26\x04\x99\x84\xd4\xb8\x92\xb2\xee\x46\x52\xbb\x27\x48\x93\xd2\x8b\x74\xb3\x5e\x24\x97\x97\x90\x94\xd0\x84\x90\x3e\x99\xca\x37\x95\xfb\xbc\x5e\xa9\x4c\xf5\x2a\x3b\x82\x5e\x7f\x45\xbf\x10\xe1\xbf\x14\x4e\x46\xbd\xea\x6a\xde\xab\xce\xff\x2f\x85\xdd\xa3\x7f\x34\xac\x41\xd1\x9c\xa5\x59\x07\x45\xeb\x28\x7b\xe2\x41\xf9\x94\x9e\x3f\xa5\xb3\xe9\x7b\xe3\x4c\xd0\x9b\x6c\xd2\x5e\x42\x13\x02\x57\x89\xae\x2a\xe2\x4b\xd0\x4f\x84\x0f\xd5\x19\x5f\x82\x7e\xc2\x31\xd3\x9b\xf4\xc0\x97\xb1\x4f\xb9\xe1\x53\xb0\xd8\x51\xfa\x47\x97\x1b\x94\x4f\x3e\xf5\x93\xa6\x6a\x9a\x11\x90\xa0\x83\xc2\x15\xa3\x77\xad\x43\xd7\x06\x19\xe7\x8f\x45\x29\xec\x0e\x83\xf2\x49\xa3\x86\xdc\xa0\x35\x1d\xa4\xfa\x4d\x55\xdb\x87\xd4\x85\x64\xb2\xb9\x1d\x94\xa2\x94\xfe\x28\x45\xa6\xc2\x49\x4a\x5f\xd2\xa7\xb8\xa0\x35\xfe\xcc\xf9\x83\xa4\x75\x36\xed\x01\x93\xf4\xcd\x3e\x7d\xf3\xd2\x76\x30\xed\x05\x93\xf4\xc9\x4b\xac\x5e\x83\xf4\xad\xb6\x7d\xeb\x68\xdf\x3a\xda\xb7\x8e\xb2\x8c\x4d\xd2\x37\x7b\xea\x8c\x83\xa4\xdc\x8b\x7d\xe6\x25\x14\x25\x68\xf7\x88\x3a\xa9\x4c\xb7\x7e\x21\x68\x75\xd3\x4f\x1b\x39\xfd\xb4\xfe\xe9\xd3\xa9\xd3\xb7\xbb\xd4\x5f\xb7\x0f\xa5\x28\x25\x68\x0b\xd4\xff\xcb\xe9\xd3\xf1\xea\xb7\xe3\xb5\xe9\xbe\xa0\x24\x96\x18\x3e\x26\xb4\x97\x24\x13\xfd\x20\xc5\x6f\x2a\x91\xe6\x20\x7d\x3f\x94\xbe\x18\xbe\xad\x19\xca\xa7\x5f\x87\xcf\xba\x37\x49\xdf\x54\xca\x44\xf9\x32\x51\xbe\x4c\x94\x2f\x13\x55\xba\x54\xa8\xd2\xa5\x42\xd5\xce\xf1\x52\x3e\xb9\xdc\x76\xf0\xde\xec\xa1\xc3\x9b\x3d\x74\x1e\x70\xf7\xe1\xef\x71\x9c\x18\x26\xbc\x7e\x52\xfd\x5e\x1b\xca\x7f\x66\xcf\x9c\xd9\xce\x71\xec\x22\xec\x33\xbe\x01\xaf\xf7\xab\x5d\xff\xc8\x34\x50\xb9\xee\x83\xf6\xf8\xf3\xc7\x7e\x4f\x2a\xe3\xca\x26\x11\x1a\x98\xc8\x86\x32\x13\x13\xdb\x3d\x79\xb5\x07\x17\x4d\xec\xc1\x45\x13\x5b\x2f\x47\x5f\x7e\x43\xf6\x01\xfb\x36\xe1\x5c\x3b\x5b\x84\xeb\x80\xb5\x09\x90\x48\x6e\xe0\x2e\x17\xf2\xde\xdc\xfb\x6e\x83\xd0\xbf\x1c\x6c\xbf\xb5\x43\xd8\xe7\xa6\xf6\xcc\x67\x27\xee\x07\x66\xcd\xe7\x6f\x2e\xa2\x9d\xd1\x47\x73\x7c\xeb\x42\xb1\xd8\x73\xef\xc4\x6d\x08\x69\x18\xc2\xac\xd9\xa3\x9d\xc1\xdf\x30\xb3\x07\xf7\xcf\x6c\x3e\xfa\xdc\x1c\xdb\xe9\xde\x07\x58\x70\xbb\xdb\x2c\x18\xae\x50\xec\x84\x26\x73\x38\x13\x1f\xaf\xb8\x8d\x25\x3b\x93\xfc\xb2\x09\x7e\x98\xfe\x5c\x50\x5c\xf8\xbc\xfb\xb5\xcb\xaf\xa6\x0c\x4a\xf5\xee\x7d\xa0\x4d\x38\x17\x82\x0e\xa1\x4a\x1e\x5d\x6b\x79\xc2\xde\x9f\x62\xcb\xed\x7d\x93\x90\xa4\xa2\x8f\x70\x7e\x9f\x24\x3b\x99\x36\x95\x8d\x0a\xeb\x41\x5d\xa2\xe6\xe1\x1f\x51\xdc\x29\x98\x47\xfc\xbf\x3c\x8a\xa6\x40\x1b\x46\xf7\xb0\xf9\x21\x68\x8a\xa8\x79\x44\x93\x52\xd8\x11\xa2\x8b\x59\x77\x32\xed\x3e\xd3\xea\xa2\x26\x2f\xc2\xe3\xfb\x78\x61\x2f\x4e\xe8\xf2\xc9\x07\xb5\x3e\x2c\x69\xfe\xc7\xef\x7b\x13\x8e\xab\xce\x26\x24\x37\xa7\x58\x66\x19\x7e\x4e\x7a\xa7\x5b\x9f\x5f\x18\x6e\x40\xa1\xf6\x79\xbc\x14\x48\x5a\x67\x08\x8e\x34\xbc\x63\xf9\xe1\x76\x8a\x76\xa4\x79\x55\xf7\x10\xb6\x17\xca\x21\x9c\x36\x58\x84\x73\x5d\x72\x13\x6e\x47\x0f\x37\xb4\x6b\x5d\xf0\x41\x75\x5c\xa0\x59\x73\xce\x7f\xb3\xb8\x7e\xdd\x77\x2c\x60\xc1\xb8\x6e\xd2\xab\xce\xd7\xf3\x0c\x42\x99\xb7\x6d\x41\x9a\xfb\x07\x4f\x5a\x51\xcf\x36\xe9\x5c\x1c\x3c\x84\xd3\xa5\x16\xe1\x76\xfe\x49\xb8\x2f\xfe\x1e\xc2\x48\xd1\x41\x68\xbe\x81\x66\xf8\x0c\xa5\xa4\xf4\xa1\x3c\xbe\x0d\xed\x06\x65\xf2\xf9\x14\xdf\xa8\x36\x43\x1c\x49\x3e\xe5\x4b\x49\x9a\x73\x2e\x9a\xf3\x32\x8b\x59\x40\xe4\x54\x73\x4e\x9b\xae\xce\xc7\xff\xe9\x64\xd0\xd8\x2f\xee\x13\x4e\x87\x10\xd9\xb7\xcc\x05\x51\x72\xa4\x75\xad\xc9\xcb\xf2\xd1\x2a\xd7\x2b\x16\x6b\xe8\x71\x86\x3e\x15\xa2\x8f\x25\xb3\x09\x77\xc9\x0e\x37\xbc\xe8\xfa\x3e\x1a\x7a\x6c\x4c\x10\x84\x73\x47\xb4\x70\xd5\xa9\x25\x17\xe7\x2f\x38\xe0\x43\x56\xd8\xf9\xeb\xab\x5b\xee\x5d\xe0\x25\xac\x4d\xa0\x23\x24\x4f\x38\x97\xc2\x6c\x86\x53\x89\xe3\xe4\x22\x1c\x3c\x82\x0d\x14\x4f\xb1\x7b\xb4\xef\x28\xf9\x01\x25\xb8\x0e\xbc\x53\x24\x49\x11\xf4\x93\xa0\x29\xec\x43\xb8\xfd\x79\x7d\xd2\x24\xc5\xb2\x62\xfb\x14\xa6\xc5\xb4\xa2\x94\xfe\x61\xa5\x7f\xb8\xed\x5a\xd4\xde\x68\x7a\x8a\xe6\x6c\x9f\x4a\xda\xb7\x96\xc6\x7c\xee\x61\xc7\xa5\xec\x5d\x83\xa3\x24\xfd\x4a\x24\x1c\x9f\xb3\xf9\xf6\x94\xa6\x14\xe6\xe3\xdc\x58\x1c\x85\xad\x1b\xef\xd9\x80\xa7\x7c\x72\x2e\x9f\x9c\xcb\x27\xe7\xf6\xa1\xf4\x4f\x59\xbd\x9c\x79\x78\x53\x3e\xa5\x77\x2d\x5d\x5a\xe7\xa5\x04\xcd\x59\xda\x62\x50\x3e\xf9\xd8\x27\x1f\x53\x9e\xb7\x01\xc0\x53\x3e\x39\x7f\x24\x3f\x5a\xd0\x48\xf9\xe4\x4c\xc9\xe7\x24\x83\x24\x27\x19\x02\xf9\xec\xe3\x2d\x5c\x87\xd6\x35\x9f\xf4\xee\xa7\xd5\xe3\x8e\x3d\x79\xf0\x41\x1d\xc6\xdd\xa0\xeb\x0c\x3b\xa0\x9b\x7c\x8c\x33\xb8\xf9\xb8\x05\x23\xed\xbd\xf0\xb7\xe0\x08\x2b\xbd\x1c\xd8\x3c\x69\xb9\x40\x80\xc4\x54\x31\xfc\x6c\xfa\xef\x6f\xca\xba\xcb\xdd\x41\x99\xf7\x78\x1c\x61\x19\x90\x1d\x65\xc5\x4d\x72\x94\x34\xbd\xa1\x1c\xa5\x3d\x9a\x66\x5f\x29\x72\xa4\xbd\xa0\x6c\xca\xd1\x68\x36\xe1\xe8\x9d\x2e\x9b\x75\xb3\xc8\x93\x0a\x98\x7e\xd5\x80\x75\x9a\xe1\x28\xeb\x76\x8f\xa3\x3c\x3e\x9f\xfb\x54\xd9\x21\x98\x12\x02\xb9\xb1\xe7\xde\x8e\xf6\xa4\x75\x55\x6a\x93\x86\x93\xbd\x93\x46\x45\x6f\xf0\x2b\x93\xdd\x38\xa8\xb3\x14\xef\xbe\xfc\x42\xe7\x0f\x3c\xa0\xd3\x05\xe2\xef\xbe\xd3\x6b\xf1\x57\x4e\xb0\x39\x8b\xfe\xf6\xa0\x8d\x57\xff\xef\x3d\x90\x85\xaf\xea\x38\xf1\xd5\x1c\x07\x3e\x7d\x30\xfe\xcc\x29\xe2\xf1\x5c\x8b\x9b\x1c\x98\x01\x75\x17\x82\x74\xe1\xbb\xbe\x0f\xec\xf3\x6d\xc8\x17\xea\xfa\xc4\x27\xec\xb4\xad\x69\x5b\xf1\xe3\xea\xe4\x83\x0c\x2e\x1c\x0a\x7f\x3f\xd1\x49\x57\x0c\x99\xad\xc5\x8e\xe9\xc2\xd5\x63\x04\xf3\x3f\x31\x78\x2e\x61\x9d\xa3\x38\xc2\x6a\xe4\x43\x58\xd1\x77\x0e\x61\x29\xf4\xf7\x01\x83\xd1\xbb\x26\x6c\x84\xe9\xf2\xf6\xc2\xe8\x1a\x73\xe6\xbd\x47\xc1\xe5\x6f\x69\x9f\xeb\xbd\x84\x67\x9f\xe9\x5e\x42\x13\x9c\x3c\xff\xe1\xf9\xa1\x82\xe1\x84\x71\xb8\x84\x3d\x84\x0e\x61\xdd\x98\x77\x59\x34\xcd\x93\x79\x14\xd6\xd2\x37\xc1\xc0\x81\xf5\x0c\x6e\x80\x5d\xca\x1e\x5f\x97\xb2\x02\x10\x78\xca\x73\x18\x19\xf3\x6d\x3b\x6c\xac\xe9\xf7\xa4\xef\xbe\xdf\xb9\xa7\x26\x6e\xbd\xae\x6d\x66\x35\x86\xdd\xc1\x31\x5f\x86\xb8\x1d\x6b\xe0\x47\x6a\xd5\xa5\x56\xed\x53\xab\xb6\xa3\x6c\x5c\x82\xc8\xb6\x9d\xed\xc5\x21\xb0\x3d\x9b\xb4\x67\x73\xed\xf9\x6a\x8d\xcb\xb8\x7d\x60\x22\x6c\x84\x41\x3e\x4e\x90\xe7\xdd\xb5\x9c\xdf\xdb\x61\x6e\xe2\xc2\xef\xab\xe0\x26\xdc\x34\x61\xa7\x4b\xf9\xdb\xc9\xe3\x12\x82\x9d\xea\x4f\x42\x92\x2c\xb7\x63\x80\xab\x53\xd3\x4f\xa4\xd4\x90\x54\x0c\x59\xf9\x28\x5a\x4a\xd1\x3c\x8a\xe6\x51\x35\x0f\x15\x46\x50\x69\x04\x15\x47\xe8\xfa\xc9\x3a\x8f\x75\x9f\x34\x12\xec\x11\x3e\xcc\x1e\x25\x24\x25\xe8\x27\xd1\x94\x20\x7c\x18\xa5\x7e\xbc\x49\x2f\x7e\xbc\xcc\xe3\x0e\xc1\x77\xf0\x73\xba\xfd\xca\x4f\xda\x68\xfb\x49\x9e\x0f\x7a\x62\x81\xbd\x11\xef\x33\x13\x47\x00\xcf\x7e\x5d\xb4\x7b\xff\xfb\x26\x77\x43\x73\x11\x50\x85\xf6\x03\x0c\x0f\xf3\x0b\x32\xd0\x82\x8c\xb4\x20\x43\x2d\xe8\x58\x1b\xb7\xdd\x84\x60\x9a\xa5\x69\x9e\x51\xf3\x30\xb4\xc3\x9b\x42\x33\xe5\x90\x0e\xb6\xbd\xb3\x1c\x1f\x8f\x16\xd3\x0b\xea\x6e\xfb\x5e\x8e\xfb\x86\xe3\x72\xe4\xc2\x7c\xa3\x08\x28\x8a\x80\xa2\x08\x28\xaa\x80\xa2\x0a\x28\xaa\x80\xa2\x0a\x68\x3a\x57\x83\x10\x35\xd3\xa4\x9f\x14\x25\x54\x2d\xb6\x69\xb1\xed\x53\x15\x2d\xb6\x6b\xb1\x5d\x33\x1d\x6b\x97\x50\x34\xdb\xf0\x7c\x64\xf4\x15\xd2\x47\x4a\xe1\x23\xa6\xf0\x91\x53\xf8\x08\x2a\x7c\x24\x15\xe2\x27\xe7\xf4\xc9\xf9\x23\x4e\x99\x5c\x43\xd4\xd9\x75\x50\x3e\x39\x97\x4f\xce\x9f\x76\x91\x29\x37\x44\x9d\x73\x07\xe5\x93\xf3\xa7\xf5\xc2\xa7\xf9\xc2\xa7\xfd\xc2\xa7\x01\xed\xd3\x5e\xf6\x69\x2f\xfb\xb4\x97\x7d\xda\xcb\xa4\xbd\xb2\x8c\x93\x24\x13\x4d\x96\x71\x93\x65\xdc\x64\x1d\x15\x59\x47\x45\xd6\x51\x91\x75\x54\x64\x9d\x36\xb2\x0e\x93\xfc\x4b\x9a\x47\xd6\x3c\xb2\xe6\x51\x95\xd0\x94\xd3\xf6\xa9\x9b\x96\xd2\xb1\xba\x87\xfc\xe9\xb9\xf9\xd3\x73\xf3\xa7\xe7\xe6\x4f\x3f\xcd\x9f\x5e\x99\x3f\xbd\x32\x7f\x7a\x65\xfe\xf4\xca\xfc\xe9\x4d\xf9\xd3\x9b\xf2\xa7\x37\x65\x5d\xe9\x43\xfe\xf4\xaf\xfc\xe9\x5f\xf9\xd3\xbf\xf2\xa7\x7f\x65\x5d\xee\x07\xe5\xf3\xd5\x47\x62\xb2\xe2\x0f\x0d\x9e\x38\x09\x6e\x82\x85\x91\xa2\xb3\x77\xd1\x7e\x5a\xb4\x9f\x16\xf4\xd3\x00\xfd\xdd\xc2\x54\x6d\x1f\x07\xc3\xe3\x53\x9b\xd7\x9c\x27\xee\xe6\x09\xd7\x14\x34\x71\x61\xf6\xc3\xc5\xce\x1c\xc4\xaf\x4d\x4a\x1b\xfa\x80\xe3\x66\xec\xbb\x3c\x86\x7e\x10\xf6\x72\x8f\x04\xcd\x17\x30\x15\x08\xfc\xee\x1b\x69\x26\x48\x9e\x43\x59\xdb\xc3\x5e\xfd\x41\xd8\xa6\xc0\x43\x70\x9b\xbb\xcd\x96\x32\xee\x77\xb8\x8b\xe2\xb6\xb8\xc3\x92\xfe\x7b\xbc\x64\x43\x84\x52\x11\xd6\xba\xeb\x53\x24\x8a\x73\x84\xfd\xc4\x17\xd7\x46\x75\x08\xe7\xa4\x28\xe2\xea\xbf\x45\x5c\xfd\xb7\x88\xab\xff\xc6\x57\x66\x2c\x22\x4e\x82\x45\xc4\x49\xb0\x78\x43\x25\xad\xc4\x9d\xa6\x8e\xe5\x61\xb1\xed\x0f\xde\xc3\xc2\x85\x4c\x5f\x16\x17\x31\xc0\x58\x14\x8b\xcb\xa8\x9a\x37\xb9\x58\x84\x6d\xc4\xc5\x25\xb6\x48\xab\xd0\x8a\x71\x7b\x0f\x7d\x76\x98\xe1\xe6\xf2\xf7\xae\x19\x13\xba\xb3\xcf\xc8\xb3\xcd\x38\x22\xd8\xba\x03\xdd\x45\xf0\xbb\x5c\xcb\xfe\x69\xf4\x4d\x80\x71\x27\x67\xb1\xd0\x0c\xc2\x31\xd1\xec\xc8\x0f\xf1\xfe\xb8\xe3\x63\xfe\x17\x00\x00\xff\xff\xe2\x90\x21\x36\x5b\x90\x03\x00")
-
-func notChinaCidrTxtBytes() ([]byte, error) {
-	return bindataRead(
-		_notChinaCidrTxt,
-		"not-china-cidr.txt",
-	)
-}
-
-func notChinaCidrTxt() (*asset, error) {
-	bytes, err := notChinaCidrTxtBytes()
-	if err != nil {
-		return nil, err
-	}
-
-	info := bindataFileInfo{name: "not-china-cidr.txt", size: 233563, mode: os.FileMode(493), modTime: time.Unix(1584685955, 0)}
-	a := &asset{bytes: bytes, info: info}
-	return a, nil
-}
-
-// Asset loads and returns the asset for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func Asset(name string) ([]byte, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
-		}
-		return a.bytes, nil
-	}
-	return nil, fmt.Errorf("Asset %s not found", name)
-}
-
-// MustAsset is like Asset but panics when Asset would return an error.
-// It simplifies safe initialization of global variables.
-func MustAsset(name string) []byte {
-	a, err := Asset(name)
-	if err != nil {
-		panic("asset: Asset(" + name + "): " + err.Error())
-	}
-
-	return a
-}
-
-// AssetInfo loads and returns the asset info for the given name.
-// It returns an error if the asset could not be found or
-// could not be loaded.
-func AssetInfo(name string) (os.FileInfo, error) {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	if f, ok := _bindata[cannonicalName]; ok {
-		a, err := f()
-		if err != nil {
-			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
-		}
-		return a.info, nil
-	}
-	return nil, fmt.Errorf("AssetInfo %s not found", name)
-}
-
-// AssetNames returns the names of the assets.
-func AssetNames() []string {
-	names := make([]string, 0, len(_bindata))
-	for name := range _bindata {
-		names = append(names, name)
-	}
-	return names
-}
-
-// _bindata is a table, holding each asset generator, mapped to its name.
-var _bindata = map[string]func() (*asset, error){
-	"china-cidr.txt":     chinaCidrTxt,
-	"not-china-cidr.txt": notChinaCidrTxt,
-}
-
-// AssetDir returns the file names below a certain
-// directory embedded in the file by go-bindata.
-// For example if you run go-bindata on data/... and data contains the
-// following hierarchy:
-//     data/
-//       foo.txt
-//       img/
-//         a.png
-//         b.png
-// then AssetDir("data") would return []string{"foo.txt", "img"}
-// AssetDir("data/img") would return []string{"a.png", "b.png"}
-// AssetDir("foo.txt") and AssetDir("notexist") would return an error
-// AssetDir("") will return []string{"data"}.
-func AssetDir(name string) ([]string, error) {
-	node := _bintree
-	if len(name) != 0 {
-		cannonicalName := strings.Replace(name, "\\", "/", -1)
-		pathList := strings.Split(cannonicalName, "/")
-		for _, p := range pathList {
-			node = node.Children[p]
-			if node == nil {
-				return nil, fmt.Errorf("Asset %s not found", name)
-			}
-		}
-	}
-	if node.Func != nil {
-		return nil, fmt.Errorf("Asset %s not found", name)
-	}
-	rv := make([]string, 0, len(node.Children))
-	for childName := range node.Children {
-		rv = append(rv, childName)
-	}
-	return rv, nil
-}
-
-type bintree struct {
-	Func     func() (*asset, error)
-	Children map[string]*bintree
-}
-
-var _bintree = &bintree{nil, map[string]*bintree{
-	"china-cidr.txt":     &bintree{chinaCidrTxt, map[string]*bintree{}},
-	"not-china-cidr.txt": &bintree{notChinaCidrTxt, map[string]*bintree{}},
-}}
-
-// RestoreAsset restores an asset under the given directory
-func RestoreAsset(dir, name string) error {
-	data, err := Asset(name)
-	if err != nil {
-		return err
-	}
-	info, err := AssetInfo(name)
-	if err != nil {
-		return err
-	}
-	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
-	if err != nil {
-		return err
-	}
-	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
-	if err != nil {
-		return err
-	}
-	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
-	if err != nil {
-		return err
-	}
-	return nil
-}
-
-// RestoreAssets restores an asset under the given directory recursively
-func RestoreAssets(dir, name string) error {
-	children, err := AssetDir(name)
-	// File
-	if err != nil {
-		return RestoreAsset(dir, name)
-	}
-	// Dir
-	for _, child := range children {
-		err = RestoreAssets(dir, filepath.Join(name, child))
-		if err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func _filePath(dir, name string) string {
-	cannonicalName := strings.Replace(name, "\\", "/", -1)
-	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
-}
@@ -0,0 +1,140 @@
+package iplib
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"sort"
+	"strings"
+
+	"github.com/lixiangzhong/tcpguarder"
+)
+
+// CIDRError 列表中有问题的一行
+type CIDRError struct {
+	Line int
+	Text string
+	Err  string
+}
+
+func (e CIDRError) Error() string {
+	return fmt.Sprintf("line %v: %q %v", e.Line, e.Text, e.Err)
+}
+
+// ParseCIDRList 解析每行一个 IP/CIDR 的列表, # 或 ; 之后为注释,
+// 兼容纯文本列表, FireHOL .netset 和 Spamhaus DROP (1.10.16.0/20 ; SBL256894);
+// 无法解析的行不返回, 主机位不为0的网段按掩码修正后返回, 两者都记录在 errs
+func ParseCIDRList(r io.Reader) (nets []*net.IPNet, errs []CIDRError, err error) {
+	scanner := bufio.NewScanner(r)
+	n := 0
+	for scanner.Scan() {
+		n++
+		line := scanner.Text()
+		if i := strings.IndexAny(line, "#;"); i >= 0 {
+			line = line[:i]
+		}
+		for _, v := range strings.Fields(line) {
+			ipnet := tcpguarder.ParseIPNet(v)
+			if ipnet == nil {
+				errs = append(errs, CIDRError{Line: n, Text: v, Err: "invalid"})
+				continue
+			}
+			if ip, _, _ := net.ParseCIDR(v); ip != nil && !ip.Equal(ipnet.IP) {
+				errs = append(errs, CIDRError{Line: n, Text: v, Err: "host bits set, use " + ipnet.String()})
+			}
+			nets = append(nets, ipnet)
+		}
+	}
+	return nets, errs, scanner.Err()
+}
+
+// SplitFamily 按 IPv4/IPv6 拆分
+func SplitFamily(nets []*net.IPNet) (v4, v6 []*net.IPNet) {
+	for _, v := range nets {
+		if v.IP.To4() != nil {
+			v4 = append(v4, v)
+		} else {
+			v6 = append(v6, v)
+		}
+	}
+	return
+}
+
+// Aggregate 合并重叠和相邻的网段, 返回等价的最少 CIDR, IPv4 在前, 各自按地址排序
+func Aggregate(nets []*net.IPNet) []*net.IPNet {
+	var v4, v6 []ipRange
+	for _, v := range nets {
+		r := toRange(v)
+		if r.size == net.IPv4len {
+			v4 = append(v4, r)
+		} else {
+			v6 = append(v6, r)
+		}
+	}
+	result := make([]*net.IPNet, 0, len(nets))
+	for _, ranges := range [][]ipRange{v4, v6} {
+		for _, r := range mergeRanges(ranges) {
+			result = append(result, tcpguarder.RangeToCIDRs(r.ip(r.lo), r.ip(r.hi))...)
+		}
+	}
+	return result
+}
+
+type ipRange struct {
+	lo, hi *big.Int
+	size   int
+}
+
+func toRange(ipnet *net.IPNet) ipRange {
+	ip := ipnet.IP
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+	} else {
+		ip = ip.To16()
+	}
+	ones, bits := ipnet.Mask.Size()
+	lo := new(big.Int).SetBytes(ip.Mask(ipnet.Mask))
+	hostmask := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), uint(bits-ones)), big.NewInt(1))
+	return ipRange{lo: lo, hi: new(big.Int).Or(lo, hostmask), size: len(ip)}
+}
+
+func (r ipRange) ip(i *big.Int) net.IP {
+	b := i.Bytes()
+	ip := make(net.IP, r.size)
+	copy(ip[r.size-len(b):], b)
+	return ip
+}
+
+func mergeRanges(ranges []ipRange) []ipRange {
+	if len(ranges) == 0 {
+		return nil
+	}
+	sort.Slice(ranges, func(i, j int) bool {
+		return ranges[i].lo.Cmp(ranges[j].lo) < 0
+	})
+	merged := []ipRange{ranges[0]}
+	one := big.NewInt(1)
+	for _, r := range ranges[1:] {
+		last := &merged[len(merged)-1]
+		//重叠或相邻
+		if r.lo.Cmp(new(big.Int).Add(last.hi, one)) <= 0 {
+			if r.hi.Cmp(last.hi) > 0 {
+				last.hi = r.hi
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// Minimise 解析列表并返回合并后的 CIDR, 忽略无法解析的行
+func Minimise(b []byte) ([]*net.IPNet, error) {
+	nets, _, err := ParseCIDRList(strings.NewReader(string(b)))
+	if err != nil {
+		return nil, err
+	}
+	return Aggregate(nets), nil
+}
@@ -0,0 +1,96 @@
+package iplib
+
+import (
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/lixiangzhong/tcpguarder"
+)
+
+func parseNets(t *testing.T, ss ...string) []*net.IPNet {
+	var nets []*net.IPNet
+	for _, s := range ss {
+		ipnet := tcpguarder.ParseIPNet(s)
+		if ipnet == nil {
+			t.Fatalf("bad cidr %q", s)
+		}
+		nets = append(nets, ipnet)
+	}
+	return nets
+}
+
+func netsToStrings(nets []*net.IPNet) []string {
+	ss := make([]string, len(nets))
+	for i, v := range nets {
+		ss[i] = v.String()
+	}
+	return ss
+}
+
+func TestAggregate(t *testing.T) {
+	tests := []struct {
+		in   []string
+		want []string
+	}{
+		{nil, []string{}},
+		{[]string{"10.0.0.0/24", "10.0.1.0/24"}, []string{"10.0.0.0/23"}},
+		{[]string{"10.0.1.0/24", "10.0.2.0/24"}, []string{"10.0.1.0/24", "10.0.2.0/24"}},
+		{[]string{"10.0.0.0/8", "10.1.0.0/16", "10.0.0.1"}, []string{"10.0.0.0/8"}},
+		{[]string{"10.0.0.0/25", "10.0.0.64/26", "10.0.0.128/25"}, []string{"10.0.0.0/24"}},
+		{[]string{"10.0.0.1", "10.0.0.2", "10.0.0.3"}, []string{"10.0.0.1/32", "10.0.0.2/31"}},
+		{[]string{"255.255.255.255", "255.255.255.254"}, []string{"255.255.255.254/31"}},
+		{[]string{"0.0.0.0/1", "128.0.0.0/1"}, []string{"0.0.0.0/0"}},
+		{[]string{"::ffff:10.0.0.0/120", "10.0.1.0/24"}, []string{"10.0.0.0/23"}},
+		//IPv4 在前, 各自按地址排序
+		{[]string{"2001:db8:1::/48", "192.0.2.0/24", "2001:db8::/48", "10.0.0.0/8"},
+			[]string{"10.0.0.0/8", "192.0.2.0/24", "2001:db8::/47"}},
+		{[]string{"2001:db8::/33", "2001:db8:8000::/33"}, []string{"2001:db8::/32"}},
+	}
+	for _, tt := range tests {
+		got := netsToStrings(Aggregate(parseNets(t, tt.in...)))
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Aggregate(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestParseCIDRList(t *testing.T) {
+	nets, errs, err := ParseCIDRList(strings.NewReader(`# FireHOL netset
+1.10.16.0/20 ; SBL256894
+10.0.0.1 10.0.0.2
+10.0.0.5/30
+bad 2001:db8::/129
+2001:db8::/32
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"1.10.16.0/20", "10.0.0.1/32", "10.0.0.2/32", "10.0.0.4/30", "2001:db8::/32"}
+	if got := netsToStrings(nets); !reflect.DeepEqual(got, want) {
+		t.Errorf("nets = %v, want %v", got, want)
+	}
+	wantErrs := []CIDRError{
+		{Line: 4, Text: "10.0.0.5/30", Err: "host bits set, use 10.0.0.4/30"},
+		{Line: 5, Text: "bad", Err: "invalid"},
+		{Line: 5, Text: "2001:db8::/129", Err: "invalid"},
+	}
+	if !reflect.DeepEqual(errs, wantErrs) {
+		t.Errorf("errs = %+v, want %+v", errs, wantErrs)
+	}
+}
+
+func TestMinimise(t *testing.T) {
+	nets, err := Minimise([]byte("10.0.0.0/24\nbad\n10.0.1.0/24\n2001:db8::1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	v4, v6 := SplitFamily(nets)
+	if got := netsToStrings(v4); !reflect.DeepEqual(got, []string{"10.0.0.0/23"}) {
+		t.Errorf("v4 = %v", got)
+	}
+	if got := netsToStrings(v6); !reflect.DeepEqual(got, []string{"2001:db8::1/128"}) {
+		t.Errorf("v6 = %v", got)
+	}
+}
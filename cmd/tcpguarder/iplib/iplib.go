@@ -0,0 +1,65 @@
+// Package iplib 内置的 CIDR 列表, 运行时可用外部文件或目录覆盖
+package iplib
+
+import (
+	"embed"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+//go:embed china-cidr.txt not-china-cidr.txt
+var lists embed.FS
+
+// Asset 读取内置列表
+func Asset(name string) ([]byte, error) {
+	return lists.ReadFile(name)
+}
+
+// AssetNames 内置列表名
+func AssetNames() []string {
+	entries, err := lists.ReadDir(".")
+	if err != nil {
+		return nil
+	}
+	names := make([]string, 0, len(entries))
+	for _, v := range entries {
+		names = append(names, v.Name())
+	}
+	return names
+}
+
+// Load 读取列表 name, override 为空时读取内置列表;
+// override 为目录时读取目录中的同名文件, 不存在则读取内置列表; override 为文件时直接读取该文件
+func Load(name, override string) ([]byte, error) {
+	if override == "" {
+		return Asset(name)
+	}
+	fi, err := os.Stat(override)
+	if err != nil {
+		return nil, err
+	}
+	if !fi.IsDir() {
+		return ioutil.ReadFile(override)
+	}
+	b, err := ioutil.ReadFile(filepath.Join(override, name))
+	if os.IsNotExist(err) {
+		return Asset(name)
+	}
+	return b, err
+}
+
+// Source 返回 Load 实际读取的来源, 用于提示
+func Source(name, override string) string {
+	if override == "" {
+		return "embedded " + name
+	}
+	if fi, err := os.Stat(override); err == nil && fi.IsDir() {
+		file := filepath.Join(override, name)
+		if _, err := os.Stat(file); err != nil {
+			return "embedded " + name
+		}
+		return file
+	}
+	return override
+}
@@ -0,0 +1,63 @@
+package iplib
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadOverride(t *testing.T) {
+	dir, err := ioutil.TempDir("", "iplib")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	const name = "china-cidr.txt"
+	embedded, err := Asset(name)
+	if err != nil || len(embedded) == 0 {
+		t.Fatalf("Asset(%v) = %v bytes, %v", name, len(embedded), err)
+	}
+	file := filepath.Join(dir, "my.txt")
+	if err := ioutil.WriteFile(file, []byte("10.0.0.0/8\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	withList := filepath.Join(dir, "withlist")
+	os.Mkdir(withList, 0755)
+	if err := ioutil.WriteFile(filepath.Join(withList, name), []byte("192.0.2.0/24\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	empty := filepath.Join(dir, "empty")
+	os.Mkdir(empty, 0755)
+
+	tests := []struct {
+		override string
+		want     []byte
+		source   string
+	}{
+		{"", embedded, "embedded " + name},
+		{file, []byte("10.0.0.0/8\n"), file},
+		{withList, []byte("192.0.2.0/24\n"), filepath.Join(withList, name)},
+		{empty, embedded, "embedded " + name}, //目录中没有同名文件时用内置列表
+	}
+	for _, tt := range tests {
+		b, err := Load(name, tt.override)
+		if err != nil || !bytes.Equal(b, tt.want) {
+			t.Errorf("Load(%q) = %v bytes, %v", tt.override, len(b), err)
+		}
+		if got := Source(name, tt.override); got != tt.source {
+			t.Errorf("Source(%q) = %v, want %v", tt.override, got, tt.source)
+		}
+	}
+	if _, err := Load(name, filepath.Join(dir, "nosuch")); err == nil {
+		t.Error("Load of a missing override: no error")
+	}
+}
+
+func TestAssetNames(t *testing.T) {
+	names := AssetNames()
+	if len(names) != 2 || names[0] != "china-cidr.txt" || names[1] != "not-china-cidr.txt" {
+		t.Errorf("AssetNames = %v", names)
+	}
+}
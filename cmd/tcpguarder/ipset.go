@@ -0,0 +1,63 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lixiangzhong/tcpguarder"
+)
+
+// ipsetMaxName ipset 名字的最大长度
+const ipsetMaxName = 31
+
+// checkRestoreName restoreipset 的临时集合 name-tmp 也不能超过 ipset 名字的长度
+func checkRestoreName(name string) error {
+	if len(name+"-tmp") > ipsetMaxName {
+		return fmt.Errorf("ipset name %q too long, at most %v characters", name, ipsetMaxName-len("-tmp"))
+	}
+	return nil
+}
+
+// restoreipset 用 ipset restore 把 entries 原子替换进 hash:net 类型的 name:
+// 先写入临时集合, 再 swap, 替换过程中 name 始终可用
+func restoreipset(name, family string, entries []string) error {
+	if err := checkRestoreName(name); err != nil {
+		return err
+	}
+	tmp := name + "-tmp"
+	maxelem := 65536
+	for maxelem < len(entries) {
+		maxelem *= 2
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "create %v hash:net family %v maxelem %v -exist\n", name, family, maxelem)
+	fmt.Fprintf(&buf, "create %v hash:net family %v maxelem %v -exist\n", tmp, family, maxelem)
+	fmt.Fprintf(&buf, "flush %v\n", tmp)
+	for _, v := range entries {
+		for _, e := range splitDefaultRoute(v) {
+			fmt.Fprintf(&buf, "add %v %v -exist\n", tmp, e)
+		}
+	}
+	fmt.Fprintf(&buf, "swap %v %v\n", tmp, name)
+	fmt.Fprintf(&buf, "destroy %v\n", tmp)
+	cmd := tcpguarder.NewCmd("ipset restore")
+	cmd.Stdin = &buf
+	b, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.New(strings.TrimSpace(string(b)) + " " + err.Error())
+	}
+	return nil
+}
+
+// splitDefaultRoute hash:net 不接受 /0, 拆成两个 /1
+func splitDefaultRoute(v string) []string {
+	switch v {
+	case "0.0.0.0/0":
+		return []string{"0.0.0.0/1", "128.0.0.0/1"}
+	case "::/0":
+		return []string{"::/1", "8000::/1"}
+	}
+	return []string{v}
+}
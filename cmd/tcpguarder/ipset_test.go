@@ -0,0 +1,106 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRestoreIPSet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	out := filepath.Join(dir, "restore")
+	script := "#!/bin/sh\necho \"$*\" > " + out + "\ncat >> " + out + "\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "ipset"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	if err := restoreipset("drop", "inet", []string{"0.0.0.0/0", "10.0.0.0/8"}); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadFile(out)
+	want := `restore
+create drop hash:net family inet maxelem 65536 -exist
+create drop-tmp hash:net family inet maxelem 65536 -exist
+flush drop-tmp
+add drop-tmp 0.0.0.0/1 -exist
+add drop-tmp 128.0.0.0/1 -exist
+add drop-tmp 10.0.0.0/8 -exist
+swap drop-tmp drop
+destroy drop-tmp
+`
+	if string(b) != want {
+		t.Errorf("ipset restore input:\n%s", b)
+	}
+	if err := restoreipset("drop6", "inet6", []string{"::/0"}); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := ioutil.ReadFile(out); !strings.Contains(string(b), "add drop6-tmp ::/1 -exist\nadd drop6-tmp 8000::/1 -exist\n") {
+		t.Errorf("ipset restore input:\n%s", b)
+	}
+	//加上 -tmp 后超过 31 个字符
+	os.Remove(out)
+	name := strings.Repeat("x", 28)
+	if err := restoreipset(name, "inet", nil); err == nil || !strings.Contains(err.Error(), "too long") {
+		t.Errorf("restoreipset(%v) = %v, want too long", name, err)
+	}
+	if _, err := os.Stat(out); err == nil {
+		t.Error("ipset called with a too long name")
+	}
+	if err := restoreipset(strings.Repeat("x", 27), "inet", nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCreateAssetIPSetFamilies(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	out := filepath.Join(dir, "restore")
+	script := "#!/bin/sh\ncat >> " + out + "\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "ipset"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	//-iplib 覆盖的列表中混有 IPv6
+	override := filepath.Join(dir, "list.txt")
+	if err := ioutil.WriteFile(override, []byte("1.0.1.0/24\n1.0.2.0/23\n2001:db8::/32\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := createAssetIPSet("china", "china-cidr.txt", override); err != nil {
+		t.Fatal(err)
+	}
+	b, _ := ioutil.ReadFile(out)
+	for _, v := range []string{
+		"create china hash:net family inet ",
+		"add china-tmp 1.0.1.0/24 -exist\nadd china-tmp 1.0.2.0/23 -exist\nswap china-tmp china\n",
+		"create china6 hash:net family inet6 ",
+		"add china6-tmp 2001:db8::/32 -exist\nswap china6-tmp china6\n",
+	} {
+		if !strings.Contains(string(b), v) {
+			t.Errorf("ipset restore input missing %q:\n%s", v, b)
+		}
+	}
+	//只有 IPv4 时不创建 name6
+	os.Remove(out)
+	if err := ioutil.WriteFile(override, []byte("1.0.1.0/24\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := createAssetIPSet("china", "china-cidr.txt", override); err != nil {
+		t.Fatal(err)
+	}
+	if b, _ := ioutil.ReadFile(out); strings.Contains(string(b), "china6") {
+		t.Errorf("china6 created without ipv6:\n%s", b)
+	}
+}
@@ -0,0 +1,314 @@
+package main
+
+import (
+	"bytes"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/smtp"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/urfave/cli/v2"
+)
+
+// mailMaxBans 一封摘要最多列出的封禁数, 其余只计数
+const mailMaxBans = 50
+
+// mailTop 摘要中当前连接数和异常分数的条数
+const mailTop = 10
+
+// mailSettings 邮件参数, smtp 为空时不发送
+type mailSettings struct {
+	smtp        string
+	user        string
+	password    string
+	from        string
+	to          []string
+	every       time.Duration
+	burst       int
+	minInterval time.Duration
+}
+
+func loadMailSettings(c *cli.Context) (mailSettings, error) {
+	m := mailSettings{
+		smtp:        c.String("mail-smtp"),
+		user:        c.String("mail-user"),
+		password:    c.String("mail-password"),
+		from:        c.String("mail-from"),
+		to:          c.StringSlice("mail-to"),
+		every:       c.Duration("mail-every"),
+		burst:       c.Int("mail-burst"),
+		minInterval: c.Duration("mail-min-interval"),
+	}
+	if m.smtp == "" {
+		return m, nil
+	}
+	if _, _, err := net.SplitHostPort(m.smtp); err != nil {
+		return m, fmt.Errorf("mail-smtp: %v", err)
+	}
+	if len(m.to) == 0 {
+		return m, errors.New("mail-smtp needs -mail-to")
+	}
+	if m.from == "" {
+		host, _ := os.Hostname()
+		m.from = "tcpguarder@" + host
+	}
+	if m.every < 0 || m.minInterval < 0 || m.burst < 0 {
+		return m, errors.New("mail-every, mail-burst and mail-min-interval must not be negative")
+	}
+	return m, nil
+}
+
+// mailDigest 收集一段时间内的事件, 定期或封禁数达到 burst 时发送摘要,
+// 两封邮件至少间隔 minInterval, 攻击时也不会产生大量邮件
+type mailDigest struct {
+	mu       sync.Mutex
+	settings mailSettings
+	start    time.Time //本期开始时间
+	last     time.Time //上次发送时间
+	bans     []Event
+	total    int            //本期封禁数, 超过 mailMaxBans 的不保存
+	counts   map[string]int //本期其他事件数
+}
+
+var digest = &mailDigest{start: time.Now(), counts: make(map[string]int)}
+
+func (d *mailDigest) configure(m mailSettings) {
+	d.mu.Lock()
+	d.settings = m
+	d.mu.Unlock()
+}
+
+func (d *mailDigest) add(e Event) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.settings.smtp == "" {
+		return
+	}
+	switch e.Event {
+	case EventBan:
+		d.total++
+		if len(d.bans) < mailMaxBans {
+			d.bans = append(d.bans, e)
+		}
+	case EventBanFailed, EventSkip, EventUnban:
+		d.counts[e.Event]++
+	}
+}
+
+// due 到了发送时间时返回本期内容并开始新的一期
+func (d *mailDigest) due(now time.Time) (m mailSettings, start time.Time, bans []Event, total int, counts map[string]int, ok bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	m = d.settings
+	if m.smtp == "" || d.total+d.counts[EventBanFailed] == 0 {
+		d.start = now
+		return
+	}
+	if now.Sub(d.last) < m.minInterval {
+		return
+	}
+	if now.Sub(d.start) < m.every && (m.burst == 0 || d.total < m.burst) {
+		return
+	}
+	start, bans, total, counts = d.start, d.bans, d.total, d.counts
+	d.start, d.last, d.bans, d.total, d.counts = now, now, nil, 0, make(map[string]int)
+	return m, start, bans, total, counts, true
+}
+
+// run 检查是否需要发送摘要, 发送失败时下一期再合并发送
+func (d *mailDigest) run() {
+	tk := time.NewTicker(5 * time.Second)
+	defer tk.Stop()
+	for now := range tk.C {
+		m, start, list, total, counts, ok := d.due(now)
+		if !ok {
+			continue
+		}
+		control.mu.Lock()
+		stats, filter, rules := control.stats, control.filter, control.rules
+		control.mu.Unlock()
+		subject := fmt.Sprintf("%v bans in %v", total, now.Sub(start).Round(time.Second))
+		body := mailBody(start, now, list, total, counts, stats, filter, rules)
+		if err := sendMail(m, subject, body); err != nil {
+			log.Println("mail:", err)
+			d.putBack(start, list, total, counts)
+		}
+	}
+}
+
+// putBack 发送失败的内容放回下一期, 保持 mailMaxBans 上限
+func (d *mailDigest) putBack(start time.Time, list []Event, total int, counts map[string]int) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.start = start
+	d.bans = append(list, d.bans...)
+	if len(d.bans) > mailMaxBans {
+		d.bans = d.bans[:mailMaxBans]
+	}
+	d.total += total
+	for k, v := range counts {
+		d.counts[k] += v
+	}
+}
+
+// mailBody 纯文本摘要: 封禁列表, 封禁最多的IP, 当前连接数和异常分数最高的IP
+func mailBody(start, end time.Time, list []Event, total int, counts map[string]int,
+	stats []tcpguarder.ConnStat, filter tcpguarder.Filter, rules tcpguarder.RuleSet) string {
+	var b bytes.Buffer
+	host, _ := os.Hostname()
+	fmt.Fprintf(&b, "tcpguarder on %v, %v - %v\n\n", host, start.Format(time.RFC3339), end.Format(time.RFC3339))
+	fmt.Fprintf(&b, "bans: %v, failed: %v, skipped (white list): %v, unbans: %v\n",
+		total, counts[EventBanFailed], counts[EventSkip], counts[EventUnban])
+	if len(list) > 0 {
+		fmt.Fprintln(&b, "\nbans")
+		for _, e := range list {
+			fmt.Fprintf(&b, "%v\t%v\t%v\t%v\t%v\n", e.Time.Format("15:04:05"), e.IP, e.Rule, e.Count, geoinfo(e.IP))
+		}
+		if total > len(list) {
+			fmt.Fprintf(&b, "... and %v more\n", total-len(list))
+		}
+		offenders := make(map[string]int)
+		for _, e := range list {
+			offenders[e.IP]++
+		}
+		var top []tcpguarder.CountItem
+		for ip, n := range offenders {
+			if n > 1 {
+				top = append(top, tcpguarder.CountItem{Key: ip, N: n})
+			}
+		}
+		if len(top) > 0 {
+			sort.Slice(top, func(i, j int) bool {
+				if top[i].N != top[j].N {
+					return top[i].N > top[j].N
+				}
+				return top[i].Key < top[j].Key
+			})
+			fmt.Fprintln(&b, "\nbanned more than once")
+			writeMailTop(&b, top)
+		}
+	}
+	if len(stats) > 0 {
+		fmt.Fprintln(&b, "\ntop connections now")
+		writeMailTop(&b, tcpguarder.TopFilter(stats, filter))
+		var abnormal []tcpguarder.CountItem
+		for _, v := range rules.TopFilter(stats, filter) {
+			if v.N > 0 {
+				abnormal = append(abnormal, v)
+			}
+		}
+		if len(abnormal) > 0 {
+			fmt.Fprintln(&b, "\ntop abnormal scores now")
+			writeMailTop(&b, abnormal)
+		}
+	}
+	return b.String()
+}
+
+func writeMailTop(b *bytes.Buffer, ss []tcpguarder.CountItem) {
+	for i, v := range ss {
+		if i == mailTop {
+			break
+		}
+		fmt.Fprintf(b, "%v\t%v\t%v\n", v.Key, v.N, geoinfo(v.Key))
+	}
+}
+
+// sendMail 服务器支持时使用 STARTTLS, 有 user 时认证
+func sendMail(m mailSettings, subject, body string) error {
+	host, _, _ := net.SplitHostPort(m.smtp)
+	conn, err := net.DialTimeout("tcp", m.smtp, 10*time.Second)
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(time.Minute))
+	c, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer c.Close()
+	if ok, _ := c.Extension("STARTTLS"); ok {
+		if err := c.StartTLS(&tls.Config{ServerName: host}); err != nil {
+			return err
+		}
+	}
+	if m.user != "" {
+		if err := c.Auth(smtp.PlainAuth("", m.user, m.password, host)); err != nil {
+			return err
+		}
+	}
+	if err := c.Mail(m.from); err != nil {
+		return err
+	}
+	for _, v := range m.to {
+		if err := c.Rcpt(v); err != nil {
+			return err
+		}
+	}
+	w, err := c.Data()
+	if err != nil {
+		return err
+	}
+	if _, err := w.Write(mailMessage(m, subject, body)); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	return c.Quit()
+}
+
+func mailMessage(m mailSettings, subject, body string) []byte {
+	host, _ := os.Hostname()
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "From: %v\r\n", m.from)
+	fmt.Fprintf(&b, "To: %v\r\n", strings.Join(m.to, ", "))
+	fmt.Fprintf(&b, "Subject: [tcpguarder] %v: %v\r\n", host, subject)
+	fmt.Fprintf(&b, "Date: %v\r\n", time.Now().Format(time.RFC1123Z))
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	return b.Bytes()
+}
+
+// MailAction 立即发送当前连接的摘要, 用于测试邮件参数
+func MailAction(c *cli.Context) error {
+	m, err := loadMailSettings(c)
+	if err != nil {
+		return err
+	}
+	if m.smtp == "" {
+		return errors.New("need -mail-smtp")
+	}
+	if err := loadGeoIP(c); err != nil {
+		return err
+	}
+	filter, err := connFilter(c)
+	if err != nil {
+		return err
+	}
+	rules, err := loadRules(c.String("rules"))
+	if err != nil {
+		return err
+	}
+	stats, err := tcpguarder.ConnStats()
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	body := mailBody(now, now, nil, 0, nil, stats, filter, rules)
+	if err := sendMail(m, "test digest", body); err != nil {
+		return err
+	}
+	fmt.Println("sent to", m.to)
+	return nil
+}
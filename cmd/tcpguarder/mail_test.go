@@ -0,0 +1,170 @@
+package main
+
+import (
+	"bufio"
+	"encoding/base64"
+	"net"
+	"strings"
+	"testing"
+	"time"
+)
+
+// smtpStub 最简单的 SMTP 服务器, 不支持 STARTTLS, 收到的邮件发到 mails
+type smtpStub struct {
+	l     net.Listener
+	mails chan string
+}
+
+func newSMTPStub(t *testing.T) *smtpStub {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &smtpStub{l: l, mails: make(chan string, 10)}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go s.serve(conn)
+		}
+	}()
+	return s
+}
+
+func (s *smtpStub) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	reply := func(line string) { conn.Write([]byte(line + "\r\n")) }
+	reply("220 stub ESMTP")
+	var mail []string
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		line = strings.TrimRight(line, "\r\n")
+		cmd := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+		switch cmd {
+		case "EHLO":
+			reply("250-stub")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			//AUTH PLAIN base64(\x00user\x00password)
+			b, _ := base64.StdEncoding.DecodeString(strings.TrimPrefix(line, "AUTH PLAIN "))
+			if string(b) != "\x00tcpguarder\x00secret" {
+				reply("535 bad credentials")
+				continue
+			}
+			reply("235 ok")
+		case "MAIL", "RCPT":
+			mail = append(mail, line)
+			reply("250 ok")
+		case "DATA":
+			reply("354 go ahead")
+			for {
+				line, err := r.ReadString('\n')
+				if err != nil {
+					return
+				}
+				if line == ".\r\n" {
+					break
+				}
+				mail = append(mail, strings.TrimRight(line, "\r\n"))
+			}
+			s.mails <- strings.Join(mail, "\n")
+			mail = nil
+			reply("250 queued")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 not implemented")
+		}
+	}
+}
+
+func TestSendMail(t *testing.T) {
+	s := newSMTPStub(t)
+	defer s.l.Close()
+	m := mailSettings{
+		smtp:     s.l.Addr().String(),
+		user:     "tcpguarder",
+		password: "secret",
+		from:     "tcpguarder@example.com",
+		to:       []string{"ops@example.com", "sec@example.com"},
+	}
+	if err := sendMail(m, "3 bans in 1m0s", "bans: 3\nline two\n"); err != nil {
+		t.Fatal(err)
+	}
+	var got string
+	select {
+	case got = <-s.mails:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no mail received")
+	}
+	for _, want := range []string{
+		"MAIL FROM:<tcpguarder@example.com>",
+		"RCPT TO:<ops@example.com>",
+		"RCPT TO:<sec@example.com>",
+		"To: ops@example.com, sec@example.com",
+		": 3 bans in 1m0s",
+		"Content-Type: text/plain; charset=utf-8",
+		"bans: 3\nline two",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("mail missing %q:\n%v", want, got)
+		}
+	}
+	m.password = "wrong"
+	if err := sendMail(m, "x", "x"); err == nil {
+		t.Error("bad password want error")
+	}
+}
+
+func TestMailDigestDue(t *testing.T) {
+	t0 := time.Date(2026, 10, 19, 10, 0, 0, 0, time.UTC)
+	d := &mailDigest{start: t0, counts: make(map[string]int)}
+	ban := Event{Event: EventBan, IP: "203.0.113.9"}
+	//未配置 smtp 时不收集
+	d.add(ban)
+	if d.total != 0 {
+		t.Fatal("events collected without smtp")
+	}
+	d.configure(mailSettings{smtp: "127.0.0.1:25", to: []string{"ops@example.com"}, every: time.Hour, burst: 3, minInterval: 10 * time.Minute})
+	steps := []struct {
+		add   int //这一步之前加入的封禁数
+		at    time.Duration
+		ok    bool
+		total int
+	}{
+		{0, 2 * time.Hour, false, 0},               //没有封禁, 不发送, 从现在开始新的一期
+		{2, 2*time.Hour + time.Minute, false, 0},   //未到 every, 未到 burst
+		{1, 2*time.Hour + time.Minute, true, 3},    //达到 burst
+		{3, 2*time.Hour + 5*time.Minute, false, 0}, //达到 burst, 但距上次不足 min interval
+		{0, 2*time.Hour + 11*time.Minute, true, 3},
+		{1, 2*time.Hour + 30*time.Minute, false, 0},
+		{0, 3*time.Hour + 11*time.Minute, true, 1}, //达到 every
+	}
+	for i, st := range steps {
+		for j := 0; j < st.add; j++ {
+			d.add(ban)
+		}
+		_, _, list, total, _, ok := d.due(t0.Add(st.at))
+		if ok != st.ok || total != st.total || len(list) != st.total {
+			t.Errorf("step %v: due = %v %v %v, want %v %v", i, ok, total, len(list), st.ok, st.total)
+		}
+	}
+	//只有封禁失败也发送, 发送失败的内容放回下一期
+	d.add(Event{Event: EventBanFailed})
+	_, start, list, total, counts, ok := d.due(t0.Add(5 * time.Hour))
+	if !ok || total != 0 || counts[EventBanFailed] != 1 {
+		t.Fatalf("ban_failed only: due = %v %v %v", ok, total, counts)
+	}
+	d.add(ban)
+	d.putBack(start, list, total, counts)
+	if _, _, _, total, counts, ok = d.due(t0.Add(6 * time.Hour)); !ok || total != 1 || counts[EventBanFailed] != 1 {
+		t.Errorf("after putBack: due = %v %v %v", ok, total, counts)
+	}
+}
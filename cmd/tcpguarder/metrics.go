@@ -0,0 +1,174 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+)
+
+// runMetrics run 的指标, -metrics 指定地址时通过 /metrics 导出
+var runMetrics = &metrics{bans: make(map[string]uint64)}
+
+type connKey struct {
+	State tcpguarder.TCPStat
+	Port  string //监听端口, 其余为 other
+}
+
+type metrics struct {
+	sync.Mutex
+	top         int
+	conns       map[connKey]int
+	remotes     []tcpguarder.CountItem
+	bans        map[string]uint64
+	ipsets      []string
+	scans       uint64
+	scanErrors  uint64
+	scanSeconds float64
+}
+
+// localPorts 返回连接的本地端口标签, 监听端口之外(主动连接的随机端口)合并为 other
+func localPorts(stats []tcpguarder.ConnStat) func(tcpguarder.ConnStat) string {
+	listen := make(map[uint16]bool)
+	for _, v := range stats {
+		if v.Stat == tcpguarder.LISTEN {
+			listen[v.Local.Port] = true
+		}
+	}
+	return func(v tcpguarder.ConnStat) string {
+		if listen[v.Local.Port] {
+			return strconv.Itoa(int(v.Local.Port))
+		}
+		return "other"
+	}
+}
+
+// countConns 按状态和本地端口统计
+func countConns(stats []tcpguarder.ConnStat, port func(tcpguarder.ConnStat) string) map[connKey]int {
+	m := make(map[connKey]int)
+	for _, v := range stats {
+		m[connKey{v.Stat, port(v)}]++
+	}
+	return m
+}
+
+// observe 记录一次检测, err 不为 nil 时只计数
+func (m *metrics) observe(stats []tcpguarder.ConnStat, d time.Duration, err error, ipsets []string) {
+	m.Lock()
+	defer m.Unlock()
+	m.scans++
+	m.scanSeconds += d.Seconds()
+	m.ipsets = ipsets
+	if err != nil {
+		m.scanErrors++
+		return
+	}
+	m.conns = countConns(stats, localPorts(stats))
+	remotes := tcpguarder.TopFilter(stats, nil)
+	if len(remotes) > m.top {
+		remotes = remotes[:m.top]
+	}
+	m.remotes = remotes
+}
+
+// ban 记录一次封禁, rule 为封禁原因
+func (m *metrics) ban(rule string) {
+	m.Lock()
+	m.bans[rule]++
+	m.Unlock()
+}
+
+func (m *metrics) write(w io.Writer) {
+	m.Lock()
+	conns := make([]connKey, 0, len(m.conns))
+	for k := range m.conns {
+		conns = append(conns, k)
+	}
+	sort.Slice(conns, func(i, j int) bool {
+		if conns[i].State != conns[j].State {
+			return conns[i].State < conns[j].State
+		}
+		return conns[i].Port < conns[j].Port
+	})
+	rules := make([]string, 0, len(m.bans))
+	for k := range m.bans {
+		rules = append(rules, k)
+	}
+	sort.Strings(rules)
+	p := promWriter{w}
+	p.header("tcpguarder_connections", "gauge", "TCP connections by state and local listening port.")
+	for _, k := range conns {
+		p.sample("tcpguarder_connections", float64(m.conns[k]), "state", string(k.State), "port", k.Port)
+	}
+	p.header("tcpguarder_remote_connections", "gauge", "TCP connections of the top remote IPs.")
+	for _, v := range m.remotes {
+		p.sample("tcpguarder_remote_connections", float64(v.N), "ip", v.Key)
+	}
+	p.header("tcpguarder_bans_total", "counter", "IPs banned by rule.")
+	for _, rule := range rules {
+		p.sample("tcpguarder_bans_total", float64(m.bans[rule]), "rule", rule)
+	}
+	p.header("tcpguarder_scan_duration_seconds", "summary", "Time spent reading connections and applying rules.")
+	p.sample("tcpguarder_scan_duration_seconds_sum", m.scanSeconds)
+	p.sample("tcpguarder_scan_duration_seconds_count", float64(m.scans))
+	p.header("tcpguarder_scan_errors_total", "counter", "Scans that failed to read connections.")
+	p.sample("tcpguarder_scan_errors_total", float64(m.scanErrors))
+	ipsets := m.ipsets
+	m.Unlock()
+	p.header("tcpguarder_parse_errors_total", "counter", "Connection lines that could not be parsed.")
+	p.sample("tcpguarder_parse_errors_total", float64(tcpguarder.ParseErrors()))
+	p.header("tcpguarder_ipset_entries", "gauge", "Entries in the ban ipsets.")
+	for _, name := range ipsets {
+		n, err := ipsetEntries(name)
+		if err != nil {
+			continue
+		}
+		p.sample("tcpguarder_ipset_entries", float64(n), "set", name)
+	}
+}
+
+// ipsetEntries ipset 当前条目数
+func ipsetEntries(name string) (int, error) {
+	b, err := tcpguarder.NewCmd("ipset list -terse " + name).Output()
+	if err != nil {
+		return 0, err
+	}
+	scanner := bufio.NewScanner(bytes.NewReader(b))
+	for scanner.Scan() {
+		if s := strings.TrimPrefix(scanner.Text(), "Number of entries:"); s != scanner.Text() {
+			return strconv.Atoi(strings.TrimSpace(s))
+		}
+	}
+	return 0, fmt.Errorf("ipset %v: number of entries not found", name)
+}
+
+type metricsWriter interface {
+	write(w io.Writer)
+}
+
+// serveMetrics 在 l 上提供 /metrics, 先监听再启动, 地址被占用等错误在启动时返回
+func serveMetrics(l net.Listener, m metricsWriter) error {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		m.write(w)
+	})
+	fmt.Printf("metrics on http://%v/metrics\n", l.Addr())
+	return http.Serve(l, mux)
+}
+
+// setTop 修改导出的远端IP个数, 重新加载配置时调用
+func (m *metrics) setTop(n int) {
+	m.Lock()
+	m.top = n
+	m.Unlock()
+}
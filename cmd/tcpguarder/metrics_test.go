@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+)
+
+func TestMetricsWrite(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	//ipset list -terse 只有 blackhold 存在
+	script := `#!/bin/sh
+if [ "$3" = blackhold ]; then
+	printf 'Name: blackhold\nType: hash:ip\nNumber of entries: 3\n'
+	exit 0
+fi
+echo "ipset v7.1: The set with the given name does not exist" >&2
+exit 1
+`
+	if err := ioutil.WriteFile(filepath.Join(dir, "ipset"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+os.Getenv("PATH"))
+
+	conn := func(lport uint16, remote string, stat tcpguarder.TCPStat) tcpguarder.ConnStat {
+		return tcpguarder.ConnStat{
+			Local:  tcpguarder.IPPort{IP: net.ParseIP("10.0.0.1"), Port: lport},
+			Remote: tcpguarder.IPPort{IP: net.ParseIP(remote), Port: 50000},
+			Stat:   stat,
+		}
+	}
+	stats := []tcpguarder.ConnStat{
+		conn(443, "0.0.0.0", tcpguarder.LISTEN),
+		conn(443, "203.0.113.1", tcpguarder.ESTABLISHED),
+		conn(443, "203.0.113.1", tcpguarder.TIME_WAIT),
+		conn(443, "203.0.113.1", tcpguarder.CLOSE_WAIT),
+		conn(443, "203.0.113.2", tcpguarder.ESTABLISHED),
+		conn(443, "203.0.113.2", tcpguarder.ESTABLISHED),
+		conn(40000, "198.51.100.7", tcpguarder.ESTABLISHED),
+	}
+	m := &metrics{top: 2, bans: map[string]uint64{"scan": 2, `we"b\`: 1}}
+	m.observe(stats, 250*time.Millisecond, nil, []string{"blackhold", "sshban"})
+	m.observe(nil, 250*time.Millisecond, fmt.Errorf("cat: /proc/net/tcp: No such file"), []string{"blackhold", "sshban"})
+	var b bytes.Buffer
+	m.write(&b)
+	want := `# HELP tcpguarder_connections TCP connections by state and local listening port.
+# TYPE tcpguarder_connections gauge
+tcpguarder_connections{state="CLOSE_WAIT",port="443"} 1
+tcpguarder_connections{state="ESTABLISHED",port="443"} 3
+tcpguarder_connections{state="ESTABLISHED",port="other"} 1
+tcpguarder_connections{state="LISTEN",port="443"} 1
+tcpguarder_connections{state="TIME_WAIT",port="443"} 1
+# HELP tcpguarder_remote_connections TCP connections of the top remote IPs.
+# TYPE tcpguarder_remote_connections gauge
+tcpguarder_remote_connections{ip="203.0.113.1"} 3
+tcpguarder_remote_connections{ip="203.0.113.2"} 2
+# HELP tcpguarder_bans_total IPs banned by rule.
+# TYPE tcpguarder_bans_total counter
+tcpguarder_bans_total{rule="scan"} 2
+tcpguarder_bans_total{rule="we\"b\\"} 1
+# HELP tcpguarder_scan_duration_seconds Time spent reading connections and applying rules.
+# TYPE tcpguarder_scan_duration_seconds summary
+tcpguarder_scan_duration_seconds_sum 0.5
+tcpguarder_scan_duration_seconds_count 2
+# HELP tcpguarder_scan_errors_total Scans that failed to read connections.
+# TYPE tcpguarder_scan_errors_total counter
+tcpguarder_scan_errors_total 1
+# HELP tcpguarder_parse_errors_total Connection lines that could not be parsed.
+# TYPE tcpguarder_parse_errors_total counter
+tcpguarder_parse_errors_total ` + fmt.Sprint(tcpguarder.ParseErrors()) + `
+# HELP tcpguarder_ipset_entries Entries in the ban ipsets.
+# TYPE tcpguarder_ipset_entries gauge
+tcpguarder_ipset_entries{set="blackhold"} 3
+`
+	if got := b.String(); got != want {
+		t.Errorf("metrics:\n%v\nwant:\n%v", got, want)
+	}
+}
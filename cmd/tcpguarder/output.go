@@ -0,0 +1,172 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+)
+
+// 输出格式, table 为默认的制表符分隔文本
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputCSV   = "csv"
+)
+
+func checkOutput(format string) error {
+	switch format {
+	case outputTable, outputJSON, outputCSV:
+		return nil
+	}
+	return fmt.Errorf("output: unknown format %q, want table, json or csv", format)
+}
+
+// outputItem 默认和 -ab 视图中的一行, -ab 时 count 为异常分数
+type outputItem struct {
+	IP      string `json:"ip"`
+	Count   int    `json:"count"`
+	Country string `json:"country,omitempty"`
+	ASN     uint64 `json:"asn,omitempty"`
+	ASOrg   string `json:"as_org,omitempty"`
+}
+
+type outputTotal struct {
+	IP  int `json:"ip"`
+	TCP int `json:"tcp"`
+}
+
+type outputTop struct {
+	View  string       `json:"view"` //top 或 abnormal
+	Items []outputItem `json:"items"`
+	Total outputTotal  `json:"total"`
+}
+
+// writeTop 输出前 top 条, total 为全部
+func writeTop(w io.Writer, format, view string, ss []tcpguarder.CountItem, top int) error {
+	out := outputTop{View: view, Items: []outputItem{}}
+	for i, v := range ss {
+		out.Total.TCP += v.N
+		if i >= top {
+			continue
+		}
+		item := outputItem{IP: v.Key, Count: v.N}
+		if geo := tcpguarder.CurrentGeo(); geo != nil {
+			info := geo.Lookup(net.ParseIP(v.Key))
+			item.Country, item.ASN, item.ASOrg = info.Country, info.ASN, info.ASOrg
+		}
+		out.Items = append(out.Items, item)
+	}
+	out.Total.IP = len(ss)
+	switch format {
+	case outputJSON:
+		return json.NewEncoder(w).Encode(out)
+	case outputCSV:
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"ip", "count", "country", "asn", "as_org"})
+		for _, v := range out.Items {
+			asn := ""
+			if v.ASN != 0 {
+				asn = strconv.FormatUint(v.ASN, 10)
+			}
+			cw.Write([]string{v.IP, strconv.Itoa(v.Count), v.Country, asn, v.ASOrg})
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+	for _, v := range out.Items {
+		if geo := geoinfo(v.IP); geo != "" {
+			fmt.Fprintf(w, "%v\t%v\t%v\n", v.IP, v.Count, geo)
+			continue
+		}
+		fmt.Fprintf(w, "%v\t%v\n", v.IP, v.Count)
+	}
+	fmt.Fprintln(w, "\ntotal\nip:", out.Total.IP, "tcp:", out.Total.TCP)
+	return nil
+}
+
+type outputGroupItem struct {
+	Group string `json:"group"`
+	Count int    `json:"count"`
+}
+
+type outputGroup struct {
+	View  string            `json:"view"` //group 或 abnormal_group
+	Items []outputGroupItem `json:"items"`
+	Total struct {
+		Group int `json:"group"`
+		TCP   int `json:"tcp"`
+	} `json:"total"`
+}
+
+func writeGroup(w io.Writer, format, view string, items []tcpguarder.GroupItem, top int) error {
+	out := outputGroup{View: view, Items: []outputGroupItem{}}
+	for i, v := range items {
+		out.Total.TCP += v.N
+		if i >= top {
+			continue
+		}
+		out.Items = append(out.Items, outputGroupItem{Group: v.Key.String(), Count: v.N})
+	}
+	out.Total.Group = len(items)
+	switch format {
+	case outputJSON:
+		return json.NewEncoder(w).Encode(out)
+	case outputCSV:
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"group", "count"})
+		for _, v := range out.Items {
+			cw.Write([]string{v.Group, strconv.Itoa(v.Count)})
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+	for _, v := range out.Items {
+		fmt.Fprintf(w, "%v\t%v\n", v.Group, v.Count)
+	}
+	fmt.Fprintln(w, "\ntotal\ngroup:", out.Total.Group, "tcp:", out.Total.TCP)
+	return nil
+}
+
+type outputBans struct {
+	Bans  []Ban `json:"bans"`
+	Total struct {
+		Ban int `json:"ban"`
+	} `json:"total"`
+}
+
+func writeBans(w io.Writer, format string, list []Ban) error {
+	out := outputBans{Bans: list}
+	if out.Bans == nil {
+		out.Bans = []Ban{}
+	}
+	out.Total.Ban = len(list)
+	expires := func(b Ban, never string) string {
+		if b.Expires == nil {
+			return never
+		}
+		return b.Expires.Format(time.RFC3339)
+	}
+	switch format {
+	case outputJSON:
+		return json.NewEncoder(w).Encode(out)
+	case outputCSV:
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"ip", "set", "rule", "time", "expires"})
+		for _, v := range list {
+			cw.Write([]string{v.IP, v.Set, v.Rule, v.Time.Format(time.RFC3339), expires(v, "")})
+		}
+		cw.Flush()
+		return cw.Error()
+	}
+	for _, v := range list {
+		fmt.Fprintf(w, "%v\t%v\t%v\t%v\t%v\n", v.IP, v.Set, v.Rule, v.Time.Format(time.RFC3339), expires(v, "never"))
+	}
+	fmt.Fprintln(w, "\ntotal\nban:", len(list))
+	return nil
+}
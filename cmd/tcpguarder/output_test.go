@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/urfave/cli/v2"
+)
+
+func TestWriteTopLimit(t *testing.T) {
+	ss := []tcpguarder.CountItem{{Key: "203.0.113.1", N: 5}, {Key: "203.0.113.2", N: 3}, {Key: "203.0.113.3", N: 1}}
+	tests := []struct {
+		top  int
+		want int
+	}{
+		{0, 0},
+		{1, 1},
+		{2, 2},
+		{3, 3},
+		{10, 3},
+	}
+	for _, tt := range tests {
+		var b bytes.Buffer
+		if err := writeTop(&b, outputJSON, "top", ss, tt.top); err != nil {
+			t.Fatal(err)
+		}
+		var out outputTop
+		if err := json.Unmarshal(b.Bytes(), &out); err != nil {
+			t.Fatal(err)
+		}
+		if len(out.Items) != tt.want || out.Total.IP != 3 || out.Total.TCP != 9 {
+			t.Errorf("top %v: %+v, want %v items", tt.top, out, tt.want)
+		}
+		b.Reset()
+		writeTop(&b, outputCSV, "top", ss, tt.top)
+		if rows := strings.Count(b.String(), "\n") - 1; rows != tt.want {
+			t.Errorf("top %v: csv rows %v, want %v", tt.top, rows, tt.want)
+		}
+	}
+}
+
+func TestWriteGroupLimit(t *testing.T) {
+	items := []tcpguarder.GroupItem{
+		{Key: tcpguarder.GroupKey{Remote: tcpguarder.ParseIPNet("203.0.113.0/24")}, N: 4},
+		{Key: tcpguarder.GroupKey{Remote: tcpguarder.ParseIPNet("198.51.100.0/24")}, N: 2},
+	}
+	var b bytes.Buffer
+	if err := writeGroup(&b, outputJSON, "group", items, 1); err != nil {
+		t.Fatal(err)
+	}
+	var out outputGroup
+	if err := json.Unmarshal(b.Bytes(), &out); err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Items) != 1 || out.Items[0].Count != 4 || out.Total.Group != 2 || out.Total.TCP != 6 {
+		t.Errorf("writeGroup top 1 = %+v", out)
+	}
+}
+
+func TestShowPortsJSON(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stdout := os.Stdout
+	os.Stdout = w
+	app := cli.NewApp()
+	app.Flags = []cli.Flag{
+		&cli.IntSliceFlag{Name: "port"},
+		&cli.StringFlag{Name: "output", Value: outputTable},
+	}
+	app.Before = showPortsAction
+	app.Action = func(c *cli.Context) error {
+		ss := []tcpguarder.CountItem{{Key: "203.0.113.1", N: 5}}
+		return writeTop(os.Stdout, c.String("output"), "top", ss, 10)
+	}
+	err = app.Run([]string{"tcpguarder", "-port", "80", "-output", "json"})
+	os.Stdout = stdout
+	w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out outputTop
+	if err := json.Unmarshal(b, &out); err != nil {
+		t.Fatalf("stdout is not json: %v\n%s", err, b)
+	}
+	if len(out.Items) != 1 {
+		t.Errorf("items = %+v", out.Items)
+	}
+}
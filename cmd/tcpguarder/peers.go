@@ -0,0 +1,211 @@
+package main
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"math"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/urfave/cli/v2"
+)
+
+// 同步封禁请求的签名, HMAC-SHA256(secret, timestamp + "." + body)
+const (
+	peerTimestampHeader = "X-Tcpguarder-Timestamp"
+	peerSignatureHeader = "X-Tcpguarder-Signature"
+	peerPath            = "/v1/ban"
+)
+
+// peerMaxSkew 请求时间与本机时间最多相差多少, 窗口内见过的签名拒绝重放
+const peerMaxSkew = 5 * time.Minute
+
+// peerSettings 与其他主机同步封禁的参数
+type peerSettings struct {
+	name   string //本机名, 作为封禁来源发给其他主机
+	secret string
+	ipset  string //其他主机同步来的封禁加入的 ipset
+	urls   []string
+}
+
+func peerSign(secret, ts string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(ts))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// loadPeers 未指定 -peer 和 -peer-listen 时返回 nil
+func loadPeers(c *cli.Context) (*peerSettings, []*Hook, error) {
+	urls := c.StringSlice("peer")
+	if len(urls) == 0 && c.String("peer-listen") == "" {
+		return nil, nil, nil
+	}
+	s := &peerSettings{
+		name:   c.String("peer-name"),
+		secret: c.String("peer-secret"),
+		ipset:  c.String("ipset"),
+		urls:   urls,
+	}
+	if len(s.secret) < 16 {
+		return nil, nil, errors.New("peer-secret: need at least 16 characters")
+	}
+	if s.name == "" {
+		s.name, _ = os.Hostname()
+	}
+	var list []*Hook
+	for _, v := range urls {
+		u, err := url.Parse(v)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return nil, nil, fmt.Errorf("peer: bad url %q", v)
+		}
+		h, err := newHook(HookConfig{URL: strings.TrimSuffix(v, "/") + peerPath, Events: []string{EventBan}})
+		if err != nil {
+			return nil, nil, err
+		}
+		h.peer = s
+		list = append(list, h)
+	}
+	return s, list, nil
+}
+
+// peerState 当前的同步参数, 重新加载配置时替换
+type peerState struct {
+	mu       sync.Mutex
+	settings *peerSettings
+}
+
+var peer = &peerState{}
+
+func (p *peerState) set(s *peerSettings) {
+	p.mu.Lock()
+	p.settings = s
+	p.mu.Unlock()
+}
+
+func (p *peerState) get() *peerSettings {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.settings
+}
+
+// peerReplay 时间窗口内收到过的签名
+type peerReplay struct {
+	mu   sync.Mutex
+	seen map[string]time.Time //签名, 请求时间戳超出窗口的时间
+}
+
+var peerSeen = &peerReplay{seen: make(map[string]time.Time)}
+
+// first 签名第一次出现时记录并返回 true, expires 之后时间戳已过期, 不需要再记录
+func (p *peerReplay) first(sig string, expires, now time.Time) bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for k, v := range p.seen {
+		if now.After(v) {
+			delete(p.seen, k)
+		}
+	}
+	if _, ok := p.seen[sig]; ok {
+		return false
+	}
+	p.seen[sig] = expires
+	return true
+}
+
+// peerBanHandler 验证签名后按剩余时长加入本机 ipset, 本机白名单中的IP不封禁
+func peerBanHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, errors.New("POST only"))
+		return
+	}
+	s := peer.get()
+	if s == nil {
+		writeError(w, http.StatusServiceUnavailable, errors.New("peer disabled"))
+		return
+	}
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, 64<<10))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	ts := r.Header.Get(peerTimestampHeader)
+	sec, err := strconv.ParseInt(ts, 10, 64)
+	if err != nil || math.Abs(time.Since(time.Unix(sec, 0)).Seconds()) > peerMaxSkew.Seconds() {
+		writeError(w, http.StatusUnauthorized, errors.New("bad or stale timestamp"))
+		return
+	}
+	sig, err := hex.DecodeString(r.Header.Get(peerSignatureHeader))
+	want, _ := hex.DecodeString(peerSign(s.secret, ts, body))
+	if err != nil || !hmac.Equal(sig, want) {
+		writeError(w, http.StatusUnauthorized, errors.New("bad signature"))
+		return
+	}
+	if !peerSeen.first(string(sig), time.Unix(sec, 0).Add(peerMaxSkew), time.Now()) {
+		writeError(w, http.StatusConflict, errors.New("replayed request"))
+		return
+	}
+	var e Event
+	if err := json.Unmarshal(body, &e); err != nil {
+		writeError(w, http.StatusBadRequest, err)
+		return
+	}
+	//ipset 为 hash:ip inet, 只能加入 IPv4
+	if ip := net.ParseIP(e.IP); e.Event != EventBan || ip == nil || ip.To4() == nil || e.Origin == "" {
+		writeError(w, http.StatusBadRequest, fmt.Errorf("bad event %v %q from %q", e.Event, e.IP, e.Origin))
+		return
+	}
+	if e.Origin == s.name {
+		writeJSON(w, http.StatusOK, map[string]interface{}{"applied": false, "reason": "own ban"})
+		return
+	}
+	timeout := 0
+	if e.Duration > 0 {
+		left := time.Until(e.Time.Add(time.Duration(e.Duration) * time.Second))
+		if left <= 0 {
+			writeJSON(w, http.StatusOK, map[string]interface{}{"applied": false, "reason": "expired"})
+			return
+		}
+		timeout = int(math.Ceil(left.Seconds()))
+	}
+	ok := ban(banRequest{
+		IP:      e.IP,
+		Set:     s.ipset,
+		Timeout: timeout,
+		Rule:    "peer:" + e.Rule,
+		Count:   e.Count,
+		Ports:   e.Ports,
+		Origin:  e.Origin,
+		Text:    []interface{}{"peer", e.Origin, e.Rule},
+	})
+	writeJSON(w, http.StatusOK, map[string]interface{}{"applied": ok})
+}
+
+// servePeers 接收其他主机同步的封禁
+func servePeers(addr string) error {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return err
+	}
+	mux := http.NewServeMux()
+	mux.HandleFunc(peerPath, peerBanHandler)
+	fmt.Println("peer api on", addr)
+	go func() {
+		srv := &http.Server{Handler: mux, ReadTimeout: 10 * time.Second, WriteTimeout: 10 * time.Second}
+		log.Println("peer api:", srv.Serve(l))
+	}()
+	return nil
+}
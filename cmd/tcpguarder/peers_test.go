@@ -0,0 +1,66 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestPeerBanHandler(t *testing.T) {
+	s := &peerSettings{name: "web1", secret: "0123456789abcdef", ipset: "blackhold"}
+	peer.set(s)
+	defer peer.set(nil)
+	post := func(e Event, ts time.Time, secret string) int {
+		body, _ := json.Marshal(e)
+		sec := strconv.FormatInt(ts.Unix(), 10)
+		r := httptest.NewRequest(http.MethodPost, peerPath, bytes.NewReader(body))
+		r.Header.Set(peerTimestampHeader, sec)
+		r.Header.Set(peerSignatureHeader, peerSign(secret, sec, body))
+		w := httptest.NewRecorder()
+		peerBanHandler(w, r)
+		return w.Code
+	}
+	now := time.Now()
+	//本机发出的封禁不会再加入 ipset, 可以不调用 ipset 测试签名和重放
+	own := Event{Event: EventBan, IP: "203.0.113.9", Rule: "scan", Origin: "web1", Time: now}
+	tests := []struct {
+		name   string
+		e      Event
+		ts     time.Time
+		secret string
+		want   int
+	}{
+		{"bad signature", own, now, "fedcba9876543210", http.StatusUnauthorized},
+		{"stale", own, now.Add(-peerMaxSkew - time.Minute), s.secret, http.StatusUnauthorized},
+		{"future", own, now.Add(peerMaxSkew + time.Minute), s.secret, http.StatusUnauthorized},
+		{"ipv6", Event{Event: EventBan, IP: "2001:db8::1", Origin: "web2"}, now, s.secret, http.StatusBadRequest},
+		{"mapped ipv4", Event{Event: EventBan, IP: "::ffff:203.0.113.9", Origin: "web1"}, now, s.secret, http.StatusOK},
+		{"no origin", Event{Event: EventBan, IP: "203.0.113.9"}, now, s.secret, http.StatusBadRequest},
+		{"unban", Event{Event: EventUnban, IP: "203.0.113.9", Origin: "web2"}, now, s.secret, http.StatusBadRequest},
+		{"first", own, now, s.secret, http.StatusOK},
+		{"replay", own, now, s.secret, http.StatusConflict},
+		{"same event, new timestamp", own, now.Add(-time.Second), s.secret, http.StatusOK},
+	}
+	for _, tt := range tests {
+		if got := post(tt.e, tt.ts, tt.secret); got != tt.want {
+			t.Errorf("%v: status %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestPeerReplayExpire(t *testing.T) {
+	p := &peerReplay{seen: make(map[string]time.Time)}
+	t0 := time.Now()
+	if !p.first("a", t0.Add(peerMaxSkew), t0) || p.first("a", t0.Add(peerMaxSkew), t0.Add(time.Minute)) {
+		t.Fatal("second use of a signature inside the window accepted")
+	}
+	//窗口过后记录清除, 这时时间戳检查已经会拒绝
+	p.first("b", t0.Add(2*peerMaxSkew), t0.Add(peerMaxSkew+time.Second))
+	if _, ok := p.seen["a"]; ok || len(p.seen) != 1 {
+		t.Errorf("expired signatures kept: %v", p.seen)
+	}
+}
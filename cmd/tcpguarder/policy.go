@@ -0,0 +1,174 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/urfave/cli/v2"
+)
+
+// Policy 按本地端口的封禁策略, 每个策略有自己的阈值, ipset 和封禁时长
+type Policy struct {
+	Name      string
+	Ports     []int //空为所有端口
+	Kill      int
+	IPSet     string
+	Timeout   int
+	countries countryKill //只用于默认策略
+}
+
+func (p Policy) String() string {
+	ports := "all"
+	if len(p.Ports) > 0 {
+		ports = jointostring(p.Ports, ",")
+	}
+	return fmt.Sprintf("%v: ports %v kill if conn/ip >= %v, ipset %v timeout %v", p.Name, ports, p.Kill, p.IPSet, p.Timeout)
+}
+
+// limit 返回 ip 在此策略下的阈值
+func (p Policy) limit(ip string) int {
+	return p.countries.limit(ip, p.Kill)
+}
+
+type PolicyConfig struct {
+	Name    string   `yaml:"name"`
+	Ports   []string `yaml:"ports"` //端口或 port_groups 中的组名
+	Kill    int      `yaml:"kill"`
+	IPSet   string   `yaml:"ipset"`
+	Timeout int      `yaml:"timeout"`
+}
+
+// resolvePorts 端口或端口组名转换为端口
+func resolvePorts(items []string, groups map[string][]int) ([]int, error) {
+	var ports []int
+	for _, v := range items {
+		v = strings.TrimSpace(v)
+		if g, ok := groups[v]; ok {
+			ports = append(ports, g...)
+			continue
+		}
+		port, err := strconv.Atoi(v)
+		if err != nil || port <= 0 || port > 65535 {
+			return nil, fmt.Errorf("bad port or port group %q", v)
+		}
+		ports = append(ports, port)
+	}
+	return ports, nil
+}
+
+// parsePolicy 解析 NAME:PORTS:KILL[:IPSET[:TIMEOUT]], PORTS 为逗号分隔的端口或端口组名
+func parsePolicy(s string, groups map[string][]int) (PolicyConfig, error) {
+	var p PolicyConfig
+	ss := strings.Split(s, ":")
+	if len(ss) < 3 || len(ss) > 5 {
+		return p, fmt.Errorf("policy %q: want NAME:PORTS:KILL[:IPSET[:TIMEOUT]]", s)
+	}
+	p.Name = ss[0]
+	p.Ports = strings.Split(ss[1], ",")
+	var err error
+	if p.Kill, err = strconv.Atoi(ss[2]); err != nil {
+		return p, fmt.Errorf("policy %q: bad kill %q", s, ss[2])
+	}
+	if len(ss) > 3 {
+		p.IPSet = ss[3]
+	}
+	if len(ss) > 4 {
+		if p.Timeout, err = strconv.Atoi(ss[4]); err != nil {
+			return p, fmt.Errorf("policy %q: bad timeout %q", s, ss[4])
+		}
+	}
+	return p, nil
+}
+
+// newPolicy 未指定的 ipset 和 timeout 使用 -ipset 和 -timeout
+func newPolicy(pc PolicyConfig, groups map[string][]int, ipset string, timeout int) (Policy, error) {
+	ports, err := resolvePorts(pc.Ports, groups)
+	if err != nil {
+		return Policy{}, fmt.Errorf("policy %v: %v", pc.Name, err)
+	}
+	p := Policy{Name: pc.Name, Ports: ports, Kill: pc.Kill, IPSet: pc.IPSet, Timeout: pc.Timeout}
+	if p.IPSet == "" {
+		p.IPSet = ipset
+	}
+	if p.Timeout == 0 {
+		p.Timeout = timeout
+	}
+	if p.Name == "" {
+		return p, fmt.Errorf("policy without name")
+	}
+	if p.Kill <= 0 {
+		return p, fmt.Errorf("policy %v: kill must be positive", p.Name)
+	}
+	if len(p.IPSet) > 31 {
+		return p, fmt.Errorf("policy %v: ipset name longer than 31", p.Name)
+	}
+	if p.Timeout < 0 || p.Timeout > 2147483 {
+		return p, fmt.Errorf("policy %v: timeout must be 0-2147483", p.Name)
+	}
+	return p, nil
+}
+
+// loadPolicies 默认策略(-kill -port -ipset -timeout -kill-country) 加上 -policy,
+// 命令行未指定 -policy 时使用配置文件中的 policies
+func loadPolicies(c *cli.Context, geo *tcpguarder.GeoIP) ([]Policy, error) {
+	var policies []Policy
+	ipset, timeout := c.String("ipset"), c.Int("timeout")
+	if kill := c.Int("kill"); kill > 0 {
+		countries, err := parseCountryKill(c.StringSlice("kill-country"), geo)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, Policy{
+			Name:      "default",
+			Ports:     c.IntSlice("port"),
+			Kill:      kill,
+			IPSet:     ipset,
+			Timeout:   timeout,
+			countries: countries,
+		})
+	}
+	pcs := config.Policies
+	if c.IsSet("policy") {
+		pcs = nil
+		for _, s := range c.StringSlice("policy") {
+			pc, err := parsePolicy(s, config.PortGroups)
+			if err != nil {
+				return nil, err
+			}
+			pcs = append(pcs, pc)
+		}
+	}
+	for _, pc := range pcs {
+		p, err := newPolicy(pc, config.PortGroups, ipset, timeout)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+	return policies, nil
+}
+
+// killPolicy 按策略封禁连接数超过阈值的IP
+func killPolicy(p Policy, stats []tcpguarder.ConnStat, filter tcpguarder.Filter) {
+	ss := tcpguarder.TopFilter(stats, tcpguarder.And(tcpguarder.PortFilter(p.Ports), filter))
+	min := p.countries.min(p.Kill)
+	for _, v := range ss {
+		if v.N < min {
+			return
+		}
+		if v.N < p.limit(v.Key) {
+			continue
+		}
+		ban(banRequest{
+			IP:      v.Key,
+			Set:     p.IPSet,
+			Timeout: p.Timeout,
+			Rule:    "policy:" + p.Name,
+			Count:   v.N,
+			Ports:   p.Ports,
+			Text:    []interface{}{"policy", p.Name, "tcp", v.N},
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParsePolicy(t *testing.T) {
+	groups := map[string][]int{"web": {80, 443}}
+	tests := []struct {
+		in   string
+		want Policy
+		err  string //为空时应没有错误
+	}{
+		{in: "ssh:22:10:sshban:3600", want: Policy{Name: "ssh", Ports: []int{22}, Kill: 10, IPSet: "sshban", Timeout: 3600}},
+		{in: "web:80,443:300", want: Policy{Name: "web", Ports: []int{80, 443}, Kill: 300, IPSet: "blackhold", Timeout: 600}},
+		{in: "web:web,8080:100", want: Policy{Name: "web", Ports: []int{80, 443, 8080}, Kill: 100, IPSet: "blackhold", Timeout: 600}},
+		{in: "ssh:22:10:sshban", want: Policy{Name: "ssh", Ports: []int{22}, Kill: 10, IPSet: "sshban", Timeout: 600}},
+		{in: "ssh:22:10::0", want: Policy{Name: "ssh", Ports: []int{22}, Kill: 10, IPSet: "blackhold", Timeout: 600}},
+		{in: "ssh:22", err: `policy "ssh:22": want NAME:PORTS:KILL[:IPSET[:TIMEOUT]]`},
+		{in: "ssh:22:10:sshban:3600:x", err: `policy "ssh:22:10:sshban:3600:x": want NAME:PORTS:KILL[:IPSET[:TIMEOUT]]`},
+		{in: "ssh:22:ten", err: `policy "ssh:22:ten": bad kill "ten"`},
+		{in: "ssh:22:10:sshban:1h", err: `policy "ssh:22:10:sshban:1h": bad timeout "1h"`},
+		{in: "ssh:ssh:10", err: `policy ssh: bad port or port group "ssh"`},
+		{in: "ssh:0:10", err: `policy ssh: bad port or port group "0"`},
+		{in: "ssh:65536:10", err: `policy ssh: bad port or port group "65536"`},
+		{in: "ssh::10", err: `policy ssh: bad port or port group ""`},
+		{in: ":22:10", err: "policy without name"},
+		{in: "ssh:22:0", err: "policy ssh: kill must be positive"},
+		{in: "ssh:22:10:" + strings.Repeat("x", 32), err: "policy ssh: ipset name longer than 31"},
+		{in: "ssh:22:10:sshban:-1", err: "policy ssh: timeout must be 0-2147483"},
+		{in: "ssh:22:10:sshban:2147484", err: "policy ssh: timeout must be 0-2147483"},
+	}
+	for _, tt := range tests {
+		pc, err := parsePolicy(tt.in, groups)
+		var p Policy
+		if err == nil {
+			p, err = newPolicy(pc, groups, "blackhold", 600)
+		}
+		if tt.err != "" {
+			if err == nil || err.Error() != tt.err {
+				t.Errorf("%q: err = %v, want %v", tt.in, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%q: %v", tt.in, err)
+			continue
+		}
+		if !reflect.DeepEqual(p, tt.want) {
+			t.Errorf("%q = %+v, want %+v", tt.in, p, tt.want)
+		}
+	}
+}
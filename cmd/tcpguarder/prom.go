@@ -0,0 +1,44 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// promWriter Prometheus 文本格式
+// https://prometheus.io/docs/instrumenting/exposition_formats/
+type promWriter struct {
+	w io.Writer
+}
+
+func (p promWriter) header(name, typ, help string) {
+	fmt.Fprintf(p.w, "# HELP %v %v\n# TYPE %v %v\n", name, help, name, typ)
+}
+
+// sample labels 为 key, value 交替
+func (p promWriter) sample(name string, value float64, labels ...string) {
+	var b strings.Builder
+	b.WriteString(name)
+	if len(labels) > 0 {
+		b.WriteByte('{')
+		for i := 0; i+1 < len(labels); i += 2 {
+			if i > 0 {
+				b.WriteByte(',')
+			}
+			fmt.Fprintf(&b, "%v=\"%v\"", labels[i], promEscape(labels[i+1]))
+		}
+		b.WriteByte('}')
+	}
+	b.WriteByte(' ')
+	b.WriteString(strconv.FormatFloat(value, 'g', -1, 64))
+	b.WriteByte('\n')
+	io.WriteString(p.w, b.String())
+}
+
+var promEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func promEscape(s string) string {
+	return promEscaper.Replace(s)
+}
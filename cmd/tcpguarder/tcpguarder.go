@@ -1,14 +1,17 @@
 package main
 
 import (
+	"errors"
 	"fmt"
-	"io/ioutil"
+	"io"
 	"log"
 	"net"
 	"os"
+	"os/signal"
 	"sort"
 	"strconv"
 	"strings"
+	"syscall"
 	"time"
 
 	"github.com/lixiangzhong/tcpguarder"
@@ -17,7 +20,8 @@ import (
 )
 
 var (
-	whiteip = make(map[*net.IPNet]bool)
+	whitelist = tcpguarder.NewAllowlist()
+	policies  []Policy
 )
 
 func main() {
@@ -27,27 +31,132 @@ func main() {
 	app.EnableBashCompletion = true
 	app.Flags = []cli.Flag{
 		&FLagTop, &FlagPort, &FlagIPSetName, &FlagIPSetTimeout, &FlagWhiteIPFile, &FlagAbnormal,
+		&FlagRules, &FlagFilter, &FlagGroupBy, &FlagGeoIPCountry, &FlagGeoIPASN, &FlagConfig, &FlagSource,
+		&FlagOutput,
 	}
-	app.Before = showPortsAction
+	app.Before = beforeApp
 	app.Action = ShowTopAction
 	app.Commands = []*cli.Command{
 		&cli.Command{
 			Name:        "run",
 			Usage:       "block ip auto",
-			Description: "example: run -kill=200",
+			Description: "example: run -kill=200 -policy ssh:22:10:sshban:3600",
 			Before:      BeforeKill,
 			Action:      KillAction,
-			Flags:       []cli.Flag{&FlagPort, &FlagKill, &FlagIPSetName, &FlagIPSetTimeout, &FlagWhiteIPFile, &FlagDuraion},
+			After:       AfterKill,
+			Flags: []cli.Flag{
+				&FlagPort, &FlagKill, &FlagIPSetName, &FlagIPSetTimeout, &FlagWhiteIPFile, &FlagDuraion,
+				&FlagScan, &FlagScanWindow, &FlagTrapPort, &FlagTrapTimeout, &FlagRules, &FlagKillScore, &FlagFilter,
+				&FlagAutoWhite, &FlagSSHPort, &FlagCrawler, &FlagCrawlerDNS, &FlagCrawlerTTL,
+				&FlagGeoIPCountry, &FlagGeoIPASN, &FlagKillCountry, &FlagConfig, &FlagSource, &FlagPolicy,
+				&FlagPidFile, &FlagCleanup, &FlagMetrics, &FlagMetricsTop, &FlagSocket,
+				&FlagLogFormat, &FlagLogOutput, &FlagWebhook, &FlagHookExec,
+				&FlagMailSMTP, &FlagMailUser, &FlagMailPassword, &FlagMailFrom, &FlagMailTo,
+				&FlagMailEvery, &FlagMailBurst, &FlagMailMinInterval,
+				&FlagPeer, &FlagPeerListen, &FlagPeerSecret, &FlagPeerName,
+			},
+		},
+		&cli.Command{
+			Name:        "exporter",
+			Usage:       "serve prometheus metrics of tcp connections only, no blocking",
+			Description: "example: exporter -metrics :9107 -metrics-top 20 -every 15s",
+			Before:      applyConfig,
+			Action:      ExporterAction,
+			Flags: []cli.Flag{
+				&FlagMetrics, &FlagMetricsTop, &FlagDuraion, &FlagPort, &FlagFilter, &FlagConfig, &FlagSource,
+			},
+		},
+		&cli.Command{
+			Name:        "watch",
+			Usage:       "live top, refresh every -duration, ban or white list the selected ip with hotkeys",
+			Description: "example: watch -p 443 -every 2s",
+			Before:      applyConfig,
+			Action:      WatchAction,
+			Flags: []cli.Flag{
+				&FlagPort, &FlagFilter, &FlagRules, &FlagDuraion, &FlagGeoIPCountry, &FlagGeoIPASN,
+				&FlagIPSetName, &FlagIPSetTimeout, &FlagWhiteIPFile, &FlagAutoWhite, &FlagSSHPort,
+				&FlagSocket, &FlagConfig, &FlagSource,
+			},
+		},
+		&cli.Command{
+			Name:        "ctl",
+			Usage:       "control a running tcpguarder run through its unix socket",
+			Description: "example: ctl ban 203.0.113.9, ctl whitelist add 198.51.100.0/24, ctl pause",
+			Flags:       []cli.Flag{&FlagSocket},
+			Subcommands: []*cli.Command{
+				&cli.Command{Name: "status", Usage: "show status", Action: CtlStatusAction},
+				&cli.Command{Name: "top", Usage: "show top list of the last scan", Action: CtlTopAction, Flags: []cli.Flag{&FLagTop, &FlagAbnormal}},
+				&cli.Command{Name: "bans", Usage: "list active bans", Action: CtlBansAction, Flags: []cli.Flag{&FlagOutput}},
+				&cli.Command{Name: "ban", Usage: "ban IP", Action: CtlBanAction, Flags: []cli.Flag{&FlagIPSetName, &FlagIPSetTimeout}},
+				&cli.Command{Name: "unban", Usage: "unban IP", Action: CtlUnbanAction},
+				&cli.Command{
+					Name:  "whitelist",
+					Usage: "change white list at runtime",
+					Subcommands: []*cli.Command{
+						&cli.Command{Name: "add", Usage: "add IP or CIDR", Action: CtlWhiteAddAction},
+						&cli.Command{Name: "remove", Usage: "remove IP or CIDR", Action: CtlWhiteRemoveAction},
+					},
+				},
+				&cli.Command{Name: "pause", Usage: "stop banning, keep scanning", Action: CtlPauseAction},
+				&cli.Command{Name: "resume", Usage: "resume banning", Action: CtlResumeAction},
+			},
 		},
 		&cli.Command{
 			Name:   "china",
 			Usage:  "create china ipset",
 			Action: CreateChinaIPSet,
+			Flags:  []cli.Flag{&FlagIPLib},
 		},
 		&cli.Command{
 			Name:   "notchina",
 			Usage:  "create not-china ipset",
 			Action: CreateNotChinaIPSet,
+			Flags:  []cli.Flag{&FlagIPLib},
+		},
+		&cli.Command{
+			Name:        "geoset",
+			Usage:       "create country ipset from RIR delegated-stats files",
+			Description: "example: geoset -file delegated-apnic-latest -cc CN -cc HK -name cnhk",
+			Action:      GeoSetAction,
+			Flags:       []cli.Flag{&FlagRIRFile, &FlagCountry, &FlagGeoSetName},
+		},
+		&cli.Command{
+			Name:        "cidr",
+			Usage:       "verify and minimise cidr list files",
+			Description: "example: cidr -out china-min.txt china-cidr.txt",
+			Action:      CIDRCheckAction,
+			Flags:       []cli.Flag{&FlagCIDROut},
+		},
+		&cli.Command{
+			Name:  "config",
+			Usage: "config file tools",
+			Subcommands: []*cli.Command{
+				&cli.Command{
+					Name:        "check",
+					Usage:       "validate config file",
+					Description: "example: config check -config /etc/tcpguarder.yml",
+					Action:      ConfigCheckAction,
+					Flags:       []cli.Flag{&FlagConfig},
+				},
+			},
+		},
+		&cli.Command{
+			Name:        "mail",
+			Usage:       "send a digest of the current connections now, to test the mail settings of run",
+			Description: "example: mail -mail-smtp 127.0.0.1:25 -mail-to ops@example.com -p 443",
+			Before:      applyConfig,
+			Action:      MailAction,
+			Flags: []cli.Flag{
+				&FlagMailSMTP, &FlagMailUser, &FlagMailPassword, &FlagMailFrom, &FlagMailTo,
+				&FlagPort, &FlagFilter, &FlagRules, &FlagGeoIPCountry, &FlagGeoIPASN, &FlagConfig, &FlagSource,
+			},
+		},
+		&cli.Command{
+			Name:        "blocklist",
+			Usage:       "sync blocklist files or urls (spamhaus drop, firehol netset, plain ip/cidr) into ipset",
+			Description: "example: blocklist -name drop -every 12h drop.txt https://www.spamhaus.org/drop/edrop.txt firehol_level1.netset",
+			Action:      BlocklistAction,
+			Flags:       []cli.Flag{&FlagBlocklistName, &FlagBlocklistEvery},
 		},
 	}
 	sort.Sort(cli.FlagsByName(app.Flags))
@@ -57,136 +166,476 @@ func main() {
 }
 
 func ShowTopAction(c *cli.Context) (err error) {
+	if err = checkOutput(c.String("output")); err != nil {
+		return
+	}
+	if err = loadGeoIP(c); err != nil {
+		return
+	}
+	filter, err := connFilter(c)
+	if err != nil {
+		return
+	}
+	if c.String("group-by") != "" {
+		return ShowGroupAction(c, filter)
+	}
 	var ss []tcpguarder.CountItem
+	view := "top"
 	if c.Bool("ab") {
-		ss, err = TopAbnormal(c.IntSlice("port"))
+		var rules tcpguarder.RuleSet
+		rules, err = loadRules(c.String("rules"))
+		if err != nil {
+			return
+		}
+		ss, err = TopAbnormal(rules, filter)
 		if err != nil {
 			return
 		}
+		view = "abnormal"
 	} else {
-		ss, err = tcpguarder.Top(c.IntSlice("port"))
+		var stats []tcpguarder.ConnStat
+		stats, err = tcpguarder.ConnStats()
 		if err != nil {
 			return
 		}
+		ss = tcpguarder.TopFilter(stats, filter)
 	}
-	total := 0
-	for i, v := range ss {
-		total += v.N
-		if i > c.Int("top") {
-			continue
+	return writeTop(os.Stdout, c.String("output"), view, ss, c.Int("top"))
+}
+
+func ShowGroupAction(c *cli.Context, filter tcpguarder.Filter) error {
+	by, err := tcpguarder.ParseGroupBy(c.String("group-by"))
+	if err != nil {
+		return err
+	}
+	stats, err := tcpguarder.ConnStats()
+	if err != nil {
+		return err
+	}
+	var items []tcpguarder.GroupItem
+	view := "group"
+	if c.Bool("ab") {
+		rules, err := loadRules(c.String("rules"))
+		if err != nil {
+			return err
+		}
+		items, err = tcpguarder.GroupFunc(stats, filter, by, rules.Score)
+		if err != nil {
+			return err
+		}
+		view = "abnormal_group"
+	} else {
+		items, err = tcpguarder.Group(stats, filter, by)
+		if err != nil {
+			return err
 		}
-		fmt.Printf("%v\t%v\n", v.Key, v.N)
 	}
-	fmt.Println("\ntotal\nip:", len(ss), "tcp:", total)
-	return
+	return writeGroup(os.Stdout, c.String("output"), view, items, c.Int("top"))
 }
 
-func TopAbnormal(ports []int) ([]tcpguarder.CountItem, error) {
-	allport := len(ports) == 0
+func TopAbnormal(rules tcpguarder.RuleSet, filter tcpguarder.Filter) ([]tcpguarder.CountItem, error) {
 	stats, err := tcpguarder.ConnStats()
 	if err != nil {
 		return nil, err
 	}
-	result := make([]tcpguarder.CountItem, 0)
-	m := map[string]int{}
-	for _, v := range stats {
-		if allport {
-			if isAbnormalLink(v) {
-				m[v.Remote.IP.String()]++
-			}
-			continue
-		}
-		for _, port := range ports {
-			if v.Local.Port == uint16(port) {
-				if isAbnormalLink(v) {
-					m[v.Remote.IP.String()]++
-				}
-			}
+	return rules.TopFilter(stats, filter), nil
+}
+
+// connFilter 组合 -port 与 -filter
+func connFilter(c *cli.Context) (tcpguarder.Filter, error) {
+	filter, err := exprFilter(c)
+	if err != nil {
+		return nil, err
+	}
+	return tcpguarder.And(tcpguarder.PortFilter(c.IntSlice("port")), filter), nil
+}
+
+// exprFilter -filter, 未指定时为 nil
+func exprFilter(c *cli.Context) (tcpguarder.Filter, error) {
+	expr := c.String("filter")
+	if expr == "" {
+		return nil, nil
+	}
+	f, err := tcpguarder.ParseFilter(expr)
+	if err != nil {
+		return nil, fmt.Errorf("filter: %v", err)
+	}
+	return f, nil
+}
+
+// loadRules 优先 -rules 文件, 其次配置文件中的 rules, 都没有时使用默认规则
+func loadRules(file string) (tcpguarder.RuleSet, error) {
+	if file != "" {
+		return tcpguarder.LoadRules(file)
+	}
+	rules, err := config.RuleSet()
+	if err != nil || len(rules) > 0 {
+		return rules, err
+	}
+	return tcpguarder.DefaultRules, nil
+}
+
+// guard run 的检测和封禁参数, 启动和 SIGHUP 重新加载配置时生成
+type guard struct {
+	c           *cli.Context
+	duration    time.Duration
+	ipset       string
+	timeout     int
+	filter      tcpguarder.Filter
+	userFilter  tcpguarder.Filter //策略有各自的端口, 只叠加 -filter
+	scan        int
+	scanner     *tcpguarder.ScanDetector
+	traps       []int
+	trapTimeout int
+	killScore   int
+	rules       tcpguarder.RuleSet
+	policies    []Policy
+	white       whiteSettings
+	whiteMod    time.Time
+	sshKey      string //白名单中的 ssh 客户端
+}
+
+// newGuard old 不为 nil 时沿用其端口扫描记录
+func newGuard(c *cli.Context, old *guard, policies []Policy) (*guard, error) {
+	g := &guard{
+		c:           c,
+		duration:    c.Duration("duration"),
+		ipset:       c.String("ipset"),
+		timeout:     c.Int("timeout"),
+		scan:        c.Int("scan"),
+		traps:       c.IntSlice("trap"),
+		trapTimeout: c.Int("trap-timeout"),
+		killScore:   c.Int("kill-score"),
+		policies:    policies,
+		white:       loadWhiteSettings(c),
+	}
+	g.whiteMod = g.white.modtime()
+	if g.white.auto {
+		stats, _ := tcpguarder.ConnStats()
+		g.sshKey = sshKey(sshClients(stats, g.white.sshPorts))
+	}
+	var err error
+	if g.filter, err = connFilter(c); err != nil {
+		return nil, err
+	}
+	if g.userFilter, err = exprFilter(c); err != nil {
+		return nil, err
+	}
+	if g.rules, err = loadRules(c.String("rules")); err != nil {
+		return nil, err
+	}
+	if old != nil {
+		g.scanner = old.scanner
+		g.scanner.Window = c.Duration("scan-window")
+	} else {
+		g.scanner = tcpguarder.NewScanDetector(c.Duration("scan-window"))
+	}
+	return g, nil
+}
+
+func (g *guard) print() {
+	fmt.Printf("every %v\n", g.duration)
+	for _, p := range g.policies {
+		fmt.Println("policy", p)
+		for cc, n := range p.countries {
+			fmt.Printf("kill if conn/ip >= %v for country %v\n", n, cc)
 		}
 	}
-	for k, v := range m {
-		result = append(result, tcpguarder.CountItem{
-			Key: k,
-			N:   v,
-		})
+	if g.scan > 0 {
+		fmt.Printf("kill if distinct ports/ip >= %v in %v\n", g.scan, g.scanner.Window)
+	}
+	if len(g.traps) > 0 {
+		fmt.Printf("kill %vs if connect to trap ports %v\n", g.trapTimeout, g.traps)
+	}
+	if g.killScore > 0 {
+		fmt.Printf("kill if abnormal score/ip >= %v, rules: %v\n", g.killScore, len(g.rules))
 	}
-	sort.Slice(result, func(i, j int) bool {
-		return result[i].N > result[j].N
-	})
-	return result, nil
 }
-func isAbnormalLink(l tcpguarder.ConnStat) bool {
-	switch l.Stat {
-	case tcpguarder.CLOSING, tcpguarder.FIN_WAIT1:
-		return true
+
+func (g *guard) do() {
+	c := g.c
+	start := time.Now()
+	stats, err := tcpguarder.ConnStats()
+	defer func() {
+		runMetrics.observe(stats, time.Since(start), err, ipsetNames(c))
+	}()
+	if err != nil {
+		log.Println(err)
+		return
 	}
-	if l.CongestionWindow == 1 && l.TxQueue != 0 && l.RxQueue != 0 {
-		return true
+	control.update(g, stats)
+	g.checkSSH(stats)
+	for _, v := range bans.expire(time.Now()) {
+		events.emit(Event{Event: EventUnban, IP: v.IP, Rule: v.Rule, Backend: "ipset:" + v.Set, Reason: "timeout"},
+			"unblock", v.IP, v.Set, "timeout")
 	}
-	if l.TimerActive == 1 && l.RTOTimeouts > 3 {
-		return true
+	if control.isPaused() {
+		return
+	}
+	if g.scan > 0 {
+		g.scanner.Observe(stats, time.Now())
+		for _, v := range g.scanner.Scanners(g.scan) {
+			ok := ban(banRequest{
+				IP:      v.IP,
+				Set:     g.ipset,
+				Timeout: g.timeout,
+				Rule:    "scan",
+				Count:   v.Ports,
+				Text:    []interface{}{"scan ports", v.Ports, "halfopen", v.HalfOpen},
+			})
+			if ok {
+				g.scanner.Forget(v.IP)
+			}
+		}
+	}
+	if len(g.traps) > 0 {
+		for _, v := range tcpguarder.TopStats(stats, g.traps) {
+			ban(banRequest{
+				IP:      v.Key,
+				Set:     g.ipset,
+				Timeout: g.trapTimeout,
+				Exist:   true,
+				Rule:    "trap",
+				Count:   v.N,
+				Ports:   g.traps,
+				Text:    []interface{}{"trap tcp", v.N},
+			})
+		}
+	}
+	if g.killScore > 0 {
+		for _, v := range g.rules.TopFilter(stats, g.filter) {
+			if v.N < g.killScore {
+				break
+			}
+			ban(banRequest{
+				IP:      v.Key,
+				Set:     g.ipset,
+				Timeout: g.timeout,
+				Rule:    "abnormal",
+				Count:   v.N,
+				Text:    []interface{}{"abnormal score", v.N},
+			})
+		}
+	}
+	for _, p := range g.policies {
+		killPolicy(p, stats, g.userFilter)
 	}
-	return false
 }
 
 func KillAction(c *cli.Context) error {
-	ports := c.IntSlice("port")
-	duraion := c.Duration("duration")
-	tk := time.NewTicker(duraion)
+	g, err := newGuard(c, nil, policies)
+	if err != nil {
+		return err
+	}
+	g.print()
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGTERM, syscall.SIGINT, syscall.SIGHUP)
+	defer signal.Stop(sigs)
+	tk := time.NewTicker(g.duration)
 	defer tk.Stop()
-	kill := c.Int("kill")
-	fmt.Printf("every %v kill if conn/ip >= %v\n", duraion, kill)
-	do := func() {
-		ss, err := tcpguarder.Top(ports)
-		if err != nil {
-			log.Println(err)
-			return
-		}
-		for _, v := range ss {
-			if v.N >= kill {
-				if isWhiteIP(v.Key) {
-					continue
-				}
-				if blockip(c, v.Key) {
-					log.Println("block", v.Key, "tcp", v.N)
+	wt := time.NewTicker(whiteCheckInterval)
+	defer wt.Stop()
+	var watchdog <-chan time.Time
+	if d := sdWatchdog(); d > 0 {
+		wd := time.NewTicker(d / 2)
+		defer wd.Stop()
+		watchdog = wd.C
+	}
+	g.do()
+	sdNotify("READY=1")
+	for {
+		select {
+		case <-tk.C:
+			g.do()
+		case <-wt.C:
+			g.checkWhitelist()
+		case <-watchdog:
+			sdNotify("WATCHDOG=1")
+		case sig := <-sigs:
+			if sig == syscall.SIGHUP {
+				log.Println("SIGHUP, reload config")
+				sdNotify("RELOADING=1")
+				if ng, err := reloadGuard(c, g); err != nil {
+					log.Println("reload:", err)
+				} else {
+					g = ng
+					tk.Reset(g.duration)
+					g.print()
 				}
+				sdNotify("READY=1")
 				continue
 			}
-			return
+			log.Println(sig, "shutdown")
+			sdNotify("STOPPING=1")
+			if c.Bool("cleanup") {
+				cleanupIPSets(c)
+			}
+			return nil
 		}
 	}
-	do()
-	for range tk.C {
-		do()
-	}
-	return nil
 }
 
-func isWhiteIP(ip string) bool {
-	for ipnet := range whiteip {
-		if ipnet.Contains(net.ParseIP(ip)) {
-			return true
-		}
+func beforeApp(c *cli.Context) error {
+	if err := applyConfig(c); err != nil {
+		return err
 	}
-	return false
+	return showPortsAction(c)
 }
 
+// showPortsAction 打印监听的端口, -output json/csv 时写到 stderr, 不破坏 stdout 的输出格式
 func showPortsAction(c *cli.Context) error {
 	ports := c.IntSlice("port")
 	if len(ports) > 0 {
-		fmt.Println("local ports:", ports)
+		w := io.Writer(os.Stdout)
+		if c.String("output") != outputTable {
+			w = os.Stderr
+		}
+		fmt.Fprintln(w, "local ports:", ports)
 	}
 	return nil
 }
 
 func BeforeKill(c *cli.Context) error {
+	if err := applyConfig(c); err != nil {
+		return err
+	}
+	if file := c.String("pid-file"); file != "" {
+		f, err := lockPidFile(file)
+		if err != nil {
+			return err
+		}
+		pidFile = f
+	}
+	if err := setupKill(c); err != nil {
+		return err
+	}
+	go digest.run()
+	//-metrics 只在启动时监听, 修改需要重启, -metrics-top 重新加载配置时生效
+	if addr := c.String("metrics"); addr != "" {
+		l, err := net.Listen("tcp", addr)
+		if err != nil {
+			return fmt.Errorf("metrics: %v", err)
+		}
+		go func() {
+			log.Println("metrics:", serveMetrics(l, runMetrics))
+		}()
+	}
+	if addr := c.String("peer-listen"); addr != "" {
+		if err := servePeers(addr); err != nil {
+			return err
+		}
+	}
+	if socket := c.String("socket"); socket != "" {
+		if err := serveControl(socket); err != nil {
+			return fmt.Errorf("control api: %v", err)
+		}
+	}
+	return nil
+}
+
+func AfterKill(c *cli.Context) error {
+	if controlSocket != "" {
+		os.Remove(controlSocket)
+	}
+	hooks.stop(10 * time.Second)
+	unlockPidFile(pidFile)
+	return nil
+}
+
+// setupKill 启动时创建 ipset, 加载白名单等
+func setupKill(c *cli.Context) error {
+	s, err := prepareKill(c)
+	if err != nil {
+		return err
+	}
+	s.apply(c)
+	return nil
+}
+
+// killSetup 根据参数生成的新状态, 全部生成成功后才由 apply 替换当前状态
+type killSetup struct {
+	log      *eventLog
+	geo      *tcpguarder.GeoIP
+	policies []Policy
+	hooks    []*Hook
+	peers    []*Hook
+	peer     *peerSettings
+	mail     mailSettings
+	white    *tcpguarder.Allowlist
+	crawler  *tcpguarder.CrawlerVerifier
+}
+
+// prepareKill 只读取参数和文件, 不改变当前状态, 失败时已打开的日志输出会关闭
+func prepareKill(c *cli.Context) (_ *killSetup, err error) {
+	s := &killSetup{}
+	if s.log, err = openEventLog(c.String("log-format"), c.String("log-output")); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			s.log.close()
+		}
+	}()
+	if s.geo, err = openGeoIP(c); err != nil {
+		return nil, err
+	}
+	if s.policies, err = loadPolicies(c, s.geo); err != nil {
+		return nil, err
+	}
+	if len(s.policies) == 0 && c.Int("kill-score") <= 0 && c.Int("scan") <= 0 && len(c.IntSlice("trap")) == 0 {
+		return nil, errors.New("nothing to do, need -kill, -policy, -kill-score, -scan or -trap")
+	}
+	if s.hooks, err = loadHooks(c); err != nil {
+		return nil, err
+	}
+	if s.mail, err = loadMailSettings(c); err != nil {
+		return nil, err
+	}
+	if s.peer, s.peers, err = loadPeers(c); err != nil {
+		return nil, err
+	}
+	s.white = buildWhitelist(loadWhiteSettings(c))
+	if domains := c.StringSlice("crawler"); len(domains) > 0 {
+		s.crawler = tcpguarder.NewCrawlerVerifier(domains, c.String("crawler-dns"))
+		s.crawler.TTL = c.Duration("crawler-ttl")
+	}
+	return s, nil
+}
+
+// apply 换入新状态, 创建 ipset 并打印需要的 iptables 规则
+func (s *killSetup) apply(c *cli.Context) {
+	events.replace(s.log)
 	showPortsAction(c)
+	tcpguarder.SetGeo(s.geo)
+	runMetrics.setTop(c.Int("metrics-top"))
+	policies = s.policies
+	hooks.replace(append(s.hooks, s.peers...))
+	for _, h := range s.hooks {
+		fmt.Println("hook", h)
+	}
+	peer.set(s.peer)
+	if s.peer != nil {
+		fmt.Printf("peer name %v, bans from peers go to ipset %v\n", s.peer.name, s.peer.ipset)
+		for _, h := range s.peers {
+			fmt.Println("share bans with", h)
+		}
+	}
+	mail := s.mail
+	digest.configure(mail)
+	if mail.smtp != "" {
+		fmt.Printf("mail digest to %v via %v every %v, burst %v, min interval %v\n",
+			mail.to, mail.smtp, mail.every, mail.burst, mail.minInterval)
+	}
 	name := c.String("ipset")
 	timeout := c.Int("timeout")
-	err := createipset(name, timeout)
-	if err == nil {
+	if createipset(name, timeout) == nil {
 		fmt.Printf("ipset create %v hash:ip timeout %v\n", name, timeout)
 	}
+	for _, p := range policies {
+		if p.IPSet != name && createipset(p.IPSet, p.Timeout) == nil {
+			fmt.Printf("ipset create %v hash:ip timeout %v\n", p.IPSet, p.Timeout)
+		}
+	}
 	ports := c.IntSlice("port")
 	fmt.Println("please confirm the following iptable is in effect")
 	if len(ports) > 0 {
@@ -194,33 +643,21 @@ func BeforeKill(c *cli.Context) error {
 		fmt.Println("or")
 	}
 	fmt.Printf("iptables -I INPUT -p tcp -m set --match-set %v src -j DROP\n", name)
-	fmt.Println("load white ip file:", c.String("white"))
-	b, err := ioutil.ReadFile(c.String("white"))
-	if err != nil {
-		log.Println(err)
-	}
-	for _, v := range strings.Fields(string(b)) {
-		ip := net.ParseIP(v)
-		if ip.To4() != nil {
-			v += "/32"
-		}
-		if _, ipnet, err := net.ParseCIDR(v); err == nil {
-			whiteip[ipnet] = true
-		}
-	}
-	fmt.Println("white ip num:", len(whiteip))
-	for _, v := range LocalIPList() {
-		v = v.To4()
-		if v == nil {
+	for _, p := range policies {
+		if p.IPSet == name {
 			continue
 		}
-		if _, ipnet, err := net.ParseCIDR(v.String() + "/32"); err == nil {
-			fmt.Println("local ip:", v)
-			whiteip[ipnet] = true
+		if len(p.Ports) > 0 {
+			fmt.Printf("iptables -I INPUT -p tcp -m set --match-set %v src -m multiport --dports %v -j DROP\n", p.IPSet, jointostring(p.Ports, ","))
+			continue
 		}
+		fmt.Printf("iptables -I INPUT -p tcp -m set --match-set %v src -j DROP\n", p.IPSet)
+	}
+	whitelist.Replace(s.white)
+	crawlers.set(s.crawler)
+	if s.crawler != nil {
+		fmt.Println("verified crawler domains:", c.StringSlice("crawler"))
 	}
-	fmt.Println("white ip num:", len(whiteip))
-	return nil
 }
 
 func jointostring(elems []int, sep string) string {
@@ -235,42 +672,48 @@ func createipset(name string, timeout int) error {
 	return tcpguarder.NewCmd(fmt.Sprintf("ipset create %v hash:ip timeout %v", name, timeout)).Run()
 }
 
-func blockip(c *cli.Context, ip string) bool {
-	return tcpguarder.NewCmd(fmt.Sprintf("ipset add %v %v", c.String("ipset"), ip)).Run() == nil
-}
-
 func CreateChinaIPSet(c *cli.Context) error {
 	fmt.Println("please confirm the following iptable is in effect")
 	fmt.Println("iptables -I INPUT -p tcp -m set --match-set china src -j DROP")
 	fmt.Println("iptables -I INPUT -p tcp -m set --match-set china src -m multiport --dports 80,443 -j DROP")
-	err := tcpguarder.NewCmd("ipset create china hash:net").Run()
-	if err != nil {
-		log.Println(err)
-	}
-	b, err := iplib.Asset("china-cidr.txt")
-	if err != nil {
-		return err
-	}
-	for _, v := range strings.Fields(string(b)) {
-		tcpguarder.NewCmd(fmt.Sprintf("ipset add china %v", v)).Run()
-	}
-	return nil
+	return createAssetIPSet("china", "china-cidr.txt", c.String("iplib"))
 }
 
 func CreateNotChinaIPSet(c *cli.Context) error {
 	fmt.Println("please confirm the following iptable is in effect")
 	fmt.Println("iptables -I INPUT -p tcp -m set --match-set notchina src -j DROP")
 	fmt.Println("iptables -I INPUT -p tcp -m set --match-set notchina src -m multiport --dports 80,443 -j DROP")
-	err := tcpguarder.NewCmd("ipset create notchina hash:net").Run()
+	return createAssetIPSet("notchina", "not-china-cidr.txt", c.String("iplib"))
+}
+
+// createAssetIPSet 合并 iplib 中的列表后写入 ipset name(IPv4),
+// -iplib 覆盖的列表中有 IPv6 时写入 name6
+func createAssetIPSet(name, asset, override string) error {
+	fmt.Println("load cidr list:", iplib.Source(asset, override))
+	b, err := iplib.Load(asset, override)
 	if err != nil {
-		log.Println(err)
+		return err
 	}
-	b, err := iplib.Asset("not-china-cidr.txt")
+	nets, err := iplib.Minimise(b)
 	if err != nil {
 		return err
 	}
-	for _, v := range strings.Fields(string(b)) {
-		tcpguarder.NewCmd(fmt.Sprintf("ipset add notchina %v", v)).Run()
+	v4, v6 := iplib.SplitFamily(nets)
+	if len(v6) > 0 {
+		if err := checkRestoreName(name + "6"); err != nil {
+			return err
+		}
+	}
+	if err := restoreipset(name, "inet", netsToStrings(v4)); err != nil {
+		return err
+	}
+	fmt.Printf("ipset %v: %v cidr\n", name, len(v4))
+	if len(v6) > 0 {
+		if err := restoreipset(name+"6", "inet6", netsToStrings(v6)); err != nil {
+			return err
+		}
+		fmt.Printf("ipset %v6: %v cidr\n", name, len(v6))
+		fmt.Printf("ip6tables -I INPUT -p tcp -m set --match-set %v6 src -j DROP\n", name)
 	}
 	return nil
 }
@@ -0,0 +1,69 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/urfave/cli/v2"
+)
+
+func TestTrapBanOnce(t *testing.T) {
+	logfile, cleanup := fakeIPSet(t)
+	defer cleanup()
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	//203.0.113.50 连接陷阱端口 23, 198.51.100.7 连接 80
+	source := filepath.Join(dir, "tcp")
+	lines := `  sl  local_address rem_address   st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 0100000A:0017 327100CB:C350 01 00000000:00000000 00:00000000 00000000     0        0 101 1 0000000000000000 20 4 30 10 -1
+   1: 0100000A:0050 076433C6:C351 01 00000000:00000000 00:00000000 00000000     0        0 102 1 0000000000000000 20 4 30 10 -1
+`
+	if err := ioutil.WriteFile(source, []byte(lines), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer func(s []string) { tcpguarder.Sources = s }(tcpguarder.Sources)
+	tcpguarder.Sources = []string{source}
+	count := func() uint64 {
+		runMetrics.Lock()
+		defer runMetrics.Unlock()
+		return runMetrics.bans["trap"]
+	}
+	before := count()
+
+	app := cli.NewApp()
+	app.Flags = []cli.Flag{&cli.StringFlag{Name: "ipset", Value: "blackhold"}}
+	app.Action = func(c *cli.Context) error {
+		g := &guard{
+			c:           c,
+			ipset:       "blackhold",
+			traps:       []int{23},
+			trapTimeout: 86400,
+			scanner:     tcpguarder.NewScanDetector(0),
+		}
+		for i := 0; i < 3; i++ {
+			g.do()
+		}
+		return nil
+	}
+	if err := app.Run([]string{"tcpguarder"}); err != nil {
+		t.Fatal(err)
+	}
+	defer bans.remove("203.0.113.50")
+	//第一次命中就封禁, 之后每次检测只刷新超时
+	b, _ := ioutil.ReadFile(logfile)
+	want := "add blackhold 203.0.113.50 timeout 86400\n" +
+		"add blackhold 203.0.113.50 timeout 86400\n-exist add blackhold 203.0.113.50 timeout 86400\n" +
+		"add blackhold 203.0.113.50 timeout 86400\n-exist add blackhold 203.0.113.50 timeout 86400\n"
+	if string(b) != want {
+		t.Errorf("ipset calls:\n%s", b)
+	}
+	if n := count() - before; n != 1 {
+		t.Errorf("trap ban events = %v, want 1", n)
+	}
+}
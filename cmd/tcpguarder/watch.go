@@ -0,0 +1,425 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"sort"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/urfave/cli/v2"
+)
+
+// watchRow watch 列表中的一个远端IP
+type watchRow struct {
+	IP       string
+	N        int
+	SynRecv  int
+	TimeWait int
+	Rate     float64 //每秒新连接
+	Score    int
+	Geo      string
+}
+
+type watchSort struct {
+	Key  byte
+	Name string
+	Less func(a, b watchRow) bool
+}
+
+var watchSorts = []watchSort{
+	{'c', "count", func(a, b watchRow) bool { return a.N > b.N }},
+	{'s', "syn_recv", func(a, b watchRow) bool { return a.SynRecv > b.SynRecv }},
+	{'t', "time_wait", func(a, b watchRow) bool { return a.TimeWait > b.TimeWait }},
+	{'r', "rate", func(a, b watchRow) bool { return a.Rate > b.Rate }},
+	{'a', "score", func(a, b watchRow) bool { return a.Score > b.Score }},
+}
+
+type connTuple struct {
+	Local, Remote string
+}
+
+type watchUI struct {
+	c        *cli.Context
+	filter   tcpguarder.Filter
+	rules    tcpguarder.RuleSet
+	stats    []tcpguarder.ConnStat
+	rows     []watchRow
+	states   map[tcpguarder.TCPStat]int
+	prev     map[connTuple]bool
+	prevTime time.Time
+	sortBy   int
+	selected int
+	offset   int
+	detail   string //下钻的IP, 空为列表
+	message  string
+}
+
+func (u *watchUI) refresh() {
+	stats, err := tcpguarder.ConnStats()
+	if err != nil {
+		u.message = err.Error()
+		return
+	}
+	now := time.Now()
+	stats = tcpguarder.FilterStats(stats, u.filter)
+	seen := make(map[connTuple]bool, len(stats))
+	rows := make(map[string]*watchRow)
+	u.states = make(map[tcpguarder.TCPStat]int)
+	elapsed := now.Sub(u.prevTime).Seconds()
+	for _, v := range stats {
+		u.states[v.Stat]++
+		if v.Stat == tcpguarder.LISTEN {
+			continue
+		}
+		ip := v.Remote.IP.String()
+		row := rows[ip]
+		if row == nil {
+			row = &watchRow{IP: ip}
+			rows[ip] = row
+		}
+		row.N++
+		switch v.Stat {
+		case tcpguarder.SYN_RECV:
+			row.SynRecv++
+		case tcpguarder.TIME_WAIT:
+			row.TimeWait++
+		}
+		row.Score += u.rules.Score(v)
+		t := connTuple{v.Local.String(), v.Remote.String()}
+		seen[t] = true
+		if u.prev != nil && !u.prev[t] && elapsed > 0 {
+			row.Rate += 1 / elapsed
+		}
+	}
+	u.stats, u.prev, u.prevTime = stats, seen, now
+	u.rows = u.rows[:0]
+	for _, v := range rows {
+		v.Geo = geoinfo(v.IP)
+		u.rows = append(u.rows, *v)
+	}
+	u.sort()
+}
+
+func (u *watchUI) sort() {
+	selected := u.selectedIP()
+	less := watchSorts[u.sortBy].Less
+	sort.Slice(u.rows, func(i, j int) bool {
+		if less(u.rows[i], u.rows[j]) {
+			return true
+		}
+		if less(u.rows[j], u.rows[i]) {
+			return false
+		}
+		return u.rows[i].IP < u.rows[j].IP
+	})
+	//刷新后保持选中同一个IP
+	u.selected = 0
+	for i, v := range u.rows {
+		if v.IP == selected {
+			u.selected = i
+		}
+	}
+}
+
+func (u *watchUI) selectedIP() string {
+	if u.selected < len(u.rows) {
+		return u.rows[u.selected].IP
+	}
+	return ""
+}
+
+// key 处理按键, 返回 true 时退出
+func (u *watchUI) key(k string) bool {
+	u.message = ""
+	switch k {
+	case "q", "\x03":
+		return true
+	case "\x1b[A", "k":
+		if u.selected > 0 {
+			u.selected--
+		}
+	case "\x1b[B", "j":
+		if u.selected < len(u.rows)-1 {
+			u.selected++
+		}
+	case "\r", "\n":
+		u.detail = u.selectedIP()
+	case "\x1b", "\x7f":
+		u.detail = ""
+	case "b":
+		if ip := u.target(); ip != "" {
+			u.message = watchBan(u.c, ip)
+		}
+	case "w":
+		if ip := u.target(); ip != "" {
+			u.message = watchWhite(u.c, ip)
+		}
+	default:
+		for i, v := range watchSorts {
+			if len(k) == 1 && k[0] == v.Key {
+				u.sortBy = i
+				u.sort()
+			}
+		}
+	}
+	return false
+}
+
+// target 下钻时为下钻的IP, 否则为选中的IP
+func (u *watchUI) target() string {
+	if u.detail != "" {
+		return u.detail
+	}
+	return u.selectedIP()
+}
+
+func (u *watchUI) draw(w io.Writer) {
+	rows, cols := terminalSize()
+	var lines []string
+	total := 0
+	for _, v := range u.rows {
+		total += v.N
+	}
+	lines = append(lines, fmt.Sprintf("tcpguarder watch  every %v  ip: %v  tcp: %v  sort: %v  %v",
+		u.c.Duration("duration"), len(u.rows), total, watchSorts[u.sortBy].Name, time.Now().Format("15:04:05")))
+	var states []string
+	for _, s := range []tcpguarder.TCPStat{tcpguarder.ESTABLISHED, tcpguarder.SYN_RECV, tcpguarder.TIME_WAIT,
+		tcpguarder.CLOSE_WAIT, tcpguarder.FIN_WAIT1, tcpguarder.FIN_WAIT2, tcpguarder.LAST_ACK, tcpguarder.LISTEN} {
+		states = append(states, fmt.Sprintf("%v %v", s, u.states[s]))
+	}
+	lines = append(lines, strings.Join(states, "  "), "")
+	footer := "q quit  j/k move  enter detail  esc back  b ban  w white  sort: c count s syn_recv t time_wait r rate a score"
+	if u.message != "" {
+		footer = u.message
+	}
+	body := rows - len(lines) - 2
+	selected := -1
+	if u.detail != "" {
+		lines = append(lines, u.detailLines(u.detail, body)...)
+	} else {
+		lines = append(lines, fmt.Sprintf("  %-39v %7v %8v %9v %7v %6v  %v", "IP", "COUNT", "SYN_RECV", "TIME_WAIT", "RATE/S", "SCORE", "GEO"))
+		body--
+		if u.selected < u.offset {
+			u.offset = u.selected
+		}
+		if body > 0 && u.selected >= u.offset+body {
+			u.offset = u.selected - body + 1
+		}
+		for i := u.offset; i < len(u.rows) && i < u.offset+body; i++ {
+			v := u.rows[i]
+			line := fmt.Sprintf("  %-39v %7v %8v %9v %7.1f %6v  %v", v.IP, v.N, v.SynRecv, v.TimeWait, v.Rate, v.Score, v.Geo)
+			if i == u.selected {
+				selected = len(lines)
+			}
+			lines = append(lines, line)
+		}
+	}
+	for len(lines) < rows-1 {
+		lines = append(lines, "")
+	}
+	lines = append(lines, footer)
+	var buf bytes.Buffer
+	buf.WriteString("\x1b[H\x1b[2J")
+	for i, v := range lines {
+		if i > 0 {
+			buf.WriteString("\r\n")
+		}
+		v = truncate(v, cols)
+		switch i {
+		case selected:
+			v = "\x1b[7m" + v + "\x1b[0m"
+		case len(lines) - 1:
+			v = "\x1b[1m" + v + "\x1b[0m"
+		}
+		buf.WriteString(v)
+	}
+	w.Write(buf.Bytes())
+}
+
+// detailLines 下钻IP的所有连接
+func (u *watchUI) detailLines(ip string, n int) []string {
+	procs, _ := tcpguarder.SocketProcesses()
+	lines := []string{
+		fmt.Sprintf("%v  %v", ip, geoinfo(ip)),
+		fmt.Sprintf("  %-45v %-45v %-11v %6v %6v %7v %6v  %v", "LOCAL", "REMOTE", "STATE", "TXQ", "RXQ", "RETRANS", "SCORE", "PROCESS"),
+	}
+	for _, v := range u.stats {
+		if v.Remote.IP.String() != ip {
+			continue
+		}
+		proc := ""
+		if p, ok := procs[v.Inode]; ok && v.Inode != 0 {
+			proc = fmt.Sprintf("%v/%v", p.PID, p.Name)
+		}
+		lines = append(lines, fmt.Sprintf("  %-45v %-45v %-11v %6v %6v %7v %6v  %v", v.Local, v.Remote,
+			v.Stat, v.TxQueue, v.RxQueue, v.RTOTimeouts, u.rules.Score(v), proc))
+	}
+	if len(lines) > n && n > 0 {
+		lines = lines[:n]
+	}
+	return lines
+}
+
+func truncate(s string, n int) string {
+	if n <= 0 || len(s) <= n {
+		return s
+	}
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n])
+}
+
+// runListening 是否有 run 在 -socket 上监听, 进程退出后残留的 socket 文件连不上
+func runListening(c *cli.Context) bool {
+	conn, err := net.DialTimeout("unix", c.String("socket"), time.Second)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// watchBan 有运行中的 run 时通过控制接口封禁, 否则检查白名单后直接加入 -ipset
+func watchBan(c *cli.Context, ip string) string {
+	if runListening(c) {
+		var out interface{}
+		if err := ctlCall(c, "/ban", apiRequest{IP: ip}, &out); err != nil {
+			return "ban " + ip + ": " + err.Error()
+		}
+		return "banned " + ip + " by tcpguarder run"
+	}
+	if whitelist.ContainsString(ip) {
+		return ip + " is in white list"
+	}
+	cmd := fmt.Sprintf("ipset -exist add %v %v timeout %v", c.String("ipset"), ip, c.Int("timeout"))
+	if out, err := tcpguarder.NewCmd(cmd).CombinedOutput(); err != nil {
+		return fmt.Sprintf("%v: %v %s", cmd, err, bytes.TrimSpace(out))
+	}
+	return "banned " + ip + " in ipset " + c.String("ipset")
+}
+
+// watchWhite 有运行中的 run 时通过控制接口添加, 否则追加到 -white 文件
+func watchWhite(c *cli.Context, ip string) string {
+	if runListening(c) {
+		var out interface{}
+		if err := ctlCall(c, "/whitelist/add", apiRequest{IP: ip}, &out); err != nil {
+			return "white " + ip + ": " + err.Error()
+		}
+		return "added " + ip + " to white list of tcpguarder run"
+	}
+	file := c.String("white")
+	f, err := os.OpenFile(file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err.Error()
+	}
+	defer f.Close()
+	if _, err := fmt.Fprintln(f, ip); err != nil {
+		return err.Error()
+	}
+	whitelist.AddIP(tcpguarder.ParseIPNet(ip).IP)
+	return "added " + ip + " to " + file
+}
+
+func stty(args ...string) (string, error) {
+	cmd := exec.Command("stty", args...)
+	cmd.Stdin = os.Stdin
+	out, err := cmd.Output()
+	return strings.TrimSpace(string(out)), err
+}
+
+// terminalSize 行数和列数, 失败时为 24x80
+func terminalSize() (rows, cols int) {
+	out, err := stty("size")
+	if err == nil {
+		if ss := strings.Fields(out); len(ss) == 2 {
+			rows, _ = strconv.Atoi(ss[0])
+			cols, _ = strconv.Atoi(ss[1])
+		}
+	}
+	if rows <= 0 || cols <= 0 {
+		return 24, 80
+	}
+	return
+}
+
+// readKeys 按键, 方向键等转义序列作为一个按键
+func readKeys(keys chan<- string) {
+	buf := make([]byte, 16)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil {
+			close(keys)
+			return
+		}
+		s := string(buf[:n])
+		for s != "" {
+			k := s[:1]
+			if strings.HasPrefix(s, "\x1b[") && len(s) >= 3 {
+				k = s[:3]
+			}
+			keys <- k
+			s = s[len(k):]
+		}
+	}
+}
+
+func WatchAction(c *cli.Context) error {
+	filter, err := connFilter(c)
+	if err != nil {
+		return err
+	}
+	rules, err := loadRules(c.String("rules"))
+	if err != nil {
+		return err
+	}
+	if err := loadGeoIP(c); err != nil {
+		return err
+	}
+	//没有 run 时直接封禁, 和 run 一样不能封本机IP, 网关, DNS 和 ssh 客户端
+	whitelist.Replace(buildWhitelist(loadWhiteSettings(c)))
+	saved, err := stty("-g")
+	if err != nil {
+		return errors.New("watch needs a terminal")
+	}
+	if _, err := stty("raw", "-echo"); err != nil {
+		return err
+	}
+	defer stty(saved)
+	//隐藏光标, 退出时恢复并清屏
+	os.Stdout.WriteString("\x1b[?25l")
+	defer os.Stdout.WriteString("\x1b[?25h\x1b[H\x1b[2J")
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGTERM, syscall.SIGHUP)
+	defer signal.Stop(sigs)
+	keys := make(chan string)
+	go readKeys(keys)
+	u := &watchUI{c: c, filter: filter, rules: rules}
+	tk := time.NewTicker(c.Duration("duration"))
+	defer tk.Stop()
+	u.refresh()
+	for {
+		u.draw(os.Stdout)
+		select {
+		case <-tk.C:
+			u.refresh()
+		case k, ok := <-keys:
+			if !ok || u.key(k) {
+				return nil
+			}
+		case <-sigs:
+			return nil
+		}
+	}
+}
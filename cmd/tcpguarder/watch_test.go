@@ -0,0 +1,63 @@
+package main
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/urfave/cli/v2"
+)
+
+func TestWatchBanLocal(t *testing.T) {
+	logfile, cleanup := fakeIPSet(t)
+	defer cleanup()
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	//run 退出后残留的 socket 文件
+	socket := filepath.Join(dir, "tcpguarder.sock")
+	l, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.(*net.UnixListener).SetUnlinkOnClose(false)
+	l.Close()
+	if _, err := os.Stat(socket); err != nil {
+		t.Fatal(err)
+	}
+	old := whitelist
+	whitelist = buildWhitelist(whiteSettings{file: filepath.Join(dir, "whiteip.txt")})
+	defer func() { whitelist = old }()
+
+	var msgs []string
+	app := cli.NewApp()
+	app.Flags = []cli.Flag{
+		&cli.StringFlag{Name: "socket", Value: socket},
+		&cli.StringFlag{Name: "ipset", Value: "blackhold"},
+		&cli.IntFlag{Name: "timeout", Value: 60},
+	}
+	app.Action = func(c *cli.Context) error {
+		for _, ip := range []string{"127.0.0.1", "203.0.113.30"} {
+			msgs = append(msgs, watchBan(c, ip))
+		}
+		return nil
+	}
+	if err := app.Run([]string{"tcpguarder"}); err != nil {
+		t.Fatal(err)
+	}
+	if msgs[0] != "127.0.0.1 is in white list" {
+		t.Errorf("ban 127.0.0.1: %v", msgs[0])
+	}
+	if msgs[1] != "banned 203.0.113.30 in ipset blackhold" {
+		t.Errorf("ban with stale socket: %v", msgs[1])
+	}
+	b, _ := ioutil.ReadFile(logfile)
+	if got := strings.TrimSpace(string(b)); got != "-exist add blackhold 203.0.113.30 timeout 60" {
+		t.Errorf("ipset calls:\n%v", got)
+	}
+}
@@ -0,0 +1,194 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+	"github.com/urfave/cli/v2"
+)
+
+// crawlerPending 爬虫验证还在后台进行, 这次不封禁也不记录
+const crawlerPending = "crawler pending"
+
+// whiteReason 不能封禁的原因, 可以封禁时为空
+func whiteReason(ip string) string {
+	if whitelist.ContainsString(ip) {
+		return "whitelist"
+	}
+	if crawler := crawlers.get(); crawler != nil {
+		host, ok, done := crawler.Check(ip)
+		if !done {
+			return crawlerPending
+		}
+		if ok {
+			return "crawler " + host
+		}
+	}
+	return ""
+}
+
+// crawlerState 当前的爬虫验证, 重新加载配置时替换
+type crawlerState struct {
+	mu       sync.Mutex
+	verifier *tcpguarder.CrawlerVerifier
+}
+
+var crawlers = &crawlerState{}
+
+func (s *crawlerState) set(v *tcpguarder.CrawlerVerifier) {
+	s.mu.Lock()
+	s.verifier = v
+	s.mu.Unlock()
+}
+
+func (s *crawlerState) get() *tcpguarder.CrawlerVerifier {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.verifier
+}
+
+// whiteSettings 构建白名单的参数, 从 cli.Context 取出后不再读取, 避免与重新加载配置并发
+type whiteSettings struct {
+	file     string
+	auto     bool
+	sshPorts []int
+}
+
+func loadWhiteSettings(c *cli.Context) whiteSettings {
+	return whiteSettings{file: c.String("white"), auto: c.Bool("auto-white"), sshPorts: c.IntSlice("ssh-port")}
+}
+
+// modtime 白名单文件的修改时间, 不存在时为零值
+func (s whiteSettings) modtime() time.Time {
+	fi, err := os.Stat(s.file)
+	if err != nil {
+		return time.Time{}
+	}
+	return fi.ModTime()
+}
+
+// buildWhitelist 构建白名单: 白名单文件 + 本机IP + 自动白名单 + ctl 添加的
+func buildWhitelist(s whiteSettings) *tcpguarder.Allowlist {
+	file := s.file
+	fmt.Println("load white ip file:", file)
+	list, err := tcpguarder.LoadAllowlist(file)
+	if err != nil {
+		log.Println("white ip file:", err)
+	}
+	if list == nil {
+		list = tcpguarder.NewAllowlist()
+	}
+	fmt.Println("white ip num:", list.Len())
+	for _, v := range LocalIPList() {
+		fmt.Println("local ip:", v)
+		list.AddIP(v)
+	}
+	fmt.Println("white ip num:", list.Len())
+	if s.auto {
+		for _, v := range autoWhiteIPs(s.sshPorts) {
+			fmt.Printf("auto white ip: %v (%v)\n", v.IP, v.Reason)
+			list.AddIP(v.IP)
+		}
+		fmt.Println("white ip num:", list.Len())
+	}
+	if nets := control.whiteNets(); len(nets) > 0 {
+		for _, v := range nets {
+			list.Add(v)
+		}
+		fmt.Println("white ip num:", list.Len(), "with", len(nets), "added by ctl")
+	}
+	return list
+}
+
+type autoWhiteIP struct {
+	IP     net.IP
+	Reason string
+}
+
+// autoWhiteIPs 默认网关, DNS, 已登录的 ssh 客户端, 避免把自己封掉
+func autoWhiteIPs(sshports []int) []autoWhiteIP {
+	var ips []autoWhiteIP
+	gws, err := tcpguarder.DefaultGateways("/proc/net/route", "/proc/net/ipv6_route")
+	if err != nil {
+		log.Println(err)
+	}
+	for _, v := range gws {
+		ips = append(ips, autoWhiteIP{v, "default gateway"})
+	}
+	nss, err := tcpguarder.Nameservers("/etc/resolv.conf")
+	if err != nil {
+		log.Println(err)
+	}
+	for _, v := range nss {
+		ips = append(ips, autoWhiteIP{v, "nameserver"})
+	}
+	stats, err := tcpguarder.ConnStats()
+	if err != nil {
+		log.Println(err)
+	}
+	return append(ips, sshClients(stats, sshports)...)
+}
+
+// sshClients 已登录的 ssh 客户端
+func sshClients(stats []tcpguarder.ConnStat, sshports []int) []autoWhiteIP {
+	var ips []autoWhiteIP
+	seen := make(map[string]bool)
+	for _, v := range stats {
+		if v.Stat != tcpguarder.ESTABLISHED {
+			continue
+		}
+		for _, port := range sshports {
+			if v.Local.Port == uint16(port) && !seen[v.Remote.IP.String()] {
+				seen[v.Remote.IP.String()] = true
+				ips = append(ips, autoWhiteIP{v.Remote.IP, fmt.Sprintf("ssh session on port %v", port)})
+			}
+		}
+	}
+	return ips
+}
+
+// sshKey 比较 ssh 客户端是否变化
+func sshKey(ips []autoWhiteIP) string {
+	ss := make([]string, 0, len(ips))
+	for _, v := range ips {
+		ss = append(ss, v.IP.String())
+	}
+	sort.Strings(ss)
+	return strings.Join(ss, ",")
+}
+
+// whiteCheckInterval 多久检查一次白名单文件是否变化
+const whiteCheckInterval = 2 * time.Second
+
+// checkWhitelist 白名单文件变化时重新加载, 由 KillAction 定时调用, SIGHUP 时整体重新加载
+func (g *guard) checkWhitelist() {
+	if t := g.white.modtime(); !t.Equal(g.whiteMod) {
+		g.whiteMod = t
+		log.Println("white ip file changed, reload")
+		whitelist.Replace(buildWhitelist(g.white))
+	}
+}
+
+// checkSSH -auto-white 时用每次检测的连接更新 ssh 客户端, 新登录的客户端在本次封禁之前加入白名单
+func (g *guard) checkSSH(stats []tcpguarder.ConnStat) {
+	if !g.white.auto {
+		return
+	}
+	clients := sshClients(stats, g.white.sshPorts)
+	if key := sshKey(clients); key != g.sshKey {
+		g.sshKey = key
+		log.Println("ssh sessions changed, reload white ip")
+		list := buildWhitelist(g.white)
+		for _, v := range clients {
+			list.AddIP(v.IP)
+		}
+		whitelist.Replace(list)
+	}
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/lixiangzhong/tcpguarder"
+)
+
+func TestCheckWhitelistBadLine(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "whiteip.txt")
+	if err := ioutil.WriteFile(file, []byte("10.0.0.0/8\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	old := whitelist
+	whitelist = tcpguarder.NewAllowlist()
+	defer func() { whitelist = old }()
+
+	g := &guard{white: whiteSettings{file: file}}
+	g.checkWhitelist()
+	if !whitelist.ContainsString("10.1.1.1") {
+		t.Fatal("10.0.0.0/8 not loaded")
+	}
+	//重新加载时一行写错, 其他条目不能丢
+	if err := ioutil.WriteFile(file, []byte("10.0.0.0/8\n192.168.0.0/33\n172.16.0.0/12\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(file, later, later); err != nil {
+		t.Fatal(err)
+	}
+	g.checkWhitelist()
+	if !whitelist.ContainsString("10.1.1.1") || !whitelist.ContainsString("172.16.1.1") {
+		t.Error("reload with one bad line dropped the other entries")
+	}
+	if whitelist.ContainsString("192.168.1.1") {
+		t.Error("bad entry must be skipped")
+	}
+}
+
+func TestCheckSSH(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	old := whitelist
+	whitelist = tcpguarder.NewAllowlist()
+	defer func() { whitelist = old }()
+
+	ssh := func(remote string, stat tcpguarder.TCPStat) tcpguarder.ConnStat {
+		return tcpguarder.ConnStat{
+			Local:  tcpguarder.IPPort{IP: net.ParseIP("10.0.0.1"), Port: 2222},
+			Remote: tcpguarder.IPPort{IP: net.ParseIP(remote), Port: 50000},
+			Stat:   stat,
+		}
+	}
+	g := &guard{white: whiteSettings{file: filepath.Join(dir, "whiteip.txt"), auto: true, sshPorts: []int{2222}}}
+	//启动之后才登录的 ssh 客户端
+	g.checkSSH([]tcpguarder.ConnStat{ssh("198.51.100.9", tcpguarder.ESTABLISHED), ssh("198.51.100.10", tcpguarder.SYN_RECV)})
+	if !whitelist.ContainsString("198.51.100.9") {
+		t.Error("new ssh client not white listed")
+	}
+	if whitelist.ContainsString("198.51.100.10") {
+		t.Error("half open ssh connection white listed")
+	}
+	//没有变化时不重新构建
+	list := whitelist
+	whitelist = tcpguarder.NewAllowlist()
+	g.checkSSH([]tcpguarder.ConnStat{ssh("198.51.100.9", tcpguarder.ESTABLISHED)})
+	if whitelist.Len() != 0 {
+		t.Error("white list rebuilt without ssh change")
+	}
+	whitelist = list
+	//-auto-white=false 时不处理
+	g.white.auto = false
+	g.checkSSH([]tcpguarder.ConnStat{ssh("198.51.100.11", tcpguarder.ESTABLISHED)})
+	if whitelist.ContainsString("198.51.100.11") {
+		t.Error("ssh client white listed without -auto-white")
+	}
+}
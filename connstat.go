@@ -6,9 +6,11 @@ import (
 	"errors"
 	"fmt"
 	"net"
+	"os"
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync/atomic"
 )
 
 type TCPStat string
@@ -65,6 +67,7 @@ type ConnStat struct {
 	RTO                    int // 单位是clock_t
 	CongestionWindow       int //当前拥塞窗口大小
 	SlowStartSizeThreshold int //慢启动阈值 ,慢启动阈值大于等于0xFFFF则显示-1
+	Inode                  uint64
 }
 
 /*
@@ -76,14 +79,25 @@ TimerActive
   4  zero window probe timer is pending  //持续定时器
 */
 
-func catProcNetTCP() ([]byte, error) {
-	b, err := NewCmd("cat /proc/net/tcp").CombinedOutput()
+// Sources ConnStats 读取的文件, IPv6 连接可加入 /proc/net/tcp6
+var Sources = []string{"/proc/net/tcp"}
+
+func catProcNetTCP(file string) ([]byte, error) {
+	b, err := NewCmd("cat " + file).CombinedOutput()
 	if err != nil {
 		return nil, errors.New(string(b) + err.Error())
 	}
 	return b, nil
 }
 
+// parseErrors 无法解析的连接行数
+var parseErrors uint64
+
+// ParseErrors 启动以来 /proc/net/tcp 中无法解析的连接行数
+func ParseErrors() uint64 {
+	return atomic.LoadUint64(&parseErrors)
+}
+
 func parseProcNetTCP(b []byte) (stats []ConnStat, err error) {
 	lines := strings.Split(string(b), "\n")
 	for _, line := range lines {
@@ -94,60 +108,81 @@ func parseProcNetTCP(b []byte) (stats []ConnStat, err error) {
 		if cols[0] == "sl" {
 			continue
 		}
-		local, err := parseHexIPPort(cols[1])
-		if err != nil {
-			continue
-		}
-		remote, err := parseHexIPPort(cols[2])
-		if err != nil {
-			continue
-		}
-		var stat ConnStat
-		stat.Local = local
-		stat.Remote = remote
-		stat.Stat = TCPStatCodeString[cols[3]]
-		if stat.Stat == "" {
-			continue
-		}
-		stat.TxQueue, stat.RxQueue, err = parseTxRxQueue(cols[4])
+		stat, err := parseConnStat(cols)
 		if err != nil {
+			atomic.AddUint64(&parseErrors, 1)
 			continue
 		}
-		stat.TimerActive, stat.Jiffies, err = parseTrTm(cols[5])
-		if err != nil {
-			continue
-		}
-		stat.RTOTimeouts, err = HexToint64(cols[6])
-		if err != nil {
-			continue
-		}
-		stat.UID, err = strconv.Atoi(cols[7])
-		if err != nil {
-			continue
-		}
-		stat.RTO, err = strconv.Atoi(cols[12])
-		if err != nil {
+		stats = append(stats, stat)
+	}
+	return
+}
+
+func parseConnStat(cols []string) (stat ConnStat, err error) {
+	if stat.Local, err = parseHexIPPort(cols[1]); err != nil {
+		return
+	}
+	if stat.Remote, err = parseHexIPPort(cols[2]); err != nil {
+		return
+	}
+	stat.Stat = TCPStatCodeString[cols[3]]
+	if stat.Stat == "" {
+		return stat, errors.New(cols[3] + " unknown tcp state")
+	}
+	if stat.TxQueue, stat.RxQueue, err = parseTxRxQueue(cols[4]); err != nil {
+		return
+	}
+	if stat.TimerActive, stat.Jiffies, err = parseTrTm(cols[5]); err != nil {
+		return
+	}
+	if stat.RTOTimeouts, err = HexToint64(cols[6]); err != nil {
+		return
+	}
+	if stat.UID, err = strconv.Atoi(cols[7]); err != nil {
+		return
+	}
+	if stat.Inode, err = strconv.ParseUint(cols[9], 10, 64); err != nil {
+		return
+	}
+	if stat.RTO, err = strconv.Atoi(cols[12]); err != nil {
+		return
+	}
+	if stat.CongestionWindow, err = strconv.Atoi(cols[15]); err != nil {
+		return
+	}
+	stat.SlowStartSizeThreshold, err = strconv.Atoi(cols[16])
+	return
+}
+
+func ConnStats() (stats []ConnStat, err error) {
+	return ConnStatsFrom(Sources...)
+}
+
+// ConnStatsFrom 读取多个文件的连接, 不存在的文件跳过(如关闭了 IPv6 时的 /proc/net/tcp6),
+// 全部不存在时返回错误
+func ConnStatsFrom(files ...string) (stats []ConnStat, err error) {
+	var missing error
+	read := 0
+	for _, file := range files {
+		if _, err := os.Stat(file); os.IsNotExist(err) {
+			missing = err
 			continue
 		}
-		stat.CongestionWindow, err = strconv.Atoi(cols[15])
+		read++
+		b, err := catProcNetTCP(file)
 		if err != nil {
-			continue
+			return nil, err
 		}
-		stat.SlowStartSizeThreshold, err = strconv.Atoi(cols[16])
+		ss, err := parseProcNetTCP(b)
 		if err != nil {
-			continue
+			return nil, err
 		}
-		stats = append(stats, stat)
+		stats = append(stats, ss...)
 	}
-	return
-}
-
-func ConnStats() (stats []ConnStat, err error) {
-	b, err := catProcNetTCP()
-	if err != nil {
-		return
+	if read == 0 && missing != nil {
+		return nil, missing
 	}
-	return parseProcNetTCP(b)
+	return
 }
 
 func parseHexIPPort(s string) (ip IPPort, err error) {
@@ -159,9 +194,10 @@ func parseHexIPPort(s string) (ip IPPort, err error) {
 	if err != nil {
 		return ip, err
 	}
-	l := len(ip.IP)
-	for i := 0; i < l/2; i++ {
-		ip.IP[i], ip.IP[l-i-1] = ip.IP[l-i-1], ip.IP[i]
+	//每4字节为一组小端序, IPv6 为4组
+	for w := 0; w+4 <= len(ip.IP); w += 4 {
+		b := ip.IP[w : w+4]
+		b[0], b[1], b[2], b[3] = b[3], b[2], b[1], b[0]
 	}
 	b, err := hex.DecodeString(ss[1])
 	if err != nil {
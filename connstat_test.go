@@ -0,0 +1,80 @@
+package tcpguarder
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestParseHexIPPort(t *testing.T) {
+	tests := []struct {
+		in   string
+		ip   string
+		port uint16
+	}{
+		{"0100007F:0050", "127.0.0.1", 80},
+		{"0202A8C0:0016", "192.168.2.2", 22},
+		{"00000000000000000000000001000000:0016", "::1", 22},
+		{"B80D0120000000000000000001000000:01BB", "2001:db8::1", 443},
+		{"0000000000000000FFFF00000100007F:1F90", "127.0.0.1", 8080},
+	}
+	for _, tt := range tests {
+		got, err := parseHexIPPort(tt.in)
+		if err != nil {
+			t.Errorf("%v: %v", tt.in, err)
+			continue
+		}
+		if !got.IP.Equal(net.ParseIP(tt.ip)) || got.Port != tt.port {
+			t.Errorf("%v = %v, want %v:%v", tt.in, got, tt.ip, tt.port)
+		}
+	}
+	for _, s := range []string{"0100007F", "0100007G:0050", "0100007F:zz"} {
+		if _, err := parseHexIPPort(s); err == nil {
+			t.Errorf("%v: no error", s)
+		}
+	}
+}
+
+func TestParseProcNetTCP6(t *testing.T) {
+	b := []byte(`  sl  local_address                         remote_address                        st tx_queue rx_queue tr tm->when retrnsmt   uid  timeout inode
+   0: 00000000000000000000000000000000:0016 00000000000000000000000000000000:0000 0A 00000000:00000000 00:00000000 00000000     0        0 12345 1 0000000000000000 100 0 0 10 0
+   1: B80D0120000000000000000001000000:0016 B80D0120000000000000000002000000:D431 01 00000000:00000000 02:0000A1B2 00000000     0        0 12346 1 0000000000000000 20 4 30 10 -1
+`)
+	stats, err := parseProcNetTCP(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 2 {
+		t.Fatalf("got %v conns, want 2", len(stats))
+	}
+	s := stats[1]
+	if s.Stat != ESTABLISHED || s.Local.String() != "2001:db8::1:22" || s.Remote.String() != "2001:db8::2:54321" {
+		t.Errorf("conn = %v %v %v", s.Stat, s.Local, s.Remote)
+	}
+	if s.Inode != 12346 || s.RTO != 20 || s.CongestionWindow != 10 || s.SlowStartSizeThreshold != -1 {
+		t.Errorf("conn = %+v", s)
+	}
+}
+
+func TestConnStatsFromMissing(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	tcp := filepath.Join(dir, "tcp")
+	line := "   0: 0100007F:0050 0200007F:C350 01 00000000:00000000 00:00000000 00000000     0        0 100 1 0000000000000000 20 4 30 10 -1\n"
+	if err := ioutil.WriteFile(tcp, []byte(line), 0644); err != nil {
+		t.Fatal(err)
+	}
+	//tcp6 不存在时只读 tcp
+	stats, err := ConnStatsFrom(tcp, filepath.Join(dir, "tcp6"))
+	if err != nil || len(stats) != 1 {
+		t.Errorf("ConnStatsFrom = %v, %v", stats, err)
+	}
+	if _, err := ConnStatsFrom(filepath.Join(dir, "tcp6")); err == nil {
+		t.Error("no error when all sources are missing")
+	}
+}
@@ -0,0 +1,158 @@
+package tcpguarder
+
+import (
+	"context"
+	"net"
+	"strings"
+	"sync"
+	"time"
+)
+
+// CrawlerVerifier 用 forward-confirmed reverse DNS 验证搜索引擎爬虫:
+// IP 的 PTR 属于 Domains 之一, 且该域名正向解析包含此 IP
+type CrawlerVerifier struct {
+	Domains  []string //例如 googlebot.com, google.com, search.msn.com
+	Resolver *net.Resolver
+	TTL      time.Duration //结果缓存时间
+	ErrTTL   time.Duration //DNS 错误或超时视为未通过, 缓存较短时间
+	Timeout  time.Duration //单次验证超时
+	mu       sync.Mutex
+	cache    map[string]crawlerResult
+	pending  map[string]bool //Check 后台验证中的IP
+}
+
+// crawlerMaxPending Check 同时在后台验证的IP数, 超过时等下次 Check
+const crawlerMaxPending = 64
+
+type crawlerResult struct {
+	host   string
+	ok     bool
+	expire time.Time
+}
+
+// NewCrawlerVerifier server 为空时使用系统 DNS, 否则使用 server(host:port)
+func NewCrawlerVerifier(domains []string, server string) *CrawlerVerifier {
+	return &CrawlerVerifier{
+		Domains:  domains,
+		Resolver: NewResolver(server),
+		TTL:      time.Hour,
+		ErrTTL:   time.Minute,
+		Timeout:  time.Second * 3,
+		cache:    make(map[string]crawlerResult),
+		pending:  make(map[string]bool),
+	}
+}
+
+// NewResolver 返回使用指定 DNS server(host:port) 的 Resolver, server 为空时返回系统默认
+func NewResolver(server string) *net.Resolver {
+	if server == "" {
+		return net.DefaultResolver
+	}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, network, server)
+		},
+	}
+}
+
+// Verify 返回验证通过的主机名, 未缓存时阻塞到验证完成
+func (v *CrawlerVerifier) Verify(ip string) (string, bool) {
+	if r, ok := v.cached(ip); ok {
+		return r.host, r.ok
+	}
+	host, ok, err := v.verify(ip)
+	v.store(ip, host, ok, err)
+	return host, ok
+}
+
+// Check 不阻塞, 未缓存时在后台验证并返回 done=false, 验证完成后再次 Check 得到结果.
+// 攻击者控制的 PTR 超时也不会拖慢调用方
+func (v *CrawlerVerifier) Check(ip string) (host string, ok bool, done bool) {
+	if r, ok := v.cached(ip); ok {
+		return r.host, r.ok, true
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	if v.pending[ip] || len(v.pending) >= crawlerMaxPending {
+		return "", false, false
+	}
+	v.pending[ip] = true
+	go func() {
+		host, ok, err := v.verify(ip)
+		v.store(ip, host, ok, err)
+	}()
+	return "", false, false
+}
+
+func (v *CrawlerVerifier) cached(ip string) (crawlerResult, bool) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	r, ok := v.cache[ip]
+	return r, ok && time.Now().Before(r.expire)
+}
+
+func (v *CrawlerVerifier) store(ip, host string, ok bool, err error) {
+	now := time.Now()
+	ttl := v.TTL
+	if err != nil {
+		ttl = v.ErrTTL
+	}
+	v.mu.Lock()
+	defer v.mu.Unlock()
+	delete(v.pending, ip)
+	if len(v.cache) >= crawlerCacheSweep {
+		for k, r := range v.cache {
+			if now.After(r.expire) {
+				delete(v.cache, k)
+			}
+		}
+	}
+	v.cache[ip] = crawlerResult{host: host, ok: ok, expire: now.Add(ttl)}
+}
+
+// crawlerCacheSweep 缓存达到此数量时清理过期的结果
+const crawlerCacheSweep = 10000
+
+func (v *CrawlerVerifier) verify(ip string) (string, bool, error) {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return "", false, nil
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), v.Timeout)
+	defer cancel()
+	names, err := v.Resolver.LookupAddr(ctx, ip)
+	if err != nil {
+		if e, ok := err.(*net.DNSError); ok && e.IsNotFound {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+	for _, name := range names {
+		host := strings.TrimSuffix(strings.ToLower(name), ".")
+		if !v.matchDomain(host) {
+			continue
+		}
+		addrs, err := v.Resolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			continue
+		}
+		for _, a := range addrs {
+			if a.IP.Equal(addr) {
+				return host, true, nil
+			}
+		}
+	}
+	return "", false, nil
+}
+
+func (v *CrawlerVerifier) matchDomain(host string) bool {
+	for _, d := range v.Domains {
+		d = strings.TrimSuffix(strings.ToLower(d), ".")
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
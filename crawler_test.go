@@ -0,0 +1,225 @@
+package tcpguarder
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCrawlerVerifierCachesErrors(t *testing.T) {
+	var dials int32
+	v := NewCrawlerVerifier([]string{"googlebot.com"}, "")
+	v.Resolver = &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			atomic.AddInt32(&dials, 1)
+			return nil, errors.New("dns down")
+		},
+	}
+	if _, ok, done := v.Check("203.0.113.9"); ok || done {
+		t.Fatalf("first Check = ok %v done %v, want pending", ok, done)
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		_, ok, done := v.Check("203.0.113.9")
+		if done {
+			if ok {
+				t.Fatal("dns error verified as crawler")
+			}
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("Check never finished")
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	n := atomic.LoadInt32(&dials)
+	if _, ok := v.Verify("203.0.113.9"); ok {
+		t.Fatal("dns error verified as crawler")
+	}
+	if atomic.LoadInt32(&dials) != n {
+		t.Error("failed lookup not cached")
+	}
+	v.mu.Lock()
+	r := v.cache["203.0.113.9"]
+	v.mu.Unlock()
+	if ttl := time.Until(r.expire); ttl > v.ErrTTL || ttl < v.ErrTTL-time.Minute/2 {
+		t.Errorf("failed lookup cached for %v, want about %v", ttl, v.ErrTTL)
+	}
+}
+
+func TestCrawlerMatchDomain(t *testing.T) {
+	v := NewCrawlerVerifier([]string{"googlebot.com.", "Search.MSN.com"}, "")
+	for host, want := range map[string]bool{
+		"crawl-66-249-66-1.googlebot.com": true,
+		"googlebot.com":                   true,
+		"evilgooglebot.com":               false,
+		"msnbot-1.search.msn.com":         true,
+		"googlebot.com.evil.net":          false,
+	} {
+		if got := v.matchDomain(host); got != want {
+			t.Errorf("matchDomain(%v) = %v, want %v", host, got, want)
+		}
+	}
+}
+
+// dnsStub 只回答 PTR 和 A 查询的 UDP DNS 服务, 记录收到的查询
+type dnsStub struct {
+	conn net.PacketConn
+	ptr  map[string]string //反向域名 -> 主机名
+	a    map[string]net.IP
+	mu   sync.Mutex
+	seen []string //"PTR name", "A name"
+}
+
+func newDNSStub(t *testing.T, ptr map[string]string, a map[string]net.IP) *dnsStub {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &dnsStub{conn: conn, ptr: ptr, a: a}
+	go s.serve()
+	return s
+}
+
+func (s *dnsStub) serve() {
+	b := make([]byte, 512)
+	for {
+		n, addr, err := s.conn.ReadFrom(b)
+		if err != nil {
+			return
+		}
+		if resp := s.answer(b[:n]); resp != nil {
+			s.conn.WriteTo(resp, addr)
+		}
+	}
+}
+
+func (s *dnsStub) queries() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]string(nil), s.seen...)
+}
+
+func dnsName(name string) []byte {
+	var b []byte
+	for _, l := range strings.Split(strings.TrimSuffix(name, "."), ".") {
+		b = append(b, byte(len(l)))
+		b = append(b, l...)
+	}
+	return append(b, 0)
+}
+
+func (s *dnsStub) answer(q []byte) []byte {
+	if len(q) < 12 {
+		return nil
+	}
+	//问题中的域名
+	var labels []string
+	i := 12
+	for i < len(q) && q[i] != 0 {
+		l := int(q[i])
+		if i+1+l > len(q) {
+			return nil
+		}
+		labels = append(labels, string(q[i+1:i+1+l]))
+		i += 1 + l
+	}
+	if i+5 > len(q) {
+		return nil
+	}
+	name := strings.ToLower(strings.Join(labels, ".")) + "."
+	qtype := binary.BigEndian.Uint16(q[i+1:])
+	question := q[12 : i+5]
+
+	var rtype string
+	var rdata [][]byte
+	found := false
+	switch qtype {
+	case 12:
+		rtype = "PTR"
+		if host, ok := s.ptr[name]; ok {
+			found = true
+			rdata = append(rdata, dnsName(host))
+		}
+	case 1, 28:
+		rtype = "A"
+		if qtype == 28 {
+			rtype = "AAAA"
+		}
+		if ip, ok := s.a[name]; ok {
+			found = true
+			if qtype == 1 {
+				rdata = append(rdata, ip.To4())
+			}
+		}
+	}
+	s.mu.Lock()
+	s.seen = append(s.seen, rtype+" "+name)
+	s.mu.Unlock()
+
+	resp := append([]byte(nil), q[0:2]...)
+	flags := uint16(0x8180)
+	if !found {
+		flags |= 3 //NXDOMAIN
+	}
+	resp = append(resp, byte(flags>>8), byte(flags), 0, 1, 0, byte(len(rdata)), 0, 0, 0, 0)
+	resp = append(resp, question...)
+	for _, d := range rdata {
+		resp = append(resp, 0xc0, 12) //指向问题中的域名
+		resp = append(resp, byte(qtype>>8), byte(qtype), 0, 1, 0, 0, 0, 60, byte(len(d)>>8), byte(len(d)))
+		resp = append(resp, d...)
+	}
+	return resp
+}
+
+func TestCrawlerVerifyFCrDNS(t *testing.T) {
+	stub := newDNSStub(t, map[string]string{
+		"1.66.249.66.in-addr.arpa.":   "crawl-66-249-66-1.googlebot.com.",
+		"66.100.51.198.in-addr.arpa.": "crawl-66-249-66-1.googlebot.com.", //伪造的 PTR
+		"7.113.0.203.in-addr.arpa.":   "bot.example.net.",
+	}, map[string]net.IP{
+		"crawl-66-249-66-1.googlebot.com.": net.ParseIP("66.249.66.1"),
+	})
+	defer stub.conn.Close()
+	v := NewCrawlerVerifier([]string{"googlebot.com"}, stub.conn.LocalAddr().String())
+
+	if host, ok := v.Verify("66.249.66.1"); !ok || host != "crawl-66-249-66-1.googlebot.com" {
+		t.Errorf("real googlebot = %v %v, want verified", host, ok)
+	}
+	//PTR 指向 googlebot.com, 但正向解析不包含此IP
+	if host, ok := v.Verify("198.51.100.66"); ok {
+		t.Errorf("spoofed PTR verified as %v", host)
+	}
+	//不属于爬虫域名时不做正向解析
+	if _, ok := v.Verify("203.0.113.7"); ok {
+		t.Error("bot.example.net verified")
+	}
+	//没有 PTR
+	if _, ok := v.Verify("203.0.113.8"); ok {
+		t.Error("ip without PTR verified")
+	}
+	forward := 0
+	for _, q := range stub.queries() {
+		if strings.HasPrefix(q, "A ") {
+			forward++
+			if q != "A crawl-66-249-66-1.googlebot.com." {
+				t.Errorf("unexpected forward lookup %v", q)
+			}
+		}
+	}
+	if forward != 2 {
+		t.Errorf("forward lookups %v, want 2: %v", forward, stub.queries())
+	}
+	//结果已缓存
+	n := len(stub.queries())
+	if _, ok := v.Verify("66.249.66.1"); !ok || len(stub.queries()) != n {
+		t.Error("verified crawler not cached")
+	}
+}
@@ -0,0 +1,471 @@
+package tcpguarder
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// Filter 判断一条连接是否满足条件
+type Filter func(ConnStat) bool
+
+// geo 供表达式中 country/asn 字段查询远端IP, 未设置时 country 为空, asn 为 0
+var geo atomic.Value
+
+// SetGeo 替换 country/asn 字段使用的 GeoIP, 可与表达式求值并发调用
+func SetGeo(g *GeoIP) {
+	geo.Store(g)
+}
+
+// CurrentGeo 当前的 GeoIP, 未设置时为 nil
+func CurrentGeo() *GeoIP {
+	g, _ := geo.Load().(*GeoIP)
+	return g
+}
+
+// ParseFilter 解析表达式为 Filter,例如:
+//
+//	cwnd == 1 and txq != 0 and rxq != 0
+//	state == CLOSING or (timer == 1 and retrans > 3)
+//	state established and sport = :443 and dst 10.0.0.0/8 and rxq > 0
+//
+// 支持 and/&&, or/||, not/!, 括号, 以及 == = != < <= > >= (eq ne lt le gt ge) 比较
+// 与 ss 一致, src/sport 指本地地址/端口, dst/dport 指远端地址/端口
+// country/asn 为远端IP的国家代码和 ASN, 需先 SetGeo, 例如 country != CN
+func ParseFilter(s string) (Filter, error) {
+	p := &exprParser{tokens: tokenize(s)}
+	if len(p.tokens) == 0 {
+		return nil, errors.New("empty expression")
+	}
+	f, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+	if !p.eof() {
+		return nil, fmt.Errorf("unexpected %q in %q", p.peek(), s)
+	}
+	return f, nil
+}
+
+type fieldKind int
+
+const (
+	numField fieldKind = iota
+	statField
+	excludeField
+	portField
+	addrField
+	countryField
+)
+
+type exprField struct {
+	kind fieldKind
+	num  func(ConnStat) int64
+	addr func(ConnStat) IPPort
+}
+
+var exprFields = map[string]exprField{
+	"state":    {kind: statField},
+	"exclude":  {kind: excludeField},
+	"txq":      {num: func(c ConnStat) int64 { return c.TxQueue }},
+	"rxq":      {num: func(c ConnStat) int64 { return c.RxQueue }},
+	"timer":    {num: func(c ConnStat) int64 { return int64(c.TimerActive) }},
+	"jiffies":  {num: func(c ConnStat) int64 { return c.Jiffies }},
+	"retrans":  {num: func(c ConnStat) int64 { return c.RTOTimeouts }},
+	"uid":      {num: func(c ConnStat) int64 { return int64(c.UID) }},
+	"rto":      {num: func(c ConnStat) int64 { return int64(c.RTO) }},
+	"cwnd":     {num: func(c ConnStat) int64 { return int64(c.CongestionWindow) }},
+	"ssthresh": {num: func(c ConnStat) int64 { return int64(c.SlowStartSizeThreshold) }},
+	"lport":    {kind: portField, num: func(c ConnStat) int64 { return int64(c.Local.Port) }},
+	"rport":    {kind: portField, num: func(c ConnStat) int64 { return int64(c.Remote.Port) }},
+	"sport":    {kind: portField, num: func(c ConnStat) int64 { return int64(c.Local.Port) }},
+	"dport":    {kind: portField, num: func(c ConnStat) int64 { return int64(c.Remote.Port) }},
+	"src":      {kind: addrField, addr: func(c ConnStat) IPPort { return c.Local }},
+	"dst":      {kind: addrField, addr: func(c ConnStat) IPPort { return c.Remote }},
+	"laddr":    {kind: addrField, addr: func(c ConnStat) IPPort { return c.Local }},
+	"raddr":    {kind: addrField, addr: func(c ConnStat) IPPort { return c.Remote }},
+	"country":  {kind: countryField},
+	"asn":      {num: func(c ConnStat) int64 { return int64(CurrentGeo().Lookup(c.Remote.IP).ASN) }},
+}
+
+var wordOps = map[string]string{
+	"eq":  "==",
+	"ne":  "!=",
+	"neq": "!=",
+	"lt":  "<",
+	"le":  "<=",
+	"leq": "<=",
+	"gt":  ">",
+	"ge":  ">=",
+	"geq": ">=",
+}
+
+// ss 风格的状态名
+var ssStates = map[string]TCPStat{
+	"established": ESTABLISHED,
+	"syn-sent":    SYN_SENT,
+	"syn-recv":    SYN_RECV,
+	"fin-wait-1":  FIN_WAIT1,
+	"fin-wait-2":  FIN_WAIT2,
+	"time-wait":   TIME_WAIT,
+	"closed":      CLOSE,
+	"close-wait":  CLOSE_WAIT,
+	"last-ack":    LAST_ACK,
+	"listening":   LISTEN,
+	"closing":     CLOSING,
+}
+
+func tokenize(s string) []string {
+	var tokens []string
+	for i := 0; i < len(s); {
+		switch ch := s[i]; {
+		case ch == ' ' || ch == '\t' || ch == '\n' || ch == '\r':
+			i++
+		case ch == '(' || ch == ')':
+			tokens = append(tokens, s[i:i+1])
+			i++
+		case strings.IndexByte("=!<>&|", ch) >= 0:
+			if i+1 < len(s) {
+				switch two := s[i : i+2]; two {
+				case "==", "!=", "<=", ">=", "&&", "||":
+					tokens = append(tokens, two)
+					i += 2
+					continue
+				}
+			}
+			tokens = append(tokens, s[i:i+1])
+			i++
+		default:
+			j := i
+			for j < len(s) && strings.IndexByte(" \t\n\r()=!<>&|", s[j]) < 0 {
+				j++
+			}
+			tokens = append(tokens, s[i:j])
+			i = j
+		}
+	}
+	return tokens
+}
+
+type exprParser struct {
+	tokens []string
+	pos    int
+}
+
+func (p *exprParser) eof() bool {
+	return p.pos >= len(p.tokens)
+}
+
+func (p *exprParser) peek() string {
+	if p.eof() {
+		return ""
+	}
+	return p.tokens[p.pos]
+}
+
+func (p *exprParser) next() string {
+	t := p.peek()
+	p.pos++
+	return t
+}
+
+func (p *exprParser) parseOr() (Filter, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+	for t := strings.ToLower(p.peek()); t == "or" || t == "||"; t = strings.ToLower(p.peek()) {
+		p.next()
+		right, err := p.parseAnd()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(c ConnStat) bool { return l(c) || right(c) }
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseAnd() (Filter, error) {
+	left, err := p.parseNot()
+	if err != nil {
+		return nil, err
+	}
+	for t := strings.ToLower(p.peek()); t == "and" || t == "&&"; t = strings.ToLower(p.peek()) {
+		p.next()
+		right, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		l := left
+		left = func(c ConnStat) bool { return l(c) && right(c) }
+	}
+	return left, nil
+}
+
+func (p *exprParser) parseNot() (Filter, error) {
+	if t := strings.ToLower(p.peek()); t == "not" || t == "!" {
+		p.next()
+		f, err := p.parseNot()
+		if err != nil {
+			return nil, err
+		}
+		return func(c ConnStat) bool { return !f(c) }, nil
+	}
+	return p.parsePrimary()
+}
+
+func (p *exprParser) parsePrimary() (Filter, error) {
+	if p.eof() {
+		return nil, errors.New("unexpected end of expression")
+	}
+	if p.peek() == "(" {
+		p.next()
+		f, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if p.next() != ")" {
+			return nil, errors.New("missing )")
+		}
+		return f, nil
+	}
+	name := strings.ToLower(p.next())
+	field, ok := exprFields[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown field %q", name)
+	}
+	// state/exclude/src/dst 可省略运算符: state established, src 10.0.0.0/8
+	op := "=="
+	if isOp(p.peek()) {
+		op = p.next()
+		if w, ok := wordOps[strings.ToLower(op)]; ok {
+			op = w
+		}
+	} else if field.kind == numField || field.kind == portField || field.kind == countryField {
+		return nil, fmt.Errorf("missing operator after %v", name)
+	}
+	if p.eof() {
+		return nil, fmt.Errorf("missing value after %v %v", name, op)
+	}
+	value := p.next()
+	switch field.kind {
+	case statField:
+		return stateCompare(op, value)
+	case excludeField:
+		f, err := stateCompare(op, value)
+		if err != nil {
+			return nil, err
+		}
+		return func(c ConnStat) bool { return !f(c) }, nil
+	case portField:
+		port, err := parsePort(value)
+		if err != nil {
+			return nil, err
+		}
+		return numCompare(field.num, op, strconv.Itoa(port))
+	case addrField:
+		return addrCompare(field.addr, op, value)
+	case countryField:
+		return countryCompare(op, value)
+	default:
+		if name == "asn" {
+			value = strings.TrimPrefix(strings.ToUpper(value), "AS")
+		}
+		return numCompare(field.num, op, value)
+	}
+}
+
+func isOp(t string) bool {
+	switch t {
+	case "==", "=", "!=", "<", "<=", ">", ">=":
+		return true
+	}
+	_, ok := wordOps[strings.ToLower(t)]
+	return ok
+}
+
+func parseStat(s string) (TCPStat, error) {
+	if stat, ok := ssStates[strings.ToLower(s)]; ok {
+		return stat, nil
+	}
+	stat := TCPStat(strings.ToUpper(s))
+	for _, v := range TCPStatCodeString {
+		if v == stat {
+			return stat, nil
+		}
+	}
+	return "", fmt.Errorf("unknown state %q", s)
+}
+
+func stateCompare(op, value string) (Filter, error) {
+	stat, err := parseStat(value)
+	if err != nil {
+		return nil, err
+	}
+	switch op {
+	case "==", "=":
+		return func(c ConnStat) bool { return c.Stat == stat }, nil
+	case "!=":
+		return func(c ConnStat) bool { return c.Stat != stat }, nil
+	}
+	return nil, fmt.Errorf("bad operator %q for state", op)
+}
+
+func countryCompare(op, value string) (Filter, error) {
+	cc := strings.ToUpper(value)
+	switch op {
+	case "==", "=":
+		return func(c ConnStat) bool { return CurrentGeo().Lookup(c.Remote.IP).Country == cc }, nil
+	case "!=":
+		return func(c ConnStat) bool { return CurrentGeo().Lookup(c.Remote.IP).Country != cc }, nil
+	}
+	return nil, fmt.Errorf("bad operator %q for country", op)
+}
+
+func numCompare(get func(ConnStat) int64, op, value string) (Filter, error) {
+	n, err := strconv.ParseInt(value, 0, 64)
+	if err != nil {
+		return nil, fmt.Errorf("bad number %q", value)
+	}
+	switch op {
+	case "==", "=":
+		return func(c ConnStat) bool { return get(c) == n }, nil
+	case "!=":
+		return func(c ConnStat) bool { return get(c) != n }, nil
+	case "<":
+		return func(c ConnStat) bool { return get(c) < n }, nil
+	case "<=":
+		return func(c ConnStat) bool { return get(c) <= n }, nil
+	case ">":
+		return func(c ConnStat) bool { return get(c) > n }, nil
+	case ">=":
+		return func(c ConnStat) bool { return get(c) >= n }, nil
+	}
+	return nil, fmt.Errorf("bad operator %q", op)
+}
+
+// parsePort 支持 443, :443, :https
+func parsePort(s string) (int, error) {
+	s = strings.TrimPrefix(s, ":")
+	if port, err := strconv.Atoi(s); err == nil {
+		return port, nil
+	}
+	port, err := net.LookupPort("tcp", s)
+	if err != nil {
+		return 0, fmt.Errorf("bad port %q", s)
+	}
+	return port, nil
+}
+
+// addrCompare 支持 10.0.0.1, 10.0.0.0/8, 10.0.0.1:80, [::1]:80, :80, *
+func addrCompare(get func(ConnStat) IPPort, op, value string) (Filter, error) {
+	host, port := value, -1
+	if strings.HasPrefix(value, "[") {
+		i := strings.Index(value, "]")
+		if i < 0 {
+			return nil, fmt.Errorf("bad address %q", value)
+		}
+		host = value[1:i]
+		if rest := value[i+1:]; rest != "" {
+			p, err := parsePort(rest)
+			if err != nil {
+				return nil, err
+			}
+			port = p
+		}
+	} else if strings.Count(value, ":") == 1 {
+		i := strings.Index(value, ":")
+		p, err := parsePort(value[i:])
+		if err != nil {
+			return nil, err
+		}
+		host, port = value[:i], p
+	}
+	var ipnet *net.IPNet
+	if host != "" && host != "*" {
+		ipnet = ParseIPNet(host)
+		if ipnet == nil {
+			return nil, fmt.Errorf("bad address %q", value)
+		}
+	}
+	match := func(c ConnStat) bool {
+		a := get(c)
+		if ipnet != nil && !ipnet.Contains(a.IP) {
+			return false
+		}
+		return port < 0 || int(a.Port) == port
+	}
+	switch op {
+	case "==", "=":
+		return match, nil
+	case "!=":
+		return func(c ConnStat) bool { return !match(c) }, nil
+	}
+	return nil, fmt.Errorf("bad operator %q for address", op)
+}
+
+// ParseIPNet 解析 IP 或 CIDR, 单个IP视为 /32 或 /128, 失败返回 nil
+func ParseIPNet(s string) *net.IPNet {
+	if !strings.Contains(s, "/") {
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil
+		}
+		if v4 := ip.To4(); v4 != nil {
+			return &net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)}
+		}
+		return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+	}
+	_, ipnet, err := net.ParseCIDR(s)
+	if err != nil {
+		return nil
+	}
+	return ipnet
+}
+
+// PortFilter 本地端口为 ports 之一, ports 为空时返回 nil 不过滤
+func PortFilter(ports []int) Filter {
+	if len(ports) == 0 {
+		return nil
+	}
+	return func(c ConnStat) bool { return hasPort(ports, c.Local.Port) }
+}
+
+// And 组合多个 Filter, nil 视为全部通过
+func And(filters ...Filter) Filter {
+	var fs []Filter
+	for _, f := range filters {
+		if f != nil {
+			fs = append(fs, f)
+		}
+	}
+	if len(fs) == 0 {
+		return nil
+	}
+	return func(c ConnStat) bool {
+		for _, f := range fs {
+			if !f(c) {
+				return false
+			}
+		}
+		return true
+	}
+}
+
+// FilterStats 返回满足 f 的连接, f 为 nil 时原样返回
+func FilterStats(stats []ConnStat, f Filter) []ConnStat {
+	if f == nil {
+		return stats
+	}
+	result := make([]ConnStat, 0, len(stats))
+	for _, c := range stats {
+		if f(c) {
+			result = append(result, c)
+		}
+	}
+	return result
+}
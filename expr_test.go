@@ -0,0 +1,123 @@
+package tcpguarder
+
+import (
+	"net"
+	"testing"
+)
+
+func testConn(local, remote string, lport, rport uint16, stat TCPStat) ConnStat {
+	return ConnStat{
+		Local:  IPPort{IP: net.ParseIP(local), Port: lport},
+		Remote: IPPort{IP: net.ParseIP(remote), Port: rport},
+		Stat:   stat,
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	c := testConn("10.0.0.1", "203.0.113.9", 443, 51000, ESTABLISHED)
+	c.TxQueue, c.RxQueue, c.CongestionWindow = 10, 20, 1
+	c.TimerActive, c.RTOTimeouts = 1, 5
+	tests := []struct {
+		expr string
+		want bool
+	}{
+		{"cwnd == 1 and txq != 0 and rxq != 0", true},
+		{"cwnd eq 1 and txq ne 0", true},
+		{"retrans gt 3 && timer = 1", true},
+		{"retrans >= 6", false},
+		{"txq < 11 and rxq <= 20", true},
+		{"state established", true},
+		{"state == ESTABLISHED", true},
+		{"state != established", false},
+		{"exclude time-wait", true},
+		{"exclude established", false},
+		//ss 语义: src/sport 本地, dst/dport 远端
+		{"sport = :443", true},
+		{"sport = :https", true},
+		{"dport = :443", false},
+		{"dport = 51000", true},
+		{"lport == 443 and rport == 51000", true},
+		{"src 10.0.0.0/8", true},
+		{"dst 10.0.0.0/8", false},
+		{"dst 203.0.113.9:51000", true},
+		{"dst != 203.0.113.0/24", false},
+		{"src :443", true},
+		{"src *:80", false},
+		{"laddr 10.0.0.1 and raddr 203.0.113.9", true},
+		{"sport = :443 and dst 10.0.0.0/8", false},
+		{"sport = :443 and dst 203.0.113.0/24", true},
+		//not 优先于 and, and 优先于 or
+		{"not state established or cwnd == 1", true},
+		{"! cwnd == 1", false},
+		{"cwnd == 2 or cwnd == 1 and txq == 10", true},
+		{"(cwnd == 2 or cwnd == 1) and txq == 0", false},
+		{"cwnd == 2 or cwnd == 3 and txq == 10", false},
+		{"not (cwnd == 2 or txq == 0)", true},
+		{"uid == 0x0", true},
+	}
+	for _, tt := range tests {
+		f, err := ParseFilter(tt.expr)
+		if err != nil {
+			t.Errorf("ParseFilter(%q): %v", tt.expr, err)
+			continue
+		}
+		if got := f(c); got != tt.want {
+			t.Errorf("ParseFilter(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestParseFilterIPv6(t *testing.T) {
+	c := testConn("2001:db8::1", "2001:db8:1::9", 443, 51000, ESTABLISHED)
+	for expr, want := range map[string]bool{
+		"dst 2001:db8:1::/48":       true,
+		"dst [2001:db8:1::9]:51000": true,
+		"src [2001:db8::1]:80":      false,
+		"src 10.0.0.0/8":            false,
+	} {
+		f, err := ParseFilter(expr)
+		if err != nil {
+			t.Errorf("ParseFilter(%q): %v", expr, err)
+			continue
+		}
+		if got := f(c); got != want {
+			t.Errorf("ParseFilter(%q) = %v, want %v", expr, got, want)
+		}
+	}
+}
+
+func TestParseFilterError(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"cwnd",
+		"cwnd ==",
+		"cwnd == x",
+		"nosuch == 1",
+		"state bogus",
+		"state < established",
+		"(cwnd == 1",
+		"cwnd == 1)",
+		"cwnd == 1 and",
+		"src 10.0.0.0/33",
+		"dst [::1",
+		"sport = :nosuchservice",
+	} {
+		if _, err := ParseFilter(expr); err == nil {
+			t.Errorf("ParseFilter(%q) want error", expr)
+		}
+	}
+}
+
+func TestAnd(t *testing.T) {
+	if And(nil, nil) != nil {
+		t.Error("And(nil, nil) want nil")
+	}
+	c := testConn("10.0.0.1", "203.0.113.9", 443, 51000, ESTABLISHED)
+	f := And(PortFilter([]int{80, 443}), nil)
+	if !f(c) {
+		t.Error("port 443 want match")
+	}
+	if And(PortFilter([]int{80}))(c) {
+		t.Error("port 80 want no match")
+	}
+}
@@ -1,5 +1,8 @@
 module github.com/lixiangzhong/tcpguarder
 
-go 1.14
+go 1.16
 
-require github.com/urfave/cli/v2 v2.2.0
+require (
+	github.com/urfave/cli/v2 v2.2.0
+	gopkg.in/yaml.v2 v2.4.0
+)
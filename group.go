@@ -0,0 +1,198 @@
+package tcpguarder
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// GroupBy 分组维度, 可任意组合
+type GroupBy struct {
+	Remote    int //按远端IPv4前缀分组, 例如 24, 只指定 Remote6 时为完整地址
+	Remote6   int //按远端IPv6前缀分组, 例如 64, 只指定 Remote 时为完整地址
+	LocalIP   bool
+	LocalPort bool
+	State     bool
+	UID       bool
+	Process   bool
+}
+
+// ParseGroupBy 解析逗号分隔的分组维度:
+//
+//	remote      远端IP, 等价于 remote/32,remote6/128
+//	remote/N    远端IPv4 /N 前缀
+//	remote6/N   远端IPv6 /N 前缀
+//	lip lport state uid process
+func ParseGroupBy(s string) (GroupBy, error) {
+	var by GroupBy
+	for _, v := range strings.Split(s, ",") {
+		v = strings.ToLower(strings.TrimSpace(v))
+		if v == "" {
+			continue
+		}
+		name, bits := v, ""
+		if i := strings.Index(v, "/"); i >= 0 {
+			name, bits = v[:i], v[i+1:]
+		}
+		switch name {
+		case "remote", "remote6":
+			max := 32
+			if name == "remote6" {
+				max = 128
+			}
+			n := max
+			if bits != "" {
+				var err error
+				n, err = strconv.Atoi(bits)
+				if err != nil || n <= 0 || n > max {
+					return by, fmt.Errorf("bad prefix length %q", v)
+				}
+			}
+			if name == "remote" {
+				by.Remote = n
+				if bits == "" {
+					by.Remote6 = 128
+				}
+			} else {
+				by.Remote6 = n
+			}
+		case "lip":
+			by.LocalIP = true
+		case "lport":
+			by.LocalPort = true
+		case "state":
+			by.State = true
+		case "uid":
+			by.UID = true
+		case "process":
+			by.Process = true
+		default:
+			return by, fmt.Errorf("unknown group by %q", v)
+		}
+	}
+	if by == (GroupBy{}) {
+		return by, fmt.Errorf("empty group by")
+	}
+	return by, nil
+}
+
+// GroupKey 分组键, 未参与分组的字段为零值, UID 未参与分组时为 -1
+type GroupKey struct {
+	Remote    *net.IPNet
+	LocalIP   net.IP
+	LocalPort uint16
+	State     TCPStat
+	UID       int
+	Process   string
+}
+
+func (k GroupKey) String() string {
+	var ss []string
+	if k.Remote != nil {
+		ones, bits := k.Remote.Mask.Size()
+		if ones == bits {
+			ss = append(ss, k.Remote.IP.String())
+		} else {
+			ss = append(ss, k.Remote.String())
+		}
+	}
+	if k.LocalIP != nil {
+		ss = append(ss, k.LocalIP.String())
+	}
+	if k.LocalPort != 0 {
+		ss = append(ss, ":"+strconv.Itoa(int(k.LocalPort)))
+	}
+	if k.State != "" {
+		ss = append(ss, string(k.State))
+	}
+	if k.UID >= 0 {
+		ss = append(ss, "uid="+strconv.Itoa(k.UID))
+	}
+	if k.Process != "" {
+		ss = append(ss, k.Process)
+	}
+	return strings.Join(ss, " ")
+}
+
+type GroupItem struct {
+	Key GroupKey
+	N   int
+}
+
+// Group 按 by 统计满足 f 的连接数, 不含 LISTEN, 倒序
+func Group(stats []ConnStat, f Filter, by GroupBy) ([]GroupItem, error) {
+	return GroupFunc(stats, f, by, func(ConnStat) int { return 1 })
+}
+
+// GroupFunc 同 Group, 每条连接计 weight(c), 例如 RuleSet.Score
+func GroupFunc(stats []ConnStat, f Filter, by GroupBy, weight func(ConnStat) int) ([]GroupItem, error) {
+	var procs map[uint64]Process
+	if by.Process {
+		var err error
+		procs, err = SocketProcesses()
+		if err != nil {
+			return nil, err
+		}
+	}
+	m := make(map[string]*GroupItem)
+	for _, c := range stats {
+		if c.Stat == LISTEN {
+			continue
+		}
+		if f != nil && !f(c) {
+			continue
+		}
+		n := weight(c)
+		if n == 0 {
+			continue
+		}
+		key := GroupKey{UID: -1}
+		if by.Remote > 0 || by.Remote6 > 0 {
+			ip, n, bits := c.Remote.IP, by.Remote6, 128
+			if v4 := ip.To4(); v4 != nil {
+				ip, n, bits = v4, by.Remote, 32
+			}
+			//只指定了另一协议的前缀时按完整地址, 避免都归入空的分组
+			if n == 0 {
+				n = bits
+			}
+			mask := net.CIDRMask(n, bits)
+			key.Remote = &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+		}
+		if by.LocalIP {
+			key.LocalIP = c.Local.IP
+		}
+		if by.LocalPort {
+			key.LocalPort = c.Local.Port
+		}
+		if by.State {
+			key.State = c.Stat
+		}
+		if by.UID {
+			key.UID = c.UID
+		}
+		if by.Process {
+			key.Process = "-"
+			if p, ok := procs[c.Inode]; ok {
+				key.Process = p.Name + "/" + strconv.Itoa(p.PID)
+			}
+		}
+		s := key.String()
+		item, ok := m[s]
+		if !ok {
+			item = &GroupItem{Key: key}
+			m[s] = item
+		}
+		item.N += n
+	}
+	items := make([]GroupItem, 0, len(m))
+	for _, v := range m {
+		items = append(items, *v)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].N > items[j].N
+	})
+	return items, nil
+}
@@ -0,0 +1,55 @@
+package tcpguarder
+
+import "testing"
+
+func TestGroupRemoteFamilies(t *testing.T) {
+	stats := []ConnStat{
+		testConn("10.0.0.1", "203.0.113.1", 443, 1, ESTABLISHED),
+		testConn("10.0.0.1", "203.0.113.2", 443, 2, ESTABLISHED),
+		testConn("2001:db8::1", "2001:db8:1::1", 443, 3, ESTABLISHED),
+		testConn("2001:db8::1", "2001:db8:2::1", 443, 4, ESTABLISHED),
+		testConn("10.0.0.1", "0.0.0.0", 443, 0, LISTEN),
+	}
+	tests := []struct {
+		by   string
+		want map[string]int
+	}{
+		{"remote/24", map[string]int{"203.0.113.0/24": 2, "2001:db8:1::1": 1, "2001:db8:2::1": 1}},
+		{"remote6/32", map[string]int{"203.0.113.1": 1, "203.0.113.2": 1, "2001:db8::/32": 2}},
+		{"remote/16,remote6/48", map[string]int{"203.0.0.0/16": 2, "2001:db8:1::/48": 1, "2001:db8:2::/48": 1}},
+		{"remote", map[string]int{"203.0.113.1": 1, "203.0.113.2": 1, "2001:db8:1::1": 1, "2001:db8:2::1": 1}},
+		{"lport", map[string]int{":443": 4}},
+	}
+	for _, tt := range tests {
+		by, err := ParseGroupBy(tt.by)
+		if err != nil {
+			t.Fatal(err)
+		}
+		items, err := Group(stats, nil, by)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := make(map[string]int)
+		for _, v := range items {
+			got[v.Key.String()] = v.N
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("Group(%v) = %v, want %v", tt.by, got, tt.want)
+			continue
+		}
+		for k, n := range tt.want {
+			if got[k] != n {
+				t.Errorf("Group(%v) = %v, want %v", tt.by, got, tt.want)
+				break
+			}
+		}
+	}
+}
+
+func TestParseGroupByError(t *testing.T) {
+	for _, s := range []string{"", "remote/33", "remote6/0", "remote/x", "nosuch"} {
+		if _, err := ParseGroupBy(s); err == nil {
+			t.Errorf("ParseGroupBy(%q) want error", s)
+		}
+	}
+}
@@ -0,0 +1,393 @@
+package tcpguarder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"math/big"
+	"net"
+)
+
+// MMDB MaxMind DB 格式(GeoLite2/GeoIP2 等)的只读解析
+// https://maxmind.github.io/MaxMind-DB/
+type MMDB struct {
+	Metadata   map[string]interface{}
+	buf        []byte
+	nodeCount  uint
+	recordSize uint
+	ipVersion  uint
+	treeSize   uint
+	ipv4Start  uint //IPv6 库中 ::/96 对应的节点
+}
+
+var mmdbMetadataMarker = []byte("\xAB\xCD\xEFMaxMind.com")
+
+func OpenMMDB(file string) (*MMDB, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return NewMMDB(b)
+}
+
+func NewMMDB(b []byte) (*MMDB, error) {
+	i := bytes.LastIndex(b, mmdbMetadataMarker)
+	if i < 0 {
+		return nil, errors.New("mmdb: metadata not found")
+	}
+	d := mmdbDecoder{buf: b[i+len(mmdbMetadataMarker):]}
+	v, _, err := d.decode(0)
+	if err != nil {
+		return nil, fmt.Errorf("mmdb: metadata: %v", err)
+	}
+	meta, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("mmdb: bad metadata")
+	}
+	db := &MMDB{Metadata: meta, buf: b}
+	db.nodeCount = metaUint(meta, "node_count")
+	db.recordSize = metaUint(meta, "record_size")
+	db.ipVersion = metaUint(meta, "ip_version")
+	switch db.recordSize {
+	case 24, 28, 32:
+	default:
+		return nil, fmt.Errorf("mmdb: unsupported record size %v", db.recordSize)
+	}
+	db.treeSize = db.nodeCount * db.recordSize / 4
+	if db.treeSize+16 > uint(len(b)) {
+		return nil, errors.New("mmdb: bad search tree size")
+	}
+	if db.ipVersion == 6 {
+		node := uint(0)
+		for i := 0; i < 96 && node < db.nodeCount; i++ {
+			node = db.readNode(node, 0)
+		}
+		db.ipv4Start = node
+	}
+	return db, nil
+}
+
+func metaUint(meta map[string]interface{}, key string) uint {
+	switch v := meta[key].(type) {
+	case uint64:
+		return uint(v)
+	case uint32:
+		return uint(v)
+	case uint16:
+		return uint(v)
+	}
+	return 0
+}
+
+func (db *MMDB) readNode(node uint, bit uint) uint {
+	off := node * db.recordSize / 4
+	b := db.buf[off:]
+	switch db.recordSize {
+	case 24:
+		o := bit * 3
+		return uint(b[o])<<16 | uint(b[o+1])<<8 | uint(b[o+2])
+	case 28:
+		if bit == 0 {
+			return uint(b[3]&0xF0)<<20 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])
+		}
+		return uint(b[3]&0x0F)<<24 | uint(b[4])<<16 | uint(b[5])<<8 | uint(b[6])
+	default:
+		return uint(binary.BigEndian.Uint32(b[bit*4:]))
+	}
+}
+
+// Lookup 返回 ip 对应的记录, 未找到返回 nil
+func (db *MMDB) Lookup(ip net.IP) (interface{}, error) {
+	node := uint(0)
+	bits := 128
+	if v4 := ip.To4(); v4 != nil {
+		ip = v4
+		bits = 32
+		if db.ipVersion == 6 {
+			node = db.ipv4Start
+		}
+	} else if db.ipVersion == 4 {
+		return nil, nil
+	}
+	for i := 0; i < bits && node < db.nodeCount; i++ {
+		node = db.readNode(node, uint(bit(ip, i)))
+	}
+	if node == db.nodeCount {
+		return nil, nil
+	}
+	if node < db.nodeCount {
+		return nil, errors.New("mmdb: invalid node in search tree")
+	}
+	off := node - db.nodeCount - 16
+	d := mmdbDecoder{buf: db.buf[db.treeSize+16:]}
+	if off >= uint(len(d.buf)) {
+		return nil, errors.New("mmdb: invalid data pointer")
+	}
+	v, _, err := d.decode(off)
+	return v, err
+}
+
+// LookupString 按路径取字符串, 例如 "country", "iso_code"
+func (db *MMDB) LookupString(ip net.IP, path ...string) string {
+	v, err := db.Lookup(ip)
+	if err != nil {
+		return ""
+	}
+	return mmdbPathString(v, path...)
+}
+
+// LookupUint 按路径取整数, 例如 "autonomous_system_number"
+func (db *MMDB) LookupUint(ip net.IP, path ...string) uint64 {
+	v, err := db.Lookup(ip)
+	if err != nil {
+		return 0
+	}
+	return mmdbPathUint(v, path...)
+}
+
+func mmdbPathString(v interface{}, path ...string) string {
+	s, _ := mmdbPath(v, path...).(string)
+	return s
+}
+
+func mmdbPathUint(v interface{}, path ...string) uint64 {
+	switch n := mmdbPath(v, path...).(type) {
+	case uint64:
+		return n
+	case uint32:
+		return uint64(n)
+	case uint16:
+		return uint64(n)
+	}
+	return 0
+}
+
+func mmdbPath(v interface{}, path ...string) interface{} {
+	for _, p := range path {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		v = m[p]
+	}
+	return v
+}
+
+type mmdbDecoder struct {
+	buf []byte
+}
+
+const (
+	mmdbExtended = iota
+	mmdbPointer
+	mmdbString
+	mmdbDouble
+	mmdbBytes
+	mmdbUint16
+	mmdbUint32
+	mmdbMap
+	mmdbInt32
+	mmdbUint64
+	mmdbUint128
+	mmdbArray
+	mmdbContainer
+	mmdbEndMarker
+	mmdbBool
+	mmdbFloat
+)
+
+var errMMDBData = errors.New("mmdb: invalid data section")
+
+func (d *mmdbDecoder) decode(off uint) (interface{}, uint, error) {
+	if off >= uint(len(d.buf)) {
+		return nil, 0, errMMDBData
+	}
+	ctrl := d.buf[off]
+	off++
+	typ := uint(ctrl >> 5)
+	if typ == mmdbPointer {
+		ptr, next, err := d.pointer(ctrl, off)
+		if err != nil {
+			return nil, 0, err
+		}
+		v, _, err := d.decode(ptr)
+		return v, next, err
+	}
+	if typ == mmdbExtended {
+		if off >= uint(len(d.buf)) {
+			return nil, 0, errMMDBData
+		}
+		typ = uint(d.buf[off]) + 7
+		off++
+	}
+	size := uint(ctrl & 0x1F)
+	if size >= 29 {
+		n := size - 28
+		if off+n > uint(len(d.buf)) {
+			return nil, 0, errMMDBData
+		}
+		var v uint
+		for _, b := range d.buf[off : off+n] {
+			v = v<<8 | uint(b)
+		}
+		off += n
+		switch size {
+		case 29:
+			size = 29 + v
+		case 30:
+			size = 285 + v
+		default:
+			size = 65821 + v
+		}
+	}
+	switch typ {
+	case mmdbMap:
+		m := make(map[string]interface{}, size)
+		for i := uint(0); i < size; i++ {
+			k, next, err := d.decode(off)
+			if err != nil {
+				return nil, 0, err
+			}
+			key, ok := k.(string)
+			if !ok {
+				return nil, 0, errMMDBData
+			}
+			v, next, err := d.decode(next)
+			if err != nil {
+				return nil, 0, err
+			}
+			m[key] = v
+			off = next
+		}
+		return m, off, nil
+	case mmdbArray:
+		a := make([]interface{}, 0, size)
+		for i := uint(0); i < size; i++ {
+			v, next, err := d.decode(off)
+			if err != nil {
+				return nil, 0, err
+			}
+			a = append(a, v)
+			off = next
+		}
+		return a, off, nil
+	case mmdbBool:
+		return size != 0, off, nil
+	}
+	if off+size > uint(len(d.buf)) {
+		return nil, 0, errMMDBData
+	}
+	b := d.buf[off : off+size]
+	off += size
+	switch typ {
+	case mmdbString:
+		return string(b), off, nil
+	case mmdbBytes:
+		return append([]byte(nil), b...), off, nil
+	case mmdbDouble:
+		if size != 8 {
+			return nil, 0, errMMDBData
+		}
+		return math.Float64frombits(binary.BigEndian.Uint64(b)), off, nil
+	case mmdbFloat:
+		if size != 4 {
+			return nil, 0, errMMDBData
+		}
+		return math.Float32frombits(binary.BigEndian.Uint32(b)), off, nil
+	case mmdbUint16, mmdbUint32, mmdbUint64:
+		var v uint64
+		for _, c := range b {
+			v = v<<8 | uint64(c)
+		}
+		switch typ {
+		case mmdbUint16:
+			return uint16(v), off, nil
+		case mmdbUint32:
+			return uint32(v), off, nil
+		}
+		return v, off, nil
+	case mmdbInt32:
+		var v uint32
+		for _, c := range b {
+			v = v<<8 | uint32(c)
+		}
+		return int32(v), off, nil
+	case mmdbUint128:
+		return new(big.Int).SetBytes(b), off, nil
+	}
+	return nil, 0, fmt.Errorf("mmdb: unknown data type %v", typ)
+}
+
+func (d *mmdbDecoder) pointer(ctrl byte, off uint) (uint, uint, error) {
+	ss := uint(ctrl>>3) & 0x3
+	n := ss + 1
+	if off+n > uint(len(d.buf)) {
+		return 0, 0, errMMDBData
+	}
+	b := d.buf[off : off+n]
+	vvv := uint(ctrl & 0x7)
+	var ptr uint
+	switch ss {
+	case 0:
+		ptr = vvv<<8 | uint(b[0])
+	case 1:
+		ptr = (vvv<<16 | uint(b[0])<<8 | uint(b[1])) + 2048
+	case 2:
+		ptr = (vvv<<24 | uint(b[0])<<16 | uint(b[1])<<8 | uint(b[2])) + 526336
+	default:
+		ptr = uint(binary.BigEndian.Uint32(b))
+	}
+	return ptr, off + n, nil
+}
+
+// GeoIP 国家和 ASN 查询, 对应 GeoLite2-Country 和 GeoLite2-ASN 两个库, 任一可为 nil
+type GeoIP struct {
+	Country *MMDB
+	ASN     *MMDB
+}
+
+type GeoInfo struct {
+	Country string //ISO 国家代码
+	ASN     uint64
+	ASOrg   string
+}
+
+// Lookup g 为 nil 时返回空, 每个库只解码一次记录
+func (g *GeoIP) Lookup(ip net.IP) GeoInfo {
+	var info GeoInfo
+	if g == nil || ip == nil {
+		return info
+	}
+	if g.Country != nil {
+		if v, err := g.Country.Lookup(ip); err == nil {
+			info.Country = mmdbPathString(v, "country", "iso_code")
+			if info.Country == "" {
+				info.Country = mmdbPathString(v, "registered_country", "iso_code")
+			}
+		}
+	}
+	if g.ASN != nil {
+		if v, err := g.ASN.Lookup(ip); err == nil {
+			info.ASN = mmdbPathUint(v, "autonomous_system_number")
+			info.ASOrg = mmdbPathString(v, "autonomous_system_organization")
+		}
+	}
+	return info
+}
+
+func (i GeoInfo) String() string {
+	s := i.Country
+	if s == "" {
+		s = "-"
+	}
+	if i.ASN != 0 {
+		s += fmt.Sprintf(" AS%v", i.ASN)
+		if i.ASOrg != "" {
+			s += " " + i.ASOrg
+		}
+	}
+	return s
+}
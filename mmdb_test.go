@@ -0,0 +1,226 @@
+package tcpguarder
+
+import (
+	"bytes"
+	"encoding/binary"
+	"net"
+	"testing"
+)
+
+// 测试用的 MMDB 数据编码, 只支持长度小于65821的值
+func mmdbCtrl(typ, size int) []byte {
+	var ext []byte
+	switch {
+	case size >= 285:
+		ext = []byte{byte((size - 285) >> 8), byte(size - 285)}
+		size = 30
+	case size >= 29:
+		ext = []byte{byte(size - 29)}
+		size = 29
+	}
+	if typ > 7 {
+		return append([]byte{byte(size), byte(typ - 7)}, ext...)
+	}
+	return append([]byte{byte(typ<<5 | size)}, ext...)
+}
+
+func mmdbStr(s string) []byte {
+	return append(mmdbCtrl(mmdbString, len(s)), s...)
+}
+
+func mmdbU32(v uint32) []byte {
+	b := make([]byte, 4)
+	binary.BigEndian.PutUint32(b, v)
+	return append(mmdbCtrl(mmdbUint32, 4), b...)
+}
+
+func mmdbMapOf(kvs ...[]byte) []byte {
+	b := mmdbCtrl(mmdbMap, len(kvs)/2)
+	for _, v := range kvs {
+		b = append(b, v...)
+	}
+	return b
+}
+
+// mmdbPtr 按 ptr 大小选最短的指针编码
+func mmdbPtr(ptr uint) []byte {
+	switch {
+	case ptr < 2048:
+		return []byte{byte(0x20 | ptr>>8), byte(ptr)}
+	case ptr < 526336:
+		p := ptr - 2048
+		return []byte{byte(0x28 | p>>16), byte(p >> 8), byte(p)}
+	case ptr < 134744064:
+		p := ptr - 526336
+		return []byte{byte(0x30 | p>>24), byte(p >> 16), byte(p >> 8), byte(p)}
+	}
+	return []byte{0x38, byte(ptr >> 24), byte(ptr >> 16), byte(ptr >> 8), byte(ptr)}
+}
+
+func putRecord(b []byte, size, bit int, v uint) {
+	switch size {
+	case 24:
+		o := bit * 3
+		b[o], b[o+1], b[o+2] = byte(v>>16), byte(v>>8), byte(v)
+	case 28:
+		if bit == 0 {
+			b[0], b[1], b[2] = byte(v>>16), byte(v>>8), byte(v)
+			b[3] = b[3]&0x0F | byte(v>>20)&0xF0
+		} else {
+			b[4], b[5], b[6] = byte(v>>16), byte(v>>8), byte(v)
+			b[3] = b[3]&0xF0 | byte(v>>24)&0x0F
+		}
+	default:
+		binary.BigEndian.PutUint32(b[bit*4:], uint32(v))
+	}
+}
+
+// buildMMDB 生成一个小库:
+// 0.0.0.0/2 国家 CN, AS4134 CHINANET; 64.0.0.0/2 只有 registered_country JP; 128.0.0.0/1 无记录.
+// IPv6 库中 ::/96 之前的96个节点全部向左, 其余 IPv6 地址无记录
+func buildMMDB(recordSize, ipVersion int) []byte {
+	//数据区: 0 处为 "CN", 记录用指针引用
+	data := mmdbStr("CN")
+	recA := uint(len(data))
+	data = append(data, mmdbMapOf(
+		mmdbStr("country"), mmdbMapOf(mmdbStr("iso_code"), mmdbPtr(0)),
+		mmdbStr("autonomous_system_number"), mmdbU32(4134),
+		mmdbStr("autonomous_system_organization"), mmdbStr("CHINANET"),
+	)...)
+	recB := uint(len(data))
+	data = append(data, mmdbMapOf(
+		mmdbStr("registered_country"), mmdbMapOf(mmdbStr("iso_code"), mmdbStr("JP")),
+	)...)
+
+	var nodes [][2]uint
+	prefix := 0
+	if ipVersion == 6 {
+		prefix = 96
+	}
+	count := uint(prefix + 2)
+	for i := 0; i < prefix; i++ {
+		nodes = append(nodes, [2]uint{uint(i + 1), count})
+	}
+	nodes = append(nodes,
+		[2]uint{uint(prefix + 1), count},
+		[2]uint{count + 16 + recA, count + 16 + recB},
+	)
+	nodeSize := recordSize / 4
+	tree := make([]byte, len(nodes)*nodeSize)
+	for i, n := range nodes {
+		putRecord(tree[i*nodeSize:], recordSize, 0, n[0])
+		putRecord(tree[i*nodeSize:], recordSize, 1, n[1])
+	}
+	b := append(tree, make([]byte, 16)...)
+	b = append(b, data...)
+	b = append(b, mmdbMetadataMarker...)
+	b = append(b, mmdbMapOf(
+		mmdbStr("node_count"), mmdbU32(uint32(count)),
+		mmdbStr("record_size"), append(mmdbCtrl(mmdbUint16, 1), byte(recordSize)),
+		mmdbStr("ip_version"), append(mmdbCtrl(mmdbUint16, 1), byte(ipVersion)),
+	)...)
+	return b
+}
+
+func TestMMDBLookup(t *testing.T) {
+	tests := []struct {
+		ip   string
+		want GeoInfo
+	}{
+		{"1.2.3.4", GeoInfo{Country: "CN", ASN: 4134, ASOrg: "CHINANET"}},
+		{"::ffff:63.255.255.255", GeoInfo{Country: "CN", ASN: 4134, ASOrg: "CHINANET"}},
+		{"64.0.0.1", GeoInfo{Country: "JP"}},
+		{"128.0.0.1", GeoInfo{}},
+		{"255.255.255.255", GeoInfo{}},
+		{"2001:db8::1", GeoInfo{}},
+	}
+	for _, size := range []int{24, 28, 32} {
+		for _, version := range []int{4, 6} {
+			db, err := NewMMDB(buildMMDB(size, version))
+			if err != nil {
+				t.Fatalf("record size %v ip version %v: %v", size, version, err)
+			}
+			g := &GeoIP{Country: db, ASN: db}
+			for _, tt := range tests {
+				if got := g.Lookup(net.ParseIP(tt.ip)); got != tt.want {
+					t.Errorf("record size %v ip version %v: Lookup(%v) = %+v, want %+v", size, version, tt.ip, got, tt.want)
+				}
+			}
+		}
+	}
+}
+
+func TestMMDBReadNode(t *testing.T) {
+	tests := []struct {
+		size        uint
+		b           []byte
+		left, right uint
+	}{
+		{24, []byte{0x12, 0x34, 0x56, 0xAB, 0xCD, 0xEF}, 0x123456, 0xABCDEF},
+		{28, []byte{0x12, 0x34, 0x56, 0xAB, 0xCD, 0xEF, 0x01}, 0xA123456, 0xBCDEF01},
+		{32, []byte{0x12, 0x34, 0x56, 0x78, 0x9A, 0xBC, 0xDE, 0xF0}, 0x12345678, 0x9ABCDEF0},
+	}
+	for _, tt := range tests {
+		db := &MMDB{buf: tt.b, recordSize: tt.size}
+		if l, r := db.readNode(0, 0), db.readNode(0, 1); l != tt.left || r != tt.right {
+			t.Errorf("record size %v: readNode = %#x %#x, want %#x %#x", tt.size, l, r, tt.left, tt.right)
+		}
+	}
+}
+
+func TestMMDBPointer(t *testing.T) {
+	for _, ptr := range []uint{0, 2047, 2048, 526335, 526336, 600000} {
+		enc := mmdbPtr(ptr)
+		buf := make([]byte, 600016)
+		copy(buf, enc)
+		//目标不能与指针本身重叠
+		target := ptr
+		if target < uint(len(enc)) {
+			target = 16
+			enc = mmdbPtr(target)
+			copy(buf, enc)
+		}
+		copy(buf[target:], mmdbStr("ok"))
+		d := mmdbDecoder{buf: buf}
+		v, next, err := d.decode(0)
+		if err != nil || v != "ok" || next != uint(len(enc)) {
+			t.Errorf("pointer %v: decode = %v, %v, %v", target, v, next, err)
+		}
+	}
+}
+
+func TestMMDBDecodeTypes(t *testing.T) {
+	b := mmdbMapOf(
+		mmdbStr("u64"), append(mmdbCtrl(mmdbUint64, 2), 0x01, 0x00),
+		mmdbStr("i32"), append(mmdbCtrl(mmdbInt32, 4), 0xFF, 0xFF, 0xFF, 0xFE),
+		mmdbStr("bool"), mmdbCtrl(mmdbBool, 1),
+		mmdbStr("arr"), append(mmdbCtrl(mmdbArray, 2), append(mmdbStr("a"), mmdbStr("b")...)...),
+		mmdbStr("long"), mmdbStr(string(bytes.Repeat([]byte("x"), 300))),
+	)
+	d := mmdbDecoder{buf: b}
+	v, _, err := d.decode(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := v.(map[string]interface{})
+	if m["u64"] != uint64(256) || m["i32"] != int32(-2) || m["bool"] != true ||
+		len(m["arr"].([]interface{})) != 2 || m["long"] != string(bytes.Repeat([]byte("x"), 300)) {
+		t.Errorf("decode = %#v", m)
+	}
+	if _, _, err := (&mmdbDecoder{buf: b[:len(b)-1]}).decode(0); err == nil {
+		t.Error("truncated data want error")
+	}
+}
+
+func TestNewMMDBError(t *testing.T) {
+	b := buildMMDB(24, 4)
+	if _, err := NewMMDB(b[:10]); err == nil {
+		t.Error("missing metadata want error")
+	}
+	bad := append([]byte(nil), b...)
+	i := bytes.Index(bad, []byte("record_size"))
+	bad[i+len("record_size")+1] = 20
+	if _, err := NewMMDB(bad); err == nil {
+		t.Error("record size 20 want error")
+	}
+}
@@ -0,0 +1,53 @@
+package tcpguarder
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+type Process struct {
+	PID  int
+	Name string
+}
+
+// SocketProcesses 扫描 /proc/[pid]/fd 返回 socket inode 对应的进程, 非 root 只能看到自己的进程
+func SocketProcesses() (map[uint64]Process, error) {
+	dirs, err := ioutil.ReadDir("/proc")
+	if err != nil {
+		return nil, err
+	}
+	m := make(map[uint64]Process)
+	for _, d := range dirs {
+		pid, err := strconv.Atoi(d.Name())
+		if err != nil || !d.IsDir() {
+			continue
+		}
+		fddir := filepath.Join("/proc", d.Name(), "fd")
+		fds, err := ioutil.ReadDir(fddir)
+		if err != nil {
+			continue
+		}
+		var proc *Process
+		for _, fd := range fds {
+			link, err := os.Readlink(filepath.Join(fddir, fd.Name()))
+			if err != nil || !strings.HasPrefix(link, "socket:[") {
+				continue
+			}
+			inode, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(link, "socket:["), "]"), 10, 64)
+			if err != nil {
+				continue
+			}
+			if proc == nil {
+				proc = &Process{PID: pid}
+				if b, err := ioutil.ReadFile(filepath.Join("/proc", d.Name(), "comm")); err == nil {
+					proc.Name = strings.TrimSpace(string(b))
+				}
+			}
+			m[inode] = *proc
+		}
+	}
+	return m, nil
+}
@@ -0,0 +1,119 @@
+package tcpguarder
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ParseDelegated 解析 RIR delegated-stats 文件 (APNIC/RIPE/ARIN/LACNIC/AFRINIC 通用格式),
+// 返回国家代码为 ccs 之一的 IPv4/IPv6 网段, 只包含 allocated 和 assigned
+//
+//	apnic|CN|ipv4|1.0.1.0|256|20110414|allocated
+//	apnic|CN|ipv6|2001:250::|35|20000426|allocated
+func ParseDelegated(r io.Reader, ccs []string) (v4, v6 []*net.IPNet, err error) {
+	want := make(map[string]bool)
+	for _, cc := range ccs {
+		want[strings.ToUpper(cc)] = true
+	}
+	scanner := bufio.NewScanner(r)
+	n := 0
+	for scanner.Scan() {
+		n++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		cols := strings.Split(line, "|")
+		//版本行和汇总行
+		if len(cols) < 7 || cols[1] == "*" {
+			continue
+		}
+		if !want[strings.ToUpper(cols[1])] {
+			continue
+		}
+		switch cols[6] {
+		case "allocated", "assigned":
+		default:
+			continue
+		}
+		//asn 记录
+		if cols[2] != "ipv4" && cols[2] != "ipv6" {
+			continue
+		}
+		start := net.ParseIP(cols[3])
+		if start == nil {
+			return nil, nil, fmt.Errorf("line %v: bad start %q", n, cols[3])
+		}
+		value, err := strconv.ParseUint(cols[4], 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("line %v: bad value %q", n, cols[4])
+		}
+		switch cols[2] {
+		case "ipv4":
+			//value 为地址个数, 不一定是2的幂
+			if start.To4() == nil || value == 0 || value > 1<<32 {
+				return nil, nil, fmt.Errorf("line %v: bad ipv4 range", n)
+			}
+			end := new(big.Int).Add(ipToInt(start.To4()), new(big.Int).SetUint64(value-1))
+			if end.BitLen() > 32 {
+				return nil, nil, fmt.Errorf("line %v: bad ipv4 range", n)
+			}
+			v4 = append(v4, RangeToCIDRs(start.To4(), intToIP(end, net.IPv4len))...)
+		case "ipv6":
+			//value 为前缀长度
+			if start.To4() != nil || value > 128 {
+				return nil, nil, fmt.Errorf("line %v: bad ipv6 prefix", n)
+			}
+			mask := net.CIDRMask(int(value), 128)
+			v6 = append(v6, &net.IPNet{IP: start.Mask(mask), Mask: mask})
+		}
+	}
+	return v4, v6, scanner.Err()
+}
+
+// RangeToCIDRs 把 [start, end] 地址范围拆成最少的 CIDR, start 和 end 须同为 IPv4 或 IPv6
+func RangeToCIDRs(start, end net.IP) []*net.IPNet {
+	size := net.IPv6len
+	if start.To4() != nil && end.To4() != nil {
+		size = net.IPv4len
+		start, end = start.To4(), end.To4()
+	}
+	bits := size * 8
+	lo, hi := ipToInt(start), ipToInt(end)
+	one := big.NewInt(1)
+	var nets []*net.IPNet
+	for lo.Cmp(hi) <= 0 {
+		//lo 对齐的最大块
+		n := int(lo.TrailingZeroBits())
+		if lo.Sign() == 0 {
+			n = bits
+		}
+		//不超过 hi
+		for n > 0 {
+			last := new(big.Int).Add(lo, new(big.Int).Sub(new(big.Int).Lsh(one, uint(n)), one))
+			if last.Cmp(hi) <= 0 {
+				break
+			}
+			n--
+		}
+		nets = append(nets, &net.IPNet{IP: intToIP(lo, size), Mask: net.CIDRMask(bits-n, bits)})
+		lo = new(big.Int).Add(lo, new(big.Int).Lsh(one, uint(n)))
+	}
+	return nets
+}
+
+func ipToInt(ip net.IP) *big.Int {
+	return new(big.Int).SetBytes(ip)
+}
+
+func intToIP(i *big.Int, size int) net.IP {
+	b := i.Bytes()
+	ip := make(net.IP, size)
+	copy(ip[size-len(b):], b)
+	return ip
+}
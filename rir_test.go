@@ -0,0 +1,85 @@
+package tcpguarder
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"testing"
+)
+
+func netsString(nets []*net.IPNet) string {
+	ss := make([]string, len(nets))
+	for i, v := range nets {
+		ss[i] = v.String()
+	}
+	return strings.Join(ss, " ")
+}
+
+func TestRangeToCIDRs(t *testing.T) {
+	tests := []struct {
+		start, end string
+		want       string
+	}{
+		{"1.0.1.0", "1.0.1.255", "1.0.1.0/24"},
+		{"1.0.1.0", "1.0.2.255", "1.0.1.0/24 1.0.2.0/24"},
+		{"1.0.0.0", "1.0.2.255", "1.0.0.0/23 1.0.2.0/24"},
+		{"10.0.0.1", "10.0.0.6", "10.0.0.1/32 10.0.0.2/31 10.0.0.4/31 10.0.0.6/32"},
+		{"10.0.0.5", "10.0.0.5", "10.0.0.5/32"},
+		{"0.0.0.0", "255.255.255.255", "0.0.0.0/0"},
+		{"255.255.255.254", "255.255.255.255", "255.255.255.254/31"},
+		{"::ffff:192.0.2.0", "192.0.2.255", "192.0.2.0/24"},
+		{"2001:db8::", "2001:db8::ffff", "2001:db8::/112"},
+		{"::", "ffff:ffff:ffff:ffff:ffff:ffff:ffff:ffff", "::/0"},
+		{"10.0.0.2", "10.0.0.1", ""},
+	}
+	for _, tt := range tests {
+		got := netsString(RangeToCIDRs(net.ParseIP(tt.start), net.ParseIP(tt.end)))
+		if got != tt.want {
+			t.Errorf("RangeToCIDRs(%v, %v) = %v, want %v", tt.start, tt.end, got, tt.want)
+		}
+	}
+}
+
+func TestParseDelegated(t *testing.T) {
+	v4, v6, err := ParseDelegated(strings.NewReader(`
+2|apnic|20240101|3|19830613|20240101|+1000
+apnic|*|ipv4|*|2|summary
+# comment
+apnic|CN|ipv4|1.0.1.0|256|20110414|allocated
+apnic|cn|ipv4|1.0.2.0|768|20110414|assigned
+apnic|CN|ipv4|1.0.8.0|2048|20110412|available
+apnic|JP|ipv4|1.0.16.0|4096|20110412|allocated
+apnic|CN|ipv6|2001:250::1|35|20000426|allocated
+apnic|CN|asn|4134|1|20020801|allocated
+`), []string{"cn"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := netsString(v4), "1.0.1.0/24 1.0.2.0/23 1.0.4.0/24"; got != want {
+		t.Errorf("v4 = %v, want %v", got, want)
+	}
+	if got, want := netsString(v6), "2001:250::/35"; got != want {
+		t.Errorf("v6 = %v, want %v", got, want)
+	}
+}
+
+func TestParseDelegatedError(t *testing.T) {
+	tests := []struct {
+		line string
+		err  string
+	}{
+		{"apnic|CN|ipv4|1.0.1|256|0|allocated", `bad start "1.0.1"`},
+		{"apnic|CN|ipv4|1.0.1.0|x|0|allocated", `bad value "x"`},
+		{"apnic|CN|ipv4|1.0.1.0|0|0|allocated", "bad ipv4 range"},
+		{"apnic|CN|ipv4|255.255.255.0|257|0|allocated", "bad ipv4 range"},
+		{"apnic|CN|ipv4|2001:db8::|256|0|allocated", "bad ipv4 range"},
+		{"apnic|CN|ipv6|2001:db8::|129|0|allocated", "bad ipv6 prefix"},
+		{"apnic|CN|ipv6|1.0.1.0|24|0|allocated", "bad ipv6 prefix"},
+	}
+	for _, tt := range tests {
+		_, _, err := ParseDelegated(strings.NewReader("\n"+tt.line), []string{"CN"})
+		if want := fmt.Sprintf("line 2: %v", tt.err); err == nil || err.Error() != want {
+			t.Errorf("%v: err = %v, want %v", tt.line, err, want)
+		}
+	}
+}
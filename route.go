@@ -0,0 +1,76 @@
+package tcpguarder
+
+import (
+	"bufio"
+	"encoding/hex"
+	"io/ioutil"
+	"net"
+	"os"
+	"strings"
+)
+
+// DefaultGateways 从 route(/proc/net/route) 和 route6(/proc/net/ipv6_route) 读取默认网关,
+// route6 不存在时只返回 IPv4 网关
+func DefaultGateways(route, route6 string) ([]net.IP, error) {
+	var gws []net.IP
+	b, err := ioutil.ReadFile(route)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		cols := strings.Fields(line)
+		if len(cols) < 8 || cols[1] != "00000000" || cols[7] != "00000000" {
+			continue
+		}
+		ip, err := hex.DecodeString(cols[2])
+		if err != nil || len(ip) != 4 {
+			continue
+		}
+		//小端序
+		ip[0], ip[1], ip[2], ip[3] = ip[3], ip[2], ip[1], ip[0]
+		if !net.IP(ip).IsUnspecified() {
+			gws = append(gws, net.IP(ip))
+		}
+	}
+	b, err = ioutil.ReadFile(route6)
+	if err != nil {
+		//未启用 IPv6
+		return gws, nil
+	}
+	for _, line := range strings.Split(string(b), "\n") {
+		cols := strings.Fields(line)
+		if len(cols) < 10 || cols[1] != "00" || strings.Trim(cols[0], "0") != "" {
+			continue
+		}
+		ip, err := hex.DecodeString(cols[4])
+		if err != nil || len(ip) != 16 {
+			continue
+		}
+		if !net.IP(ip).IsUnspecified() {
+			gws = append(gws, net.IP(ip))
+		}
+	}
+	return gws, nil
+}
+
+// Nameservers 读取 resolv.conf 中的 nameserver
+func Nameservers(file string) ([]net.IP, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var ips []net.IP
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		cols := strings.Fields(scanner.Text())
+		if len(cols) < 2 || cols[0] != "nameserver" {
+			continue
+		}
+		//去掉 IPv6 zone, 例如 fe80::1%eth0
+		if ip := net.ParseIP(strings.SplitN(cols[1], "%", 2)[0]); ip != nil {
+			ips = append(ips, ip)
+		}
+	}
+	return ips, scanner.Err()
+}
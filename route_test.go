@@ -0,0 +1,73 @@
+package tcpguarder
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDefaultGateways(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	route := filepath.Join(dir, "route")
+	route6 := filepath.Join(dir, "ipv6_route")
+	//默认路由经 192.168.1.1, 另有一条直连路由和一条没有网关的默认路由
+	if err := ioutil.WriteFile(route, []byte(`Iface	Destination	Gateway 	Flags	RefCnt	Use	Metric	Mask		MTU	Window	IRTT
+eth0	00000000	0101A8C0	0003	0	0	100	00000000	0	0	0
+eth0	0001A8C0	00000000	0001	0	0	100	00FFFFFF	0	0	0
+tun0	00000000	00000000	0001	0	0	50	00000000	0	0	0
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(route6, []byte(`fe800000000000000000000000000000 40 00000000000000000000000000000000 00 00000000000000000000000000000000 00000100 00000001 00000000 00000001     eth0
+00000000000000000000000000000000 00 00000000000000000000000000000000 00 fe800000000000000000000000000001 00000400 00000001 00000000 00000003     eth0
+00000000000000000000000000000000 00 00000000000000000000000000000000 00 00000000000000000000000000000000 ffffffff 00000001 00000000 00200200       lo
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	gws, err := DefaultGateways(route, route6)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gws) != 2 || !gws[0].Equal(net.ParseIP("192.168.1.1")) || !gws[1].Equal(net.ParseIP("fe80::1")) {
+		t.Errorf("DefaultGateways = %v", gws)
+	}
+	//未启用 IPv6
+	gws, err = DefaultGateways(route, filepath.Join(dir, "nosuch"))
+	if err != nil || len(gws) != 1 {
+		t.Errorf("DefaultGateways without ipv6 = %v, %v", gws, err)
+	}
+	if _, err := DefaultGateways(filepath.Join(dir, "nosuch"), route6); err == nil {
+		t.Error("missing route file: no error")
+	}
+}
+
+func TestNameservers(t *testing.T) {
+	dir, err := ioutil.TempDir("", "tcpguarder")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "resolv.conf")
+	if err := ioutil.WriteFile(file, []byte(`# comment
+search example.com
+nameserver 8.8.8.8
+nameserver fe80::1%eth0
+nameserver bad
+options ndots:2
+`), 0644); err != nil {
+		t.Fatal(err)
+	}
+	ips, err := Nameservers(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ips) != 2 || !ips[0].Equal(net.ParseIP("8.8.8.8")) || !ips[1].Equal(net.ParseIP("fe80::1")) {
+		t.Errorf("Nameservers = %v", ips)
+	}
+}
@@ -0,0 +1,125 @@
+package tcpguarder
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Rule 一条异常连接规则,连接满足 Expr 时得 Weight 分
+type Rule struct {
+	Name   string
+	Weight int
+	Expr   string
+	match  Filter
+}
+
+func NewRule(name string, weight int, expr string) (Rule, error) {
+	f, err := ParseFilter(expr)
+	if err != nil {
+		return Rule{}, fmt.Errorf("rule %v: %v", name, err)
+	}
+	return Rule{Name: name, Weight: weight, Expr: expr, match: f}, nil
+}
+
+func (r Rule) Match(c ConnStat) bool {
+	return r.match != nil && r.match(c)
+}
+
+type RuleSet []Rule
+
+// DefaultRules 默认规则, 一个连接最多得1分, 与原来的异常连接数相同
+//
+//	abnormal  1  state == CLOSING or state == FIN_WAIT1 or (cwnd == 1 and txq != 0 and rxq != 0) or (timer == 1 and retrans > 3)
+var DefaultRules = mustParseRules(`
+abnormal  1  state == CLOSING or state == FIN_WAIT1 or (cwnd == 1 and txq != 0 and rxq != 0) or (timer == 1 and retrans > 3)
+`)
+
+// ParseRules 每行一条规则: 名称 权重 表达式, # 开头为注释
+func ParseRules(r io.Reader) (RuleSet, error) {
+	var rules RuleSet
+	scanner := bufio.NewScanner(r)
+	n := 0
+	for scanner.Scan() {
+		n++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		cols := strings.Fields(line)
+		if len(cols) < 3 {
+			return nil, fmt.Errorf("line %v: want: name weight expression", n)
+		}
+		weight, err := strconv.Atoi(cols[1])
+		if err != nil {
+			return nil, fmt.Errorf("line %v: bad weight %q", n, cols[1])
+		}
+		rule, err := NewRule(cols[0], weight, strings.Join(cols[2:], " "))
+		if err != nil {
+			return nil, fmt.Errorf("line %v: %v", n, err)
+		}
+		rules = append(rules, rule)
+	}
+	return rules, scanner.Err()
+}
+
+func LoadRules(file string) (RuleSet, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseRules(f)
+}
+
+func mustParseRules(s string) RuleSet {
+	rules, err := ParseRules(strings.NewReader(s))
+	if err != nil {
+		panic(err)
+	}
+	return rules
+}
+
+// Score 连接命中的所有规则权重之和
+func (rs RuleSet) Score(c ConnStat) int {
+	score := 0
+	for _, r := range rs {
+		if r.Match(c) {
+			score += r.Weight
+		}
+	}
+	return score
+}
+
+func (rs RuleSet) TopStats(stats []ConnStat, dstports []int) []CountItem {
+	return rs.TopFilter(stats, PortFilter(dstports))
+}
+
+// TopFilter 按远端IP汇总满足 f 的连接得分,倒序, 不含 LISTEN
+func (rs RuleSet) TopFilter(stats []ConnStat, f Filter) []CountItem {
+	ipn := make(map[string]int)
+	for _, c := range stats {
+		if c.Stat == LISTEN {
+			continue
+		}
+		if f != nil && !f(c) {
+			continue
+		}
+		if score := rs.Score(c); score != 0 {
+			ipn[c.Remote.IP.String()] += score
+		}
+	}
+	return sortCount(ipn)
+}
+
+func hasPort(ports []int, port uint16) bool {
+	for _, p := range ports {
+		if uint16(p) == port {
+			return true
+		}
+	}
+	return false
+}
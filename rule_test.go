@@ -0,0 +1,108 @@
+package tcpguarder
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseRules(t *testing.T) {
+	rules, err := ParseRules(strings.NewReader(`
+# comment
+closing  2  state == CLOSING or state == FIN_WAIT1
+
+zero     1  rxq == 0 and txq == 0
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 || rules[0].Name != "closing" || rules[0].Weight != 2 ||
+		rules[0].Expr != "state == CLOSING or state == FIN_WAIT1" {
+		t.Fatalf("ParseRules = %+v", rules)
+	}
+	tests := []struct {
+		stat TCPStat
+		want int
+	}{
+		{CLOSING, 3},
+		{FIN_WAIT1, 3},
+		{ESTABLISHED, 1},
+	}
+	for _, tt := range tests {
+		c := testConn("10.0.0.1", "203.0.113.9", 443, 51000, tt.stat)
+		if got := rules.Score(c); got != tt.want {
+			t.Errorf("Score(%v) = %v, want %v", tt.stat, got, tt.want)
+		}
+	}
+}
+
+func TestParseRulesError(t *testing.T) {
+	tests := []struct {
+		text string
+		err  string
+	}{
+		{"closing 1", "line 1: want: name weight expression"},
+		{"\nclosing x state closing", "line 2: bad weight"},
+		{"closing 1 state nosuch", "line 1: rule closing: unknown state"},
+	}
+	for _, tt := range tests {
+		_, err := ParseRules(strings.NewReader(tt.text))
+		if err == nil || !strings.HasPrefix(err.Error(), tt.err) {
+			t.Errorf("ParseRules(%q) = %v, want %v", tt.text, err, tt.err)
+		}
+	}
+}
+
+func TestRuleSetTopFilter(t *testing.T) {
+	stats := []ConnStat{
+		testConn("10.0.0.1", "203.0.113.1", 443, 1, CLOSING),
+		testConn("10.0.0.1", "203.0.113.1", 443, 2, CLOSING),
+		testConn("10.0.0.1", "203.0.113.2", 443, 3, FIN_WAIT1),
+		testConn("10.0.0.1", "203.0.113.3", 443, 4, ESTABLISHED),
+		testConn("10.0.0.1", "203.0.113.4", 80, 5, CLOSING),
+	}
+	got := DefaultRules.TopStats(stats, []int{443})
+	if len(got) != 2 || got[0].Key != "203.0.113.1" || got[0].N != 2 || got[1].Key != "203.0.113.2" || got[1].N != 1 {
+		t.Errorf("TopStats = %+v", got)
+	}
+}
+
+func TestDefaultRulesScore(t *testing.T) {
+	all := testConn("10.0.0.1", "203.0.113.9", 443, 1, FIN_WAIT1)
+	all.TxQueue, all.RxQueue, all.CongestionWindow = 10, 20, 1
+	all.TimerActive, all.RTOTimeouts = 1, 5
+	cwnd := testConn("10.0.0.1", "203.0.113.9", 443, 2, ESTABLISHED)
+	cwnd.TxQueue, cwnd.RxQueue, cwnd.CongestionWindow = 10, 20, 1
+	retrans := testConn("10.0.0.1", "203.0.113.9", 443, 3, ESTABLISHED)
+	retrans.TimerActive, retrans.RTOTimeouts = 1, 4
+	tests := []struct {
+		name string
+		c    ConnStat
+		want int
+	}{
+		{"all", all, 1}, //命中多个条件也只得1分
+		{"closing", testConn("10.0.0.1", "203.0.113.9", 443, 4, CLOSING), 1},
+		{"cwnd", cwnd, 1},
+		{"retrans", retrans, 1},
+		{"normal", testConn("10.0.0.1", "203.0.113.9", 443, 5, ESTABLISHED), 0},
+	}
+	for _, tt := range tests {
+		if got := DefaultRules.Score(tt.c); got != tt.want {
+			t.Errorf("%v: Score = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestRuleSetTopFilterListen(t *testing.T) {
+	rules, err := ParseRules(strings.NewReader("idle 1 rxq == 0 and txq == 0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stats := []ConnStat{
+		testConn("0.0.0.0", "0.0.0.0", 443, 0, LISTEN),
+		testConn("10.0.0.1", "203.0.113.1", 443, 1, ESTABLISHED),
+	}
+	got := rules.TopFilter(stats, nil)
+	if len(got) != 1 || got[0].Key != "203.0.113.1" || got[0].N != 1 {
+		t.Errorf("TopFilter = %+v, want LISTEN skipped", got)
+	}
+}
@@ -0,0 +1,105 @@
+package tcpguarder
+
+import (
+	"sort"
+	"time"
+)
+
+// ScanDetector 在滑动窗口内统计每个远端IP访问过的不同本地端口数
+type ScanDetector struct {
+	Window time.Duration
+	ips    map[string]*scanTrack
+}
+
+type scanTrack struct {
+	ports    map[uint16]time.Time //本地端口 -> 最后出现时间
+	halfopen map[string]time.Time //SYN_RECV/CLOSE 连接 -> 最后出现时间
+}
+
+type ScanItem struct {
+	IP       string
+	Ports    int //窗口内不同本地端口数
+	HalfOpen int //窗口内 SYN_RECV/CLOSE 连接数
+}
+
+func NewScanDetector(window time.Duration) *ScanDetector {
+	return &ScanDetector{
+		Window: window,
+		ips:    make(map[string]*scanTrack),
+	}
+}
+
+// Observe 记录一次采样,只统计连入本机监听端口的连接,忽略本机主动发起的连接
+func (d *ScanDetector) Observe(stats []ConnStat, now time.Time) {
+	listen := make(map[uint16]bool)
+	for _, c := range stats {
+		if c.Stat == LISTEN {
+			listen[c.Local.Port] = true
+		}
+	}
+	for _, c := range stats {
+		if c.Stat == LISTEN {
+			continue
+		}
+		if !listen[c.Local.Port] && c.Stat != SYN_RECV {
+			continue
+		}
+		ip := c.Remote.IP.String()
+		t, ok := d.ips[ip]
+		if !ok {
+			t = &scanTrack{
+				ports:    make(map[uint16]time.Time),
+				halfopen: make(map[string]time.Time),
+			}
+			d.ips[ip] = t
+		}
+		t.ports[c.Local.Port] = now
+		switch c.Stat {
+		case SYN_RECV, CLOSE:
+			t.halfopen[c.Local.String()+"-"+c.Remote.String()] = now
+		}
+	}
+	d.expire(now)
+}
+
+func (d *ScanDetector) expire(now time.Time) {
+	for ip, t := range d.ips {
+		for port, last := range t.ports {
+			if now.Sub(last) > d.Window {
+				delete(t.ports, port)
+			}
+		}
+		for conn, last := range t.halfopen {
+			if now.Sub(last) > d.Window {
+				delete(t.halfopen, conn)
+			}
+		}
+		if len(t.ports) == 0 {
+			delete(d.ips, ip)
+		}
+	}
+}
+
+// Scanners 返回窗口内不同本地端口数 >= threshold 的IP,按端口数倒序
+func (d *ScanDetector) Scanners(threshold int) []ScanItem {
+	items := make([]ScanItem, 0)
+	for ip, t := range d.ips {
+		if len(t.ports) < threshold {
+			continue
+		}
+		items = append(items, ScanItem{
+			IP:       ip,
+			Ports:    len(t.ports),
+			HalfOpen: len(t.halfopen),
+		})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Ports > items[j].Ports
+	})
+	return items
+}
+
+// Forget 清除IP的统计,通常在封禁之后调用
+func (d *ScanDetector) Forget(ip string) {
+	delete(d.ips, ip)
+}
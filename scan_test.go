@@ -0,0 +1,122 @@
+package tcpguarder
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScanDetector(t *testing.T) {
+	listen := func(port uint16) ConnStat {
+		return testConn("0.0.0.0", "0.0.0.0", port, 0, LISTEN)
+	}
+	conn := func(remote string, lport uint16, stat TCPStat) ConnStat {
+		return testConn("10.0.0.1", remote, lport, 50000+lport, stat)
+	}
+	base := []ConnStat{listen(22), listen(80), listen(443)}
+	t0 := time.Unix(1600000000, 0)
+	tests := []struct {
+		name      string
+		samples   [][]ConnStat //每秒一次采样
+		threshold int
+		want      []ScanItem
+	}{
+		{
+			name: "distinct ports",
+			samples: [][]ConnStat{{
+				conn("203.0.113.1", 22, ESTABLISHED),
+				conn("203.0.113.1", 80, SYN_RECV),
+				conn("203.0.113.1", 443, CLOSE),
+				conn("203.0.113.2", 80, ESTABLISHED),
+			}},
+			threshold: 3,
+			want:      []ScanItem{{IP: "203.0.113.1", Ports: 3, HalfOpen: 2}},
+		},
+		{
+			name: "same port counted once",
+			samples: [][]ConnStat{
+				{conn("203.0.113.1", 80, ESTABLISHED), testConn("10.0.0.1", "203.0.113.1", 80, 1, ESTABLISHED)},
+				{conn("203.0.113.1", 80, TIME_WAIT)},
+			},
+			threshold: 2,
+		},
+		{
+			name: "ports add up across samples",
+			samples: [][]ConnStat{
+				{conn("203.0.113.1", 22, ESTABLISHED)},
+				{conn("203.0.113.1", 80, ESTABLISHED)},
+				{conn("203.0.113.1", 443, ESTABLISHED)},
+			},
+			threshold: 3,
+			want:      []ScanItem{{IP: "203.0.113.1", Ports: 3}},
+		},
+		{
+			name: "below threshold",
+			samples: [][]ConnStat{
+				{conn("203.0.113.1", 22, ESTABLISHED), conn("203.0.113.1", 80, ESTABLISHED)},
+			},
+			threshold: 3,
+		},
+		{
+			name: "outbound and unlistened ports ignored",
+			samples: [][]ConnStat{{
+				testConn("10.0.0.1", "203.0.113.1", 40001, 22, ESTABLISHED),
+				testConn("10.0.0.1", "203.0.113.1", 40002, 80, ESTABLISHED),
+				conn("203.0.113.1", 8080, SYN_RECV), //未监听端口的 SYN_RECV 仍然计入
+			}},
+			threshold: 1,
+			want:      []ScanItem{{IP: "203.0.113.1", Ports: 1, HalfOpen: 1}},
+		},
+		{
+			name: "window expiry",
+			samples: [][]ConnStat{
+				{conn("203.0.113.1", 22, ESTABLISHED)},
+				{conn("203.0.113.1", 80, ESTABLISHED)},
+				nil, nil, nil, nil, //6s 时 22 端口超出 5s 窗口, 80 端口还在
+				{conn("203.0.113.1", 443, ESTABLISHED)},
+			},
+			threshold: 2,
+			want:      []ScanItem{{IP: "203.0.113.1", Ports: 2}},
+		},
+	}
+	for _, tt := range tests {
+		d := NewScanDetector(5 * time.Second)
+		for i, s := range tt.samples {
+			d.Observe(append(append([]ConnStat{}, base...), s...), t0.Add(time.Duration(i)*time.Second))
+		}
+		got := d.Scanners(tt.threshold)
+		if len(got) != len(tt.want) {
+			t.Errorf("%v: Scanners = %+v, want %+v", tt.name, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%v: Scanners = %+v, want %+v", tt.name, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestScanDetectorExpireForget(t *testing.T) {
+	d := NewScanDetector(time.Minute)
+	t0 := time.Unix(1600000000, 0)
+	stats := []ConnStat{
+		testConn("0.0.0.0", "0.0.0.0", 22, 0, LISTEN),
+		testConn("0.0.0.0", "0.0.0.0", 80, 0, LISTEN),
+		testConn("10.0.0.1", "203.0.113.1", 22, 1, SYN_RECV),
+		testConn("10.0.0.1", "203.0.113.1", 80, 2, SYN_RECV),
+		testConn("10.0.0.1", "203.0.113.2", 80, 3, ESTABLISHED),
+	}
+	d.Observe(stats, t0)
+	if got := d.Scanners(2); len(got) != 1 || got[0].IP != "203.0.113.1" {
+		t.Fatalf("Scanners = %+v", got)
+	}
+	d.Forget("203.0.113.1")
+	if got := d.Scanners(1); len(got) != 1 || got[0].IP != "203.0.113.2" {
+		t.Errorf("after Forget Scanners = %+v", got)
+	}
+	//窗口过后没有新连接的IP被清除
+	d.Observe(nil, t0.Add(time.Minute+time.Second))
+	if len(d.ips) != 0 {
+		t.Errorf("%v ips left after window", len(d.ips))
+	}
+}
@@ -3,27 +3,33 @@ package tcpguarder
 import "sort"
 
 func Top(dstports []int) ([]CountItem, error) {
-	allport := len(dstports) == 0
-	ipn := make(map[string]int)
 	stats, err := ConnStats()
 	if err != nil {
 		return nil, err
 	}
+	return TopStats(stats, dstports), nil
+}
+
+func TopStats(stats []ConnStat, dstports []int) []CountItem {
+	return TopFilter(stats, PortFilter(dstports))
+}
+
+// TopFilter 按远端IP统计满足 f 的连接数, 不含 LISTEN
+func TopFilter(stats []ConnStat, f Filter) []CountItem {
+	ipn := make(map[string]int)
 	for _, c := range stats {
 		if c.Stat == LISTEN {
 			continue
 		}
-		if allport {
-			ipn[c.Remote.IP.String()]++
+		if f != nil && !f(c) {
 			continue
 		}
-		for _, port := range dstports {
-			if c.Local.Port == uint16(port) {
-				ipn[c.Remote.IP.String()]++
-				break
-			}
-		}
+		ipn[c.Remote.IP.String()]++
 	}
+	return sortCount(ipn)
+}
+
+func sortCount(ipn map[string]int) []CountItem {
 	iptop := make([]CountItem, 0)
 	for k, v := range ipn {
 		iptop = append(iptop, CountItem{
@@ -34,7 +40,7 @@ func Top(dstports []int) ([]CountItem, error) {
 	sort.Slice(iptop, func(i, j int) bool {
 		return iptop[i].N > iptop[j].N
 	})
-	return iptop, nil
+	return iptop
 }
 
 type CountItem struct {